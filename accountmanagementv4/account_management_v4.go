@@ -194,7 +194,7 @@ func (accountManagement *AccountManagementV4) GetAccountWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "account_management", "V4", "GetAccount"))
 	builder.EnableGzipCompression = accountManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(accountManagement.Service.Options.URL, `/v4/accounts/{account_id}`, pathParamsMap)
 	if err != nil {
@@ -222,7 +222,7 @@ func (accountManagement *AccountManagementV4) GetAccountWithContext(ctx context.
 	response, err = accountManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getAccount", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,36 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package accountmanagementv4
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AccountManagementV4Intf : The operations of the AccountManagementV4 service client.
+// The interface is satisfied by *AccountManagementV4 and by mocks.AccountManagementV4, allowing consumers to substitute a mock for the client in tests.
+type AccountManagementV4Intf interface {
+	// GetAccount : Get Account by Account ID
+	GetAccount(getAccountOptions *GetAccountOptions) (result *AccountResponse, response *core.DetailedResponse, err error)
+	// GetAccountWithContext is an alternate form of the GetAccount method which supports a Context parameter
+	GetAccountWithContext(ctx context.Context, getAccountOptions *GetAccountOptions) (result *AccountResponse, response *core.DetailedResponse, err error)
+}
+
+var _ AccountManagementV4Intf = (*AccountManagementV4)(nil)
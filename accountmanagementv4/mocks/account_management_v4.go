@@ -0,0 +1,65 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the AccountManagementV4 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/accountmanagementv4"
+	"github.com/stretchr/testify/mock"
+)
+
+// AccountManagementV4 : A testify mock of the AccountManagementV4 service client.
+type AccountManagementV4 struct {
+	mock.Mock
+}
+
+var _ accountmanagementv4.AccountManagementV4Intf = (*AccountManagementV4)(nil)
+
+// GetAccount provides a mock function with the given fields.
+func (_m *AccountManagementV4) GetAccount(getAccountOptions *accountmanagementv4.GetAccountOptions) (*accountmanagementv4.AccountResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountOptions)
+	var r0 *accountmanagementv4.AccountResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*accountmanagementv4.AccountResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountWithContext provides a mock function with the given fields.
+func (_m *AccountManagementV4) GetAccountWithContext(ctx context.Context, getAccountOptions *accountmanagementv4.GetAccountOptions) (*accountmanagementv4.AccountResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountOptions)
+	var r0 *accountmanagementv4.AccountResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*accountmanagementv4.AccountResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
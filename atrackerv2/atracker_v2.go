@@ -233,7 +233,7 @@ func (atracker *AtrackerV2) CreateTargetWithContext(ctx context.Context, createT
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "CreateTarget"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/targets`, nil)
 	if err != nil {
@@ -293,7 +293,7 @@ func (atracker *AtrackerV2) CreateTargetWithContext(ctx context.Context, createT
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -330,7 +330,7 @@ func (atracker *AtrackerV2) ListTargetsWithContext(ctx context.Context, listTarg
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "ListTargets"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/targets`, nil)
 	if err != nil {
@@ -362,7 +362,7 @@ func (atracker *AtrackerV2) ListTargetsWithContext(ctx context.Context, listTarg
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_targets", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -406,7 +406,7 @@ func (atracker *AtrackerV2) GetTargetWithContext(ctx context.Context, getTargetO
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "GetTarget"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/targets/{id}`, pathParamsMap)
 	if err != nil {
@@ -434,7 +434,7 @@ func (atracker *AtrackerV2) GetTargetWithContext(ctx context.Context, getTargetO
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -478,7 +478,7 @@ func (atracker *AtrackerV2) ReplaceTargetWithContext(ctx context.Context, replac
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "ReplaceTarget"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/targets/{id}`, pathParamsMap)
 	if err != nil {
@@ -529,7 +529,7 @@ func (atracker *AtrackerV2) ReplaceTargetWithContext(ctx context.Context, replac
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -570,7 +570,7 @@ func (atracker *AtrackerV2) DeleteTargetWithContext(ctx context.Context, deleteT
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "DeleteTarget"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/targets/{id}`, pathParamsMap)
 	if err != nil {
@@ -598,7 +598,7 @@ func (atracker *AtrackerV2) DeleteTargetWithContext(ctx context.Context, deleteT
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -640,7 +640,7 @@ func (atracker *AtrackerV2) ValidateTargetWithContext(ctx context.Context, valid
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "ValidateTarget"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/targets/{id}/validate`, pathParamsMap)
 	if err != nil {
@@ -668,7 +668,7 @@ func (atracker *AtrackerV2) ValidateTargetWithContext(ctx context.Context, valid
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "validate_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -708,7 +708,7 @@ func (atracker *AtrackerV2) CreateRouteWithContext(ctx context.Context, createRo
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "CreateRoute"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/routes`, nil)
 	if err != nil {
@@ -753,7 +753,7 @@ func (atracker *AtrackerV2) CreateRouteWithContext(ctx context.Context, createRo
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -787,7 +787,7 @@ func (atracker *AtrackerV2) ListRoutesWithContext(ctx context.Context, listRoute
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "ListRoutes"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/routes`, nil)
 	if err != nil {
@@ -815,7 +815,7 @@ func (atracker *AtrackerV2) ListRoutesWithContext(ctx context.Context, listRoute
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_routes", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -858,7 +858,7 @@ func (atracker *AtrackerV2) GetRouteWithContext(ctx context.Context, getRouteOpt
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "GetRoute"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/routes/{id}`, pathParamsMap)
 	if err != nil {
@@ -886,7 +886,7 @@ func (atracker *AtrackerV2) GetRouteWithContext(ctx context.Context, getRouteOpt
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -930,7 +930,7 @@ func (atracker *AtrackerV2) ReplaceRouteWithContext(ctx context.Context, replace
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "ReplaceRoute"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/routes/{id}`, pathParamsMap)
 	if err != nil {
@@ -975,7 +975,7 @@ func (atracker *AtrackerV2) ReplaceRouteWithContext(ctx context.Context, replace
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1016,7 +1016,7 @@ func (atracker *AtrackerV2) DeleteRouteWithContext(ctx context.Context, deleteRo
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "DeleteRoute"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/routes/{id}`, pathParamsMap)
 	if err != nil {
@@ -1042,7 +1042,7 @@ func (atracker *AtrackerV2) DeleteRouteWithContext(ctx context.Context, deleteRo
 	response, err = atracker.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1066,7 +1066,7 @@ func (atracker *AtrackerV2) GetSettingsWithContext(ctx context.Context, getSetti
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "GetSettings"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/settings`, nil)
 	if err != nil {
@@ -1094,7 +1094,7 @@ func (atracker *AtrackerV2) GetSettingsWithContext(ctx context.Context, getSetti
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1134,7 +1134,7 @@ func (atracker *AtrackerV2) PutSettingsWithContext(ctx context.Context, putSetti
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "atracker", "V2", "PutSettings"))
 	builder.EnableGzipCompression = atracker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(atracker.Service.Options.URL, `/api/v2/settings`, nil)
 	if err != nil {
@@ -1185,7 +1185,7 @@ func (atracker *AtrackerV2) PutSettingsWithContext(ctx context.Context, putSetti
 	response, err = atracker.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "put_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
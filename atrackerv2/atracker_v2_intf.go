@@ -0,0 +1,84 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package atrackerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AtrackerV2Intf : The operations of the AtrackerV2 service client.
+// The interface is satisfied by *AtrackerV2 and by mocks.AtrackerV2, allowing consumers to substitute a mock for the client in tests.
+type AtrackerV2Intf interface {
+	// CreateTarget : Create a target
+	CreateTarget(createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// CreateTargetWithContext is an alternate form of the CreateTarget method which supports a Context parameter
+	CreateTargetWithContext(ctx context.Context, createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// ListTargets : List targets
+	ListTargets(listTargetsOptions *ListTargetsOptions) (result *TargetList, response *core.DetailedResponse, err error)
+	// ListTargetsWithContext is an alternate form of the ListTargets method which supports a Context parameter
+	ListTargetsWithContext(ctx context.Context, listTargetsOptions *ListTargetsOptions) (result *TargetList, response *core.DetailedResponse, err error)
+	// GetTarget : Get details of a target
+	GetTarget(getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// GetTargetWithContext is an alternate form of the GetTarget method which supports a Context parameter
+	GetTargetWithContext(ctx context.Context, getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// ReplaceTarget : Update a target
+	ReplaceTarget(replaceTargetOptions *ReplaceTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// ReplaceTargetWithContext is an alternate form of the ReplaceTarget method which supports a Context parameter
+	ReplaceTargetWithContext(ctx context.Context, replaceTargetOptions *ReplaceTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// DeleteTarget : Delete a target
+	DeleteTarget(deleteTargetOptions *DeleteTargetOptions) (result *WarningReport, response *core.DetailedResponse, err error)
+	// DeleteTargetWithContext is an alternate form of the DeleteTarget method which supports a Context parameter
+	DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *DeleteTargetOptions) (result *WarningReport, response *core.DetailedResponse, err error)
+	// ValidateTarget : Validate a target
+	ValidateTarget(validateTargetOptions *ValidateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// ValidateTargetWithContext is an alternate form of the ValidateTarget method which supports a Context parameter
+	ValidateTargetWithContext(ctx context.Context, validateTargetOptions *ValidateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// CreateRoute : Create a route
+	CreateRoute(createRouteOptions *CreateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// CreateRouteWithContext is an alternate form of the CreateRoute method which supports a Context parameter
+	CreateRouteWithContext(ctx context.Context, createRouteOptions *CreateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// ListRoutes : List routes
+	ListRoutes(listRoutesOptions *ListRoutesOptions) (result *RouteList, response *core.DetailedResponse, err error)
+	// ListRoutesWithContext is an alternate form of the ListRoutes method which supports a Context parameter
+	ListRoutesWithContext(ctx context.Context, listRoutesOptions *ListRoutesOptions) (result *RouteList, response *core.DetailedResponse, err error)
+	// GetRoute : Get details of a route
+	GetRoute(getRouteOptions *GetRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// GetRouteWithContext is an alternate form of the GetRoute method which supports a Context parameter
+	GetRouteWithContext(ctx context.Context, getRouteOptions *GetRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// ReplaceRoute : Update a route
+	ReplaceRoute(replaceRouteOptions *ReplaceRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// ReplaceRouteWithContext is an alternate form of the ReplaceRoute method which supports a Context parameter
+	ReplaceRouteWithContext(ctx context.Context, replaceRouteOptions *ReplaceRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// DeleteRoute : Delete a route
+	DeleteRoute(deleteRouteOptions *DeleteRouteOptions) (response *core.DetailedResponse, err error)
+	// DeleteRouteWithContext is an alternate form of the DeleteRoute method which supports a Context parameter
+	DeleteRouteWithContext(ctx context.Context, deleteRouteOptions *DeleteRouteOptions) (response *core.DetailedResponse, err error)
+	// GetSettings : Get settings
+	GetSettings(getSettingsOptions *GetSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+	// GetSettingsWithContext is an alternate form of the GetSettings method which supports a Context parameter
+	GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+	// PutSettings : Modify settings
+	PutSettings(putSettingsOptions *PutSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+	// PutSettingsWithContext is an alternate form of the PutSettings method which supports a Context parameter
+	PutSettingsWithContext(ctx context.Context, putSettingsOptions *PutSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+}
+
+var _ AtrackerV2Intf = (*AtrackerV2)(nil)
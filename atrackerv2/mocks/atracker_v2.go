@@ -0,0 +1,420 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the AtrackerV2 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/stretchr/testify/mock"
+)
+
+// AtrackerV2 : A testify mock of the AtrackerV2 service client.
+type AtrackerV2 struct {
+	mock.Mock
+}
+
+var _ atrackerv2.AtrackerV2Intf = (*AtrackerV2)(nil)
+
+// CreateTarget provides a mock function with the given fields.
+func (_m *AtrackerV2) CreateTarget(createTargetOptions *atrackerv2.CreateTargetOptions) (*atrackerv2.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(createTargetOptions)
+	var r0 *atrackerv2.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTargetWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) CreateTargetWithContext(ctx context.Context, createTargetOptions *atrackerv2.CreateTargetOptions) (*atrackerv2.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createTargetOptions)
+	var r0 *atrackerv2.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTargets provides a mock function with the given fields.
+func (_m *AtrackerV2) ListTargets(listTargetsOptions *atrackerv2.ListTargetsOptions) (*atrackerv2.TargetList, *core.DetailedResponse, error) {
+	ret := _m.Called(listTargetsOptions)
+	var r0 *atrackerv2.TargetList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.TargetList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTargetsWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) ListTargetsWithContext(ctx context.Context, listTargetsOptions *atrackerv2.ListTargetsOptions) (*atrackerv2.TargetList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listTargetsOptions)
+	var r0 *atrackerv2.TargetList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.TargetList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTarget provides a mock function with the given fields.
+func (_m *AtrackerV2) GetTarget(getTargetOptions *atrackerv2.GetTargetOptions) (*atrackerv2.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(getTargetOptions)
+	var r0 *atrackerv2.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTargetWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) GetTargetWithContext(ctx context.Context, getTargetOptions *atrackerv2.GetTargetOptions) (*atrackerv2.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getTargetOptions)
+	var r0 *atrackerv2.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceTarget provides a mock function with the given fields.
+func (_m *AtrackerV2) ReplaceTarget(replaceTargetOptions *atrackerv2.ReplaceTargetOptions) (*atrackerv2.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceTargetOptions)
+	var r0 *atrackerv2.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceTargetWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) ReplaceTargetWithContext(ctx context.Context, replaceTargetOptions *atrackerv2.ReplaceTargetOptions) (*atrackerv2.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceTargetOptions)
+	var r0 *atrackerv2.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTarget provides a mock function with the given fields.
+func (_m *AtrackerV2) DeleteTarget(deleteTargetOptions *atrackerv2.DeleteTargetOptions) (*atrackerv2.WarningReport, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteTargetOptions)
+	var r0 *atrackerv2.WarningReport
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.WarningReport)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTargetWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *atrackerv2.DeleteTargetOptions) (*atrackerv2.WarningReport, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteTargetOptions)
+	var r0 *atrackerv2.WarningReport
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.WarningReport)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ValidateTarget provides a mock function with the given fields.
+func (_m *AtrackerV2) ValidateTarget(validateTargetOptions *atrackerv2.ValidateTargetOptions) (*atrackerv2.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(validateTargetOptions)
+	var r0 *atrackerv2.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ValidateTargetWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) ValidateTargetWithContext(ctx context.Context, validateTargetOptions *atrackerv2.ValidateTargetOptions) (*atrackerv2.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, validateTargetOptions)
+	var r0 *atrackerv2.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRoute provides a mock function with the given fields.
+func (_m *AtrackerV2) CreateRoute(createRouteOptions *atrackerv2.CreateRouteOptions) (*atrackerv2.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(createRouteOptions)
+	var r0 *atrackerv2.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRouteWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) CreateRouteWithContext(ctx context.Context, createRouteOptions *atrackerv2.CreateRouteOptions) (*atrackerv2.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRouteOptions)
+	var r0 *atrackerv2.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoutes provides a mock function with the given fields.
+func (_m *AtrackerV2) ListRoutes(listRoutesOptions *atrackerv2.ListRoutesOptions) (*atrackerv2.RouteList, *core.DetailedResponse, error) {
+	ret := _m.Called(listRoutesOptions)
+	var r0 *atrackerv2.RouteList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.RouteList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoutesWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) ListRoutesWithContext(ctx context.Context, listRoutesOptions *atrackerv2.ListRoutesOptions) (*atrackerv2.RouteList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listRoutesOptions)
+	var r0 *atrackerv2.RouteList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.RouteList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRoute provides a mock function with the given fields.
+func (_m *AtrackerV2) GetRoute(getRouteOptions *atrackerv2.GetRouteOptions) (*atrackerv2.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(getRouteOptions)
+	var r0 *atrackerv2.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRouteWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) GetRouteWithContext(ctx context.Context, getRouteOptions *atrackerv2.GetRouteOptions) (*atrackerv2.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRouteOptions)
+	var r0 *atrackerv2.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceRoute provides a mock function with the given fields.
+func (_m *AtrackerV2) ReplaceRoute(replaceRouteOptions *atrackerv2.ReplaceRouteOptions) (*atrackerv2.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceRouteOptions)
+	var r0 *atrackerv2.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceRouteWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) ReplaceRouteWithContext(ctx context.Context, replaceRouteOptions *atrackerv2.ReplaceRouteOptions) (*atrackerv2.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceRouteOptions)
+	var r0 *atrackerv2.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteRoute provides a mock function with the given fields.
+func (_m *AtrackerV2) DeleteRoute(deleteRouteOptions *atrackerv2.DeleteRouteOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRouteOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteRouteWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) DeleteRouteWithContext(ctx context.Context, deleteRouteOptions *atrackerv2.DeleteRouteOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRouteOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetSettings provides a mock function with the given fields.
+func (_m *AtrackerV2) GetSettings(getSettingsOptions *atrackerv2.GetSettingsOptions) (*atrackerv2.Settings, *core.DetailedResponse, error) {
+	ret := _m.Called(getSettingsOptions)
+	var r0 *atrackerv2.Settings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Settings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetSettingsWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) GetSettingsWithContext(ctx context.Context, getSettingsOptions *atrackerv2.GetSettingsOptions) (*atrackerv2.Settings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getSettingsOptions)
+	var r0 *atrackerv2.Settings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Settings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PutSettings provides a mock function with the given fields.
+func (_m *AtrackerV2) PutSettings(putSettingsOptions *atrackerv2.PutSettingsOptions) (*atrackerv2.Settings, *core.DetailedResponse, error) {
+	ret := _m.Called(putSettingsOptions)
+	var r0 *atrackerv2.Settings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Settings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PutSettingsWithContext provides a mock function with the given fields.
+func (_m *AtrackerV2) PutSettingsWithContext(ctx context.Context, putSettingsOptions *atrackerv2.PutSettingsOptions) (*atrackerv2.Settings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, putSettingsOptions)
+	var r0 *atrackerv2.Settings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*atrackerv2.Settings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
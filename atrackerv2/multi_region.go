@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// SupportedRegions : The regions in which Activity Tracker is available, as accepted by GetServiceURLForRegion.
+var SupportedRegions = common.SupportedRegions(GetServiceURLForRegion)
+
+// MultiRegionAtrackerV2Options : Service options
+type MultiRegionAtrackerV2Options struct {
+	// The regions to query. Defaults to SupportedRegions.
+	Regions []string
+
+	// Use the private endpoints of the regions.
+	Private bool
+
+	// Authenticator to use for requests made by every regional client.
+	Authenticator core.Authenticator `validate:"required"`
+
+	// The maximum number of regions queried at the same time. Defaults to common.DefaultRegionConcurrency.
+	Concurrency int
+}
+
+// MultiRegionAtrackerV2 : Queries Activity Tracker in several regions concurrently and merges the results.
+// When some regions fail, the results of the others are returned with a *common.MultiRegionError.
+type MultiRegionAtrackerV2 struct {
+	common.MultiRegionClient[*AtrackerV2]
+}
+
+// RegionalTarget : A target, with the region that returned it.
+type RegionalTarget = common.Regional[Target]
+
+// RegionalRoute : A route, with the region that returned it.
+type RegionalRoute = common.Regional[Route]
+
+// RegionalSettings : The settings returned by a region.
+type RegionalSettings = common.Regional[Settings]
+
+// NewMultiRegionAtrackerV2 : constructs an instance of MultiRegionAtrackerV2 with one client per region.
+func NewMultiRegionAtrackerV2(options *MultiRegionAtrackerV2Options) (service *MultiRegionAtrackerV2, err error) {
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	regions := options.Regions
+	if len(regions) == 0 {
+		regions = SupportedRegions
+	}
+	client, err := common.NewMultiRegionClient(regions, options.Private, GetServiceURLForRegion, func(url string) (*AtrackerV2, error) {
+		return NewAtrackerV2(&AtrackerV2Options{URL: url, Authenticator: options.Authenticator})
+	})
+	if err != nil {
+		return
+	}
+	client.Concurrency = options.Concurrency
+	service = &MultiRegionAtrackerV2{MultiRegionClient: *client}
+	return
+}
+
+// ListTargets lists the targets of every region. A target returned by several regions is reported once,
+// with the first region, in the order of Regions, that returned it.
+func (service *MultiRegionAtrackerV2) ListTargets(ctx context.Context) ([]RegionalTarget, error) {
+	return common.ListAcrossRegions(ctx, &service.MultiRegionClient, func(ctx context.Context, client *AtrackerV2) ([]Target, error) {
+		result, _, err := client.ListTargetsWithContext(ctx, &ListTargetsOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return result.Targets, nil
+	}, func(target *Target) string {
+		return core.StringNilMapper(target.ID)
+	})
+}
+
+// GetTarget looks up a target in every region and returns it with the first region, in the order of Regions,
+// that has it. Regions that do not know the target are not reported as failures.
+func (service *MultiRegionAtrackerV2) GetTarget(ctx context.Context, id string) (target *RegionalTarget, err error) {
+	target, err = common.FindInRegions(ctx, &service.MultiRegionClient, func(ctx context.Context, client *AtrackerV2) (*Target, *core.DetailedResponse, error) {
+		return client.GetTargetWithContext(ctx, &GetTargetOptions{ID: core.StringPtr(id)})
+	})
+	if target == nil && err == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("target '%s' was not found in any region", id), "target-not-found", common.GetComponentInfo())
+	}
+	return
+}
+
+// ListRoutes lists the routes of every region. A route returned by several regions is reported once,
+// with the first region, in the order of Regions, that returned it.
+func (service *MultiRegionAtrackerV2) ListRoutes(ctx context.Context) ([]RegionalRoute, error) {
+	return common.ListAcrossRegions(ctx, &service.MultiRegionClient, func(ctx context.Context, client *AtrackerV2) ([]Route, error) {
+		result, _, err := client.ListRoutesWithContext(ctx, &ListRoutesOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return result.Routes, nil
+	}, func(route *Route) string {
+		return core.StringNilMapper(route.ID)
+	})
+}
+
+// GetRoute looks up a route in every region and returns it with the first region, in the order of Regions,
+// that has it. Regions that do not know the route are not reported as failures.
+func (service *MultiRegionAtrackerV2) GetRoute(ctx context.Context, id string) (route *RegionalRoute, err error) {
+	route, err = common.FindInRegions(ctx, &service.MultiRegionClient, func(ctx context.Context, client *AtrackerV2) (*Route, *core.DetailedResponse, error) {
+		return client.GetRouteWithContext(ctx, &GetRouteOptions{ID: core.StringPtr(id)})
+	})
+	if route == nil && err == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("route '%s' was not found in any region", id), "route-not-found", common.GetComponentInfo())
+	}
+	return
+}
+
+// GetSettings returns the settings of every region, in the order of Regions.
+func (service *MultiRegionAtrackerV2) GetSettings(ctx context.Context) ([]RegionalSettings, error) {
+	return common.GetAcrossRegions(ctx, &service.MultiRegionClient, func(ctx context.Context, client *AtrackerV2) (*Settings, *core.DetailedResponse, error) {
+		return client.GetSettingsWithContext(ctx, &GetSettingsOptions{})
+	})
+}
@@ -0,0 +1,82 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`MultiRegionAtrackerV2`, func() {
+	It(`Creates a client for every supported region`, func() {
+		Expect(atrackerv2.SupportedRegions).To(ContainElement("eu-de"))
+		service, err := atrackerv2.NewMultiRegionAtrackerV2(&atrackerv2.MultiRegionAtrackerV2Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			Private:       true,
+		})
+		Expect(err).To(BeNil())
+		Expect(service.Clients).To(HaveLen(len(atrackerv2.SupportedRegions)))
+		Expect(service.Client("eu-de").GetServiceURL()).To(Equal("https://private.eu-de.atracker.cloud.ibm.com"))
+
+		_, err = atrackerv2.NewMultiRegionAtrackerV2(&atrackerv2.MultiRegionAtrackerV2Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			Regions:       []string{"mars-north"},
+		})
+		Expect(err).ToNot(BeNil())
+	})
+
+	// The merging of regional results is tested with common.MultiRegionClient; this only checks that
+	// each operation is sent to the regional client.
+	It(`Sends each operation to the regional client`, func() {
+		var paths []string
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			paths = append(paths, req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"id": "x"}`)
+		}))
+		defer server.Close()
+		client, err := atrackerv2.NewAtrackerV2(&atrackerv2.AtrackerV2Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		Expect(err).To(BeNil())
+		service := &atrackerv2.MultiRegionAtrackerV2{MultiRegionClient: common.MultiRegionClient[*atrackerv2.AtrackerV2]{
+			Regions: []string{"us-south"},
+			Clients: map[string]*atrackerv2.AtrackerV2{"us-south": client},
+		}}
+
+		_, err = service.ListTargets(context.Background())
+		Expect(err).To(BeNil())
+		target, err := service.GetTarget(context.Background(), "x")
+		Expect(err).To(BeNil())
+		Expect(target.Region).To(Equal("us-south"))
+		_, err = service.ListRoutes(context.Background())
+		Expect(err).To(BeNil())
+		route, err := service.GetRoute(context.Background(), "x")
+		Expect(err).To(BeNil())
+		Expect(*route.Value.ID).To(Equal("x"))
+		settings, err := service.GetSettings(context.Background())
+		Expect(err).To(BeNil())
+		Expect(settings).To(HaveLen(1))
+		Expect(paths).To(Equal([]string{"/api/v2/targets", "/api/v2/targets/x", "/api/v2/routes", "/api/v2/routes/x", "/api/v2/settings"}))
+	})
+})
@@ -0,0 +1,244 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Special values of Rule.Locations.
+const (
+	RouteLocationGlobalConst = "global"
+	RouteLocationAnyConst    = "*"
+)
+
+// RouteEvent : A synthetic Activity Tracker event, used to evaluate routes locally.
+type RouteEvent struct {
+	// The location in which the event is generated, such as "us-south". When empty, the location is taken from SourceCRN.
+	Region string
+
+	// The CRN of the resource that generated the event.
+	SourceCRN string
+
+	// Set for global events, which are routed with the location "global".
+	Global bool
+}
+
+// Location returns the location used to match the event against the locations of a rule.
+func (event *RouteEvent) Location() string {
+	if event.Global {
+		return RouteLocationGlobalConst
+	}
+	if event.Region != "" {
+		return event.Region
+	}
+	crn, err := common.ParseCRN(event.SourceCRN)
+	if err != nil {
+		return ""
+	}
+	return crn.Location
+}
+
+// RouteMatch : The outcome of evaluating one route for an event.
+type RouteMatch struct {
+	// The evaluated route.
+	Route *Route
+
+	// The index of the first rule of the route that matched the event, or -1 if none did.
+	RuleIndex int
+
+	// The rule that matched the event, if any.
+	Rule *Rule
+}
+
+// Matched returns true if one of the route's rules matched the event.
+func (match *RouteMatch) Matched() bool {
+	return match.Rule != nil
+}
+
+// RouteEvaluation : Where an event is routed.
+type RouteEvaluation struct {
+	// The evaluated event.
+	Event *RouteEvent
+
+	// The outcome for each route, in the order in which the routes were provided.
+	Matches []RouteMatch
+
+	// The IDs of the targets that receive the event.
+	TargetIds []string
+
+	// The targets that receive the event, for the IDs that the evaluator could resolve.
+	Targets []Target
+
+	// Set if no rule matched the event and it was sent to the account's default targets.
+	DefaultTargetsUsed bool
+}
+
+// Dropped returns true if the event is not sent to any target.
+func (evaluation *RouteEvaluation) Dropped() bool {
+	return len(evaluation.TargetIds) == 0
+}
+
+// ShadowedRule : A rule that can never match because an earlier rule of the same route matches every event it would.
+type ShadowedRule struct {
+	// The ID of the route that contains the rule.
+	RouteID string
+
+	// The name of the route that contains the rule.
+	RouteName string
+
+	// The index of the unreachable rule.
+	RuleIndex int
+
+	// The index of the earlier rule that shadows it.
+	ShadowedBy int
+}
+
+// RouteEvaluator : Evaluates the routes of an account locally, to audit where Activity Tracker events are sent.
+type RouteEvaluator struct {
+	// The routes of the account.
+	Routes []Route
+
+	// The targets of the account, used to resolve the target IDs of rules. Optional.
+	Targets []Target
+
+	// The IDs of the targets that receive events that match no rule.
+	DefaultTargets []string
+}
+
+// NewRouteEvaluator : Instantiate RouteEvaluator
+func NewRouteEvaluator(routes []Route, targets []Target, defaultTargets []string) *RouteEvaluator {
+	return &RouteEvaluator{
+		Routes:         routes,
+		Targets:        targets,
+		DefaultTargets: defaultTargets,
+	}
+}
+
+// LoadRouteEvaluator fetches the routes, targets and default targets of the account and returns an evaluator for them.
+func (atracker *AtrackerV2) LoadRouteEvaluator(ctx context.Context) (evaluator *RouteEvaluator, err error) {
+	routes, _, err := atracker.ListRoutesWithContext(ctx, atracker.NewListRoutesOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-routes-error")
+		return
+	}
+	targets, _, err := atracker.ListTargetsWithContext(ctx, atracker.NewListTargetsOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-targets-error")
+		return
+	}
+	settings, _, err := atracker.GetSettingsWithContext(ctx, atracker.NewGetSettingsOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-settings-error")
+		return
+	}
+	evaluator = NewRouteEvaluator(routes.Routes, targets.Targets, settings.DefaultTargets)
+	return
+}
+
+// Evaluate determines where the specified event is sent. Every route is evaluated; within a route, the first
+// rule whose locations include the event's location sends the event to its targets. If no rule of any route
+// matched the event, it is sent to the default targets.
+func (evaluator *RouteEvaluator) Evaluate(event *RouteEvent) *RouteEvaluation {
+	evaluation := &RouteEvaluation{
+		Event:     event,
+		Matches:   make([]RouteMatch, 0, len(evaluator.Routes)),
+		TargetIds: []string{},
+		Targets:   []Target{},
+	}
+
+	seen := map[string]bool{}
+	addTargets := func(ids []string) {
+		for _, id := range ids {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			evaluation.TargetIds = append(evaluation.TargetIds, id)
+			for _, target := range evaluator.Targets {
+				if core.StringNilMapper(target.ID) == id {
+					evaluation.Targets = append(evaluation.Targets, target)
+					break
+				}
+			}
+		}
+	}
+
+	matched := false
+	location := event.Location()
+	for i := range evaluator.Routes {
+		route := &evaluator.Routes[i]
+		match := RouteMatch{Route: route, RuleIndex: -1}
+		for j := range route.Rules {
+			rule := &route.Rules[j]
+			if common.ContainsString(rule.Locations, location) || common.ContainsString(rule.Locations, RouteLocationAnyConst) {
+				match.RuleIndex = j
+				match.Rule = rule
+				break
+			}
+		}
+		if match.Matched() {
+			matched = true
+			addTargets(match.Rule.TargetIds)
+		}
+		evaluation.Matches = append(evaluation.Matches, match)
+	}
+
+	if !matched {
+		evaluation.DefaultTargetsUsed = true
+		addTargets(evaluator.DefaultTargets)
+	}
+	return evaluation
+}
+
+// ShadowedRules returns the rules that can never match an event because an earlier rule
+// of the same route already matches all of their locations.
+func (evaluator *RouteEvaluator) ShadowedRules() []ShadowedRule {
+	shadowed := []ShadowedRule{}
+	for _, route := range evaluator.Routes {
+		for j := 1; j < len(route.Rules); j++ {
+			for i := 0; i < j; i++ {
+				if locationsCover(route.Rules[i].Locations, route.Rules[j].Locations) {
+					shadowed = append(shadowed, ShadowedRule{
+						RouteID:    core.StringNilMapper(route.ID),
+						RouteName:  core.StringNilMapper(route.Name),
+						RuleIndex:  j,
+						ShadowedBy: i,
+					})
+					break
+				}
+			}
+		}
+	}
+	return shadowed
+}
+
+// locationsCover returns true if every location matched by "later" is also matched by "earlier".
+func locationsCover(earlier []string, later []string) bool {
+	if common.ContainsString(earlier, RouteLocationAnyConst) {
+		return true
+	}
+	for _, location := range later {
+		if !common.ContainsString(earlier, location) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RouteEvaluator`, func() {
+	routes := []atrackerv2.Route{
+		{
+			ID:   core.StringPtr("route-1"),
+			Name: core.StringPtr("regional"),
+			Rules: []atrackerv2.Rule{
+				{TargetIds: []string{"t-us"}, Locations: []string{"us-south", "us-east"}},
+				{TargetIds: []string{"t-south"}, Locations: []string{"us-south"}},
+				{TargetIds: []string{"t-rest"}, Locations: []string{"*"}},
+				{TargetIds: []string{"t-never"}, Locations: []string{"eu-de"}},
+			},
+		},
+		{
+			ID:    core.StringPtr("route-2"),
+			Name:  core.StringPtr("global"),
+			Rules: []atrackerv2.Rule{{TargetIds: []string{"t-global", "t-us"}, Locations: []string{"global"}}},
+		},
+	}
+	targets := []atrackerv2.Target{
+		{ID: core.StringPtr("t-us"), Name: core.StringPtr("us")},
+		{ID: core.StringPtr("t-global"), Name: core.StringPtr("global")},
+	}
+	evaluator := atrackerv2.NewRouteEvaluator(routes, targets, []string{"t-default"})
+
+	It(`Resolves the matched rule and targets`, func() {
+		evaluation := evaluator.Evaluate(&atrackerv2.RouteEvent{SourceCRN: "crn:v1:bluemix:public:is:us-east:a/123::instance:abc"})
+		Expect(evaluation.Matches[0].RuleIndex).To(Equal(0))
+		Expect(evaluation.Matches[1].Matched()).To(BeFalse())
+		Expect(evaluation.TargetIds).To(Equal([]string{"t-us"}))
+		Expect(*evaluation.Targets[0].Name).To(Equal("us"))
+
+		evaluation = evaluator.Evaluate(&atrackerv2.RouteEvent{Global: true})
+		Expect(evaluation.Matches[0].RuleIndex).To(Equal(2))
+		Expect(evaluation.Matches[1].RuleIndex).To(Equal(0))
+		Expect(evaluation.TargetIds).To(Equal([]string{"t-rest", "t-global", "t-us"}))
+		Expect(evaluation.Targets).To(HaveLen(2))
+
+		noMatch := atrackerv2.NewRouteEvaluator(routes[1:], nil, []string{"t-default"})
+		evaluation = noMatch.Evaluate(&atrackerv2.RouteEvent{Region: "eu-de"})
+		Expect(evaluation.DefaultTargetsUsed).To(BeTrue())
+		Expect(evaluation.TargetIds).To(Equal([]string{"t-default"}))
+		Expect(evaluation.Dropped()).To(BeFalse())
+	})
+
+	It(`Flags rules shadowed by earlier rules`, func() {
+		Expect(evaluator.ShadowedRules()).To(Equal([]atrackerv2.ShadowedRule{
+			{RouteID: "route-1", RouteName: "regional", RuleIndex: 1, ShadowedBy: 0},
+			{RouteID: "route-1", RouteName: "regional", RuleIndex: 3, ShadowedBy: 2},
+		}))
+	})
+})
@@ -0,0 +1,329 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the TargetFinding.Severity property.
+const (
+	TargetFindingSeverityErrorConst   = "error"
+	TargetFindingSeverityWarningConst = "warning"
+)
+
+// Constants associated with the TargetFinding.Code property.
+const (
+	TargetFindingCodeInvalidTargetTypeConst = "invalid_target_type"
+	TargetFindingCodeMissingEndpointConst   = "missing_endpoint"
+	TargetFindingCodeIgnoredEndpointConst   = "ignored_endpoint"
+	TargetFindingCodeInvalidCRNConst        = "invalid_crn"
+	TargetFindingCodeCRNServiceConst        = "crn_service_mismatch"
+	TargetFindingCodeInvalidEndpointConst   = "invalid_endpoint"
+	TargetFindingCodeInvalidBucketConst     = "invalid_bucket_name"
+	TargetFindingCodeInvalidBrokerConst     = "invalid_broker"
+	TargetFindingCodeInvalidTopicConst      = "invalid_topic"
+	TargetFindingCodeMissingAuthConst       = "missing_auth"
+	TargetFindingCodeConflictingAuthConst   = "conflicting_auth"
+	TargetFindingCodeInvalidAPIKeyConst     = "invalid_api_key"
+	TargetFindingCodeUnsupportedRegionConst = "unsupported_region"
+	TargetFindingCodeRegionMismatchConst    = "region_mismatch"
+)
+
+// TargetFinding : A problem with the definition of a target, found locally before any API call.
+type TargetFinding struct {
+	// A code that identifies the kind of problem, one of the TargetFindingCode constants.
+	Code string
+
+	// The severity: an error is expected to make the request or the target fail; a warning may be intended.
+	Severity string
+
+	// The request field at fault, such as "cos_endpoint.bucket".
+	Field string
+
+	// A description of the problem.
+	Message string
+}
+
+// String returns the finding as "severity: field: message".
+func (finding *TargetFinding) String() string {
+	return fmt.Sprintf("%s: %s: %s", finding.Severity, finding.Field, finding.Message)
+}
+
+// TargetPreflightReport : The findings of a pre-flight validation of a target.
+type TargetPreflightReport struct {
+	Findings []TargetFinding
+}
+
+// HasErrors returns true if at least one finding is an error.
+func (report *TargetPreflightReport) HasErrors() bool {
+	for _, finding := range report.Findings {
+		if finding.Severity == TargetFindingSeverityErrorConst {
+			return true
+		}
+	}
+	return false
+}
+
+// WarningReport returns the findings in the form of the WarningReport returned by the service.
+func (report *TargetPreflightReport) WarningReport() *WarningReport {
+	warningReport := &WarningReport{Warnings: []Warning{}}
+	for _, finding := range report.Findings {
+		warningReport.Warnings = append(warningReport.Warnings, Warning{
+			Code:    core.StringPtr(finding.Code),
+			Message: core.StringPtr(finding.Field + ": " + finding.Message),
+		})
+	}
+	return warningReport
+}
+
+func (report *TargetPreflightReport) add(severity string, code string, field string, format string, args ...interface{}) {
+	report.Findings = append(report.Findings, TargetFinding{
+		Code:     code,
+		Severity: severity,
+		Field:    field,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// PreflightTarget checks the options of a CreateTarget call locally: the presence of the endpoint that matches the
+// target type, the format and service of CRNs, Cloud Object Storage bucket names and endpoints, Event Streams brokers
+// and topics, the consistency of API key and service-to-service authentication, and the compatibility of regions.
+// "serviceRegion" is the region of the endpoint that the request is sent to; it is the region of the target
+// when options.Region is not set, and may be empty if unknown.
+func PreflightTarget(options *CreateTargetOptions, serviceRegion string) *TargetPreflightReport {
+	report := &TargetPreflightReport{Findings: []TargetFinding{}}
+	region := serviceRegion
+	if options.Region != nil && *options.Region != "" {
+		region = *options.Region
+		if !common.ContainsString(SupportedRegions, region) {
+			report.add(TargetFindingSeverityErrorConst, TargetFindingCodeUnsupportedRegionConst, "region",
+				"'%s' is not a region in which Activity Tracker is available", region)
+		}
+	}
+	preflightEndpoints(report, core.StringNilMapper(options.TargetType), region,
+		options.CosEndpoint, options.EventstreamsEndpoint, options.CloudlogsEndpoint, options.AppconfigEndpoint)
+	return report
+}
+
+// PreflightReplaceTarget checks the options of a ReplaceTarget call locally, as PreflightTarget does. The target type
+// is inferred from the endpoint in the options. "targetRegion" is the region of the target, and may be empty if unknown.
+func PreflightReplaceTarget(options *ReplaceTargetOptions, targetRegion string) *TargetPreflightReport {
+	report := &TargetPreflightReport{Findings: []TargetFinding{}}
+	targetType := ""
+	switch {
+	case options.CosEndpoint != nil:
+		targetType = TargetTargetTypeCloudObjectStorageConst
+	case options.EventstreamsEndpoint != nil:
+		targetType = TargetTargetTypeEventStreamsConst
+	case options.CloudlogsEndpoint != nil:
+		targetType = TargetTargetTypeCloudLogsConst
+	case options.AppconfigEndpoint != nil:
+		targetType = TargetTargetTypeAppConfigConst
+	default:
+		// Replacing only the name of a target is valid.
+		return report
+	}
+	preflightEndpoints(report, targetType, targetRegion,
+		options.CosEndpoint, options.EventstreamsEndpoint, options.CloudlogsEndpoint, options.AppconfigEndpoint)
+	return report
+}
+
+func preflightEndpoints(report *TargetPreflightReport, targetType string, region string, cos *CosEndpointPrototype,
+	eventStreams *EventstreamsEndpointPrototype, cloudLogs *CloudLogsEndpointPrototype, appConfig *AppconfigEndpointPrototype) {
+	endpoints := map[string]bool{
+		TargetTargetTypeCloudObjectStorageConst: cos != nil,
+		TargetTargetTypeEventStreamsConst:       eventStreams != nil,
+		TargetTargetTypeCloudLogsConst:          cloudLogs != nil,
+		TargetTargetTypeAppConfigConst:          appConfig != nil,
+	}
+	fields := map[string]string{
+		TargetTargetTypeCloudObjectStorageConst: "cos_endpoint",
+		TargetTargetTypeEventStreamsConst:       "eventstreams_endpoint",
+		TargetTargetTypeCloudLogsConst:          "cloudlogs_endpoint",
+		TargetTargetTypeAppConfigConst:          "appconfig_endpoint",
+	}
+	present, known := endpoints[targetType]
+	if !known {
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidTargetTypeConst, "target_type",
+			"'%s' is not one of cloud_object_storage, event_streams, cloud_logs or app_config", targetType)
+		return
+	}
+	if !present {
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeMissingEndpointConst, fields[targetType],
+			"a %s target requires %s", targetType, fields[targetType])
+	}
+	for _, other := range []string{TargetTargetTypeCloudObjectStorageConst, TargetTargetTypeEventStreamsConst,
+		TargetTargetTypeCloudLogsConst, TargetTargetTypeAppConfigConst} {
+		if other != targetType && endpoints[other] {
+			report.add(TargetFindingSeverityWarningConst, TargetFindingCodeIgnoredEndpointConst, fields[other],
+				"is ignored for a %s target", targetType)
+		}
+	}
+
+	switch {
+	case targetType == TargetTargetTypeCloudObjectStorageConst && cos != nil:
+		preflightCRN(report, "cos_endpoint.target_crn", core.StringNilMapper(cos.TargetCRN), "cloud-object-storage", "")
+		preflightCosEndpoint(report, core.StringNilMapper(cos.Endpoint), region)
+		preflightBucket(report, core.StringNilMapper(cos.Bucket))
+		preflightAuth(report, "cos_endpoint", cos.APIKey, cos.ServiceToServiceEnabled)
+	case targetType == TargetTargetTypeEventStreamsConst && eventStreams != nil:
+		preflightCRN(report, "eventstreams_endpoint.target_crn", core.StringNilMapper(eventStreams.TargetCRN), "messagehub", region)
+		preflightBrokers(report, eventStreams.Brokers)
+		preflightTopic(report, core.StringNilMapper(eventStreams.Topic))
+		preflightAuth(report, "eventstreams_endpoint", eventStreams.APIKey, eventStreams.ServiceToServiceEnabled)
+	case targetType == TargetTargetTypeCloudLogsConst && cloudLogs != nil:
+		preflightCRN(report, "cloudlogs_endpoint.target_crn", core.StringNilMapper(cloudLogs.TargetCRN), "logs", region)
+	case targetType == TargetTargetTypeAppConfigConst && appConfig != nil:
+		preflightCRN(report, "appconfig_endpoint.target_crn", core.StringNilMapper(appConfig.TargetCRN), "apprapp", region)
+	}
+}
+
+// preflightCRN checks that "crn" is a well-formed CRN of the expected service and, if "region" is set,
+// warns when the instance is in another region.
+func preflightCRN(report *TargetPreflightReport, field string, crn string, service string, region string) {
+	parsed, err := common.ParseCRN(crn)
+	if err != nil {
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidCRNConst, field, "%s", err.Error())
+		return
+	}
+	if parsed.AccountID() == "" {
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidCRNConst, field,
+			"the scope of '%s' must identify an account, as in a/<account-id>", crn)
+	}
+	if parsed.ServiceInstance == "" {
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidCRNConst, field,
+			"'%s' does not identify a service instance", crn)
+	}
+	if parsed.ServiceName != service {
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeCRNServiceConst, field,
+			"the CRN is for service '%s' rather than '%s'", parsed.ServiceName, service)
+	}
+	if region != "" && parsed.Location != "" && parsed.Location != "global" && parsed.Location != region {
+		report.add(TargetFindingSeverityWarningConst, TargetFindingCodeRegionMismatchConst, field,
+			"the instance is in '%s' but the target is in '%s'; events will cross regions", parsed.Location, region)
+	}
+}
+
+// cosEndpointPattern matches the host names of Cloud Object Storage endpoints, capturing their location:
+// s3.<location>.cloud-object-storage.appdomain.cloud, with an optional "private" or "direct" qualifier.
+var cosEndpointPattern = regexp.MustCompile(`^s3\.(?:(?:private|direct)\.)?([a-z0-9-]+)\.cloud-object-storage\.appdomain\.cloud$`)
+
+func preflightCosEndpoint(report *TargetPreflightReport, endpoint string, region string) {
+	const field = "cos_endpoint.endpoint"
+	if strings.Contains(endpoint, "://") || strings.ContainsAny(endpoint, "/ ") {
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidEndpointConst, field,
+			"'%s' must be a host name, without a scheme or path", endpoint)
+		return
+	}
+	matches := cosEndpointPattern.FindStringSubmatch(endpoint)
+	if matches == nil {
+		report.add(TargetFindingSeverityWarningConst, TargetFindingCodeInvalidEndpointConst, field,
+			"'%s' is not a Cloud Object Storage endpoint of the form s3.<location>.cloud-object-storage.appdomain.cloud", endpoint)
+		return
+	}
+	// Regional endpoints are named after the region; cross-region endpoints (us, eu, ap) are not compared.
+	location := matches[1]
+	if region != "" && strings.Count(location, "-") == 1 && location != region {
+		report.add(TargetFindingSeverityWarningConst, TargetFindingCodeRegionMismatchConst, field,
+			"the endpoint is in '%s' but the target is in '%s'", location, region)
+	}
+}
+
+var bucketPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// preflightBucket checks the Cloud Object Storage bucket naming rules: 3 to 63 lowercase letters, digits, dots and
+// hyphens, starting and ending with a letter or digit, without adjacent punctuation, and not formatted as an IP address.
+func preflightBucket(report *TargetPreflightReport, bucket string) {
+	const field = "cos_endpoint.bucket"
+	switch {
+	case !bucketPattern.MatchString(bucket):
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidBucketConst, field,
+			"'%s' must be 3 to 63 lowercase letters, digits, dots or hyphens, starting and ending with a letter or digit", bucket)
+	case strings.Contains(bucket, "..") || strings.Contains(bucket, ".-") || strings.Contains(bucket, "-."):
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidBucketConst, field,
+			"'%s' must not contain adjacent dots and hyphens", bucket)
+	case net.ParseIP(bucket) != nil:
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidBucketConst, field,
+			"'%s' must not be formatted as an IP address", bucket)
+	}
+}
+
+func preflightBrokers(report *TargetPreflightReport, brokers []string) {
+	const field = "eventstreams_endpoint.brokers"
+	if len(brokers) == 0 {
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidBrokerConst, field, "at least one broker is required")
+		return
+	}
+	seen := map[string]bool{}
+	for i, broker := range brokers {
+		host, port, err := net.SplitHostPort(broker)
+		if err == nil && host == "" {
+			err = fmt.Errorf("missing host")
+		}
+		if err == nil {
+			var number int
+			number, err = strconv.Atoi(port)
+			if err == nil && (number < 1 || number > 65535) {
+				err = fmt.Errorf("port out of range")
+			}
+		}
+		if err != nil {
+			report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidBrokerConst, fmt.Sprintf("%s[%d]", field, i),
+				"'%s' is not of the form host:port", broker)
+			continue
+		}
+		if seen[broker] {
+			report.add(TargetFindingSeverityWarningConst, TargetFindingCodeInvalidBrokerConst, fmt.Sprintf("%s[%d]", field, i),
+				"'%s' is listed more than once", broker)
+		}
+		seen[broker] = true
+	}
+}
+
+var topicPattern = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`)
+
+func preflightTopic(report *TargetPreflightReport, topic string) {
+	if !topicPattern.MatchString(topic) || topic == "." || topic == ".." {
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidTopicConst, "eventstreams_endpoint.topic",
+			"'%s' must be 1 to 249 letters, digits, dots, underscores or hyphens", topic)
+	}
+}
+
+// preflightAuth checks that exactly one of an API key and service-to-service authentication is used.
+func preflightAuth(report *TargetPreflightReport, field string, apiKey *string, serviceToService *bool) {
+	key := core.StringNilMapper(apiKey)
+	s2s := serviceToService != nil && *serviceToService
+	switch {
+	case key != "" && s2s:
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeConflictingAuthConst, field+".api_key",
+			"do not supply an API key when service_to_service_enabled is set")
+	case key == "" && !s2s:
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeMissingAuthConst, field+".api_key",
+			"an API key is required unless service_to_service_enabled is set")
+	case key != "" && strings.TrimSpace(key) != key:
+		report.add(TargetFindingSeverityErrorConst, TargetFindingCodeInvalidAPIKeyConst, field+".api_key",
+			"the API key has leading or trailing white space")
+	}
+}
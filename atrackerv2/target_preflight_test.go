@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package atrackerv2_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PreflightTarget`, func() {
+	codes := func(report *atrackerv2.TargetPreflightReport) []string {
+		result := []string{}
+		for _, finding := range report.Findings {
+			result = append(result, finding.Severity+" "+finding.Code+" "+finding.Field)
+		}
+		return result
+	}
+
+	It(`Accepts valid targets`, func() {
+		options := &atrackerv2.CreateTargetOptions{
+			Name:       core.StringPtr("cos"),
+			TargetType: core.StringPtr(atrackerv2.TargetTargetTypeCloudObjectStorageConst),
+			CosEndpoint: &atrackerv2.CosEndpointPrototype{
+				Endpoint:                core.StringPtr("s3.private.us-south.cloud-object-storage.appdomain.cloud"),
+				TargetCRN:               core.StringPtr("crn:v1:bluemix:public:cloud-object-storage:global:a/1234:abcd::"),
+				Bucket:                  core.StringPtr("at-events.2026"),
+				ServiceToServiceEnabled: core.BoolPtr(true),
+			},
+		}
+		report := atrackerv2.PreflightTarget(options, "us-south")
+		Expect(report.Findings).To(BeEmpty())
+		Expect(report.HasErrors()).To(BeFalse())
+
+		options = &atrackerv2.CreateTargetOptions{
+			Name:       core.StringPtr("es"),
+			TargetType: core.StringPtr(atrackerv2.TargetTargetTypeEventStreamsConst),
+			EventstreamsEndpoint: &atrackerv2.EventstreamsEndpointPrototype{
+				TargetCRN: core.StringPtr("crn:v1:bluemix:public:messagehub:us-south:a/1234:abcd::"),
+				Brokers:   []string{"broker-0.example.com:9093", "broker-1.example.com:9093"},
+				Topic:     core.StringPtr("at-events"),
+				APIKey:    core.StringPtr("key"),
+			},
+		}
+		Expect(atrackerv2.PreflightTarget(options, "us-south").Findings).To(BeEmpty())
+	})
+
+	It(`Reports problems with Cloud Object Storage targets`, func() {
+		options := &atrackerv2.CreateTargetOptions{
+			Name:       core.StringPtr("cos"),
+			TargetType: core.StringPtr(atrackerv2.TargetTargetTypeCloudObjectStorageConst),
+			Region:     core.StringPtr("eu-de"),
+			CosEndpoint: &atrackerv2.CosEndpointPrototype{
+				Endpoint:                core.StringPtr("s3.us-south.cloud-object-storage.appdomain.cloud"),
+				TargetCRN:               core.StringPtr("crn:v1:bluemix:public:messagehub:global:a/1234:abcd::"),
+				Bucket:                  core.StringPtr("My_Bucket"),
+				APIKey:                  core.StringPtr("key"),
+				ServiceToServiceEnabled: core.BoolPtr(true),
+			},
+			CloudlogsEndpoint: &atrackerv2.CloudLogsEndpointPrototype{TargetCRN: core.StringPtr("crn")},
+		}
+		report := atrackerv2.PreflightTarget(options, "")
+		Expect(report.HasErrors()).To(BeTrue())
+		Expect(codes(report)).To(Equal([]string{
+			"warning ignored_endpoint cloudlogs_endpoint",
+			"error crn_service_mismatch cos_endpoint.target_crn",
+			"warning region_mismatch cos_endpoint.endpoint",
+			"error invalid_bucket_name cos_endpoint.bucket",
+			"error conflicting_auth cos_endpoint.api_key",
+		}))
+
+		warningReport := report.WarningReport()
+		Expect(warningReport.Warnings).To(HaveLen(5))
+		Expect(*warningReport.Warnings[3].Code).To(Equal("invalid_bucket_name"))
+	})
+
+	It(`Reports problems with Event Streams and Cloud Logs targets`, func() {
+		options := &atrackerv2.CreateTargetOptions{
+			Name:       core.StringPtr("es"),
+			TargetType: core.StringPtr(atrackerv2.TargetTargetTypeEventStreamsConst),
+			Region:     core.StringPtr("mars-north"),
+			EventstreamsEndpoint: &atrackerv2.EventstreamsEndpointPrototype{
+				TargetCRN: core.StringPtr("crn:v1:bluemix:public:messagehub:us-south:o/1234:abcd::"),
+				Brokers:   []string{"broker-0:9093", "broker-1", "broker-0:9093", "broker-2:99999"},
+				Topic:     core.StringPtr("events/all"),
+			},
+		}
+		Expect(codes(atrackerv2.PreflightTarget(options, ""))).To(Equal([]string{
+			"error unsupported_region region",
+			"error invalid_crn eventstreams_endpoint.target_crn",
+			"warning region_mismatch eventstreams_endpoint.target_crn",
+			"error invalid_broker eventstreams_endpoint.brokers[1]",
+			"warning invalid_broker eventstreams_endpoint.brokers[2]",
+			"error invalid_broker eventstreams_endpoint.brokers[3]",
+			"error invalid_topic eventstreams_endpoint.topic",
+			"error missing_auth eventstreams_endpoint.api_key",
+		}))
+
+		options = &atrackerv2.CreateTargetOptions{
+			Name:       core.StringPtr("logs"),
+			TargetType: core.StringPtr(atrackerv2.TargetTargetTypeCloudLogsConst),
+		}
+		Expect(codes(atrackerv2.PreflightTarget(options, ""))).To(Equal([]string{"error missing_endpoint cloudlogs_endpoint"}))
+
+		replace := &atrackerv2.ReplaceTargetOptions{
+			ID:                core.StringPtr("id"),
+			CloudlogsEndpoint: &atrackerv2.CloudLogsEndpointPrototype{TargetCRN: core.StringPtr("not-a-crn")},
+		}
+		Expect(codes(atrackerv2.PreflightReplaceTarget(replace, ""))).To(Equal([]string{"error invalid_crn cloudlogs_endpoint.target_crn"}))
+		Expect(atrackerv2.PreflightReplaceTarget(&atrackerv2.ReplaceTargetOptions{ID: core.StringPtr("id")}, "").Findings).To(BeEmpty())
+	})
+})
@@ -0,0 +1,636 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// MaxAttachmentSizeInBytes is the largest attachment accepted by the case management service.
+const MaxAttachmentSizeInBytes int64 = 20 * 1024 * 1024
+
+// partialDownloadSuffix is appended to the destination path while a download is in progress.
+const partialDownloadSuffix = ".part"
+
+// partialDownloadValidatorSuffix is appended to the path of the partial file of a download for the file that holds
+// the ETag or Last-Modified time of the attachment being downloaded.
+const partialDownloadValidatorSuffix = ".validator"
+
+// defaultAttachmentRetryInterval is the wait between attempts when no RetryInterval is configured.
+const defaultAttachmentRetryInterval = 1 * time.Second
+
+// AttachmentProgressFunc is invoked as attachment bytes are transferred. "total" is -1 when the
+// size of the transfer is not known in advance.
+type AttachmentProgressFunc func(transferred int64, total int64)
+
+// UploadFileFromPath : Add a local file as an attachment to a support case
+// The file's content type is taken from the options, its extension, or by sniffing its first bytes (in that order).
+// The file size is checked against the service limit before any request is made, and failed uploads are retried
+// by re-reading the file from the beginning.
+func (caseManagement *CaseManagementV1) UploadFileFromPath(uploadFileFromPathOptions *UploadFileFromPathOptions) (result *Attachment, response *core.DetailedResponse, err error) {
+	return caseManagement.UploadFileFromPathWithContext(context.Background(), uploadFileFromPathOptions)
+}
+
+// UploadFileFromPathWithContext is an alternate form of the UploadFileFromPath method which supports a Context parameter
+func (caseManagement *CaseManagementV1) UploadFileFromPathWithContext(ctx context.Context, uploadFileFromPathOptions *UploadFileFromPathOptions) (result *Attachment, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(uploadFileFromPathOptions, "uploadFileFromPathOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(uploadFileFromPathOptions, "uploadFileFromPathOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	path := *uploadFileFromPathOptions.Path
+	info, err := os.Stat(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-file-error", common.GetComponentInfo())
+		return
+	}
+	if info.IsDir() {
+		err = core.SDKErrorf(nil, fmt.Sprintf("'%s' is a directory", path), "path-is-directory", common.GetComponentInfo())
+		return
+	}
+	maxSize := MaxAttachmentSizeInBytes
+	if uploadFileFromPathOptions.MaxSizeInBytes != nil {
+		maxSize = *uploadFileFromPathOptions.MaxSizeInBytes
+	}
+	if info.Size() > maxSize {
+		err = core.SDKErrorf(nil, fmt.Sprintf("file '%s' is %d bytes, which exceeds the attachment limit of %d bytes", path, info.Size(), maxSize),
+			"file-too-large", common.GetComponentInfo())
+		return
+	}
+
+	filename := filepath.Base(path)
+	if uploadFileFromPathOptions.Filename != nil {
+		filename = *uploadFileFromPathOptions.Filename
+	}
+	contentType := core.StringNilMapper(uploadFileFromPathOptions.ContentType)
+	if contentType == "" {
+		contentType, err = detectContentType(path)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "read-file-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	for attempt := int64(0); ; attempt++ {
+		var file *os.File
+		file, err = os.Open(path) // #nosec G304
+		if err != nil {
+			err = core.SDKErrorf(err, "", "read-file-error", common.GetComponentInfo())
+			return
+		}
+
+		uploadFileOptions := caseManagement.NewUploadFileOptions(*uploadFileFromPathOptions.CaseNumber, []FileWithMetadata{
+			{
+				Data:        newProgressReader(file, info.Size(), uploadFileFromPathOptions.Progress),
+				Filename:    &filename,
+				ContentType: &contentType,
+			},
+		})
+		uploadFileOptions.SetHeaders(uploadFileFromPathOptions.Headers)
+
+		// The multipart writer closes the file once it has been streamed; the extra Close covers requests that
+		// fail before the body is consumed.
+		result, response, err = caseManagement.UploadFileWithContext(ctx, uploadFileOptions)
+		_ = file.Close()
+		if err == nil || attempt >= int64Value(uploadFileFromPathOptions.MaxRetries) || !isRetryableTransfer(response) {
+			err = core.RepurposeSDKProblem(err, "upload-error")
+			return
+		}
+		if waitErr := waitForRetry(ctx, uploadFileFromPathOptions.RetryInterval); waitErr != nil {
+			err = core.SDKErrorf(waitErr, "", "context-done", common.GetComponentInfo())
+			return
+		}
+	}
+}
+
+// UploadFilesFromPaths uploads each of the specified local files to a support case, one request per file.
+// Uploading stops at the first file that fails; the attachments created so far are returned with the error
+// so that the caller can resume with the remaining paths.
+func (caseManagement *CaseManagementV1) UploadFilesFromPaths(ctx context.Context, caseNumber string, paths []string, options *UploadFileFromPathOptions) (result []Attachment, err error) {
+	for _, path := range paths {
+		fileOptions := &UploadFileFromPathOptions{}
+		if options != nil {
+			*fileOptions = *options
+			fileOptions.Filename = nil
+		}
+		fileOptions.SetCaseNumber(caseNumber)
+		fileOptions.SetPath(path)
+
+		var attachment *Attachment
+		attachment, _, err = caseManagement.UploadFileFromPathWithContext(ctx, fileOptions)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("error uploading '%s'", path), "upload-error", common.GetComponentInfo())
+			return
+		}
+		if attachment != nil {
+			result = append(result, *attachment)
+		}
+	}
+	return
+}
+
+// DownloadFileToPath : Download an attachment to a local file
+// The attachment is written to a temporary ".part" file next to the destination path, which is renamed into place
+// once the download is complete and verified. If a ".part" file is left over from an earlier attempt, the download
+// resumes from its current size by using an HTTP Range request.
+func (caseManagement *CaseManagementV1) DownloadFileToPath(downloadFileToPathOptions *DownloadFileToPathOptions) (response *core.DetailedResponse, err error) {
+	return caseManagement.DownloadFileToPathWithContext(context.Background(), downloadFileToPathOptions)
+}
+
+// DownloadFileToPathWithContext is an alternate form of the DownloadFileToPath method which supports a Context parameter
+func (caseManagement *CaseManagementV1) DownloadFileToPathWithContext(ctx context.Context, downloadFileToPathOptions *DownloadFileToPathOptions) (response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(downloadFileToPathOptions, "downloadFileToPathOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(downloadFileToPathOptions, "downloadFileToPathOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	path := *downloadFileToPathOptions.Path
+	partPath := path + partialDownloadSuffix
+	total := int64(-1)
+	if downloadFileToPathOptions.ExpectedSizeInBytes != nil {
+		total = *downloadFileToPathOptions.ExpectedSizeInBytes
+	}
+
+	for attempt := int64(0); ; attempt++ {
+		response, err = caseManagement.downloadToPartFile(ctx, downloadFileToPathOptions, partPath, total)
+		if err == nil || attempt >= int64Value(downloadFileToPathOptions.MaxRetries) || !isRetryableTransfer(response) {
+			break
+		}
+		if waitErr := waitForRetry(ctx, downloadFileToPathOptions.RetryInterval); waitErr != nil {
+			err = core.SDKErrorf(waitErr, "", "context-done", common.GetComponentInfo())
+			return
+		}
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "download-error")
+		return
+	}
+
+	err = verifyDownload(partPath, downloadFileToPathOptions)
+	if err != nil {
+		_ = os.Remove(partPath)
+		_ = os.Remove(partPath + partialDownloadValidatorSuffix)
+		return
+	}
+
+	err = os.Rename(partPath, path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "write-file-error", common.GetComponentInfo())
+		return
+	}
+	_ = os.Remove(partPath + partialDownloadValidatorSuffix)
+	return
+}
+
+// downloadToPartFile performs a single download attempt, appending to "partPath" when the server
+// honors the Range request and truncating it otherwise. A partial file is only resumed when the ETag or
+// Last-Modified time of the response that started it is known; it is sent in If-Range, so that the server
+// sends the whole attachment again if it changed since.
+func (caseManagement *CaseManagementV1) downloadToPartFile(ctx context.Context, options *DownloadFileToPathOptions, partPath string, total int64) (response *core.DetailedResponse, err error) {
+	validatorPath := partPath + partialDownloadValidatorSuffix
+	var offset int64
+	var validator string
+	if info, statErr := os.Stat(partPath); statErr == nil {
+		if data, readErr := os.ReadFile(validatorPath); readErr == nil && len(data) > 0 { // #nosec G304
+			offset = info.Size()
+			validator = string(data)
+		}
+	}
+	if total >= 0 && offset >= total {
+		// A previous attempt already transferred everything; just verify it.
+		return
+	}
+
+	headers := make(map[string]string, len(options.Headers)+2)
+	for headerName, headerValue := range options.Headers {
+		headers[headerName] = headerValue
+	}
+	if offset > 0 {
+		headers["Range"] = fmt.Sprintf("bytes=%d-", offset)
+		headers["If-Range"] = validator
+	}
+
+	downloadFileOptions := caseManagement.NewDownloadFileOptions(*options.CaseNumber, *options.FileID)
+	downloadFileOptions.SetHeaders(headers)
+
+	body, response, err := caseManagement.DownloadFileWithContext(ctx, downloadFileOptions)
+	if err != nil {
+		if offset > 0 && response != nil && response.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			// Nothing remains past the end of the partial file; it is already complete.
+			err = nil
+		}
+		return
+	}
+	if body == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("no content returned for attachment '%s'", *options.FileID), "no-content",
+			common.GetComponentInfo())
+		return
+	}
+	defer body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if response.StatusCode != http.StatusPartialContent {
+		// The server ignored the Range header, or the attachment changed, and sent the whole file.
+		flags = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+		offset = 0
+		err = writeDownloadValidator(validatorPath, response)
+		if err != nil {
+			return
+		}
+	}
+	file, err := os.OpenFile(partPath, flags, 0600) // #nosec G304
+	if err != nil {
+		err = core.SDKErrorf(err, "", "write-file-error", common.GetComponentInfo())
+		return
+	}
+
+	progress := newProgressReader(body, total, options.Progress)
+	progress.transferred = offset
+	_, err = io.Copy(file, progress)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Treat interrupted streams like transport failures so that they are resumed.
+		err = core.SDKErrorf(err, "", "write-file-error", common.GetComponentInfo())
+		response = nil
+	}
+	return
+}
+
+// writeDownloadValidator stores the strong ETag, or else the Last-Modified time, of a download next to its partial
+// file, to resume it with If-Range. Without either, the partial file is not resumed.
+func writeDownloadValidator(validatorPath string, response *core.DetailedResponse) (err error) {
+	validator := response.GetHeaders().Get("ETag")
+	if validator == "" || strings.HasPrefix(validator, "W/") {
+		validator = response.GetHeaders().Get("Last-Modified")
+	}
+	if validator == "" {
+		err = os.Remove(validatorPath)
+		if os.IsNotExist(err) {
+			err = nil
+		}
+	} else {
+		err = os.WriteFile(validatorPath, []byte(validator), 0600)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "write-file-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// verifyDownload checks the size and checksum of a completed download against the expected values.
+func verifyDownload(partPath string, options *DownloadFileToPathOptions) (err error) {
+	if options.ExpectedSizeInBytes != nil {
+		var info os.FileInfo
+		info, err = os.Stat(partPath)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "read-file-error", common.GetComponentInfo())
+			return
+		}
+		if info.Size() != *options.ExpectedSizeInBytes {
+			err = core.SDKErrorf(nil, fmt.Sprintf("downloaded %d bytes, expected %d bytes", info.Size(), *options.ExpectedSizeInBytes),
+				"size-mismatch", common.GetComponentInfo())
+			return
+		}
+	}
+	if options.ExpectedSHA256 != nil {
+		var checksum string
+		checksum, err = FileSHA256(partPath)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "read-file-error", common.GetComponentInfo())
+			return
+		}
+		if !strings.EqualFold(checksum, *options.ExpectedSHA256) {
+			err = core.SDKErrorf(nil, fmt.Sprintf("checksum mismatch: got sha256 %s, expected %s", checksum, *options.ExpectedSHA256),
+				"checksum-mismatch", common.GetComponentInfo())
+			return
+		}
+	}
+	return
+}
+
+// FileSHA256 returns the hex-encoded SHA-256 checksum of the file at "path".
+func FileSHA256(path string) (string, error) {
+	file, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// detectContentType determines the content type of the file at "path", preferring the
+// registered type for its extension and falling back to sniffing its leading bytes.
+func detectContentType(path string) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(path)); contentType != "" {
+		return contentType, nil
+	}
+
+	file, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// isRetryableTransfer returns true if a failed transfer should be attempted again:
+// transport failures (no response), throttling and server-side errors.
+func isRetryableTransfer(response *core.DetailedResponse) bool {
+	if response == nil {
+		return true
+	}
+	return response.StatusCode == http.StatusTooManyRequests ||
+		(response.StatusCode >= 500 && response.StatusCode != http.StatusNotImplemented)
+}
+
+// int64Value returns the value of "p", or 0 if it is nil.
+func int64Value(p *int64) int64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// waitForRetry sleeps for the configured retry interval, returning early if the context is done.
+func waitForRetry(ctx context.Context, interval *time.Duration) error {
+	wait := defaultAttachmentRetryInterval
+	if interval != nil {
+		wait = *interval
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// progressReader reports the number of bytes read through it to an AttachmentProgressFunc.
+type progressReader struct {
+	reader      io.ReadCloser
+	total       int64
+	transferred int64
+	progress    AttachmentProgressFunc
+}
+
+func newProgressReader(reader io.ReadCloser, total int64, progress AttachmentProgressFunc) *progressReader {
+	return &progressReader{
+		reader:   reader,
+		total:    total,
+		progress: progress,
+	}
+}
+
+func (pr *progressReader) Read(p []byte) (n int, err error) {
+	n, err = pr.reader.Read(p)
+	if n > 0 {
+		pr.transferred += int64(n)
+		if pr.progress != nil {
+			pr.progress(pr.transferred, pr.total)
+		}
+	}
+	return
+}
+
+func (pr *progressReader) Close() error {
+	return pr.reader.Close()
+}
+
+// UploadFileFromPathOptions : The UploadFileFromPath options.
+type UploadFileFromPathOptions struct {
+	// Unique identifier of a case.
+	CaseNumber *string `json:"case_number" validate:"required,ne="`
+
+	// Path of the local file to upload.
+	Path *string `json:"path" validate:"required,ne="`
+
+	// Name to give the attachment. Defaults to the base name of Path.
+	Filename *string `json:"filename,omitempty"`
+
+	// Content type of the attachment. Detected from the file when not set.
+	ContentType *string `json:"content_type,omitempty"`
+
+	// Largest file that may be uploaded. Defaults to MaxAttachmentSizeInBytes.
+	MaxSizeInBytes *int64 `json:"max_size_in_bytes,omitempty"`
+
+	// Number of times a failed upload is retried.
+	MaxRetries *int64 `json:"max_retries,omitempty"`
+
+	// Wait between retries.
+	RetryInterval *time.Duration `json:"retry_interval,omitempty"`
+
+	// Invoked as the file is read into the request body.
+	Progress AttachmentProgressFunc `json:"-"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewUploadFileFromPathOptions : Instantiate UploadFileFromPathOptions
+func (*CaseManagementV1) NewUploadFileFromPathOptions(caseNumber string, path string) *UploadFileFromPathOptions {
+	return &UploadFileFromPathOptions{
+		CaseNumber: core.StringPtr(caseNumber),
+		Path:       core.StringPtr(path),
+	}
+}
+
+// SetCaseNumber : Allow user to set CaseNumber
+func (_options *UploadFileFromPathOptions) SetCaseNumber(caseNumber string) *UploadFileFromPathOptions {
+	_options.CaseNumber = core.StringPtr(caseNumber)
+	return _options
+}
+
+// SetPath : Allow user to set Path
+func (_options *UploadFileFromPathOptions) SetPath(path string) *UploadFileFromPathOptions {
+	_options.Path = core.StringPtr(path)
+	return _options
+}
+
+// SetFilename : Allow user to set Filename
+func (_options *UploadFileFromPathOptions) SetFilename(filename string) *UploadFileFromPathOptions {
+	_options.Filename = core.StringPtr(filename)
+	return _options
+}
+
+// SetContentType : Allow user to set ContentType
+func (_options *UploadFileFromPathOptions) SetContentType(contentType string) *UploadFileFromPathOptions {
+	_options.ContentType = core.StringPtr(contentType)
+	return _options
+}
+
+// SetMaxSizeInBytes : Allow user to set MaxSizeInBytes
+func (_options *UploadFileFromPathOptions) SetMaxSizeInBytes(maxSizeInBytes int64) *UploadFileFromPathOptions {
+	_options.MaxSizeInBytes = core.Int64Ptr(maxSizeInBytes)
+	return _options
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+func (_options *UploadFileFromPathOptions) SetMaxRetries(maxRetries int64) *UploadFileFromPathOptions {
+	_options.MaxRetries = core.Int64Ptr(maxRetries)
+	return _options
+}
+
+// SetRetryInterval : Allow user to set RetryInterval
+func (_options *UploadFileFromPathOptions) SetRetryInterval(retryInterval time.Duration) *UploadFileFromPathOptions {
+	_options.RetryInterval = &retryInterval
+	return _options
+}
+
+// SetProgress : Allow user to set Progress
+func (_options *UploadFileFromPathOptions) SetProgress(progress AttachmentProgressFunc) *UploadFileFromPathOptions {
+	_options.Progress = progress
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UploadFileFromPathOptions) SetHeaders(param map[string]string) *UploadFileFromPathOptions {
+	options.Headers = param
+	return options
+}
+
+// DownloadFileToPathOptions : The DownloadFileToPath options.
+type DownloadFileToPathOptions struct {
+	// Unique identifier of a case.
+	CaseNumber *string `json:"case_number" validate:"required,ne="`
+
+	// Unique identifier of a file.
+	FileID *string `json:"file_id" validate:"required,ne="`
+
+	// Local path the attachment is written to.
+	Path *string `json:"path" validate:"required,ne="`
+
+	// Expected size of the attachment, typically Attachment.SizeInBytes. When set, the download is verified against it.
+	ExpectedSizeInBytes *int64 `json:"expected_size_in_bytes,omitempty"`
+
+	// Expected hex-encoded SHA-256 checksum of the attachment. When set, the download is verified against it.
+	ExpectedSHA256 *string `json:"expected_sha256,omitempty"`
+
+	// Number of times a failed or interrupted download is resumed.
+	MaxRetries *int64 `json:"max_retries,omitempty"`
+
+	// Wait between retries.
+	RetryInterval *time.Duration `json:"retry_interval,omitempty"`
+
+	// Invoked as the attachment is written to disk.
+	Progress AttachmentProgressFunc `json:"-"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewDownloadFileToPathOptions : Instantiate DownloadFileToPathOptions
+func (*CaseManagementV1) NewDownloadFileToPathOptions(caseNumber string, fileID string, path string) *DownloadFileToPathOptions {
+	return &DownloadFileToPathOptions{
+		CaseNumber: core.StringPtr(caseNumber),
+		FileID:     core.StringPtr(fileID),
+		Path:       core.StringPtr(path),
+	}
+}
+
+// SetCaseNumber : Allow user to set CaseNumber
+func (_options *DownloadFileToPathOptions) SetCaseNumber(caseNumber string) *DownloadFileToPathOptions {
+	_options.CaseNumber = core.StringPtr(caseNumber)
+	return _options
+}
+
+// SetFileID : Allow user to set FileID
+func (_options *DownloadFileToPathOptions) SetFileID(fileID string) *DownloadFileToPathOptions {
+	_options.FileID = core.StringPtr(fileID)
+	return _options
+}
+
+// SetPath : Allow user to set Path
+func (_options *DownloadFileToPathOptions) SetPath(path string) *DownloadFileToPathOptions {
+	_options.Path = core.StringPtr(path)
+	return _options
+}
+
+// SetExpectedSizeInBytes : Allow user to set ExpectedSizeInBytes
+func (_options *DownloadFileToPathOptions) SetExpectedSizeInBytes(expectedSizeInBytes int64) *DownloadFileToPathOptions {
+	_options.ExpectedSizeInBytes = core.Int64Ptr(expectedSizeInBytes)
+	return _options
+}
+
+// SetExpectedSHA256 : Allow user to set ExpectedSHA256
+func (_options *DownloadFileToPathOptions) SetExpectedSHA256(expectedSHA256 string) *DownloadFileToPathOptions {
+	_options.ExpectedSHA256 = core.StringPtr(expectedSHA256)
+	return _options
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+func (_options *DownloadFileToPathOptions) SetMaxRetries(maxRetries int64) *DownloadFileToPathOptions {
+	_options.MaxRetries = core.Int64Ptr(maxRetries)
+	return _options
+}
+
+// SetRetryInterval : Allow user to set RetryInterval
+func (_options *DownloadFileToPathOptions) SetRetryInterval(retryInterval time.Duration) *DownloadFileToPathOptions {
+	_options.RetryInterval = &retryInterval
+	return _options
+}
+
+// SetProgress : Allow user to set Progress
+func (_options *DownloadFileToPathOptions) SetProgress(progress AttachmentProgressFunc) *DownloadFileToPathOptions {
+	_options.Progress = progress
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DownloadFileToPathOptions) SetHeaders(param map[string]string) *DownloadFileToPathOptions {
+	options.Headers = param
+	return options
+}
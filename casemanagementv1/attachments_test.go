@@ -0,0 +1,245 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseManagementV1 attachment helpers`, func() {
+	var testServer *httptest.Server
+	var tempDir string
+
+	newService := func() *casemanagementv1.CaseManagementV1 {
+		caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return caseManagementService
+	}
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "casemanagementv1")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		if testServer != nil {
+			testServer.Close()
+			testServer = nil
+		}
+		os.RemoveAll(tempDir)
+	})
+
+	Describe(`UploadFileFromPath(uploadFileFromPathOptions *UploadFileFromPathOptions)`, func() {
+		It(`Invoke UploadFileFromPath with content type detection, progress and retries`, func() {
+			attempts := 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal("/cases/CS123/attachments"))
+				Expect(req.Method).To(Equal("PUT"))
+				file, header, err := req.FormFile("file")
+				Expect(err).To(BeNil())
+				Expect(header.Filename).To(Equal("notes.txt"))
+				Expect(header.Header.Get("Content-Type")).To(ContainSubstring("text/plain"))
+				contents, _ := io.ReadAll(file)
+				Expect(string(contents)).To(Equal("hello support"))
+
+				attempts++
+				res.Header().Set("Content-type", "application/json")
+				if attempts == 1 {
+					res.WriteHeader(503)
+					fmt.Fprintf(res, "%s", `{"error": "unavailable"}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"id": "file1", "filename": "notes.txt", "size_in_bytes": 13}`)
+			}))
+			caseManagementService := newService()
+
+			path := filepath.Join(tempDir, "notes.txt")
+			Expect(os.WriteFile(path, []byte("hello support"), 0600)).To(Succeed())
+
+			var lastTransferred, lastTotal int64
+			options := caseManagementService.NewUploadFileFromPathOptions("CS123", path)
+			options.SetMaxRetries(1).SetRetryInterval(time.Millisecond)
+			options.SetProgress(func(transferred int64, total int64) {
+				lastTransferred, lastTotal = transferred, total
+			})
+
+			result, response, operationErr := caseManagementService.UploadFileFromPath(options)
+			Expect(operationErr).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(*result.ID).To(Equal("file1"))
+			Expect(attempts).To(Equal(2))
+			Expect(lastTransferred).To(Equal(int64(13)))
+			Expect(lastTotal).To(Equal(int64(13)))
+		})
+		It(`Invoke UploadFileFromPath with a file exceeding the size limit`, func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				Fail("no request expected")
+			}))
+			caseManagementService := newService()
+
+			path := filepath.Join(tempDir, "big.bin")
+			Expect(os.WriteFile(path, make([]byte, 64), 0600)).To(Succeed())
+
+			options := caseManagementService.NewUploadFileFromPathOptions("CS123", path).SetMaxSizeInBytes(32)
+			result, response, operationErr := caseManagementService.UploadFileFromPath(options)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("exceeds the attachment limit"))
+			Expect(response).To(BeNil())
+			Expect(result).To(BeNil())
+		})
+		It(`Invoke UploadFileFromPath without required parameters`, func() {
+			testServer = httptest.NewServer(http.NotFoundHandler())
+			caseManagementService := newService()
+
+			_, _, operationErr := caseManagementService.UploadFileFromPath(nil)
+			Expect(operationErr).ToNot(BeNil())
+			_, _, operationErr = caseManagementService.UploadFileFromPath(new(casemanagementv1.UploadFileFromPathOptions))
+			Expect(operationErr).ToNot(BeNil())
+		})
+	})
+
+	Describe(`DownloadFileToPath(downloadFileToPathOptions *DownloadFileToPathOptions)`, func() {
+		contents := "This is a mock binary response."
+		sum := sha256.Sum256([]byte(contents))
+		checksum := hex.EncodeToString(sum[:])
+
+		var ranges []string
+
+		BeforeEach(func() {
+			ranges = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.Method).To(Equal("GET"))
+				if req.URL.EscapedPath() != "/cases/CS123/attachments/file1" {
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"error": "not found"}`)
+					return
+				}
+				ranges = append(ranges, req.Header.Get("Range")+" "+req.Header.Get("If-Range"))
+				res.Header().Set("ETag", `"v2"`)
+				http.ServeContent(res, req, "file1", time.Time{}, strings.NewReader(contents))
+			}))
+		})
+		It(`Invoke DownloadFileToPath successfully`, func() {
+			caseManagementService := newService()
+			path := filepath.Join(tempDir, "download.bin")
+
+			options := caseManagementService.NewDownloadFileToPathOptions("CS123", "file1", path)
+			options.SetExpectedSizeInBytes(int64(len(contents))).SetExpectedSHA256(checksum)
+			_, operationErr := caseManagementService.DownloadFileToPath(options)
+			Expect(operationErr).To(BeNil())
+
+			data, err := os.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal(contents))
+			_, err = os.Stat(path + ".part")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+			_, err = os.Stat(path + ".part.validator")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+		It(`Invoke DownloadFileToPath resuming a partial download`, func() {
+			caseManagementService := newService()
+			path := filepath.Join(tempDir, "download.bin")
+			Expect(os.WriteFile(path+".part", []byte(contents[:10]), 0600)).To(Succeed())
+			Expect(os.WriteFile(path+".part.validator", []byte(`"v2"`), 0600)).To(Succeed())
+
+			options := caseManagementService.NewDownloadFileToPathOptions("CS123", "file1", path)
+			options.SetExpectedSHA256(checksum)
+			response, operationErr := caseManagementService.DownloadFileToPath(options)
+			Expect(operationErr).To(BeNil())
+			Expect(response.StatusCode).To(Equal(206))
+			Expect(ranges).To(Equal([]string{`bytes=10- "v2"`}))
+
+			data, err := os.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal(contents))
+		})
+		It(`Invoke DownloadFileToPath with a partial download of another version`, func() {
+			caseManagementService := newService()
+			path := filepath.Join(tempDir, "download.bin")
+			stale := "Stale content"
+			Expect(os.WriteFile(path+".part", []byte(stale), 0600)).To(Succeed())
+			Expect(os.WriteFile(path+".part.validator", []byte(`"v1"`), 0600)).To(Succeed())
+
+			options := caseManagementService.NewDownloadFileToPathOptions("CS123", "file1", path)
+			options.SetExpectedSHA256(checksum)
+			response, operationErr := caseManagementService.DownloadFileToPath(options)
+			Expect(operationErr).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+
+			data, err := os.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal(contents))
+
+			// A partial download whose version is unknown is started again.
+			ranges = nil
+			Expect(os.WriteFile(path+".part", []byte(stale), 0600)).To(Succeed())
+			response, operationErr = caseManagementService.DownloadFileToPath(options)
+			Expect(operationErr).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(ranges).To(Equal([]string{" "}))
+		})
+		It(`Invoke DownloadFileToPath with a missing attachment`, func() {
+			caseManagementService := newService()
+			path := filepath.Join(tempDir, "download.bin")
+
+			options := caseManagementService.NewDownloadFileToPathOptions("CS123", "file2", path)
+			_, operationErr := caseManagementService.DownloadFileToPath(options)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(errors.Is(operationErr, common.ErrNotFound)).To(BeTrue())
+			_, err := os.Stat(path)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+		It(`Invoke DownloadFileToPath with a checksum mismatch`, func() {
+			caseManagementService := newService()
+			path := filepath.Join(tempDir, "download.bin")
+
+			options := caseManagementService.NewDownloadFileToPathOptions("CS123", "file1", path)
+			options.SetExpectedSHA256("0000")
+			_, operationErr := caseManagementService.DownloadFileToPath(options)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("checksum mismatch"))
+			_, err := os.Stat(path)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
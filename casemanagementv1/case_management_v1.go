@@ -177,7 +177,7 @@ func (caseManagement *CaseManagementV1) GetCasesWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "GetCases"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases`, nil)
 	if err != nil {
@@ -221,6 +221,8 @@ func (caseManagement *CaseManagementV1) GetCasesWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_cases", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -252,7 +254,7 @@ func (caseManagement *CaseManagementV1) CreateCaseWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "CreateCase"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases`, nil)
 	if err != nil {
@@ -314,6 +316,8 @@ func (caseManagement *CaseManagementV1) CreateCaseWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "create_case", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -349,7 +353,7 @@ func (caseManagement *CaseManagementV1) GetCaseWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "GetCase"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases/{case_number}`, pathParamsMap)
 	if err != nil {
@@ -378,6 +382,8 @@ func (caseManagement *CaseManagementV1) GetCaseWithContext(ctx context.Context,
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_case", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -413,7 +419,7 @@ func (caseManagement *CaseManagementV1) UpdateCaseStatusWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "UpdateCaseStatus"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases/{case_number}/status`, pathParamsMap)
 	if err != nil {
@@ -444,6 +450,8 @@ func (caseManagement *CaseManagementV1) UpdateCaseStatusWithContext(ctx context.
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "update_case_status", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -479,7 +487,7 @@ func (caseManagement *CaseManagementV1) AddCommentWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "AddComment"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases/{case_number}/comments`, pathParamsMap)
 	if err != nil {
@@ -514,6 +522,8 @@ func (caseManagement *CaseManagementV1) AddCommentWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "add_comment", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -551,7 +561,7 @@ func (caseManagement *CaseManagementV1) AddWatchlistWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "AddWatchlist"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases/{case_number}/watchlist`, pathParamsMap)
 	if err != nil {
@@ -586,6 +596,8 @@ func (caseManagement *CaseManagementV1) AddWatchlistWithContext(ctx context.Cont
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "add_watchlist", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -622,7 +634,7 @@ func (caseManagement *CaseManagementV1) RemoveWatchlistWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "RemoveWatchlist"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases/{case_number}/watchlist`, pathParamsMap)
 	if err != nil {
@@ -657,6 +669,8 @@ func (caseManagement *CaseManagementV1) RemoveWatchlistWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "remove_watchlist", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -693,7 +707,7 @@ func (caseManagement *CaseManagementV1) AddResourceWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "AddResource"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases/{case_number}/resources`, pathParamsMap)
 	if err != nil {
@@ -737,6 +751,8 @@ func (caseManagement *CaseManagementV1) AddResourceWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "add_resource", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -773,7 +789,7 @@ func (caseManagement *CaseManagementV1) UploadFileWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "UploadFile"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases/{case_number}/attachments`, pathParamsMap)
 	if err != nil {
@@ -802,6 +818,8 @@ func (caseManagement *CaseManagementV1) UploadFileWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "upload_file", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -838,7 +856,7 @@ func (caseManagement *CaseManagementV1) DownloadFileWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "DownloadFile"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases/{case_number}/attachments/{file_id}`, pathParamsMap)
 	if err != nil {
@@ -862,6 +880,10 @@ func (caseManagement *CaseManagementV1) DownloadFileWithContext(ctx context.Cont
 
 	response, err = caseManagement.Service.Request(request, &result)
 
+	if err != nil {
+		core.EnrichHTTPProblem(err, "download_file", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
+	}
 	return
 }
 
@@ -888,7 +910,7 @@ func (caseManagement *CaseManagementV1) DeleteFileWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "case_management", "V1", "DeleteFile"))
 	builder.EnableGzipCompression = caseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(caseManagement.Service.Options.URL, `/cases/{case_number}/attachments/{file_id}`, pathParamsMap)
 	if err != nil {
@@ -913,6 +935,8 @@ func (caseManagement *CaseManagementV1) DeleteFileWithContext(ctx context.Contex
 	var rawResponse map[string]json.RawMessage
 	response, err = caseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "delete_file", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,85 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package casemanagementv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CaseManagementV1Intf : The operations of the CaseManagementV1 service client.
+// The interface is satisfied by *CaseManagementV1 and by mocks.CaseManagementV1, allowing consumers to substitute a mock for the client in tests.
+type CaseManagementV1Intf interface {
+	// GetCases : Get cases in account
+	GetCases(getCasesOptions *GetCasesOptions) (result *CaseList, response *core.DetailedResponse, err error)
+	// GetCasesWithContext is an alternate form of the GetCases method which supports a Context parameter
+	GetCasesWithContext(ctx context.Context, getCasesOptions *GetCasesOptions) (result *CaseList, response *core.DetailedResponse, err error)
+	// CreateCase : Create a case
+	CreateCase(createCaseOptions *CreateCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+	// CreateCaseWithContext is an alternate form of the CreateCase method which supports a Context parameter
+	CreateCaseWithContext(ctx context.Context, createCaseOptions *CreateCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+	// GetCase : Get a case in account
+	GetCase(getCaseOptions *GetCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+	// GetCaseWithContext is an alternate form of the GetCase method which supports a Context parameter
+	GetCaseWithContext(ctx context.Context, getCaseOptions *GetCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+	// UpdateCaseStatus : Update case status
+	UpdateCaseStatus(updateCaseStatusOptions *UpdateCaseStatusOptions) (result *Case, response *core.DetailedResponse, err error)
+	// UpdateCaseStatusWithContext is an alternate form of the UpdateCaseStatus method which supports a Context parameter
+	UpdateCaseStatusWithContext(ctx context.Context, updateCaseStatusOptions *UpdateCaseStatusOptions) (result *Case, response *core.DetailedResponse, err error)
+	// AddComment : Add comment to case
+	AddComment(addCommentOptions *AddCommentOptions) (result *Comment, response *core.DetailedResponse, err error)
+	// AddCommentWithContext is an alternate form of the AddComment method which supports a Context parameter
+	AddCommentWithContext(ctx context.Context, addCommentOptions *AddCommentOptions) (result *Comment, response *core.DetailedResponse, err error)
+	// AddWatchlist : Add users to watchlist of case
+	AddWatchlist(addWatchlistOptions *AddWatchlistOptions) (result *WatchlistAddResponse, response *core.DetailedResponse, err error)
+	// AddWatchlistWithContext is an alternate form of the AddWatchlist method which supports a Context parameter
+	AddWatchlistWithContext(ctx context.Context, addWatchlistOptions *AddWatchlistOptions) (result *WatchlistAddResponse, response *core.DetailedResponse, err error)
+	// RemoveWatchlist : Remove users from watchlist of case
+	RemoveWatchlist(removeWatchlistOptions *RemoveWatchlistOptions) (result *Watchlist, response *core.DetailedResponse, err error)
+	// RemoveWatchlistWithContext is an alternate form of the RemoveWatchlist method which supports a Context parameter
+	RemoveWatchlistWithContext(ctx context.Context, removeWatchlistOptions *RemoveWatchlistOptions) (result *Watchlist, response *core.DetailedResponse, err error)
+	// AddResource : Add a resource to case
+	AddResource(addResourceOptions *AddResourceOptions) (result *Resource, response *core.DetailedResponse, err error)
+	// AddResourceWithContext is an alternate form of the AddResource method which supports a Context parameter
+	AddResourceWithContext(ctx context.Context, addResourceOptions *AddResourceOptions) (result *Resource, response *core.DetailedResponse, err error)
+	// UploadFile : Add attachments to a support case
+	UploadFile(uploadFileOptions *UploadFileOptions) (result *Attachment, response *core.DetailedResponse, err error)
+	// UploadFileWithContext is an alternate form of the UploadFile method which supports a Context parameter
+	UploadFileWithContext(ctx context.Context, uploadFileOptions *UploadFileOptions) (result *Attachment, response *core.DetailedResponse, err error)
+	// DownloadFile : Download an attachment
+	DownloadFile(downloadFileOptions *DownloadFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// DownloadFileWithContext is an alternate form of the DownloadFile method which supports a Context parameter
+	DownloadFileWithContext(ctx context.Context, downloadFileOptions *DownloadFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// DeleteFile : Remove attachment from case
+	DeleteFile(deleteFileOptions *DeleteFileOptions) (result *AttachmentList, response *core.DetailedResponse, err error)
+	// DeleteFileWithContext is an alternate form of the DeleteFile method which supports a Context parameter
+	DeleteFileWithContext(ctx context.Context, deleteFileOptions *DeleteFileOptions) (result *AttachmentList, response *core.DetailedResponse, err error)
+	// UploadFileFromPath : Add a local file as an attachment to a support case
+	UploadFileFromPath(uploadFileFromPathOptions *UploadFileFromPathOptions) (result *Attachment, response *core.DetailedResponse, err error)
+	// UploadFileFromPathWithContext is an alternate form of the UploadFileFromPath method which supports a Context parameter
+	UploadFileFromPathWithContext(ctx context.Context, uploadFileFromPathOptions *UploadFileFromPathOptions) (result *Attachment, response *core.DetailedResponse, err error)
+	// DownloadFileToPath : Download an attachment to a local file
+	DownloadFileToPath(downloadFileToPathOptions *DownloadFileToPathOptions) (response *core.DetailedResponse, err error)
+	// DownloadFileToPathWithContext is an alternate form of the DownloadFileToPath method which supports a Context parameter
+	DownloadFileToPathWithContext(ctx context.Context, downloadFileToPathOptions *DownloadFileToPathOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ CaseManagementV1Intf = (*CaseManagementV1)(nil)
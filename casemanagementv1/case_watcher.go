@@ -0,0 +1,623 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// CaseEventType identifies the kind of change reported by a CaseWatcher.
+type CaseEventType string
+
+// Constants associated with CaseEventType.
+const (
+	// A case that was not previously known to the watcher has appeared.
+	CaseEventTypeNewCaseConst CaseEventType = "new_case"
+	// A comment was added to a case by someone other than IBM support.
+	CaseEventTypeNewCommentConst CaseEventType = "new_comment"
+	// A comment was added to a case by IBM support.
+	CaseEventTypeNewSupportCommentConst CaseEventType = "new_support_comment"
+	// The status of a case changed.
+	CaseEventTypeStatusChangedConst CaseEventType = "status_changed"
+	// The severity of a case changed.
+	CaseEventTypeSeverityChangedConst CaseEventType = "severity_changed"
+	// IBM support proposed a resolution for a case.
+	CaseEventTypeResolutionProvidedConst CaseEventType = "resolution_provided"
+	// A case was resolved or closed.
+	CaseEventTypeResolvedConst CaseEventType = "resolved"
+	// A case has gone without an IBM support update for longer than its SLA allows.
+	CaseEventTypeSLABreachedConst CaseEventType = "sla_breached"
+)
+
+// defaultWatchedStatuses are the case statuses polled when CaseWatcherOptions.Statuses is not set.
+var defaultWatchedStatuses = []string{
+	GetCasesOptionsStatusNewConst,
+	GetCasesOptionsStatusInProgressConst,
+	GetCasesOptionsStatusWaitingOnClientConst,
+	GetCasesOptionsStatusResolutionProvidedConst,
+}
+
+// defaultCaseWatcherPollInterval is used when CaseWatcherOptions.PollInterval is not set.
+const defaultCaseWatcherPollInterval = 5 * time.Minute
+
+// CaseEvent : A change detected on a support case.
+type CaseEvent struct {
+	// The kind of change.
+	Type CaseEventType
+
+	// Identifying number of the case.
+	CaseNumber string
+
+	// The case as most recently retrieved. Nil for SLA breaches detected without a fresh read.
+	Case *Case
+
+	// The new comment, for CaseEventTypeNewCommentConst and CaseEventTypeNewSupportCommentConst events.
+	Comment *Comment
+
+	// Previous and current status, for CaseEventTypeStatusChangedConst events.
+	OldStatus string
+	NewStatus string
+
+	// Previous and current severity, for CaseEventTypeSeverityChangedConst events.
+	OldSeverity float64
+	NewSeverity float64
+
+	// The SLA that was breached, for CaseEventTypeSLABreachedConst events.
+	SLA *CaseSLA
+
+	// Time at which the watcher detected the change.
+	DetectedAt time.Time
+}
+
+// CaseEventHandler is invoked for each event of the type it was registered for.
+type CaseEventHandler func(ctx context.Context, event *CaseEvent) error
+
+// CaseSLA : The longest a case may wait for an IBM support update before it is escalated.
+type CaseSLA struct {
+	// Severity the SLA applies to; 0 applies to every severity.
+	Severity int64 `json:"severity,omitempty"`
+
+	// Time allowed since the last IBM support update, or since the case's creation when support has not
+	// updated it yet. Cases without a valid creation time are timed from when the watcher first saw them.
+	Within time.Duration `json:"within"`
+
+	// Comment added to the case when the SLA is breached. No comment is added if empty.
+	EscalationComment string `json:"escalation_comment,omitempty"`
+}
+
+// CaseSnapshot : The state of a case as last observed by a CaseWatcher.
+type CaseSnapshot struct {
+	Number              string    `json:"number"`
+	Status              string    `json:"status"`
+	Severity            float64   `json:"severity"`
+	UpdatedAt           string    `json:"updated_at"`
+	CommentCount        int       `json:"comment_count"`
+	LastSupportActivity time.Time `json:"last_support_activity"`
+	Escalated           bool      `json:"escalated"`
+}
+
+// CaseWatcherOptions : The options used to construct a CaseWatcher.
+type CaseWatcherOptions struct {
+	// How often Run polls for changes. Defaults to five minutes.
+	PollInterval time.Duration
+
+	// Case statuses to watch. Defaults to all open statuses.
+	Statuses []string
+
+	// When true, resolutions proposed by IBM support are accepted automatically.
+	AutoAcceptResolution bool
+
+	// Comment sent with an automatic acceptance.
+	AutoAcceptComment string
+
+	// SLAs checked on every poll. The first SLA matching a case's severity applies.
+	SLAs []CaseSLA
+
+	// Decides whether a comment was written by IBM support. By default, a comment is attributed to
+	// support unless its author created the case, is the case contact, or is on the case watchlist.
+	IsSupportComment func(c *Case, comment *Comment) bool
+
+	// Called with the errors of the polls of Run, which then keeps polling. When nil, Run returns the first error.
+	OnError func(err error)
+
+	// Clock used for SLA tracking; defaults to time.Now.
+	Now func() time.Time
+}
+
+// CaseWatcher polls support cases, compares them with a local snapshot and dispatches
+// typed events to registered handlers.
+type CaseWatcher struct {
+	client    *CaseManagementV1
+	options   CaseWatcherOptions
+	polling   sync.Mutex
+	mu        sync.Mutex
+	handlers  map[CaseEventType][]CaseEventHandler
+	snapshots map[string]*CaseSnapshot
+	seeded    bool
+}
+
+// pendingEvent : An event found by a poll, with the snapshot its case takes once the event is delivered.
+type pendingEvent struct {
+	event    CaseEvent
+	snapshot *CaseSnapshot
+}
+
+// NewCaseWatcher returns a new CaseWatcher for the cases visible to this client.
+func (caseManagement *CaseManagementV1) NewCaseWatcher(options *CaseWatcherOptions) (watcher *CaseWatcher, err error) {
+	watcher = &CaseWatcher{
+		client:    caseManagement,
+		handlers:  make(map[CaseEventType][]CaseEventHandler),
+		snapshots: make(map[string]*CaseSnapshot),
+	}
+	if options != nil {
+		watcher.options = *options
+	}
+	if watcher.options.PollInterval < 0 {
+		err = core.SDKErrorf(nil, "the 'options.PollInterval' field must not be negative", "invalid-poll-interval", common.GetComponentInfo())
+		return nil, err
+	}
+	for _, sla := range watcher.options.SLAs {
+		if sla.Within <= 0 {
+			err = core.SDKErrorf(nil, fmt.Sprintf("SLA for severity %d must have a positive 'Within' duration", sla.Severity), "invalid-sla", common.GetComponentInfo())
+			return nil, err
+		}
+	}
+	if watcher.options.PollInterval == 0 {
+		watcher.options.PollInterval = defaultCaseWatcherPollInterval
+	}
+	if len(watcher.options.Statuses) == 0 {
+		watcher.options.Statuses = defaultWatchedStatuses
+	}
+	if watcher.options.IsSupportComment == nil {
+		watcher.options.IsSupportComment = isSupportComment
+	}
+	if watcher.options.Now == nil {
+		watcher.options.Now = time.Now
+	}
+	return
+}
+
+// On registers a handler for the specified event type.
+func (watcher *CaseWatcher) On(eventType CaseEventType, handler CaseEventHandler) *CaseWatcher {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	watcher.handlers[eventType] = append(watcher.handlers[eventType], handler)
+	return watcher
+}
+
+// Snapshots returns a copy of the watcher's current view of each case, suitable for persisting.
+func (watcher *CaseWatcher) Snapshots() []CaseSnapshot {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	snapshots := make([]CaseSnapshot, 0, len(watcher.snapshots))
+	for _, number := range watcher.sortedNumbers(nil) {
+		snapshots = append(snapshots, *watcher.snapshots[number])
+	}
+	return snapshots
+}
+
+// LoadSnapshots replaces the watcher's view of each case, typically with the result of an
+// earlier call to Snapshots. Changes made since the snapshots were taken are reported by the next poll.
+func (watcher *CaseWatcher) LoadSnapshots(snapshots []CaseSnapshot) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+	watcher.snapshots = make(map[string]*CaseSnapshot, len(snapshots))
+	for i := range snapshots {
+		snapshot := snapshots[i]
+		watcher.snapshots[snapshot.Number] = &snapshot
+	}
+	watcher.seeded = true
+}
+
+// Run polls for changes every PollInterval until the context is done, and then returns the context's error.
+// See CaseWatcherOptions.OnError for the handling of errors.
+func (watcher *CaseWatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(watcher.options.PollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := watcher.Poll(ctx); err != nil && ctx.Err() == nil {
+			if watcher.options.OnError == nil {
+				return err
+			}
+			watcher.options.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll performs a single polling cycle and returns the events it dispatched. The first poll of a
+// watcher without loaded snapshots only records the current state of each case.
+//
+// Handlers are called without holding the watcher's lock, so they may call On or Snapshots, but not Poll.
+// When a handler fails, Poll returns the events delivered before the failure; the changes reported by the
+// events that were not delivered are reported again by the next poll.
+func (watcher *CaseWatcher) Poll(ctx context.Context) (events []CaseEvent, err error) {
+	watcher.polling.Lock()
+	defer watcher.polling.Unlock()
+
+	pending, updates, err := watcher.collect(ctx)
+	if err != nil || updates == nil {
+		return
+	}
+	delivered := 0
+	for ; delivered < len(pending); delivered++ {
+		if err = watcher.dispatch(ctx, &pending[delivered]); err != nil {
+			break
+		}
+		events = append(events, pending[delivered].event)
+	}
+	watcher.commit(pending[:delivered], pending[delivered:], updates)
+	return
+}
+
+// collect compares the cases with the snapshots and returns the events to dispatch, along with the updated
+// snapshot of each case that was read (nil for cases that are no longer watched). The first poll of a watcher
+// without loaded snapshots records the snapshots directly and returns no updates.
+func (watcher *CaseWatcher) collect(ctx context.Context) (pending []pendingEvent, updates map[string]*CaseSnapshot, err error) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	now := watcher.options.Now()
+	listed, err := watcher.listCases(ctx)
+	if err != nil {
+		return
+	}
+
+	updates = make(map[string]*CaseSnapshot)
+	seen := make(map[string]bool, len(listed))
+	for i := range listed {
+		number := core.StringNilMapper(listed[i].Number)
+		if number == "" {
+			continue
+		}
+		seen[number] = true
+		previous := watcher.snapshots[number]
+		if previous != nil && !caseChanged(previous, &listed[i]) {
+			continue
+		}
+		var current *Case
+		current, err = watcher.getCase(ctx, number)
+		if err != nil {
+			return nil, nil, err
+		}
+		snapshot, caseEvents := watcher.observe(previous, current, now)
+		updates[number] = snapshot
+		pending = append(pending, caseEvents...)
+	}
+
+	// Cases that dropped out of the listing have usually been resolved or closed.
+	for _, number := range watcher.sortedNumbers(nil) {
+		if seen[number] {
+			continue
+		}
+		var current *Case
+		current, err = watcher.getCase(ctx, number)
+		if err != nil {
+			return nil, nil, err
+		}
+		snapshot, caseEvents := watcher.observe(watcher.snapshots[number], current, now)
+		if !watcher.isWatchedStatus(core.StringNilMapper(current.Status)) {
+			snapshot = nil
+		}
+		updates[number] = snapshot
+		pending = append(pending, caseEvents...)
+	}
+
+	if !watcher.seeded {
+		watcher.seeded = true
+		watcher.apply(updates)
+		return nil, nil, nil
+	}
+
+	pending = append(pending, watcher.checkSLAs(now, updates)...)
+	return
+}
+
+// dispatch performs the automatic actions for an event, then passes it to the handlers registered for its type.
+func (watcher *CaseWatcher) dispatch(ctx context.Context, pending *pendingEvent) (err error) {
+	if err = watcher.react(ctx, &pending.event, pending.snapshot); err != nil {
+		return
+	}
+	watcher.mu.Lock()
+	handlers := watcher.handlers[pending.event.Type]
+	watcher.mu.Unlock()
+	for _, handler := range handlers {
+		if err = handler(ctx, &pending.event); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// commit records the updated snapshots of the cases whose events were all delivered. A case with undelivered
+// events takes the snapshot of its last delivered event, if any, so that the next poll reports the rest again.
+func (watcher *CaseWatcher) commit(delivered []pendingEvent, undelivered []pendingEvent, updates map[string]*CaseSnapshot) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	for _, pending := range undelivered {
+		delete(updates, pending.event.CaseNumber)
+	}
+	for _, pending := range delivered {
+		if _, updated := updates[pending.event.CaseNumber]; !updated {
+			watcher.snapshots[pending.event.CaseNumber] = pending.snapshot
+		}
+	}
+	watcher.apply(updates)
+}
+
+// apply records updated snapshots; a nil snapshot removes the case.
+func (watcher *CaseWatcher) apply(updates map[string]*CaseSnapshot) {
+	for number, snapshot := range updates {
+		if snapshot == nil {
+			delete(watcher.snapshots, number)
+		} else {
+			watcher.snapshots[number] = snapshot
+		}
+	}
+}
+
+// listCases retrieves a summary of every case with a watched status.
+func (watcher *CaseWatcher) listCases(ctx context.Context) (cases []Case, err error) {
+	options := watcher.client.NewGetCasesOptions().
+		SetStatus(watcher.options.Statuses).
+		SetFields([]string{
+			GetCasesOptionsFieldsNumberConst,
+			GetCasesOptionsFieldsStatusConst,
+			GetCasesOptionsFieldsSeverityConst,
+			GetCasesOptionsFieldsUpdatedAtConst,
+		})
+	pager, err := watcher.client.NewGetCasesPager(options)
+	if err != nil {
+		return
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+func (watcher *CaseWatcher) getCase(ctx context.Context, number string) (result *Case, err error) {
+	result, _, err = watcher.client.GetCaseWithContext(ctx, watcher.client.NewGetCaseOptions(number))
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("error retrieving case '%s'", number), "get-case-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// observe returns the current state of a case and the events describing how it differs from the previous
+// snapshot. Each event carries the snapshot the case takes once the event and those before it are delivered.
+func (watcher *CaseWatcher) observe(previous *CaseSnapshot, current *Case, now time.Time) (snapshot *CaseSnapshot, events []pendingEvent) {
+	number := core.StringNilMapper(current.Number)
+	status := core.StringNilMapper(current.Status)
+	severity := float64Value(current.Severity)
+
+	snapshot = &CaseSnapshot{
+		Number:              number,
+		Status:              status,
+		Severity:            severity,
+		UpdatedAt:           core.StringNilMapper(current.UpdatedAt),
+		CommentCount:        len(current.Comments),
+		LastSupportActivity: createdAt(current, now),
+	}
+	newEvent := func(eventType CaseEventType, delivered CaseSnapshot) *CaseEvent {
+		events = append(events, pendingEvent{
+			event:    CaseEvent{Type: eventType, CaseNumber: number, Case: current, DetectedAt: now},
+			snapshot: &delivered,
+		})
+		return &events[len(events)-1].event
+	}
+
+	if previous == nil {
+		newEvent(CaseEventTypeNewCaseConst, *snapshot)
+		return
+	}
+	snapshot.LastSupportActivity = previous.LastSupportActivity
+	snapshot.Escalated = previous.Escalated
+	delivered := *previous
+
+	for i := previous.CommentCount; i < len(current.Comments); i++ {
+		comment := &current.Comments[i]
+		eventType := CaseEventTypeNewCommentConst
+		delivered.CommentCount = i + 1
+		if watcher.options.IsSupportComment(current, comment) {
+			eventType = CaseEventTypeNewSupportCommentConst
+			snapshot.LastSupportActivity = now
+			snapshot.Escalated = false
+			delivered.LastSupportActivity = now
+			delivered.Escalated = false
+		}
+		newEvent(eventType, delivered).Comment = comment
+	}
+
+	if status != previous.Status {
+		event := newEvent(CaseEventTypeStatusChangedConst, delivered)
+		event.OldStatus = previous.Status
+		event.NewStatus = status
+
+		switch status {
+		case GetCasesOptionsStatusResolutionProvidedConst:
+			newEvent(CaseEventTypeResolutionProvidedConst, delivered)
+		case GetCasesOptionsStatusResolvedConst, GetCasesOptionsStatusClosedConst:
+			newEvent(CaseEventTypeResolvedConst, delivered)
+		}
+		// The new status is kept once every event reporting it is delivered.
+		delivered.Status = status
+		events[len(events)-1].snapshot.Status = status
+	}
+
+	if severity != previous.Severity {
+		delivered.Severity = severity
+		event := newEvent(CaseEventTypeSeverityChangedConst, delivered)
+		event.OldSeverity = previous.Severity
+		event.NewSeverity = severity
+	}
+	return
+}
+
+// checkSLAs returns an event for each open case that has waited longer than its SLA allows
+// for an IBM support update. Each case is reported once until support responds.
+func (watcher *CaseWatcher) checkSLAs(now time.Time, updates map[string]*CaseSnapshot) (events []pendingEvent) {
+	for _, number := range watcher.sortedNumbers(updates) {
+		snapshot, updated := updates[number]
+		if !updated {
+			snapshot = watcher.snapshots[number]
+		}
+		if snapshot == nil || snapshot.Escalated || !awaitingSupport(snapshot.Status) {
+			continue
+		}
+		sla := watcher.slaFor(snapshot.Severity)
+		if sla == nil || now.Sub(snapshot.LastSupportActivity) <= sla.Within {
+			continue
+		}
+		escalated := *snapshot
+		escalated.Escalated = true
+		updates[number] = &escalated
+		events = append(events, pendingEvent{
+			event: CaseEvent{
+				Type:       CaseEventTypeSLABreachedConst,
+				CaseNumber: number,
+				SLA:        sla,
+				DetectedAt: now,
+			},
+			snapshot: &escalated,
+		})
+	}
+	return
+}
+
+// react performs the automatic actions configured for an event before it is dispatched to handlers.
+// The snapshot is the one the case takes once the event is delivered.
+func (watcher *CaseWatcher) react(ctx context.Context, event *CaseEvent, snapshot *CaseSnapshot) (err error) {
+	switch event.Type {
+	case CaseEventTypeResolutionProvidedConst:
+		if !watcher.options.AutoAcceptResolution {
+			return
+		}
+		payload := &AcceptPayload{Action: core.StringPtr(AcceptPayloadActionAcceptConst)}
+		if watcher.options.AutoAcceptComment != "" {
+			payload.Comment = core.StringPtr(watcher.options.AutoAcceptComment)
+		}
+		_, _, err = watcher.client.UpdateCaseStatusWithContext(ctx, watcher.client.NewUpdateCaseStatusOptions(event.CaseNumber, payload))
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("error accepting resolution of case '%s'", event.CaseNumber), "accept-resolution-error", common.GetComponentInfo())
+		}
+	case CaseEventTypeSLABreachedConst:
+		if event.SLA.EscalationComment == "" {
+			return
+		}
+		_, _, err = watcher.client.AddCommentWithContext(ctx, watcher.client.NewAddCommentOptions(event.CaseNumber, event.SLA.EscalationComment))
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("error escalating case '%s'", event.CaseNumber), "escalation-error", common.GetComponentInfo())
+			return
+		}
+		// Our own escalation comment must not be mistaken for new activity on the next poll.
+		snapshot.CommentCount++
+	}
+	return
+}
+
+func (watcher *CaseWatcher) slaFor(severity float64) *CaseSLA {
+	for i := range watcher.options.SLAs {
+		sla := &watcher.options.SLAs[i]
+		if sla.Severity == 0 || float64(sla.Severity) == severity {
+			return sla
+		}
+	}
+	return nil
+}
+
+func (watcher *CaseWatcher) isWatchedStatus(status string) bool {
+	for _, watched := range watcher.options.Statuses {
+		if watched == status {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedNumbers returns the numbers of the cases with a snapshot or an update.
+func (watcher *CaseWatcher) sortedNumbers(updates map[string]*CaseSnapshot) []string {
+	numbers := make([]string, 0, len(watcher.snapshots)+len(updates))
+	for number := range watcher.snapshots {
+		numbers = append(numbers, number)
+	}
+	for number := range updates {
+		if _, found := watcher.snapshots[number]; !found {
+			numbers = append(numbers, number)
+		}
+	}
+	sort.Strings(numbers)
+	return numbers
+}
+
+// caseChanged returns true if the summary of a listed case differs from its snapshot.
+func caseChanged(snapshot *CaseSnapshot, listed *Case) bool {
+	return snapshot.UpdatedAt != core.StringNilMapper(listed.UpdatedAt) ||
+		snapshot.Status != core.StringNilMapper(listed.Status) ||
+		snapshot.Severity != float64Value(listed.Severity)
+}
+
+// awaitingSupport returns true for statuses in which the next action is up to IBM support.
+func awaitingSupport(status string) bool {
+	return status == GetCasesOptionsStatusNewConst || status == GetCasesOptionsStatusInProgressConst
+}
+
+// isSupportComment is the default CaseWatcherOptions.IsSupportComment implementation.
+func isSupportComment(c *Case, comment *Comment) bool {
+	if comment.AddedBy == nil || comment.AddedBy.UserID == nil {
+		return false
+	}
+	author := *comment.AddedBy.UserID
+	accountUsers := append([]User{}, c.Watchlist...)
+	if c.CreatedBy != nil {
+		accountUsers = append(accountUsers, *c.CreatedBy)
+	}
+	if c.Contact != nil {
+		accountUsers = append(accountUsers, *c.Contact)
+	}
+	for _, user := range accountUsers {
+		if core.StringNilMapper(user.UserID) == author {
+			return false
+		}
+	}
+	return true
+}
+
+func float64Value(p *float64) float64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// createdAt returns the creation time of a case, or "now" if the case has no valid creation time.
+func createdAt(c *Case, now time.Time) time.Time {
+	created, err := time.Parse(time.RFC3339, core.StringNilMapper(c.CreatedAt))
+	if err != nil || created.After(now) {
+		return now
+	}
+	return created
+}
@@ -0,0 +1,297 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package casemanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CaseWatcher`, func() {
+	var testServer *httptest.Server
+	var mu sync.Mutex
+	var current map[string]interface{}
+	var requests []string
+
+	customer := map[string]interface{}{"realm": "IBMid", "user_id": "customer@example.com"}
+	agent := map[string]interface{}{"realm": "IBMid", "user_id": "agent@ibm.com"}
+
+	BeforeEach(func() {
+		requests = nil
+		current = map[string]interface{}{
+			"number":     "CS0001",
+			"status":     "in_progress",
+			"severity":   2,
+			"updated_at": "2026-01-01T00:00:00Z",
+			"created_by": customer,
+			"comments": []interface{}{
+				map[string]interface{}{"value": "help", "added_by": customer},
+			},
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mu.Lock()
+			defer mu.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /cases":
+				var cases []interface{}
+				for _, status := range []string{"new", "in_progress", "waiting_on_client", "resolution_provided"} {
+					if current["status"] == status {
+						cases = append(cases, current)
+					}
+				}
+				_ = json.NewEncoder(res).Encode(map[string]interface{}{"total_count": len(cases), "cases": cases})
+			case "GET /cases/CS0001":
+				_ = json.NewEncoder(res).Encode(current)
+			case "PUT /cases/CS0001/status", "PUT /cases/CS0001/comments":
+				body, _ := io.ReadAll(req.Body)
+				requests = append(requests, req.URL.Path+" "+string(body))
+				_ = json.NewEncoder(res).Encode(current)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.Path)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *casemanagementv1.CaseManagementV1 {
+		caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return caseManagementService
+	}
+	update := func(changes map[string]interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		for k, v := range changes {
+			current[k] = v
+		}
+	}
+
+	It(`Reports comments and status changes, and accepts resolutions`, func() {
+		watcher, err := newService().NewCaseWatcher(&casemanagementv1.CaseWatcherOptions{
+			AutoAcceptResolution: true,
+			AutoAcceptComment:    "thanks",
+		})
+		Expect(err).To(BeNil())
+
+		var handled []casemanagementv1.CaseEventType
+		record := func(ctx context.Context, event *casemanagementv1.CaseEvent) error {
+			handled = append(handled, event.Type)
+			return nil
+		}
+		watcher.On(casemanagementv1.CaseEventTypeNewSupportCommentConst, record)
+		watcher.On(casemanagementv1.CaseEventTypeResolutionProvidedConst, record)
+
+		events, err := watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+		Expect(watcher.Snapshots()).To(HaveLen(1))
+
+		update(map[string]interface{}{
+			"status":     "resolution_provided",
+			"updated_at": "2026-01-02T00:00:00Z",
+			"comments": []interface{}{
+				map[string]interface{}{"value": "help", "added_by": customer},
+				map[string]interface{}{"value": "fixed", "added_by": agent},
+			},
+		})
+		events, err = watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(3))
+		Expect(events[0].Type).To(Equal(casemanagementv1.CaseEventTypeNewSupportCommentConst))
+		Expect(*events[0].Comment.Value).To(Equal("fixed"))
+		Expect(events[1].Type).To(Equal(casemanagementv1.CaseEventTypeStatusChangedConst))
+		Expect(events[1].OldStatus).To(Equal("in_progress"))
+		Expect(events[1].NewStatus).To(Equal("resolution_provided"))
+		Expect(events[2].Type).To(Equal(casemanagementv1.CaseEventTypeResolutionProvidedConst))
+		Expect(handled).To(Equal([]casemanagementv1.CaseEventType{
+			casemanagementv1.CaseEventTypeNewSupportCommentConst,
+			casemanagementv1.CaseEventTypeResolutionProvidedConst,
+		}))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0]).To(ContainSubstring(`"action":"accept"`))
+
+		update(map[string]interface{}{"status": "resolved", "updated_at": "2026-01-03T00:00:00Z"})
+		events, err = watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(2))
+		Expect(events[1].Type).To(Equal(casemanagementv1.CaseEventTypeResolvedConst))
+		Expect(watcher.Snapshots()).To(BeEmpty())
+	})
+
+	It(`Escalates cases that breach their SLA once`, func() {
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		watcher, err := newService().NewCaseWatcher(&casemanagementv1.CaseWatcherOptions{
+			SLAs: []casemanagementv1.CaseSLA{
+				{Severity: 2, Within: time.Hour, EscalationComment: "please escalate"},
+			},
+			Now: func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+
+		events, err := watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+
+		now = now.Add(2 * time.Hour)
+		events, err = watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Type).To(Equal(casemanagementv1.CaseEventTypeSLABreachedConst))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0]).To(ContainSubstring("please escalate"))
+
+		now = now.Add(2 * time.Hour)
+		events, err = watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+	})
+
+	It(`Times the SLA of a new case from its creation`, func() {
+		update(map[string]interface{}{"created_at": "2026-01-01T00:00:00Z"})
+		now := time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC)
+		watcher, err := newService().NewCaseWatcher(&casemanagementv1.CaseWatcherOptions{
+			SLAs: []casemanagementv1.CaseSLA{{Within: time.Hour}},
+			Now:  func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+
+		events, err := watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+		Expect(watcher.Snapshots()[0].LastSupportActivity).To(Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+		events, err = watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Type).To(Equal(casemanagementv1.CaseEventTypeSLABreachedConst))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It(`Reports again the events a handler failed to handle`, func() {
+		watcher, err := newService().NewCaseWatcher(nil)
+		Expect(err).To(BeNil())
+		_, err = watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+
+		failures := 1
+		var handled []casemanagementv1.CaseEventType
+		watcher.On(casemanagementv1.CaseEventTypeNewSupportCommentConst, func(ctx context.Context, event *casemanagementv1.CaseEvent) error {
+			// Handlers may use the watcher.
+			Expect(watcher.Snapshots()).To(HaveLen(1))
+			handled = append(handled, event.Type)
+			return nil
+		})
+		watcher.On(casemanagementv1.CaseEventTypeStatusChangedConst, func(ctx context.Context, event *casemanagementv1.CaseEvent) error {
+			if failures > 0 {
+				failures--
+				return errors.New("handler failed")
+			}
+			handled = append(handled, event.Type)
+			return nil
+		})
+
+		update(map[string]interface{}{
+			"status":     "waiting_on_client",
+			"severity":   1,
+			"updated_at": "2026-01-02T00:00:00Z",
+			"comments": []interface{}{
+				map[string]interface{}{"value": "help", "added_by": customer},
+				map[string]interface{}{"value": "more details?", "added_by": agent},
+			},
+		})
+		events, err := watcher.Poll(context.Background())
+		Expect(err).ToNot(BeNil())
+		Expect(events).To(HaveLen(1))
+		Expect(watcher.Snapshots()[0].CommentCount).To(Equal(2))
+		Expect(watcher.Snapshots()[0].Status).To(Equal("in_progress"))
+
+		events, err = watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Type).To(Equal(casemanagementv1.CaseEventTypeStatusChangedConst))
+		Expect(events[1].Type).To(Equal(casemanagementv1.CaseEventTypeSeverityChangedConst))
+		Expect(handled).To(Equal([]casemanagementv1.CaseEventType{
+			casemanagementv1.CaseEventTypeNewSupportCommentConst,
+			casemanagementv1.CaseEventTypeStatusChangedConst,
+		}))
+		Expect(watcher.Snapshots()[0].Status).To(Equal("waiting_on_client"))
+
+		events, err = watcher.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+	})
+
+	It(`Returns the first error of Run without an OnError callback`, func() {
+		failingServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(500)
+			_, _ = res.Write([]byte(`{"error": "unavailable"}`))
+		}))
+		defer failingServer.Close()
+		caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+			URL:           failingServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		watcher, err := caseManagementService.NewCaseWatcher(&casemanagementv1.CaseWatcherOptions{PollInterval: time.Millisecond})
+		Expect(err).To(BeNil())
+
+		err = watcher.Run(context.Background())
+		Expect(err).ToNot(BeNil())
+
+		var errs []error
+		ctx, cancel := context.WithCancel(context.Background())
+		watcher, err = caseManagementService.NewCaseWatcher(&casemanagementv1.CaseWatcherOptions{
+			PollInterval: time.Millisecond,
+			OnError: func(err error) {
+				errs = append(errs, err)
+				if len(errs) == 2 {
+					cancel()
+				}
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(watcher.Run(ctx)).To(Equal(context.Canceled))
+		Expect(errs).To(HaveLen(2))
+	})
+
+	It(`Rejects invalid options`, func() {
+		_, err := newService().NewCaseWatcher(&casemanagementv1.CaseWatcherOptions{
+			SLAs: []casemanagementv1.CaseSLA{{Severity: 1}},
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,421 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the CaseManagementV1 service client.
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// CaseManagementV1 : A testify mock of the CaseManagementV1 service client.
+type CaseManagementV1 struct {
+	mock.Mock
+}
+
+var _ casemanagementv1.CaseManagementV1Intf = (*CaseManagementV1)(nil)
+
+// GetCases provides a mock function with the given fields.
+func (_m *CaseManagementV1) GetCases(getCasesOptions *casemanagementv1.GetCasesOptions) (*casemanagementv1.CaseList, *core.DetailedResponse, error) {
+	ret := _m.Called(getCasesOptions)
+	var r0 *casemanagementv1.CaseList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.CaseList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCasesWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) GetCasesWithContext(ctx context.Context, getCasesOptions *casemanagementv1.GetCasesOptions) (*casemanagementv1.CaseList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCasesOptions)
+	var r0 *casemanagementv1.CaseList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.CaseList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateCase provides a mock function with the given fields.
+func (_m *CaseManagementV1) CreateCase(createCaseOptions *casemanagementv1.CreateCaseOptions) (*casemanagementv1.Case, *core.DetailedResponse, error) {
+	ret := _m.Called(createCaseOptions)
+	var r0 *casemanagementv1.Case
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Case)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateCaseWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) CreateCaseWithContext(ctx context.Context, createCaseOptions *casemanagementv1.CreateCaseOptions) (*casemanagementv1.Case, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createCaseOptions)
+	var r0 *casemanagementv1.Case
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Case)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCase provides a mock function with the given fields.
+func (_m *CaseManagementV1) GetCase(getCaseOptions *casemanagementv1.GetCaseOptions) (*casemanagementv1.Case, *core.DetailedResponse, error) {
+	ret := _m.Called(getCaseOptions)
+	var r0 *casemanagementv1.Case
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Case)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCaseWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) GetCaseWithContext(ctx context.Context, getCaseOptions *casemanagementv1.GetCaseOptions) (*casemanagementv1.Case, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCaseOptions)
+	var r0 *casemanagementv1.Case
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Case)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCaseStatus provides a mock function with the given fields.
+func (_m *CaseManagementV1) UpdateCaseStatus(updateCaseStatusOptions *casemanagementv1.UpdateCaseStatusOptions) (*casemanagementv1.Case, *core.DetailedResponse, error) {
+	ret := _m.Called(updateCaseStatusOptions)
+	var r0 *casemanagementv1.Case
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Case)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCaseStatusWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) UpdateCaseStatusWithContext(ctx context.Context, updateCaseStatusOptions *casemanagementv1.UpdateCaseStatusOptions) (*casemanagementv1.Case, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateCaseStatusOptions)
+	var r0 *casemanagementv1.Case
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Case)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddComment provides a mock function with the given fields.
+func (_m *CaseManagementV1) AddComment(addCommentOptions *casemanagementv1.AddCommentOptions) (*casemanagementv1.Comment, *core.DetailedResponse, error) {
+	ret := _m.Called(addCommentOptions)
+	var r0 *casemanagementv1.Comment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Comment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddCommentWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) AddCommentWithContext(ctx context.Context, addCommentOptions *casemanagementv1.AddCommentOptions) (*casemanagementv1.Comment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, addCommentOptions)
+	var r0 *casemanagementv1.Comment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Comment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddWatchlist provides a mock function with the given fields.
+func (_m *CaseManagementV1) AddWatchlist(addWatchlistOptions *casemanagementv1.AddWatchlistOptions) (*casemanagementv1.WatchlistAddResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(addWatchlistOptions)
+	var r0 *casemanagementv1.WatchlistAddResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.WatchlistAddResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddWatchlistWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) AddWatchlistWithContext(ctx context.Context, addWatchlistOptions *casemanagementv1.AddWatchlistOptions) (*casemanagementv1.WatchlistAddResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, addWatchlistOptions)
+	var r0 *casemanagementv1.WatchlistAddResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.WatchlistAddResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RemoveWatchlist provides a mock function with the given fields.
+func (_m *CaseManagementV1) RemoveWatchlist(removeWatchlistOptions *casemanagementv1.RemoveWatchlistOptions) (*casemanagementv1.Watchlist, *core.DetailedResponse, error) {
+	ret := _m.Called(removeWatchlistOptions)
+	var r0 *casemanagementv1.Watchlist
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Watchlist)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RemoveWatchlistWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) RemoveWatchlistWithContext(ctx context.Context, removeWatchlistOptions *casemanagementv1.RemoveWatchlistOptions) (*casemanagementv1.Watchlist, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, removeWatchlistOptions)
+	var r0 *casemanagementv1.Watchlist
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Watchlist)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddResource provides a mock function with the given fields.
+func (_m *CaseManagementV1) AddResource(addResourceOptions *casemanagementv1.AddResourceOptions) (*casemanagementv1.Resource, *core.DetailedResponse, error) {
+	ret := _m.Called(addResourceOptions)
+	var r0 *casemanagementv1.Resource
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Resource)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddResourceWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) AddResourceWithContext(ctx context.Context, addResourceOptions *casemanagementv1.AddResourceOptions) (*casemanagementv1.Resource, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, addResourceOptions)
+	var r0 *casemanagementv1.Resource
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Resource)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UploadFile provides a mock function with the given fields.
+func (_m *CaseManagementV1) UploadFile(uploadFileOptions *casemanagementv1.UploadFileOptions) (*casemanagementv1.Attachment, *core.DetailedResponse, error) {
+	ret := _m.Called(uploadFileOptions)
+	var r0 *casemanagementv1.Attachment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Attachment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UploadFileWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) UploadFileWithContext(ctx context.Context, uploadFileOptions *casemanagementv1.UploadFileOptions) (*casemanagementv1.Attachment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, uploadFileOptions)
+	var r0 *casemanagementv1.Attachment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Attachment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DownloadFile provides a mock function with the given fields.
+func (_m *CaseManagementV1) DownloadFile(downloadFileOptions *casemanagementv1.DownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(downloadFileOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DownloadFileWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) DownloadFileWithContext(ctx context.Context, downloadFileOptions *casemanagementv1.DownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, downloadFileOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteFile provides a mock function with the given fields.
+func (_m *CaseManagementV1) DeleteFile(deleteFileOptions *casemanagementv1.DeleteFileOptions) (*casemanagementv1.AttachmentList, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteFileOptions)
+	var r0 *casemanagementv1.AttachmentList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.AttachmentList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteFileWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) DeleteFileWithContext(ctx context.Context, deleteFileOptions *casemanagementv1.DeleteFileOptions) (*casemanagementv1.AttachmentList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteFileOptions)
+	var r0 *casemanagementv1.AttachmentList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.AttachmentList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UploadFileFromPath provides a mock function with the given fields.
+func (_m *CaseManagementV1) UploadFileFromPath(uploadFileFromPathOptions *casemanagementv1.UploadFileFromPathOptions) (*casemanagementv1.Attachment, *core.DetailedResponse, error) {
+	ret := _m.Called(uploadFileFromPathOptions)
+	var r0 *casemanagementv1.Attachment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Attachment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UploadFileFromPathWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) UploadFileFromPathWithContext(ctx context.Context, uploadFileFromPathOptions *casemanagementv1.UploadFileFromPathOptions) (*casemanagementv1.Attachment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, uploadFileFromPathOptions)
+	var r0 *casemanagementv1.Attachment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*casemanagementv1.Attachment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DownloadFileToPath provides a mock function with the given fields.
+func (_m *CaseManagementV1) DownloadFileToPath(downloadFileToPathOptions *casemanagementv1.DownloadFileToPathOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(downloadFileToPathOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DownloadFileToPathWithContext provides a mock function with the given fields.
+func (_m *CaseManagementV1) DownloadFileToPathWithContext(ctx context.Context, downloadFileToPathOptions *casemanagementv1.DownloadFileToPathOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, downloadFileToPathOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
@@ -191,7 +191,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAccountWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetCatalogAccount"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogaccount`, nil)
 	if err != nil {
@@ -219,7 +219,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAccountWithContext(ctx c
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -251,7 +251,7 @@ func (catalogManagement *CatalogManagementV1) UpdateCatalogAccountWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "UpdateCatalogAccount"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogaccount`, nil)
 	if err != nil {
@@ -305,7 +305,7 @@ func (catalogManagement *CatalogManagementV1) UpdateCatalogAccountWithContext(ct
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_catalog_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -337,7 +337,7 @@ func (catalogManagement *CatalogManagementV1) ListCatalogAccountAuditsWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListCatalogAccountAudits"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogaccount/audits`, nil)
 	if err != nil {
@@ -375,7 +375,7 @@ func (catalogManagement *CatalogManagementV1) ListCatalogAccountAuditsWithContex
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_catalog_account_audits", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -416,7 +416,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAccountAuditWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetCatalogAccountAudit"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogaccount/audits/{auditlog_identifier}`, pathParamsMap)
 	if err != nil {
@@ -448,7 +448,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAccountAuditWithContext(
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_account_audit", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -480,7 +480,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAccountFiltersWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetCatalogAccountFilters"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogaccount/filters`, nil)
 	if err != nil {
@@ -512,7 +512,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAccountFiltersWithContex
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_account_filters", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -553,7 +553,7 @@ func (catalogManagement *CatalogManagementV1) GetShareApprovalListWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetShareApprovalList"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/shareapproval/{object_type}/access`, pathParamsMap)
 	if err != nil {
@@ -588,7 +588,7 @@ func (catalogManagement *CatalogManagementV1) GetShareApprovalListWithContext(ct
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_share_approval_list", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -629,7 +629,7 @@ func (catalogManagement *CatalogManagementV1) DeleteShareApprovalListWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteShareApprovalList"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/shareapproval/{object_type}/access`, pathParamsMap)
 	if err != nil {
@@ -664,7 +664,7 @@ func (catalogManagement *CatalogManagementV1) DeleteShareApprovalListWithContext
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_share_approval_list", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -705,7 +705,7 @@ func (catalogManagement *CatalogManagementV1) AddShareApprovalListWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "AddShareApprovalList"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/shareapproval/{object_type}/access`, pathParamsMap)
 	if err != nil {
@@ -740,7 +740,7 @@ func (catalogManagement *CatalogManagementV1) AddShareApprovalListWithContext(ct
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_share_approval_list", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -783,7 +783,7 @@ func (catalogManagement *CatalogManagementV1) GetShareApprovalListAsSourceWithCo
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetShareApprovalListAsSource"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/shareapproval/{object_type}/access/source/{approval_state_identifier}`, pathParamsMap)
 	if err != nil {
@@ -821,7 +821,7 @@ func (catalogManagement *CatalogManagementV1) GetShareApprovalListAsSourceWithCo
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_share_approval_list_as_source", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -864,7 +864,7 @@ func (catalogManagement *CatalogManagementV1) UpdateShareApprovalListAsSourceWit
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "UpdateShareApprovalListAsSource"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/shareapproval/{object_type}/access/source/{approval_state_identifier}`, pathParamsMap)
 	if err != nil {
@@ -903,7 +903,7 @@ func (catalogManagement *CatalogManagementV1) UpdateShareApprovalListAsSourceWit
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_share_approval_list_as_source", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -936,7 +936,7 @@ func (catalogManagement *CatalogManagementV1) ListCatalogsWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListCatalogs"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs`, nil)
 	if err != nil {
@@ -964,7 +964,7 @@ func (catalogManagement *CatalogManagementV1) ListCatalogsWithContext(ctx contex
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_catalogs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -996,7 +996,7 @@ func (catalogManagement *CatalogManagementV1) CreateCatalogWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "CreateCatalog"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs`, nil)
 	if err != nil {
@@ -1077,7 +1077,7 @@ func (catalogManagement *CatalogManagementV1) CreateCatalogWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_catalog", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1118,7 +1118,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetCatalog"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}`, pathParamsMap)
 	if err != nil {
@@ -1146,7 +1146,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogWithContext(ctx context.
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1187,7 +1187,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceCatalogWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ReplaceCatalog"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}`, pathParamsMap)
 	if err != nil {
@@ -1274,7 +1274,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceCatalogWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_catalog", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1315,7 +1315,7 @@ func (catalogManagement *CatalogManagementV1) DeleteCatalogWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteCatalog"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}`, pathParamsMap)
 	if err != nil {
@@ -1341,7 +1341,7 @@ func (catalogManagement *CatalogManagementV1) DeleteCatalogWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_catalog", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1374,7 +1374,7 @@ func (catalogManagement *CatalogManagementV1) ListCatalogAuditsWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListCatalogAudits"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/audits`, pathParamsMap)
 	if err != nil {
@@ -1412,7 +1412,7 @@ func (catalogManagement *CatalogManagementV1) ListCatalogAuditsWithContext(ctx c
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_catalog_audits", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1454,7 +1454,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAuditWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetCatalogAudit"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/audits/{auditlog_identifier}`, pathParamsMap)
 	if err != nil {
@@ -1486,7 +1486,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAuditWithContext(ctx con
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_audit", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1527,7 +1527,7 @@ func (catalogManagement *CatalogManagementV1) ListEnterpriseAuditsWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListEnterpriseAudits"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/enterprises/{enterprise_identifier}/audits`, pathParamsMap)
 	if err != nil {
@@ -1565,7 +1565,7 @@ func (catalogManagement *CatalogManagementV1) ListEnterpriseAuditsWithContext(ct
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_enterprise_audits", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1607,7 +1607,7 @@ func (catalogManagement *CatalogManagementV1) GetEnterpriseAuditWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetEnterpriseAudit"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/enterprises/{enterprise_identifier}/audits/{auditlog_identifier}`, pathParamsMap)
 	if err != nil {
@@ -1639,7 +1639,7 @@ func (catalogManagement *CatalogManagementV1) GetEnterpriseAuditWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_enterprise_audit", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1673,7 +1673,7 @@ func (catalogManagement *CatalogManagementV1) GetConsumptionOfferingsWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetConsumptionOfferings"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/offerings`, nil)
 	if err != nil {
@@ -1720,7 +1720,7 @@ func (catalogManagement *CatalogManagementV1) GetConsumptionOfferingsWithContext
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_consumption_offerings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1762,7 +1762,7 @@ func (catalogManagement *CatalogManagementV1) ListOfferingsWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListOfferings"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings`, pathParamsMap)
 	if err != nil {
@@ -1809,7 +1809,7 @@ func (catalogManagement *CatalogManagementV1) ListOfferingsWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_offerings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1850,7 +1850,7 @@ func (catalogManagement *CatalogManagementV1) CreateOfferingWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "CreateOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings`, pathParamsMap)
 	if err != nil {
@@ -2018,7 +2018,7 @@ func (catalogManagement *CatalogManagementV1) CreateOfferingWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2060,7 +2060,7 @@ func (catalogManagement *CatalogManagementV1) ImportOfferingVersionWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ImportOfferingVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/version`, pathParamsMap)
 	if err != nil {
@@ -2163,7 +2163,7 @@ func (catalogManagement *CatalogManagementV1) ImportOfferingVersionWithContext(c
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "import_offering_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2204,7 +2204,7 @@ func (catalogManagement *CatalogManagementV1) ImportOfferingWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ImportOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/import/offerings`, pathParamsMap)
 	if err != nil {
@@ -2310,7 +2310,7 @@ func (catalogManagement *CatalogManagementV1) ImportOfferingWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "import_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2352,7 +2352,7 @@ func (catalogManagement *CatalogManagementV1) ReloadOfferingWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ReloadOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/reload`, pathParamsMap)
 	if err != nil {
@@ -2417,7 +2417,7 @@ func (catalogManagement *CatalogManagementV1) ReloadOfferingWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "reload_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2459,7 +2459,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}`, pathParamsMap)
 	if err != nil {
@@ -2494,7 +2494,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingWithContext(ctx context
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2536,7 +2536,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceOfferingWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ReplaceOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}`, pathParamsMap)
 	if err != nil {
@@ -2710,7 +2710,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceOfferingWithContext(ctx con
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2752,7 +2752,7 @@ func (catalogManagement *CatalogManagementV1) UpdateOfferingWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "UpdateOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}`, pathParamsMap)
 	if err != nil {
@@ -2790,7 +2790,7 @@ func (catalogManagement *CatalogManagementV1) UpdateOfferingWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2832,7 +2832,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOfferingWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}`, pathParamsMap)
 	if err != nil {
@@ -2861,7 +2861,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOfferingWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2895,7 +2895,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingStatsWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingStats"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/stats`, pathParamsMap)
 	if err != nil {
@@ -2923,7 +2923,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingStatsWithContext(ctx co
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_stats", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2965,7 +2965,7 @@ func (catalogManagement *CatalogManagementV1) ListOfferingAuditsWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListOfferingAudits"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/audits`, pathParamsMap)
 	if err != nil {
@@ -3003,7 +3003,7 @@ func (catalogManagement *CatalogManagementV1) ListOfferingAuditsWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_offering_audits", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3046,7 +3046,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAuditWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingAudit"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/audits/{auditlog_identifier}`, pathParamsMap)
 	if err != nil {
@@ -3078,7 +3078,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAuditWithContext(ctx co
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_audit", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3124,7 +3124,7 @@ func (catalogManagement *CatalogManagementV1) SetOfferingPublishWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "SetOfferingPublish"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/publish/{approval_type}/{approved}`, pathParamsMap)
 	if err != nil {
@@ -3165,7 +3165,7 @@ func (catalogManagement *CatalogManagementV1) SetOfferingPublishWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_offering_publish", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3208,7 +3208,7 @@ func (catalogManagement *CatalogManagementV1) DeprecateOfferingWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeprecateOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/deprecate/{setting}`, pathParamsMap)
 	if err != nil {
@@ -3248,7 +3248,7 @@ func (catalogManagement *CatalogManagementV1) DeprecateOfferingWithContext(ctx c
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deprecate_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3282,7 +3282,7 @@ func (catalogManagement *CatalogManagementV1) ShareOfferingWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ShareOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/share`, pathParamsMap)
 	if err != nil {
@@ -3327,7 +3327,7 @@ func (catalogManagement *CatalogManagementV1) ShareOfferingWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "share_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3370,7 +3370,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAccessWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingAccess"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/access/{access_identifier}`, pathParamsMap)
 	if err != nil {
@@ -3398,7 +3398,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAccessWithContext(ctx c
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_access", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3441,7 +3441,7 @@ func (catalogManagement *CatalogManagementV1) UpdateOfferingAccessWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "UpdateOfferingAccess"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/access/{access_identifier}`, pathParamsMap)
 	if err != nil {
@@ -3510,7 +3510,7 @@ func (catalogManagement *CatalogManagementV1) UpdateOfferingAccessWithContext(ct
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_offering_access", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3552,7 +3552,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAccessListWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingAccessList"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/access`, pathParamsMap)
 	if err != nil {
@@ -3587,7 +3587,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAccessListWithContext(c
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_access_list", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3629,7 +3629,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOfferingAccessListWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteOfferingAccessList"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/access`, pathParamsMap)
 	if err != nil {
@@ -3664,7 +3664,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOfferingAccessListWithContex
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_offering_access_list", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3706,7 +3706,7 @@ func (catalogManagement *CatalogManagementV1) AddOfferingAccessListWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "AddOfferingAccessList"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/access`, pathParamsMap)
 	if err != nil {
@@ -3741,7 +3741,7 @@ func (catalogManagement *CatalogManagementV1) AddOfferingAccessListWithContext(c
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_offering_access_list", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3783,7 +3783,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingUpdatesWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingUpdates"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/updates`, pathParamsMap)
 	if err != nil {
@@ -3852,7 +3852,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingUpdatesWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_updates", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3894,7 +3894,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingChangeNoticesWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingChangeNotices"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/changeNotices`, pathParamsMap)
 	if err != nil {
@@ -3936,7 +3936,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingChangeNoticesWithContex
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_change_notices", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3973,7 +3973,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingSourceWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingSource"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/offering/source`, nil)
 	if err != nil {
@@ -4029,7 +4029,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingSourceWithContext(ctx c
 	response, err = catalogManagement.Service.Request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_source", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4060,7 +4060,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingSourceArchiveWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingSourceArchive"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/offering/source/archive`, nil)
 	if err != nil {
@@ -4116,7 +4116,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingSourceArchiveWithContex
 	response, err = catalogManagement.Service.Request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_source_archive", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4149,7 +4149,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingSourceURLWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingSourceURL"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/offering/source/url/{key_identifier}`, pathParamsMap)
 	if err != nil {
@@ -4189,7 +4189,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingSourceURLWithContext(ct
 	response, err = catalogManagement.Service.Request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_source_url", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4225,7 +4225,7 @@ func (catalogManagement *CatalogManagementV1) GetVersionsWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetVersions"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/offerings/{offering_id}/kinds/{kind_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -4266,7 +4266,7 @@ func (catalogManagement *CatalogManagementV1) GetVersionsWithContext(ctx context
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_versions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4307,7 +4307,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAboutWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingAbout"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/about`, pathParamsMap)
 	if err != nil {
@@ -4334,7 +4334,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAboutWithContext(ctx co
 	response, err = catalogManagement.Service.Request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_about", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4367,7 +4367,7 @@ func (catalogManagement *CatalogManagementV1) GetIamPermissionsWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetIamPermissions"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/iamPermissions`, pathParamsMap)
 	if err != nil {
@@ -4395,7 +4395,7 @@ func (catalogManagement *CatalogManagementV1) GetIamPermissionsWithContext(ctx c
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_iam_permissions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4437,7 +4437,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingLicenseWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingLicense"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/licenses/{license_id}`, pathParamsMap)
 	if err != nil {
@@ -4464,7 +4464,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingLicenseWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_license", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4498,7 +4498,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingContainerImagesWithCont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingContainerImages"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/containerImages`, pathParamsMap)
 	if err != nil {
@@ -4526,7 +4526,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingContainerImagesWithCont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_container_images", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4567,7 +4567,7 @@ func (catalogManagement *CatalogManagementV1) ArchiveVersionWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ArchiveVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/archive`, pathParamsMap)
 	if err != nil {
@@ -4593,7 +4593,7 @@ func (catalogManagement *CatalogManagementV1) ArchiveVersionWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "archive_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4627,7 +4627,7 @@ func (catalogManagement *CatalogManagementV1) SetDeprecateVersionWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "SetDeprecateVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/deprecate/{setting}`, pathParamsMap)
 	if err != nil {
@@ -4667,7 +4667,7 @@ func (catalogManagement *CatalogManagementV1) SetDeprecateVersionWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_deprecate_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4700,7 +4700,7 @@ func (catalogManagement *CatalogManagementV1) ConsumableVersionWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ConsumableVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/consume-publish`, pathParamsMap)
 	if err != nil {
@@ -4726,7 +4726,7 @@ func (catalogManagement *CatalogManagementV1) ConsumableVersionWithContext(ctx c
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "consumable_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4759,7 +4759,7 @@ func (catalogManagement *CatalogManagementV1) PrereleaseVersionWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "PrereleaseVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/prerelease-publish`, pathParamsMap)
 	if err != nil {
@@ -4785,7 +4785,7 @@ func (catalogManagement *CatalogManagementV1) PrereleaseVersionWithContext(ctx c
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "prerelease_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4818,7 +4818,7 @@ func (catalogManagement *CatalogManagementV1) TestVersionWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "TestVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/test-publish`, pathParamsMap)
 	if err != nil {
@@ -4844,7 +4844,7 @@ func (catalogManagement *CatalogManagementV1) TestVersionWithContext(ctx context
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "test_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4877,7 +4877,7 @@ func (catalogManagement *CatalogManagementV1) SuspendVersionWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "SuspendVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/suspend`, pathParamsMap)
 	if err != nil {
@@ -4903,7 +4903,7 @@ func (catalogManagement *CatalogManagementV1) SuspendVersionWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "suspend_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4936,7 +4936,7 @@ func (catalogManagement *CatalogManagementV1) CommitVersionWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "CommitVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/commit`, pathParamsMap)
 	if err != nil {
@@ -4962,7 +4962,7 @@ func (catalogManagement *CatalogManagementV1) CommitVersionWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4995,7 +4995,7 @@ func (catalogManagement *CatalogManagementV1) CopyVersionWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "CopyVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/copy`, pathParamsMap)
 	if err != nil {
@@ -5050,7 +5050,7 @@ func (catalogManagement *CatalogManagementV1) CopyVersionWithContext(ctx context
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "copy_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5083,7 +5083,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingWorkingCopyWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingWorkingCopy"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/workingcopy`, pathParamsMap)
 	if err != nil {
@@ -5111,7 +5111,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingWorkingCopyWithContext(
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_working_copy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5154,7 +5154,7 @@ func (catalogManagement *CatalogManagementV1) CopyFromPreviousVersionWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "CopyFromPreviousVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/copy/{type}/{version_loc_id_to_copy_from}`, pathParamsMap)
 	if err != nil {
@@ -5180,7 +5180,7 @@ func (catalogManagement *CatalogManagementV1) CopyFromPreviousVersionWithContext
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "copy_from_previous_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5213,7 +5213,7 @@ func (catalogManagement *CatalogManagementV1) ValidateInputsWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ValidateInputs"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/validateInputs`, pathParamsMap)
 	if err != nil {
@@ -5255,7 +5255,7 @@ func (catalogManagement *CatalogManagementV1) ValidateInputsWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "validate_inputs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5296,7 +5296,7 @@ func (catalogManagement *CatalogManagementV1) GetVersionWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}`, pathParamsMap)
 	if err != nil {
@@ -5324,7 +5324,7 @@ func (catalogManagement *CatalogManagementV1) GetVersionWithContext(ctx context.
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5366,7 +5366,7 @@ func (catalogManagement *CatalogManagementV1) UpdateVersionWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "UpdateVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}`, pathParamsMap)
 	if err != nil {
@@ -5540,7 +5540,7 @@ func (catalogManagement *CatalogManagementV1) UpdateVersionWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5582,7 +5582,7 @@ func (catalogManagement *CatalogManagementV1) PatchUpdateVersionWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "PatchUpdateVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}`, pathParamsMap)
 	if err != nil {
@@ -5620,7 +5620,7 @@ func (catalogManagement *CatalogManagementV1) PatchUpdateVersionWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "patch_update_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5662,7 +5662,7 @@ func (catalogManagement *CatalogManagementV1) DeleteVersionWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}`, pathParamsMap)
 	if err != nil {
@@ -5688,7 +5688,7 @@ func (catalogManagement *CatalogManagementV1) DeleteVersionWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5721,7 +5721,7 @@ func (catalogManagement *CatalogManagementV1) GetVersionDependenciesWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetVersionDependencies"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/dependencies`, pathParamsMap)
 	if err != nil {
@@ -5749,7 +5749,7 @@ func (catalogManagement *CatalogManagementV1) GetVersionDependenciesWithContext(
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_version_dependencies", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5793,7 +5793,7 @@ func (catalogManagement *CatalogManagementV1) DeprecateVersionWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeprecateVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/deprecate`, pathParamsMap)
 	if err != nil {
@@ -5819,7 +5819,7 @@ func (catalogManagement *CatalogManagementV1) DeprecateVersionWithContext(ctx co
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deprecate_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5852,7 +5852,7 @@ func (catalogManagement *CatalogManagementV1) GetClusterWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetCluster"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/deploy/kubernetes/clusters/{cluster_id}`, pathParamsMap)
 	if err != nil {
@@ -5885,7 +5885,7 @@ func (catalogManagement *CatalogManagementV1) GetClusterWithContext(ctx context.
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_cluster", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5926,7 +5926,7 @@ func (catalogManagement *CatalogManagementV1) GetNamespacesWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetNamespaces"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/deploy/kubernetes/clusters/{cluster_id}/namespaces`, pathParamsMap)
 	if err != nil {
@@ -5965,7 +5965,7 @@ func (catalogManagement *CatalogManagementV1) GetNamespacesWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_namespaces", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6002,7 +6002,7 @@ func (catalogManagement *CatalogManagementV1) DeployOperatorsWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeployOperators"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/deploy/kubernetes/olm/operator`, nil)
 	if err != nil {
@@ -6062,7 +6062,7 @@ func (catalogManagement *CatalogManagementV1) DeployOperatorsWithContext(ctx con
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deploy_operators", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6099,7 +6099,7 @@ func (catalogManagement *CatalogManagementV1) ListOperatorsWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListOperators"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/deploy/kubernetes/olm/operator`, nil)
 	if err != nil {
@@ -6134,7 +6134,7 @@ func (catalogManagement *CatalogManagementV1) ListOperatorsWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_operators", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6171,7 +6171,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceOperatorsWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ReplaceOperators"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/deploy/kubernetes/olm/operator`, nil)
 	if err != nil {
@@ -6231,7 +6231,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceOperatorsWithContext(ctx co
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_operators", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6268,7 +6268,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOperatorsWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteOperators"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/deploy/kubernetes/olm/operator`, nil)
 	if err != nil {
@@ -6301,7 +6301,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOperatorsWithContext(ctx con
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_operators", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -6334,7 +6334,7 @@ func (catalogManagement *CatalogManagementV1) InstallVersionWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "InstallVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/install`, pathParamsMap)
 	if err != nil {
@@ -6434,7 +6434,7 @@ func (catalogManagement *CatalogManagementV1) InstallVersionWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "install_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -6467,7 +6467,7 @@ func (catalogManagement *CatalogManagementV1) PreinstallVersionWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "PreinstallVersion"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/preinstall`, pathParamsMap)
 	if err != nil {
@@ -6567,7 +6567,7 @@ func (catalogManagement *CatalogManagementV1) PreinstallVersionWithContext(ctx c
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "preinstall_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -6600,7 +6600,7 @@ func (catalogManagement *CatalogManagementV1) GetPreinstallWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetPreinstall"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/preinstall`, pathParamsMap)
 	if err != nil {
@@ -6641,7 +6641,7 @@ func (catalogManagement *CatalogManagementV1) GetPreinstallWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_preinstall", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6682,7 +6682,7 @@ func (catalogManagement *CatalogManagementV1) ValidateInstallWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ValidateInstall"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/validation/install`, pathParamsMap)
 	if err != nil {
@@ -6786,7 +6786,7 @@ func (catalogManagement *CatalogManagementV1) ValidateInstallWithContext(ctx con
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "validate_install", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -6819,7 +6819,7 @@ func (catalogManagement *CatalogManagementV1) GetValidationStatusWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetValidationStatus"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/versions/{version_loc_id}/validation/install`, pathParamsMap)
 	if err != nil {
@@ -6854,7 +6854,7 @@ func (catalogManagement *CatalogManagementV1) GetValidationStatusWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_validation_status", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6892,7 +6892,7 @@ func (catalogManagement *CatalogManagementV1) SearchObjectsWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "SearchObjects"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/objects`, nil)
 	if err != nil {
@@ -6937,7 +6937,7 @@ func (catalogManagement *CatalogManagementV1) SearchObjectsWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "search_objects", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6978,7 +6978,7 @@ func (catalogManagement *CatalogManagementV1) ListObjectsWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListObjects"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects`, pathParamsMap)
 	if err != nil {
@@ -7019,7 +7019,7 @@ func (catalogManagement *CatalogManagementV1) ListObjectsWithContext(ctx context
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_objects", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7060,7 +7060,7 @@ func (catalogManagement *CatalogManagementV1) CreateObjectWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "CreateObject"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects`, pathParamsMap)
 	if err != nil {
@@ -7147,7 +7147,7 @@ func (catalogManagement *CatalogManagementV1) CreateObjectWithContext(ctx contex
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_object", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7189,7 +7189,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetObject"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}`, pathParamsMap)
 	if err != nil {
@@ -7217,7 +7217,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectWithContext(ctx context.C
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7259,7 +7259,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceObjectWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ReplaceObject"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}`, pathParamsMap)
 	if err != nil {
@@ -7352,7 +7352,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceObjectWithContext(ctx conte
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_object", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7394,7 +7394,7 @@ func (catalogManagement *CatalogManagementV1) DeleteObjectWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteObject"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}`, pathParamsMap)
 	if err != nil {
@@ -7420,7 +7420,7 @@ func (catalogManagement *CatalogManagementV1) DeleteObjectWithContext(ctx contex
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_object", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -7454,7 +7454,7 @@ func (catalogManagement *CatalogManagementV1) ListObjectAuditsWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListObjectAudits"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/audits`, pathParamsMap)
 	if err != nil {
@@ -7492,7 +7492,7 @@ func (catalogManagement *CatalogManagementV1) ListObjectAuditsWithContext(ctx co
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_object_audits", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7535,7 +7535,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAuditWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetObjectAudit"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/audits/{auditlog_identifier}`, pathParamsMap)
 	if err != nil {
@@ -7567,7 +7567,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAuditWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object_audit", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7609,7 +7609,7 @@ func (catalogManagement *CatalogManagementV1) ConsumableShareObjectWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ConsumableShareObject"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/consume-publish`, pathParamsMap)
 	if err != nil {
@@ -7635,7 +7635,7 @@ func (catalogManagement *CatalogManagementV1) ConsumableShareObjectWithContext(c
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "consumable_share_object", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -7669,7 +7669,7 @@ func (catalogManagement *CatalogManagementV1) ShareObjectWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ShareObject"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/share`, pathParamsMap)
 	if err != nil {
@@ -7714,7 +7714,7 @@ func (catalogManagement *CatalogManagementV1) ShareObjectWithContext(ctx context
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "share_object", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7756,7 +7756,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAccessListWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetObjectAccessList"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/accessv1`, pathParamsMap)
 	if err != nil {
@@ -7791,7 +7791,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAccessListWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object_access_list", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7834,7 +7834,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAccessWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetObjectAccess"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/access/{access_identifier}`, pathParamsMap)
 	if err != nil {
@@ -7862,7 +7862,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAccessWithContext(ctx con
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object_access", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7908,7 +7908,7 @@ func (catalogManagement *CatalogManagementV1) CreateObjectAccessWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "CreateObjectAccess"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/access/{access_identifier}`, pathParamsMap)
 	if err != nil {
@@ -7934,7 +7934,7 @@ func (catalogManagement *CatalogManagementV1) CreateObjectAccessWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_object_access", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -7972,7 +7972,7 @@ func (catalogManagement *CatalogManagementV1) DeleteObjectAccessWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteObjectAccess"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/access/{access_identifier}`, pathParamsMap)
 	if err != nil {
@@ -7998,7 +7998,7 @@ func (catalogManagement *CatalogManagementV1) DeleteObjectAccessWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_object_access", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -8035,7 +8035,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAccessListDeprecatedWithC
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetObjectAccessListDeprecated"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/access`, pathParamsMap)
 	if err != nil {
@@ -8070,7 +8070,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAccessListDeprecatedWithC
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object_access_list_deprecated", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8112,7 +8112,7 @@ func (catalogManagement *CatalogManagementV1) DeleteObjectAccessListWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteObjectAccessList"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/access`, pathParamsMap)
 	if err != nil {
@@ -8147,7 +8147,7 @@ func (catalogManagement *CatalogManagementV1) DeleteObjectAccessListWithContext(
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_object_access_list", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8189,7 +8189,7 @@ func (catalogManagement *CatalogManagementV1) AddObjectAccessListWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "AddObjectAccessList"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalog_identifier}/objects/{object_identifier}/access`, pathParamsMap)
 	if err != nil {
@@ -8224,7 +8224,7 @@ func (catalogManagement *CatalogManagementV1) AddObjectAccessListWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_object_access_list", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8261,7 +8261,7 @@ func (catalogManagement *CatalogManagementV1) CreateOfferingInstanceWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "CreateOfferingInstance"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/instances/offerings`, nil)
 	if err != nil {
@@ -8393,7 +8393,7 @@ func (catalogManagement *CatalogManagementV1) CreateOfferingInstanceWithContext(
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_offering_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8434,7 +8434,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingInstanceWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingInstance"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/instances/offerings/{instance_identifier}`, pathParamsMap)
 	if err != nil {
@@ -8462,7 +8462,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingInstanceWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8503,7 +8503,7 @@ func (catalogManagement *CatalogManagementV1) PutOfferingInstanceWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "PutOfferingInstance"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/instances/offerings/{instance_identifier}`, pathParamsMap)
 	if err != nil {
@@ -8635,7 +8635,7 @@ func (catalogManagement *CatalogManagementV1) PutOfferingInstanceWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "put_offering_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8676,7 +8676,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOfferingInstanceWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeleteOfferingInstance"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/instances/offerings/{instance_identifier}`, pathParamsMap)
 	if err != nil {
@@ -8705,7 +8705,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOfferingInstanceWithContext(
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_offering_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -8738,7 +8738,7 @@ func (catalogManagement *CatalogManagementV1) ListOfferingInstanceAuditsWithCont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListOfferingInstanceAudits"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/instances/offerings/{instance_identifier}/audits`, pathParamsMap)
 	if err != nil {
@@ -8776,7 +8776,7 @@ func (catalogManagement *CatalogManagementV1) ListOfferingInstanceAuditsWithCont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_offering_instance_audits", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8818,7 +8818,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingInstanceAuditWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetOfferingInstanceAudit"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/instances/offerings/{instance_identifier}/audits/{auditlog_identifier}`, pathParamsMap)
 	if err != nil {
@@ -8850,7 +8850,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingInstanceAuditWithContex
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_instance_audit", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8891,7 +8891,7 @@ func (catalogManagement *CatalogManagementV1) GetPlanWithContext(ctx context.Con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "GetPlan"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/plans/{plan_loc_id}`, pathParamsMap)
 	if err != nil {
@@ -8919,7 +8919,7 @@ func (catalogManagement *CatalogManagementV1) GetPlanWithContext(ctx context.Con
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8960,7 +8960,7 @@ func (catalogManagement *CatalogManagementV1) DeletePlanWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "DeletePlan"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/plans/{plan_loc_id}`, pathParamsMap)
 	if err != nil {
@@ -8986,7 +8986,7 @@ func (catalogManagement *CatalogManagementV1) DeletePlanWithContext(ctx context.
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -9019,7 +9019,7 @@ func (catalogManagement *CatalogManagementV1) ConsumablePlanWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ConsumablePlan"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/plans/{plan_loc_id}/consume-publish`, pathParamsMap)
 	if err != nil {
@@ -9045,7 +9045,7 @@ func (catalogManagement *CatalogManagementV1) ConsumablePlanWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "consumable_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -9079,7 +9079,7 @@ func (catalogManagement *CatalogManagementV1) SetDeprecatePlanWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "SetDeprecatePlan"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/plans/{plan_loc_id}/deprecate/{setting}`, pathParamsMap)
 	if err != nil {
@@ -9119,7 +9119,7 @@ func (catalogManagement *CatalogManagementV1) SetDeprecatePlanWithContext(ctx co
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_deprecate_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -9143,7 +9143,7 @@ func (catalogManagement *CatalogManagementV1) PreviewRegionsWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "PreviewRegions"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/regions`, nil)
 	if err != nil {
@@ -9184,7 +9184,7 @@ func (catalogManagement *CatalogManagementV1) PreviewRegionsWithContext(ctx cont
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "preview_regions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -9216,7 +9216,7 @@ func (catalogManagement *CatalogManagementV1) ListRegionsWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "ListRegions"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/regions`, nil)
 	if err != nil {
@@ -9257,7 +9257,7 @@ func (catalogManagement *CatalogManagementV1) ListRegionsWithContext(ctx context
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_regions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
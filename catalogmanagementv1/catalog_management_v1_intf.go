@@ -0,0 +1,477 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CatalogManagementV1Intf : The operations of the CatalogManagementV1 service client.
+// The interface is satisfied by *CatalogManagementV1 and by mocks.CatalogManagementV1, allowing consumers to substitute a mock for the client in tests.
+type CatalogManagementV1Intf interface {
+	// GetCatalogAccount : Get catalog account settings
+	GetCatalogAccount(getCatalogAccountOptions *GetCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+	// GetCatalogAccountWithContext is an alternate form of the GetCatalogAccount method which supports a Context parameter
+	GetCatalogAccountWithContext(ctx context.Context, getCatalogAccountOptions *GetCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+	// UpdateCatalogAccount : Update account settings
+	UpdateCatalogAccount(updateCatalogAccountOptions *UpdateCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+	// UpdateCatalogAccountWithContext is an alternate form of the UpdateCatalogAccount method which supports a Context parameter
+	UpdateCatalogAccountWithContext(ctx context.Context, updateCatalogAccountOptions *UpdateCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+	// ListCatalogAccountAudits : Get catalog account audit logs
+	ListCatalogAccountAudits(listCatalogAccountAuditsOptions *ListCatalogAccountAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// ListCatalogAccountAuditsWithContext is an alternate form of the ListCatalogAccountAudits method which supports a Context parameter
+	ListCatalogAccountAuditsWithContext(ctx context.Context, listCatalogAccountAuditsOptions *ListCatalogAccountAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// GetCatalogAccountAudit : Get a catalog account audit log entry
+	GetCatalogAccountAudit(getCatalogAccountAuditOptions *GetCatalogAccountAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// GetCatalogAccountAuditWithContext is an alternate form of the GetCatalogAccountAudit method which supports a Context parameter
+	GetCatalogAccountAuditWithContext(ctx context.Context, getCatalogAccountAuditOptions *GetCatalogAccountAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// GetCatalogAccountFilters : Get catalog account filters
+	GetCatalogAccountFilters(getCatalogAccountFiltersOptions *GetCatalogAccountFiltersOptions) (result *AccumulatedFilters, response *core.DetailedResponse, err error)
+	// GetCatalogAccountFiltersWithContext is an alternate form of the GetCatalogAccountFilters method which supports a Context parameter
+	GetCatalogAccountFiltersWithContext(ctx context.Context, getCatalogAccountFiltersOptions *GetCatalogAccountFiltersOptions) (result *AccumulatedFilters, response *core.DetailedResponse, err error)
+	// GetShareApprovalList : Get share approval access list
+	GetShareApprovalList(getShareApprovalListOptions *GetShareApprovalListOptions) (result *ShareApprovalListAccessResult, response *core.DetailedResponse, err error)
+	// GetShareApprovalListWithContext is an alternate form of the GetShareApprovalList method which supports a Context parameter
+	GetShareApprovalListWithContext(ctx context.Context, getShareApprovalListOptions *GetShareApprovalListOptions) (result *ShareApprovalListAccessResult, response *core.DetailedResponse, err error)
+	// DeleteShareApprovalList : Delete share approval access
+	DeleteShareApprovalList(deleteShareApprovalListOptions *DeleteShareApprovalListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// DeleteShareApprovalListWithContext is an alternate form of the DeleteShareApprovalList method which supports a Context parameter
+	DeleteShareApprovalListWithContext(ctx context.Context, deleteShareApprovalListOptions *DeleteShareApprovalListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// AddShareApprovalList : Add accesses to share approval access list
+	AddShareApprovalList(addShareApprovalListOptions *AddShareApprovalListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// AddShareApprovalListWithContext is an alternate form of the AddShareApprovalList method which supports a Context parameter
+	AddShareApprovalListWithContext(ctx context.Context, addShareApprovalListOptions *AddShareApprovalListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// GetShareApprovalListAsSource : Get share approval access list for requesting accounts
+	GetShareApprovalListAsSource(getShareApprovalListAsSourceOptions *GetShareApprovalListAsSourceOptions) (result *ShareApprovalListAccessResult, response *core.DetailedResponse, err error)
+	// GetShareApprovalListAsSourceWithContext is an alternate form of the GetShareApprovalListAsSource method which supports a Context parameter
+	GetShareApprovalListAsSourceWithContext(ctx context.Context, getShareApprovalListAsSourceOptions *GetShareApprovalListAsSourceOptions) (result *ShareApprovalListAccessResult, response *core.DetailedResponse, err error)
+	// UpdateShareApprovalListAsSource : Update approval states for share approval access list for requesting accounts
+	UpdateShareApprovalListAsSource(updateShareApprovalListAsSourceOptions *UpdateShareApprovalListAsSourceOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// UpdateShareApprovalListAsSourceWithContext is an alternate form of the UpdateShareApprovalListAsSource method which supports a Context parameter
+	UpdateShareApprovalListAsSourceWithContext(ctx context.Context, updateShareApprovalListAsSourceOptions *UpdateShareApprovalListAsSourceOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// ListCatalogs : Get list of catalogs
+	ListCatalogs(listCatalogsOptions *ListCatalogsOptions) (result *CatalogSearchResult, response *core.DetailedResponse, err error)
+	// ListCatalogsWithContext is an alternate form of the ListCatalogs method which supports a Context parameter
+	ListCatalogsWithContext(ctx context.Context, listCatalogsOptions *ListCatalogsOptions) (result *CatalogSearchResult, response *core.DetailedResponse, err error)
+	// CreateCatalog : Create a catalog
+	CreateCatalog(createCatalogOptions *CreateCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+	// CreateCatalogWithContext is an alternate form of the CreateCatalog method which supports a Context parameter
+	CreateCatalogWithContext(ctx context.Context, createCatalogOptions *CreateCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+	// GetCatalog : Get catalog
+	GetCatalog(getCatalogOptions *GetCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+	// GetCatalogWithContext is an alternate form of the GetCatalog method which supports a Context parameter
+	GetCatalogWithContext(ctx context.Context, getCatalogOptions *GetCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+	// ReplaceCatalog : Update catalog
+	ReplaceCatalog(replaceCatalogOptions *ReplaceCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+	// ReplaceCatalogWithContext is an alternate form of the ReplaceCatalog method which supports a Context parameter
+	ReplaceCatalogWithContext(ctx context.Context, replaceCatalogOptions *ReplaceCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+	// DeleteCatalog : Delete catalog
+	DeleteCatalog(deleteCatalogOptions *DeleteCatalogOptions) (response *core.DetailedResponse, err error)
+	// DeleteCatalogWithContext is an alternate form of the DeleteCatalog method which supports a Context parameter
+	DeleteCatalogWithContext(ctx context.Context, deleteCatalogOptions *DeleteCatalogOptions) (response *core.DetailedResponse, err error)
+	// ListCatalogAudits : Get catalog audit logs
+	ListCatalogAudits(listCatalogAuditsOptions *ListCatalogAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// ListCatalogAuditsWithContext is an alternate form of the ListCatalogAudits method which supports a Context parameter
+	ListCatalogAuditsWithContext(ctx context.Context, listCatalogAuditsOptions *ListCatalogAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// GetCatalogAudit : Get a catalog audit log entry
+	GetCatalogAudit(getCatalogAuditOptions *GetCatalogAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// GetCatalogAuditWithContext is an alternate form of the GetCatalogAudit method which supports a Context parameter
+	GetCatalogAuditWithContext(ctx context.Context, getCatalogAuditOptions *GetCatalogAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// ListEnterpriseAudits : Get enterprise audit logs
+	ListEnterpriseAudits(listEnterpriseAuditsOptions *ListEnterpriseAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// ListEnterpriseAuditsWithContext is an alternate form of the ListEnterpriseAudits method which supports a Context parameter
+	ListEnterpriseAuditsWithContext(ctx context.Context, listEnterpriseAuditsOptions *ListEnterpriseAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// GetEnterpriseAudit : Get an enterprise audit log entry
+	GetEnterpriseAudit(getEnterpriseAuditOptions *GetEnterpriseAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// GetEnterpriseAuditWithContext is an alternate form of the GetEnterpriseAudit method which supports a Context parameter
+	GetEnterpriseAuditWithContext(ctx context.Context, getEnterpriseAuditOptions *GetEnterpriseAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// GetConsumptionOfferings : Get consumption offerings
+	GetConsumptionOfferings(getConsumptionOfferingsOptions *GetConsumptionOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+	// GetConsumptionOfferingsWithContext is an alternate form of the GetConsumptionOfferings method which supports a Context parameter
+	GetConsumptionOfferingsWithContext(ctx context.Context, getConsumptionOfferingsOptions *GetConsumptionOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+	// ListOfferings : Get list of offerings
+	ListOfferings(listOfferingsOptions *ListOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+	// ListOfferingsWithContext is an alternate form of the ListOfferings method which supports a Context parameter
+	ListOfferingsWithContext(ctx context.Context, listOfferingsOptions *ListOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+	// CreateOffering : Create offering
+	CreateOffering(createOfferingOptions *CreateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// CreateOfferingWithContext is an alternate form of the CreateOffering method which supports a Context parameter
+	CreateOfferingWithContext(ctx context.Context, createOfferingOptions *CreateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// ImportOfferingVersion : Import offering version
+	ImportOfferingVersion(importOfferingVersionOptions *ImportOfferingVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// ImportOfferingVersionWithContext is an alternate form of the ImportOfferingVersion method which supports a Context parameter
+	ImportOfferingVersionWithContext(ctx context.Context, importOfferingVersionOptions *ImportOfferingVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// ImportOffering : Import offering
+	ImportOffering(importOfferingOptions *ImportOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// ImportOfferingWithContext is an alternate form of the ImportOffering method which supports a Context parameter
+	ImportOfferingWithContext(ctx context.Context, importOfferingOptions *ImportOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// ReloadOffering : Reload offering
+	ReloadOffering(reloadOfferingOptions *ReloadOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// ReloadOfferingWithContext is an alternate form of the ReloadOffering method which supports a Context parameter
+	ReloadOfferingWithContext(ctx context.Context, reloadOfferingOptions *ReloadOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// GetOffering : Get offering
+	GetOffering(getOfferingOptions *GetOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// GetOfferingWithContext is an alternate form of the GetOffering method which supports a Context parameter
+	GetOfferingWithContext(ctx context.Context, getOfferingOptions *GetOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// ReplaceOffering : Update offering
+	ReplaceOffering(replaceOfferingOptions *ReplaceOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// ReplaceOfferingWithContext is an alternate form of the ReplaceOffering method which supports a Context parameter
+	ReplaceOfferingWithContext(ctx context.Context, replaceOfferingOptions *ReplaceOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// UpdateOffering : Update offering
+	UpdateOffering(updateOfferingOptions *UpdateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// UpdateOfferingWithContext is an alternate form of the UpdateOffering method which supports a Context parameter
+	UpdateOfferingWithContext(ctx context.Context, updateOfferingOptions *UpdateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// DeleteOffering : Delete offering
+	DeleteOffering(deleteOfferingOptions *DeleteOfferingOptions) (response *core.DetailedResponse, err error)
+	// DeleteOfferingWithContext is an alternate form of the DeleteOffering method which supports a Context parameter
+	DeleteOfferingWithContext(ctx context.Context, deleteOfferingOptions *DeleteOfferingOptions) (response *core.DetailedResponse, err error)
+	// GetOfferingStats : Get offering statistics
+	GetOfferingStats(getOfferingStatsOptions *GetOfferingStatsOptions) (result *MetricStats, response *core.DetailedResponse, err error)
+	// GetOfferingStatsWithContext is an alternate form of the GetOfferingStats method which supports a Context parameter
+	GetOfferingStatsWithContext(ctx context.Context, getOfferingStatsOptions *GetOfferingStatsOptions) (result *MetricStats, response *core.DetailedResponse, err error)
+	// ListOfferingAudits : Get offering audit logs
+	ListOfferingAudits(listOfferingAuditsOptions *ListOfferingAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// ListOfferingAuditsWithContext is an alternate form of the ListOfferingAudits method which supports a Context parameter
+	ListOfferingAuditsWithContext(ctx context.Context, listOfferingAuditsOptions *ListOfferingAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// GetOfferingAudit : Get an offering audit log entry
+	GetOfferingAudit(getOfferingAuditOptions *GetOfferingAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// GetOfferingAuditWithContext is an alternate form of the GetOfferingAudit method which supports a Context parameter
+	GetOfferingAuditWithContext(ctx context.Context, getOfferingAuditOptions *GetOfferingAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// SetOfferingPublish : Set offering publish approval settings
+	SetOfferingPublish(setOfferingPublishOptions *SetOfferingPublishOptions) (result *ApprovalResult, response *core.DetailedResponse, err error)
+	// SetOfferingPublishWithContext is an alternate form of the SetOfferingPublish method which supports a Context parameter
+	SetOfferingPublishWithContext(ctx context.Context, setOfferingPublishOptions *SetOfferingPublishOptions) (result *ApprovalResult, response *core.DetailedResponse, err error)
+	// DeprecateOffering : Allows offering to be deprecated
+	DeprecateOffering(deprecateOfferingOptions *DeprecateOfferingOptions) (response *core.DetailedResponse, err error)
+	// DeprecateOfferingWithContext is an alternate form of the DeprecateOffering method which supports a Context parameter
+	DeprecateOfferingWithContext(ctx context.Context, deprecateOfferingOptions *DeprecateOfferingOptions) (response *core.DetailedResponse, err error)
+	// ShareOffering : Allows offering to be shared
+	ShareOffering(shareOfferingOptions *ShareOfferingOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+	// ShareOfferingWithContext is an alternate form of the ShareOffering method which supports a Context parameter
+	ShareOfferingWithContext(ctx context.Context, shareOfferingOptions *ShareOfferingOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+	// GetOfferingAccess : Check for account ID in offering access list
+	GetOfferingAccess(getOfferingAccessOptions *GetOfferingAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+	// GetOfferingAccessWithContext is an alternate form of the GetOfferingAccess method which supports a Context parameter
+	GetOfferingAccessWithContext(ctx context.Context, getOfferingAccessOptions *GetOfferingAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+	// UpdateOfferingAccess : Update the access list entry for a specific account
+	UpdateOfferingAccess(updateOfferingAccessOptions *UpdateOfferingAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+	// UpdateOfferingAccessWithContext is an alternate form of the UpdateOfferingAccess method which supports a Context parameter
+	UpdateOfferingAccessWithContext(ctx context.Context, updateOfferingAccessOptions *UpdateOfferingAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+	// GetOfferingAccessList : Get offering access list
+	GetOfferingAccessList(getOfferingAccessListOptions *GetOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+	// GetOfferingAccessListWithContext is an alternate form of the GetOfferingAccessList method which supports a Context parameter
+	GetOfferingAccessListWithContext(ctx context.Context, getOfferingAccessListOptions *GetOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+	// DeleteOfferingAccessList : Delete accesses from offering access list
+	DeleteOfferingAccessList(deleteOfferingAccessListOptions *DeleteOfferingAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// DeleteOfferingAccessListWithContext is an alternate form of the DeleteOfferingAccessList method which supports a Context parameter
+	DeleteOfferingAccessListWithContext(ctx context.Context, deleteOfferingAccessListOptions *DeleteOfferingAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// AddOfferingAccessList : Add accesses to offering access list
+	AddOfferingAccessList(addOfferingAccessListOptions *AddOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+	// AddOfferingAccessListWithContext is an alternate form of the AddOfferingAccessList method which supports a Context parameter
+	AddOfferingAccessListWithContext(ctx context.Context, addOfferingAccessListOptions *AddOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+	// GetOfferingUpdates : Get version updates
+	GetOfferingUpdates(getOfferingUpdatesOptions *GetOfferingUpdatesOptions) (result []VersionUpdateDescriptor, response *core.DetailedResponse, err error)
+	// GetOfferingUpdatesWithContext is an alternate form of the GetOfferingUpdates method which supports a Context parameter
+	GetOfferingUpdatesWithContext(ctx context.Context, getOfferingUpdatesOptions *GetOfferingUpdatesOptions) (result []VersionUpdateDescriptor, response *core.DetailedResponse, err error)
+	// GetOfferingChangeNotices : Get version change notices
+	GetOfferingChangeNotices(getOfferingChangeNoticesOptions *GetOfferingChangeNoticesOptions) (result *ChangeNoticesResponse, response *core.DetailedResponse, err error)
+	// GetOfferingChangeNoticesWithContext is an alternate form of the GetOfferingChangeNotices method which supports a Context parameter
+	GetOfferingChangeNoticesWithContext(ctx context.Context, getOfferingChangeNoticesOptions *GetOfferingChangeNoticesOptions) (result *ChangeNoticesResponse, response *core.DetailedResponse, err error)
+	// GetOfferingSource : Get offering source
+	GetOfferingSource(getOfferingSourceOptions *GetOfferingSourceOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// GetOfferingSourceWithContext is an alternate form of the GetOfferingSource method which supports a Context parameter
+	GetOfferingSourceWithContext(ctx context.Context, getOfferingSourceOptions *GetOfferingSourceOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// GetOfferingSourceArchive : Get offering source
+	GetOfferingSourceArchive(getOfferingSourceArchiveOptions *GetOfferingSourceArchiveOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// GetOfferingSourceArchiveWithContext is an alternate form of the GetOfferingSourceArchive method which supports a Context parameter
+	GetOfferingSourceArchiveWithContext(ctx context.Context, getOfferingSourceArchiveOptions *GetOfferingSourceArchiveOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// GetOfferingSourceURL : Get offering source URL
+	GetOfferingSourceURL(getOfferingSourceURLOptions *GetOfferingSourceURLOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// GetOfferingSourceURLWithContext is an alternate form of the GetOfferingSourceURL method which supports a Context parameter
+	GetOfferingSourceURLWithContext(ctx context.Context, getOfferingSourceURLOptions *GetOfferingSourceURLOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// GetVersions : Get versions
+	GetVersions(getVersionsOptions *GetVersionsOptions) (result *VersionsResult, response *core.DetailedResponse, err error)
+	// GetVersionsWithContext is an alternate form of the GetVersions method which supports a Context parameter
+	GetVersionsWithContext(ctx context.Context, getVersionsOptions *GetVersionsOptions) (result *VersionsResult, response *core.DetailedResponse, err error)
+	// GetOfferingAbout : Get version about information
+	GetOfferingAbout(getOfferingAboutOptions *GetOfferingAboutOptions) (result *string, response *core.DetailedResponse, err error)
+	// GetOfferingAboutWithContext is an alternate form of the GetOfferingAbout method which supports a Context parameter
+	GetOfferingAboutWithContext(ctx context.Context, getOfferingAboutOptions *GetOfferingAboutOptions) (result *string, response *core.DetailedResponse, err error)
+	// GetIamPermissions : Get the required IAM permissions for this version with the specified user context
+	GetIamPermissions(getIamPermissionsOptions *GetIamPermissionsOptions) (result []CheckedIamPermission, response *core.DetailedResponse, err error)
+	// GetIamPermissionsWithContext is an alternate form of the GetIamPermissions method which supports a Context parameter
+	GetIamPermissionsWithContext(ctx context.Context, getIamPermissionsOptions *GetIamPermissionsOptions) (result []CheckedIamPermission, response *core.DetailedResponse, err error)
+	// GetOfferingLicense : Get version license content
+	GetOfferingLicense(getOfferingLicenseOptions *GetOfferingLicenseOptions) (result *string, response *core.DetailedResponse, err error)
+	// GetOfferingLicenseWithContext is an alternate form of the GetOfferingLicense method which supports a Context parameter
+	GetOfferingLicenseWithContext(ctx context.Context, getOfferingLicenseOptions *GetOfferingLicenseOptions) (result *string, response *core.DetailedResponse, err error)
+	// GetOfferingContainerImages : Get version's container images
+	GetOfferingContainerImages(getOfferingContainerImagesOptions *GetOfferingContainerImagesOptions) (result *ImageManifest, response *core.DetailedResponse, err error)
+	// GetOfferingContainerImagesWithContext is an alternate form of the GetOfferingContainerImages method which supports a Context parameter
+	GetOfferingContainerImagesWithContext(ctx context.Context, getOfferingContainerImagesOptions *GetOfferingContainerImagesOptions) (result *ImageManifest, response *core.DetailedResponse, err error)
+	// ArchiveVersion : Archive version immediately
+	ArchiveVersion(archiveVersionOptions *ArchiveVersionOptions) (response *core.DetailedResponse, err error)
+	// ArchiveVersionWithContext is an alternate form of the ArchiveVersion method which supports a Context parameter
+	ArchiveVersionWithContext(ctx context.Context, archiveVersionOptions *ArchiveVersionOptions) (response *core.DetailedResponse, err error)
+	// SetDeprecateVersion : Sets version to be deprecated in a certain time period
+	SetDeprecateVersion(setDeprecateVersionOptions *SetDeprecateVersionOptions) (response *core.DetailedResponse, err error)
+	// SetDeprecateVersionWithContext is an alternate form of the SetDeprecateVersion method which supports a Context parameter
+	SetDeprecateVersionWithContext(ctx context.Context, setDeprecateVersionOptions *SetDeprecateVersionOptions) (response *core.DetailedResponse, err error)
+	// ConsumableVersion : Make version consumable for sharing
+	ConsumableVersion(consumableVersionOptions *ConsumableVersionOptions) (response *core.DetailedResponse, err error)
+	// ConsumableVersionWithContext is an alternate form of the ConsumableVersion method which supports a Context parameter
+	ConsumableVersionWithContext(ctx context.Context, consumableVersionOptions *ConsumableVersionOptions) (response *core.DetailedResponse, err error)
+	// PrereleaseVersion : Make version prerelease
+	PrereleaseVersion(prereleaseVersionOptions *PrereleaseVersionOptions) (response *core.DetailedResponse, err error)
+	// PrereleaseVersionWithContext is an alternate form of the PrereleaseVersion method which supports a Context parameter
+	PrereleaseVersionWithContext(ctx context.Context, prereleaseVersionOptions *PrereleaseVersionOptions) (response *core.DetailedResponse, err error)
+	// TestVersion : Make version test
+	TestVersion(testVersionOptions *TestVersionOptions) (response *core.DetailedResponse, err error)
+	// TestVersionWithContext is an alternate form of the TestVersion method which supports a Context parameter
+	TestVersionWithContext(ctx context.Context, testVersionOptions *TestVersionOptions) (response *core.DetailedResponse, err error)
+	// SuspendVersion : Suspend a version
+	SuspendVersion(suspendVersionOptions *SuspendVersionOptions) (response *core.DetailedResponse, err error)
+	// SuspendVersionWithContext is an alternate form of the SuspendVersion method which supports a Context parameter
+	SuspendVersionWithContext(ctx context.Context, suspendVersionOptions *SuspendVersionOptions) (response *core.DetailedResponse, err error)
+	// CommitVersion : Commit version
+	CommitVersion(commitVersionOptions *CommitVersionOptions) (response *core.DetailedResponse, err error)
+	// CommitVersionWithContext is an alternate form of the CommitVersion method which supports a Context parameter
+	CommitVersionWithContext(ctx context.Context, commitVersionOptions *CommitVersionOptions) (response *core.DetailedResponse, err error)
+	// CopyVersion : Copy version to new target kind
+	CopyVersion(copyVersionOptions *CopyVersionOptions) (response *core.DetailedResponse, err error)
+	// CopyVersionWithContext is an alternate form of the CopyVersion method which supports a Context parameter
+	CopyVersionWithContext(ctx context.Context, copyVersionOptions *CopyVersionOptions) (response *core.DetailedResponse, err error)
+	// GetOfferingWorkingCopy : Create working copy of version
+	GetOfferingWorkingCopy(getOfferingWorkingCopyOptions *GetOfferingWorkingCopyOptions) (result *Version, response *core.DetailedResponse, err error)
+	// GetOfferingWorkingCopyWithContext is an alternate form of the GetOfferingWorkingCopy method which supports a Context parameter
+	GetOfferingWorkingCopyWithContext(ctx context.Context, getOfferingWorkingCopyOptions *GetOfferingWorkingCopyOptions) (result *Version, response *core.DetailedResponse, err error)
+	// CopyFromPreviousVersion : Copy values from a previous version
+	CopyFromPreviousVersion(copyFromPreviousVersionOptions *CopyFromPreviousVersionOptions) (response *core.DetailedResponse, err error)
+	// CopyFromPreviousVersionWithContext is an alternate form of the CopyFromPreviousVersion method which supports a Context parameter
+	CopyFromPreviousVersionWithContext(ctx context.Context, copyFromPreviousVersionOptions *CopyFromPreviousVersionOptions) (response *core.DetailedResponse, err error)
+	// ValidateInputs : Validates deployment input variables
+	ValidateInputs(validateInputsOptions *ValidateInputsOptions) (result *VersionInputValidationResponse, response *core.DetailedResponse, err error)
+	// ValidateInputsWithContext is an alternate form of the ValidateInputs method which supports a Context parameter
+	ValidateInputsWithContext(ctx context.Context, validateInputsOptions *ValidateInputsOptions) (result *VersionInputValidationResponse, response *core.DetailedResponse, err error)
+	// GetVersion : Get offering/kind/version 'branch'
+	GetVersion(getVersionOptions *GetVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// GetVersionWithContext is an alternate form of the GetVersion method which supports a Context parameter
+	GetVersionWithContext(ctx context.Context, getVersionOptions *GetVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// UpdateVersion : Update a version
+	UpdateVersion(updateVersionOptions *UpdateVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// UpdateVersionWithContext is an alternate form of the UpdateVersion method which supports a Context parameter
+	UpdateVersionWithContext(ctx context.Context, updateVersionOptions *UpdateVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// PatchUpdateVersion : Update a version
+	PatchUpdateVersion(patchUpdateVersionOptions *PatchUpdateVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// PatchUpdateVersionWithContext is an alternate form of the PatchUpdateVersion method which supports a Context parameter
+	PatchUpdateVersionWithContext(ctx context.Context, patchUpdateVersionOptions *PatchUpdateVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// DeleteVersion : Delete version
+	DeleteVersion(deleteVersionOptions *DeleteVersionOptions) (response *core.DetailedResponse, err error)
+	// DeleteVersionWithContext is an alternate form of the DeleteVersion method which supports a Context parameter
+	DeleteVersionWithContext(ctx context.Context, deleteVersionOptions *DeleteVersionOptions) (response *core.DetailedResponse, err error)
+	// GetVersionDependencies : Get offering/kind/version 'dependencies'
+	GetVersionDependencies(getVersionDependenciesOptions *GetVersionDependenciesOptions) (result *VersionDependant, response *core.DetailedResponse, err error)
+	// GetVersionDependenciesWithContext is an alternate form of the GetVersionDependencies method which supports a Context parameter
+	GetVersionDependenciesWithContext(ctx context.Context, getVersionDependenciesOptions *GetVersionDependenciesOptions) (result *VersionDependant, response *core.DetailedResponse, err error)
+	// DeprecateVersion : Deprecate version immediately - use /archive instead
+	DeprecateVersion(deprecateVersionOptions *DeprecateVersionOptions) (response *core.DetailedResponse, err error)
+	// DeprecateVersionWithContext is an alternate form of the DeprecateVersion method which supports a Context parameter
+	DeprecateVersionWithContext(ctx context.Context, deprecateVersionOptions *DeprecateVersionOptions) (response *core.DetailedResponse, err error)
+	// GetCluster : Get kubernetes cluster
+	GetCluster(getClusterOptions *GetClusterOptions) (result *ClusterInfo, response *core.DetailedResponse, err error)
+	// GetClusterWithContext is an alternate form of the GetCluster method which supports a Context parameter
+	GetClusterWithContext(ctx context.Context, getClusterOptions *GetClusterOptions) (result *ClusterInfo, response *core.DetailedResponse, err error)
+	// GetNamespaces : Get cluster namespaces
+	GetNamespaces(getNamespacesOptions *GetNamespacesOptions) (result *NamespaceSearchResult, response *core.DetailedResponse, err error)
+	// GetNamespacesWithContext is an alternate form of the GetNamespaces method which supports a Context parameter
+	GetNamespacesWithContext(ctx context.Context, getNamespacesOptions *GetNamespacesOptions) (result *NamespaceSearchResult, response *core.DetailedResponse, err error)
+	// DeployOperators : Deploy operators
+	DeployOperators(deployOperatorsOptions *DeployOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+	// DeployOperatorsWithContext is an alternate form of the DeployOperators method which supports a Context parameter
+	DeployOperatorsWithContext(ctx context.Context, deployOperatorsOptions *DeployOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+	// ListOperators : List operators
+	ListOperators(listOperatorsOptions *ListOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+	// ListOperatorsWithContext is an alternate form of the ListOperators method which supports a Context parameter
+	ListOperatorsWithContext(ctx context.Context, listOperatorsOptions *ListOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+	// ReplaceOperators : Update operators
+	ReplaceOperators(replaceOperatorsOptions *ReplaceOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+	// ReplaceOperatorsWithContext is an alternate form of the ReplaceOperators method which supports a Context parameter
+	ReplaceOperatorsWithContext(ctx context.Context, replaceOperatorsOptions *ReplaceOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+	// DeleteOperators : Delete operators
+	DeleteOperators(deleteOperatorsOptions *DeleteOperatorsOptions) (response *core.DetailedResponse, err error)
+	// DeleteOperatorsWithContext is an alternate form of the DeleteOperators method which supports a Context parameter
+	DeleteOperatorsWithContext(ctx context.Context, deleteOperatorsOptions *DeleteOperatorsOptions) (response *core.DetailedResponse, err error)
+	// InstallVersion : Install version
+	InstallVersion(installVersionOptions *InstallVersionOptions) (response *core.DetailedResponse, err error)
+	// InstallVersionWithContext is an alternate form of the InstallVersion method which supports a Context parameter
+	InstallVersionWithContext(ctx context.Context, installVersionOptions *InstallVersionOptions) (response *core.DetailedResponse, err error)
+	// PreinstallVersion : Pre-install version
+	PreinstallVersion(preinstallVersionOptions *PreinstallVersionOptions) (response *core.DetailedResponse, err error)
+	// PreinstallVersionWithContext is an alternate form of the PreinstallVersion method which supports a Context parameter
+	PreinstallVersionWithContext(ctx context.Context, preinstallVersionOptions *PreinstallVersionOptions) (response *core.DetailedResponse, err error)
+	// GetPreinstall : Get version pre-install status
+	GetPreinstall(getPreinstallOptions *GetPreinstallOptions) (result *InstallStatus, response *core.DetailedResponse, err error)
+	// GetPreinstallWithContext is an alternate form of the GetPreinstall method which supports a Context parameter
+	GetPreinstallWithContext(ctx context.Context, getPreinstallOptions *GetPreinstallOptions) (result *InstallStatus, response *core.DetailedResponse, err error)
+	// ValidateInstall : Validate offering
+	ValidateInstall(validateInstallOptions *ValidateInstallOptions) (response *core.DetailedResponse, err error)
+	// ValidateInstallWithContext is an alternate form of the ValidateInstall method which supports a Context parameter
+	ValidateInstallWithContext(ctx context.Context, validateInstallOptions *ValidateInstallOptions) (response *core.DetailedResponse, err error)
+	// GetValidationStatus : Get offering install status
+	GetValidationStatus(getValidationStatusOptions *GetValidationStatusOptions) (result *Validation, response *core.DetailedResponse, err error)
+	// GetValidationStatusWithContext is an alternate form of the GetValidationStatus method which supports a Context parameter
+	GetValidationStatusWithContext(ctx context.Context, getValidationStatusOptions *GetValidationStatusOptions) (result *Validation, response *core.DetailedResponse, err error)
+	// SearchObjects : List objects across catalogs
+	SearchObjects(searchObjectsOptions *SearchObjectsOptions) (result *ObjectSearchResult, response *core.DetailedResponse, err error)
+	// SearchObjectsWithContext is an alternate form of the SearchObjects method which supports a Context parameter
+	SearchObjectsWithContext(ctx context.Context, searchObjectsOptions *SearchObjectsOptions) (result *ObjectSearchResult, response *core.DetailedResponse, err error)
+	// ListObjects : List objects within a catalog
+	ListObjects(listObjectsOptions *ListObjectsOptions) (result *ObjectListResult, response *core.DetailedResponse, err error)
+	// ListObjectsWithContext is an alternate form of the ListObjects method which supports a Context parameter
+	ListObjectsWithContext(ctx context.Context, listObjectsOptions *ListObjectsOptions) (result *ObjectListResult, response *core.DetailedResponse, err error)
+	// CreateObject : Create catalog object
+	CreateObject(createObjectOptions *CreateObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+	// CreateObjectWithContext is an alternate form of the CreateObject method which supports a Context parameter
+	CreateObjectWithContext(ctx context.Context, createObjectOptions *CreateObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+	// GetObject : Get catalog object
+	GetObject(getObjectOptions *GetObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+	// GetObjectWithContext is an alternate form of the GetObject method which supports a Context parameter
+	GetObjectWithContext(ctx context.Context, getObjectOptions *GetObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+	// ReplaceObject : Update catalog object
+	ReplaceObject(replaceObjectOptions *ReplaceObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+	// ReplaceObjectWithContext is an alternate form of the ReplaceObject method which supports a Context parameter
+	ReplaceObjectWithContext(ctx context.Context, replaceObjectOptions *ReplaceObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+	// DeleteObject : Delete catalog object
+	DeleteObject(deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error)
+	// DeleteObjectWithContext is an alternate form of the DeleteObject method which supports a Context parameter
+	DeleteObjectWithContext(ctx context.Context, deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error)
+	// ListObjectAudits : Get object audit logs
+	ListObjectAudits(listObjectAuditsOptions *ListObjectAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// ListObjectAuditsWithContext is an alternate form of the ListObjectAudits method which supports a Context parameter
+	ListObjectAuditsWithContext(ctx context.Context, listObjectAuditsOptions *ListObjectAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// GetObjectAudit : Get an object audit log entry
+	GetObjectAudit(getObjectAuditOptions *GetObjectAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// GetObjectAuditWithContext is an alternate form of the GetObjectAudit method which supports a Context parameter
+	GetObjectAuditWithContext(ctx context.Context, getObjectAuditOptions *GetObjectAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// ConsumableShareObject : Make object consumable for sharing
+	ConsumableShareObject(consumableShareObjectOptions *ConsumableShareObjectOptions) (response *core.DetailedResponse, err error)
+	// ConsumableShareObjectWithContext is an alternate form of the ConsumableShareObject method which supports a Context parameter
+	ConsumableShareObjectWithContext(ctx context.Context, consumableShareObjectOptions *ConsumableShareObjectOptions) (response *core.DetailedResponse, err error)
+	// ShareObject : Allows object to be shared
+	ShareObject(shareObjectOptions *ShareObjectOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+	// ShareObjectWithContext is an alternate form of the ShareObject method which supports a Context parameter
+	ShareObjectWithContext(ctx context.Context, shareObjectOptions *ShareObjectOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+	// GetObjectAccessList : Get object access list
+	GetObjectAccessList(getObjectAccessListOptions *GetObjectAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+	// GetObjectAccessListWithContext is an alternate form of the GetObjectAccessList method which supports a Context parameter
+	GetObjectAccessListWithContext(ctx context.Context, getObjectAccessListOptions *GetObjectAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+	// GetObjectAccess : Check for account ID in object access list
+	GetObjectAccess(getObjectAccessOptions *GetObjectAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+	// GetObjectAccessWithContext is an alternate form of the GetObjectAccess method which supports a Context parameter
+	GetObjectAccessWithContext(ctx context.Context, getObjectAccessOptions *GetObjectAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+	// CreateObjectAccess : Add account ID to object access list
+	CreateObjectAccess(createObjectAccessOptions *CreateObjectAccessOptions) (response *core.DetailedResponse, err error)
+	// CreateObjectAccessWithContext is an alternate form of the CreateObjectAccess method which supports a Context parameter
+	CreateObjectAccessWithContext(ctx context.Context, createObjectAccessOptions *CreateObjectAccessOptions) (response *core.DetailedResponse, err error)
+	// DeleteObjectAccess : Remove account ID from object access list
+	DeleteObjectAccess(deleteObjectAccessOptions *DeleteObjectAccessOptions) (response *core.DetailedResponse, err error)
+	// DeleteObjectAccessWithContext is an alternate form of the DeleteObjectAccess method which supports a Context parameter
+	DeleteObjectAccessWithContext(ctx context.Context, deleteObjectAccessOptions *DeleteObjectAccessOptions) (response *core.DetailedResponse, err error)
+	// GetObjectAccessListDeprecated : Get object access list
+	GetObjectAccessListDeprecated(getObjectAccessListDeprecatedOptions *GetObjectAccessListDeprecatedOptions) (result *ObjectAccessListResult, response *core.DetailedResponse, err error)
+	// GetObjectAccessListDeprecatedWithContext is an alternate form of the GetObjectAccessListDeprecated method which supports a Context parameter
+	GetObjectAccessListDeprecatedWithContext(ctx context.Context, getObjectAccessListDeprecatedOptions *GetObjectAccessListDeprecatedOptions) (result *ObjectAccessListResult, response *core.DetailedResponse, err error)
+	// DeleteObjectAccessList : Delete accesses from object access list
+	DeleteObjectAccessList(deleteObjectAccessListOptions *DeleteObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// DeleteObjectAccessListWithContext is an alternate form of the DeleteObjectAccessList method which supports a Context parameter
+	DeleteObjectAccessListWithContext(ctx context.Context, deleteObjectAccessListOptions *DeleteObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// AddObjectAccessList : Add accesses to object access list
+	AddObjectAccessList(addObjectAccessListOptions *AddObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// AddObjectAccessListWithContext is an alternate form of the AddObjectAccessList method which supports a Context parameter
+	AddObjectAccessListWithContext(ctx context.Context, addObjectAccessListOptions *AddObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+	// CreateOfferingInstance : Create an offering resource instance
+	CreateOfferingInstance(createOfferingInstanceOptions *CreateOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+	// CreateOfferingInstanceWithContext is an alternate form of the CreateOfferingInstance method which supports a Context parameter
+	CreateOfferingInstanceWithContext(ctx context.Context, createOfferingInstanceOptions *CreateOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+	// GetOfferingInstance : Get Offering Instance
+	GetOfferingInstance(getOfferingInstanceOptions *GetOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+	// GetOfferingInstanceWithContext is an alternate form of the GetOfferingInstance method which supports a Context parameter
+	GetOfferingInstanceWithContext(ctx context.Context, getOfferingInstanceOptions *GetOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+	// PutOfferingInstance : Update Offering Instance
+	PutOfferingInstance(putOfferingInstanceOptions *PutOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+	// PutOfferingInstanceWithContext is an alternate form of the PutOfferingInstance method which supports a Context parameter
+	PutOfferingInstanceWithContext(ctx context.Context, putOfferingInstanceOptions *PutOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+	// DeleteOfferingInstance : Delete a version instance
+	DeleteOfferingInstance(deleteOfferingInstanceOptions *DeleteOfferingInstanceOptions) (response *core.DetailedResponse, err error)
+	// DeleteOfferingInstanceWithContext is an alternate form of the DeleteOfferingInstance method which supports a Context parameter
+	DeleteOfferingInstanceWithContext(ctx context.Context, deleteOfferingInstanceOptions *DeleteOfferingInstanceOptions) (response *core.DetailedResponse, err error)
+	// ListOfferingInstanceAudits : Get offering instance audit logs
+	ListOfferingInstanceAudits(listOfferingInstanceAuditsOptions *ListOfferingInstanceAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// ListOfferingInstanceAuditsWithContext is an alternate form of the ListOfferingInstanceAudits method which supports a Context parameter
+	ListOfferingInstanceAuditsWithContext(ctx context.Context, listOfferingInstanceAuditsOptions *ListOfferingInstanceAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+	// GetOfferingInstanceAudit : Get an offering instance audit log entry
+	GetOfferingInstanceAudit(getOfferingInstanceAuditOptions *GetOfferingInstanceAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// GetOfferingInstanceAuditWithContext is an alternate form of the GetOfferingInstanceAudit method which supports a Context parameter
+	GetOfferingInstanceAuditWithContext(ctx context.Context, getOfferingInstanceAuditOptions *GetOfferingInstanceAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+	// GetPlan : Get offering/plan 'branch'
+	GetPlan(getPlanOptions *GetPlanOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// GetPlanWithContext is an alternate form of the GetPlan method which supports a Context parameter
+	GetPlanWithContext(ctx context.Context, getPlanOptions *GetPlanOptions) (result *Offering, response *core.DetailedResponse, err error)
+	// DeletePlan : Delete plan
+	DeletePlan(deletePlanOptions *DeletePlanOptions) (response *core.DetailedResponse, err error)
+	// DeletePlanWithContext is an alternate form of the DeletePlan method which supports a Context parameter
+	DeletePlanWithContext(ctx context.Context, deletePlanOptions *DeletePlanOptions) (response *core.DetailedResponse, err error)
+	// ConsumablePlan : Make plan consumable for sharing
+	ConsumablePlan(consumablePlanOptions *ConsumablePlanOptions) (response *core.DetailedResponse, err error)
+	// ConsumablePlanWithContext is an alternate form of the ConsumablePlan method which supports a Context parameter
+	ConsumablePlanWithContext(ctx context.Context, consumablePlanOptions *ConsumablePlanOptions) (response *core.DetailedResponse, err error)
+	// SetDeprecatePlan : Sets plan to be deprecated in a certain time period
+	SetDeprecatePlan(setDeprecatePlanOptions *SetDeprecatePlanOptions) (response *core.DetailedResponse, err error)
+	// SetDeprecatePlanWithContext is an alternate form of the SetDeprecatePlan method which supports a Context parameter
+	SetDeprecatePlanWithContext(ctx context.Context, setDeprecatePlanOptions *SetDeprecatePlanOptions) (response *core.DetailedResponse, err error)
+	// PreviewRegions : Returns available locations based on supplied filter
+	PreviewRegions(previewRegionsOptions *PreviewRegionsOptions) (result *RegionsSearchResult, response *core.DetailedResponse, err error)
+	// PreviewRegionsWithContext is an alternate form of the PreviewRegions method which supports a Context parameter
+	PreviewRegionsWithContext(ctx context.Context, previewRegionsOptions *PreviewRegionsOptions) (result *RegionsSearchResult, response *core.DetailedResponse, err error)
+	// ListRegions : Returns available locations based on filter set on the account and supplied filter
+	ListRegions(listRegionsOptions *ListRegionsOptions) (result *RegionsSearchResult, response *core.DetailedResponse, err error)
+	// ListRegionsWithContext is an alternate form of the ListRegions method which supports a Context parameter
+	ListRegionsWithContext(ctx context.Context, listRegionsOptions *ListRegionsOptions) (result *RegionsSearchResult, response *core.DetailedResponse, err error)
+	// Add a plan to an offering.
+	AddPlan(catalogID string, offeringID string, plan *Plan, headers map[string]string) (result *Plan, response *core.DetailedResponse, err error)
+	// AddPlanWithContext is an alternate form of the addPlan method which supports a Context parameter
+	AddPlanWithContext(ctx context.Context, catalogID string, offeringID string, plan *Plan, headers map[string]string) (result *Plan, response *core.DetailedResponse, err error)
+	// Set a plan as validated.
+	SetValidatePlan(planID string, headers map[string]string) (response *core.DetailedResponse, err error)
+	// SetValidatePlanWithContext is an alternate form of the setValidatePlan method which supports a Context parameter
+	SetValidatePlanWithContext(ctx context.Context, planID string, headers map[string]string) (response *core.DetailedResponse, err error)
+	// Set a plan as publish approved.
+	SetAllowPublishPlan(planID string, headers map[string]string) (response *core.DetailedResponse, err error)
+	// SetAllowPublishPlanWithContext is an alternate form of the setAllowPublishPlan method which supports a Context parameter
+	SetAllowPublishPlanWithContext(ctx context.Context, planID string, headers map[string]string) (response *core.DetailedResponse, err error)
+	// Set allow publish offering.
+	SetAllowPublishOffering(catalogID string, offeringID string, approvalType string, setting bool, headers map[string]string) (response *core.DetailedResponse, err error)
+	// SetAllowPublishOfferingWithContext is an alternate form of the setAllowPublishOffering method which supports a Context parameter
+	SetAllowPublishOfferingWithContext(ctx context.Context, catalogID string, offeringID string, approvalType string, setting bool, headers map[string]string) (response *core.DetailedResponse, err error)
+}
+
+var _ CatalogManagementV1Intf = (*CatalogManagementV1)(nil)
@@ -0,0 +1,547 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with ManifestProblem.Severity.
+const (
+	ManifestProblemSeverityErrorConst   = "error"
+	ManifestProblemSeverityWarningConst = "warning"
+)
+
+// Input types understood by the catalog, as used in Configuration.Type.
+var manifestInputTypes = map[string]bool{
+	"string":   true,
+	"password": true,
+	"boolean":  true,
+	"int":      true,
+	"integer":  true,
+	"float":    true,
+	"number":   true,
+	"array":    true,
+	"list":     true,
+	"object":   true,
+	"map":      true,
+}
+
+var (
+	offeringNamePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	semverPattern       = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+	semverRangePattern  = regexp.MustCompile(`^(>=|<=|>|<|=|~|\^)?v?(\d+|[xX*])(\.(\d+|[xX*]))?(\.(\d+|[xX*]))?(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+)
+
+// ManifestProblem : A problem found in an offering manifest.
+type ManifestProblem struct {
+	// JSON path of the offending element, e.g. "kinds[0].versions[1].configuration[2].default_value".
+	Path string `json:"path"`
+
+	// 1-based line and column of the offending element in the manifest, or 0 if unknown.
+	Line   int `json:"line"`
+	Column int `json:"column"`
+
+	// One of error or warning.
+	Severity string `json:"severity"`
+
+	// Description of the problem.
+	Message string `json:"message"`
+}
+
+// ManifestValidationResult : The outcome of validating an offering manifest.
+type ManifestValidationResult struct {
+	// Name of the validated file, used when formatting problems.
+	File string `json:"file,omitempty"`
+
+	// The problems found, ordered by position in the manifest.
+	Problems []ManifestProblem `json:"problems"`
+}
+
+// HasErrors returns true if any problem has error severity.
+func (result *ManifestValidationResult) HasErrors() bool {
+	for _, problem := range result.Problems {
+		if problem.Severity == ManifestProblemSeverityErrorConst {
+			return true
+		}
+	}
+	return false
+}
+
+// String formats the problems one per line as "file:line:column: severity: path: message",
+// which most CI systems and editors recognize.
+func (result *ManifestValidationResult) String() string {
+	var b strings.Builder
+	for _, problem := range result.Problems {
+		fmt.Fprintf(&b, "%s:%d:%d: %s: %s: %s\n", result.File, problem.Line, problem.Column, problem.Severity, problem.Path, problem.Message)
+	}
+	return b.String()
+}
+
+// ValidateOfferingManifestFile validates the offering manifest stored in the specified file.
+// An error is returned only if the file cannot be read; problems with its contents are reported in the result.
+func ValidateOfferingManifestFile(path string) (result *ManifestValidationResult, err error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return
+	}
+	result = ValidateOfferingManifest(data)
+	result.File = path
+	return
+}
+
+// ValidateOfferingManifest validates an offering manifest: a JSON document shaped like the Offering model,
+// with its kinds, versions, configuration, dependencies and plans. The checks cover required fields,
+// input types and defaults, semantic version ranges, and consistent use of flavors and kinds.
+func ValidateOfferingManifest(data []byte) *ManifestValidationResult {
+	v := &manifestValidator{
+		data:   data,
+		result: &ManifestValidationResult{Problems: []ManifestProblem{}},
+	}
+
+	offering := new(Offering)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(offering); err != nil {
+		offset := 0
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		path := ""
+		if errors.As(err, &syntaxErr) {
+			offset = int(syntaxErr.Offset)
+		} else if errors.As(err, &typeErr) {
+			offset = int(typeErr.Offset)
+			path = typeErr.Field
+		}
+		line, column := lineAndColumn(data, offset)
+		v.result.Problems = append(v.result.Problems, ManifestProblem{
+			Path:     path,
+			Line:     line,
+			Column:   column,
+			Severity: ManifestProblemSeverityErrorConst,
+			Message:  "invalid manifest: " + err.Error(),
+		})
+		return v.result
+	}
+
+	v.positions = indexJSONPositions(data)
+	v.validateOffering(offering)
+
+	sort.SliceStable(v.result.Problems, func(i, j int) bool {
+		a, b := v.result.Problems[i], v.result.Problems[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return v.result
+}
+
+type manifestValidator struct {
+	data      []byte
+	positions map[string]int
+	result    *ManifestValidationResult
+}
+
+func (v *manifestValidator) report(severity string, path string, format string, args ...interface{}) {
+	line, column := v.position(path)
+	v.result.Problems = append(v.result.Problems, ManifestProblem{
+		Path:     path,
+		Line:     line,
+		Column:   column,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *manifestValidator) errorf(path string, format string, args ...interface{}) {
+	v.report(ManifestProblemSeverityErrorConst, path, format, args...)
+}
+
+func (v *manifestValidator) warnf(path string, format string, args ...interface{}) {
+	v.report(ManifestProblemSeverityWarningConst, path, format, args...)
+}
+
+// position returns the line and column of "path", falling back to its closest ancestor
+// for elements that are missing from the manifest.
+func (v *manifestValidator) position(path string) (int, int) {
+	for {
+		if offset, ok := v.positions[path]; ok {
+			return lineAndColumn(v.data, offset)
+		}
+		if path == "" {
+			return 0, 0
+		}
+		cut := strings.LastIndexAny(path, ".[")
+		if cut < 0 {
+			path = ""
+		} else {
+			path = path[:cut]
+		}
+	}
+}
+
+func (v *manifestValidator) validateOffering(offering *Offering) {
+	name := core.StringNilMapper(offering.Name)
+	switch {
+	case name == "":
+		v.errorf("name", "offering name is required")
+	case !offeringNamePattern.MatchString(name):
+		v.errorf("name", "offering name '%s' must contain only lowercase letters, digits and single dashes", name)
+	}
+	if core.StringNilMapper(offering.Label) == "" {
+		v.errorf("label", "offering label is required")
+	}
+	if len(offering.Kinds) == 0 {
+		v.errorf("kinds", "at least one kind is required")
+	}
+
+	formatKinds := map[string]bool{}
+	flavors := map[string]bool{}
+	for i := range offering.Kinds {
+		kind := &offering.Kinds[i]
+		path := fmt.Sprintf("kinds[%d]", i)
+		formatKind := core.StringNilMapper(kind.FormatKind)
+		if formatKind != "" {
+			if formatKinds[formatKind] {
+				v.errorf(path+".format_kind", "format kind '%s' is declared more than once", formatKind)
+			}
+			formatKinds[formatKind] = true
+		}
+		v.validateKind(path, kind, flavors)
+	}
+
+	for i := range offering.Plans {
+		v.validatePlan(fmt.Sprintf("plans[%d]", i), &offering.Plans[i], formatKinds, flavors)
+	}
+}
+
+func (v *manifestValidator) validateKind(path string, kind *Kind, flavors map[string]bool) {
+	if core.StringNilMapper(kind.FormatKind) == "" {
+		v.errorf(path+".format_kind", "format_kind is required")
+	}
+	if core.StringNilMapper(kind.InstallKind) == "" {
+		v.errorf(path+".install_kind", "install_kind is required")
+	}
+	if core.StringNilMapper(kind.TargetKind) == "" {
+		v.errorf(path+".target_kind", "target_kind is required")
+	}
+
+	seen := map[string]string{}
+	flavored, unflavored := 0, 0
+	for i := range kind.Versions {
+		version := &kind.Versions[i]
+		versionPath := fmt.Sprintf("%s.versions[%d]", path, i)
+
+		flavor := ""
+		if version.Flavor != nil {
+			flavor = core.StringNilMapper(version.Flavor.Name)
+			if flavor == "" {
+				v.errorf(versionPath+".flavor.name", "flavor name is required when a flavor is specified")
+			}
+			flavors[flavor] = true
+			flavored++
+		} else {
+			unflavored++
+		}
+
+		semver := core.StringNilMapper(version.Version)
+		identity := semver + "/" + flavor
+		if previous, ok := seen[identity]; ok && semver != "" {
+			if flavor != "" {
+				v.errorf(versionPath+".version", "version %s with flavor '%s' duplicates %s", semver, flavor, previous)
+			} else {
+				v.errorf(versionPath+".version", "version %s duplicates %s", semver, previous)
+			}
+		}
+		seen[identity] = versionPath
+
+		v.validateVersion(versionPath, version)
+	}
+	if flavored > 0 && unflavored > 0 {
+		v.errorf(path+".versions", "either every version of a kind must specify a flavor or none may (%d with, %d without)", flavored, unflavored)
+	}
+}
+
+func (v *manifestValidator) validateVersion(path string, version *Version) {
+	semver := core.StringNilMapper(version.Version)
+	switch {
+	case semver == "":
+		v.errorf(path+".version", "version is required")
+	case !semverPattern.MatchString(semver):
+		v.errorf(path+".version", "'%s' is not a valid semantic version", semver)
+	}
+
+	keys := map[string]bool{}
+	for i := range version.Configuration {
+		configuration := &version.Configuration[i]
+		configurationPath := fmt.Sprintf("%s.configuration[%d]", path, i)
+		key := core.StringNilMapper(configuration.Key)
+		if key == "" {
+			v.errorf(configurationPath+".key", "configuration key is required")
+		} else if keys[key] {
+			v.errorf(configurationPath+".key", "configuration key '%s' is declared more than once", key)
+		}
+		keys[key] = true
+		v.validateConfiguration(configurationPath, configuration)
+	}
+
+	outputs := map[string]bool{}
+	for i, output := range version.Outputs {
+		key := core.StringNilMapper(output.Key)
+		outputPath := fmt.Sprintf("%s.outputs[%d].key", path, i)
+		if key == "" {
+			v.errorf(outputPath, "output key is required")
+		} else if outputs[key] {
+			v.errorf(outputPath, "output key '%s' is declared more than once", key)
+		}
+		outputs[key] = true
+	}
+
+	if version.SolutionInfo != nil {
+		for i := range version.SolutionInfo.Dependencies {
+			v.validateDependency(fmt.Sprintf("%s.solution_info.dependencies[%d]", path, i), &version.SolutionInfo.Dependencies[i])
+		}
+	}
+}
+
+func (v *manifestValidator) validateConfiguration(path string, configuration *Configuration) {
+	inputType := strings.ToLower(core.StringNilMapper(configuration.Type))
+	if inputType == "" {
+		v.errorf(path+".type", "type is required")
+	} else if !manifestInputTypes[inputType] {
+		v.warnf(path+".type", "unrecognized input type '%s'", inputType)
+	}
+
+	required := configuration.Required != nil && *configuration.Required
+	hidden := configuration.Hidden != nil && *configuration.Hidden
+	if required && hidden && configuration.DefaultValue == nil {
+		v.errorf(path, "a required input that is hidden must have a default value")
+	}
+
+	if configuration.DefaultValue != nil && manifestInputTypes[inputType] && !matchesInputType(inputType, configuration.DefaultValue) {
+		v.errorf(path+".default_value", "default value %s does not match type '%s'", compactJSON(configuration.DefaultValue), inputType)
+	}
+
+	if len(configuration.Options) > 0 && configuration.DefaultValue != nil {
+		found := false
+		for _, option := range configuration.Options {
+			value := option
+			if m, ok := option.(map[string]interface{}); ok {
+				value = m["value"]
+			}
+			if compactJSON(value) == compactJSON(configuration.DefaultValue) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.errorf(path+".default_value", "default value %s is not one of the input's options", compactJSON(configuration.DefaultValue))
+		}
+	}
+}
+
+func (v *manifestValidator) validateDependency(path string, dependency *OfferingReference) {
+	if core.StringNilMapper(dependency.Name) == "" && core.StringNilMapper(dependency.ID) == "" {
+		v.errorf(path, "a dependency must specify a name or an id")
+	}
+	versionRange := core.StringNilMapper(dependency.Version)
+	if versionRange == "" {
+		v.errorf(path+".version", "dependency version range is required")
+	} else if !isValidSemverRange(versionRange) {
+		v.errorf(path+".version", "'%s' is not a valid semantic version range", versionRange)
+	}
+
+	declared := map[string]bool{}
+	for i, flavor := range dependency.Flavors {
+		if flavor == "" {
+			v.errorf(fmt.Sprintf("%s.flavors[%d]", path, i), "flavor names must not be empty")
+		}
+		declared[flavor] = true
+	}
+	if defaultFlavor := core.StringNilMapper(dependency.DefaultFlavor); defaultFlavor != "" && len(dependency.Flavors) > 0 && !declared[defaultFlavor] {
+		v.errorf(path+".default_flavor", "default flavor '%s' is not one of the dependency's flavors", defaultFlavor)
+	}
+	if dependency.OnByDefault != nil && *dependency.OnByDefault && (dependency.Optional == nil || !*dependency.Optional) {
+		v.warnf(path+".on_by_default", "on_by_default only has an effect on optional dependencies")
+	}
+}
+
+func (v *manifestValidator) validatePlan(path string, plan *Plan, formatKinds map[string]bool, flavors map[string]bool) {
+	if core.StringNilMapper(plan.Name) == "" {
+		v.errorf(path+".name", "plan name is required")
+	}
+	if core.StringNilMapper(plan.Label) == "" {
+		v.errorf(path+".label", "plan label is required")
+	}
+	if plan.VersionRange == nil {
+		return
+	}
+	rangePath := path + ".version_range"
+	if versionRange := core.StringNilMapper(plan.VersionRange.Version); versionRange != "" && !isValidSemverRange(versionRange) {
+		v.errorf(rangePath+".version", "'%s' is not a valid semantic version range", versionRange)
+	}
+	for i, kind := range plan.VersionRange.Kinds {
+		if !formatKinds[kind] {
+			v.errorf(fmt.Sprintf("%s.kinds[%d]", rangePath, i), "kind '%s' is not a format kind of this offering", kind)
+		}
+	}
+	for i, flavor := range plan.VersionRange.Flavors {
+		if !flavors[flavor] {
+			v.errorf(fmt.Sprintf("%s.flavors[%d]", rangePath, i), "flavor '%s' is not used by any version of this offering", flavor)
+		}
+	}
+}
+
+// matchesInputType returns true if a decoded JSON value is acceptable for the specified input type.
+func matchesInputType(inputType string, value interface{}) bool {
+	switch inputType {
+	case "string", "password":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "int", "integer":
+		f, ok := value.(float64)
+		return ok && f == float64(int64(f))
+	case "float", "number":
+		_, ok := value.(float64)
+		return ok
+	case "array", "list":
+		_, ok := value.([]interface{})
+		if !ok {
+			// Terraform list defaults are frequently supplied as JSON-encoded strings.
+			_, ok = value.(string)
+		}
+		return ok
+	case "object", "map":
+		_, ok := value.(map[string]interface{})
+		if !ok {
+			_, ok = value.(string)
+		}
+		return ok
+	}
+	return true
+}
+
+// isValidSemverRange checks the syntax of an npm-style semantic version range, such as
+// ">=1.2.0 <2.0.0", "^1.4", "1.x || 2.x" or "1.0.0 - 1.5.0".
+func isValidSemverRange(versionRange string) bool {
+	for _, alternative := range strings.Split(versionRange, "||") {
+		fields := strings.Fields(alternative)
+		if len(fields) == 0 {
+			return false
+		}
+		if len(fields) == 3 && fields[1] == "-" {
+			fields = []string{fields[0], fields[2]}
+		}
+		for _, comparator := range fields {
+			if !semverRangePattern.MatchString(comparator) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// indexJSONPositions maps the path of every element in a JSON document to the byte offset at which it starts.
+// Object members are located at their key; array elements at their first character.
+func indexJSONPositions(data []byte) map[string]int {
+	positions := map[string]int{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	var walk func(path string) error
+	walk = func(path string) error {
+		start := skipJSONSeparators(data, int(dec.InputOffset()))
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if _, ok := positions[path]; !ok {
+			positions[path] = start
+		}
+		switch tok {
+		case json.Delim('{'):
+			for dec.More() {
+				keyStart := skipJSONSeparators(data, int(dec.InputOffset()))
+				keyTok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key, _ := keyTok.(string)
+				childPath := key
+				if path != "" {
+					childPath = path + "." + key
+				}
+				positions[childPath] = keyStart
+				if err = walk(childPath); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		case json.Delim('['):
+			for i := 0; dec.More(); i++ {
+				if err = walk(fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		}
+		return err
+	}
+	_ = walk("")
+	return positions
+}
+
+func skipJSONSeparators(data []byte, offset int) int {
+	for offset < len(data) {
+		switch data[offset] {
+		case ' ', '\t', '\r', '\n', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// lineAndColumn converts a byte offset into a 1-based line and column.
+func lineAndColumn(data []byte, offset int) (line int, column int) {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	line = 1 + bytes.Count(data[:offset], []byte("\n"))
+	column = offset - bytes.LastIndexByte(data[:offset], '\n')
+	return
+}
+
+func compactJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ValidateOfferingManifest`, func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "offering-manifest")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	const validManifest = `{
+  "name": "my-offering",
+  "label": "My offering",
+  "kinds": [
+    {
+      "format_kind": "terraform",
+      "install_kind": "instance",
+      "target_kind": "terraform",
+      "versions": [
+        {
+          "version": "1.0.0",
+          "flavor": {"name": "standard"},
+          "configuration": [
+            {"key": "region", "type": "string", "default_value": "us-south", "options": [{"value": "us-south"}, {"value": "eu-de"}]},
+            {"key": "count", "type": "int", "default_value": 2}
+          ],
+          "solution_info": {
+            "dependencies": [
+              {"name": "base", "version": ">=1.2.0 <2.0.0", "flavors": ["basic"], "default_flavor": "basic"}
+            ]
+          }
+        }
+      ]
+    }
+  ],
+  "plans": [
+    {"name": "standard", "label": "Standard", "version_range": {"kinds": ["terraform"], "version": "^1.0", "flavors": ["standard"]}}
+  ]
+}`
+
+	It(`Accepts a valid manifest`, func() {
+		result := catalogmanagementv1.ValidateOfferingManifest([]byte(validManifest))
+		Expect(result.Problems).To(BeEmpty())
+		Expect(result.HasErrors()).To(BeFalse())
+	})
+
+	It(`Reports line-addressable problems`, func() {
+		manifest := `{
+  "name": "My Offering",
+  "kinds": [
+    {
+      "format_kind": "terraform",
+      "install_kind": "instance",
+      "versions": [
+        {
+          "version": "1.0",
+          "flavor": {"name": "standard"},
+          "configuration": [
+            {"key": "count", "type": "int", "default_value": "two"},
+            {"key": "count", "type": "string", "required": true, "hidden": true}
+          ],
+          "solution_info": {
+            "dependencies": [
+              {"name": "base", "version": "latest", "flavors": ["basic"], "default_flavor": "advanced"}
+            ]
+          }
+        },
+        {
+          "version": "1.1.0"
+        }
+      ]
+    }
+  ],
+  "plans": [
+    {"name": "standard", "label": "Standard", "version_range": {"kinds": ["helm"], "flavors": ["premium"]}}
+  ]
+}`
+		result := catalogmanagementv1.ValidateOfferingManifest([]byte(manifest))
+		Expect(result.HasErrors()).To(BeTrue())
+
+		byPath := map[string]catalogmanagementv1.ManifestProblem{}
+		for _, problem := range result.Problems {
+			byPath[problem.Path] = problem
+		}
+		Expect(byPath).To(HaveKey("name"))
+		Expect(byPath["name"].Line).To(Equal(2))
+		Expect(byPath["name"].Column).To(Equal(3))
+		Expect(byPath).To(HaveKey("label"))
+		Expect(byPath["label"].Line).To(Equal(1))
+		Expect(byPath).To(HaveKey("kinds[0].target_kind"))
+		Expect(byPath["kinds[0].target_kind"].Line).To(Equal(4))
+		Expect(byPath).To(HaveKey("kinds[0].versions"))
+		Expect(byPath["kinds[0].versions[0].version"].Line).To(Equal(9))
+		Expect(byPath["kinds[0].versions[0].configuration[0].default_value"].Line).To(Equal(12))
+		Expect(byPath["kinds[0].versions[0].configuration[1].key"].Message).To(ContainSubstring("more than once"))
+		Expect(byPath).To(HaveKey("kinds[0].versions[0].configuration[1]"))
+		Expect(byPath["kinds[0].versions[0].solution_info.dependencies[0].version"].Line).To(Equal(17))
+		Expect(byPath).To(HaveKey("kinds[0].versions[0].solution_info.dependencies[0].default_flavor"))
+		Expect(byPath).To(HaveKey("plans[0].version_range.kinds[0]"))
+		Expect(byPath["plans[0].version_range.flavors[0]"].Line).To(Equal(28))
+
+		for i := 1; i < len(result.Problems); i++ {
+			Expect(result.Problems[i].Line).To(BeNumerically(">=", result.Problems[i-1].Line))
+		}
+	})
+
+	It(`Reports syntax errors with their position`, func() {
+		result := catalogmanagementv1.ValidateOfferingManifest([]byte("{\n  \"name\": \"x\",\n  \"kinds\": [}\n"))
+		Expect(result.Problems).To(HaveLen(1))
+		Expect(result.Problems[0].Line).To(Equal(3))
+		Expect(result.Problems[0].Severity).To(Equal(catalogmanagementv1.ManifestProblemSeverityErrorConst))
+	})
+
+	It(`Validates a manifest file and formats problems for CI`, func() {
+		path := filepath.Join(dir, "offering.json")
+		Expect(os.WriteFile(path, []byte(`{"name": "my-offering", "label": "My offering", "kinds": []}`), 0600)).To(Succeed())
+
+		result, err := catalogmanagementv1.ValidateOfferingManifestFile(path)
+		Expect(err).To(BeNil())
+		Expect(result.Problems).To(HaveLen(1))
+		Expect(result.String()).To(Equal(path + ":1:49: error: kinds: at least one kind is required\n"))
+
+		_, err = catalogmanagementv1.ValidateOfferingManifestFile(filepath.Join(dir, "missing.json"))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,3206 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the CatalogManagementV1 service client.
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// CatalogManagementV1 : A testify mock of the CatalogManagementV1 service client.
+type CatalogManagementV1 struct {
+	mock.Mock
+}
+
+var _ catalogmanagementv1.CatalogManagementV1Intf = (*CatalogManagementV1)(nil)
+
+// GetCatalogAccount provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalogAccount(getCatalogAccountOptions *catalogmanagementv1.GetCatalogAccountOptions) (*catalogmanagementv1.Account, *core.DetailedResponse, error) {
+	ret := _m.Called(getCatalogAccountOptions)
+	var r0 *catalogmanagementv1.Account
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Account)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogAccountWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalogAccountWithContext(ctx context.Context, getCatalogAccountOptions *catalogmanagementv1.GetCatalogAccountOptions) (*catalogmanagementv1.Account, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getCatalogAccountOptions)
+	var r0 *catalogmanagementv1.Account
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Account)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogAccount provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateCatalogAccount(updateCatalogAccountOptions *catalogmanagementv1.UpdateCatalogAccountOptions) (*catalogmanagementv1.Account, *core.DetailedResponse, error) {
+	ret := _m.Called(updateCatalogAccountOptions)
+	var r0 *catalogmanagementv1.Account
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Account)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogAccountWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateCatalogAccountWithContext(ctx context.Context, updateCatalogAccountOptions *catalogmanagementv1.UpdateCatalogAccountOptions) (*catalogmanagementv1.Account, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateCatalogAccountOptions)
+	var r0 *catalogmanagementv1.Account
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Account)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListCatalogAccountAudits provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListCatalogAccountAudits(listCatalogAccountAuditsOptions *catalogmanagementv1.ListCatalogAccountAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(listCatalogAccountAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListCatalogAccountAuditsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListCatalogAccountAuditsWithContext(ctx context.Context, listCatalogAccountAuditsOptions *catalogmanagementv1.ListCatalogAccountAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listCatalogAccountAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogAccountAudit provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalogAccountAudit(getCatalogAccountAuditOptions *catalogmanagementv1.GetCatalogAccountAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(getCatalogAccountAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogAccountAuditWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalogAccountAuditWithContext(ctx context.Context, getCatalogAccountAuditOptions *catalogmanagementv1.GetCatalogAccountAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCatalogAccountAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogAccountFilters provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalogAccountFilters(getCatalogAccountFiltersOptions *catalogmanagementv1.GetCatalogAccountFiltersOptions) (*catalogmanagementv1.AccumulatedFilters, *core.DetailedResponse, error) {
+	ret := _m.Called(getCatalogAccountFiltersOptions)
+	var r0 *catalogmanagementv1.AccumulatedFilters
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccumulatedFilters)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogAccountFiltersWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalogAccountFiltersWithContext(ctx context.Context, getCatalogAccountFiltersOptions *catalogmanagementv1.GetCatalogAccountFiltersOptions) (*catalogmanagementv1.AccumulatedFilters, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCatalogAccountFiltersOptions)
+	var r0 *catalogmanagementv1.AccumulatedFilters
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccumulatedFilters)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetShareApprovalList provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetShareApprovalList(getShareApprovalListOptions *catalogmanagementv1.GetShareApprovalListOptions) (*catalogmanagementv1.ShareApprovalListAccessResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getShareApprovalListOptions)
+	var r0 *catalogmanagementv1.ShareApprovalListAccessResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ShareApprovalListAccessResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetShareApprovalListWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetShareApprovalListWithContext(ctx context.Context, getShareApprovalListOptions *catalogmanagementv1.GetShareApprovalListOptions) (*catalogmanagementv1.ShareApprovalListAccessResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getShareApprovalListOptions)
+	var r0 *catalogmanagementv1.ShareApprovalListAccessResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ShareApprovalListAccessResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteShareApprovalList provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteShareApprovalList(deleteShareApprovalListOptions *catalogmanagementv1.DeleteShareApprovalListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteShareApprovalListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteShareApprovalListWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteShareApprovalListWithContext(ctx context.Context, deleteShareApprovalListOptions *catalogmanagementv1.DeleteShareApprovalListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteShareApprovalListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddShareApprovalList provides a mock function with the given fields.
+func (_m *CatalogManagementV1) AddShareApprovalList(addShareApprovalListOptions *catalogmanagementv1.AddShareApprovalListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(addShareApprovalListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddShareApprovalListWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) AddShareApprovalListWithContext(ctx context.Context, addShareApprovalListOptions *catalogmanagementv1.AddShareApprovalListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, addShareApprovalListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetShareApprovalListAsSource provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetShareApprovalListAsSource(getShareApprovalListAsSourceOptions *catalogmanagementv1.GetShareApprovalListAsSourceOptions) (*catalogmanagementv1.ShareApprovalListAccessResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getShareApprovalListAsSourceOptions)
+	var r0 *catalogmanagementv1.ShareApprovalListAccessResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ShareApprovalListAccessResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetShareApprovalListAsSourceWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetShareApprovalListAsSourceWithContext(ctx context.Context, getShareApprovalListAsSourceOptions *catalogmanagementv1.GetShareApprovalListAsSourceOptions) (*catalogmanagementv1.ShareApprovalListAccessResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getShareApprovalListAsSourceOptions)
+	var r0 *catalogmanagementv1.ShareApprovalListAccessResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ShareApprovalListAccessResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateShareApprovalListAsSource provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateShareApprovalListAsSource(updateShareApprovalListAsSourceOptions *catalogmanagementv1.UpdateShareApprovalListAsSourceOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updateShareApprovalListAsSourceOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateShareApprovalListAsSourceWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateShareApprovalListAsSourceWithContext(ctx context.Context, updateShareApprovalListAsSourceOptions *catalogmanagementv1.UpdateShareApprovalListAsSourceOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateShareApprovalListAsSourceOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListCatalogs provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListCatalogs(listCatalogsOptions *catalogmanagementv1.ListCatalogsOptions) (*catalogmanagementv1.CatalogSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(listCatalogsOptions)
+	var r0 *catalogmanagementv1.CatalogSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.CatalogSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListCatalogsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListCatalogsWithContext(ctx context.Context, listCatalogsOptions *catalogmanagementv1.ListCatalogsOptions) (*catalogmanagementv1.CatalogSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listCatalogsOptions)
+	var r0 *catalogmanagementv1.CatalogSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.CatalogSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateCatalog provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateCatalog(createCatalogOptions *catalogmanagementv1.CreateCatalogOptions) (*catalogmanagementv1.Catalog, *core.DetailedResponse, error) {
+	ret := _m.Called(createCatalogOptions)
+	var r0 *catalogmanagementv1.Catalog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Catalog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateCatalogWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateCatalogWithContext(ctx context.Context, createCatalogOptions *catalogmanagementv1.CreateCatalogOptions) (*catalogmanagementv1.Catalog, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createCatalogOptions)
+	var r0 *catalogmanagementv1.Catalog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Catalog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalog provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalog(getCatalogOptions *catalogmanagementv1.GetCatalogOptions) (*catalogmanagementv1.Catalog, *core.DetailedResponse, error) {
+	ret := _m.Called(getCatalogOptions)
+	var r0 *catalogmanagementv1.Catalog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Catalog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalogWithContext(ctx context.Context, getCatalogOptions *catalogmanagementv1.GetCatalogOptions) (*catalogmanagementv1.Catalog, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCatalogOptions)
+	var r0 *catalogmanagementv1.Catalog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Catalog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceCatalog provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReplaceCatalog(replaceCatalogOptions *catalogmanagementv1.ReplaceCatalogOptions) (*catalogmanagementv1.Catalog, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceCatalogOptions)
+	var r0 *catalogmanagementv1.Catalog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Catalog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceCatalogWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReplaceCatalogWithContext(ctx context.Context, replaceCatalogOptions *catalogmanagementv1.ReplaceCatalogOptions) (*catalogmanagementv1.Catalog, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceCatalogOptions)
+	var r0 *catalogmanagementv1.Catalog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Catalog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteCatalog provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteCatalog(deleteCatalogOptions *catalogmanagementv1.DeleteCatalogOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteCatalogOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteCatalogWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteCatalogWithContext(ctx context.Context, deleteCatalogOptions *catalogmanagementv1.DeleteCatalogOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteCatalogOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListCatalogAudits provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListCatalogAudits(listCatalogAuditsOptions *catalogmanagementv1.ListCatalogAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(listCatalogAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListCatalogAuditsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListCatalogAuditsWithContext(ctx context.Context, listCatalogAuditsOptions *catalogmanagementv1.ListCatalogAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listCatalogAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogAudit provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalogAudit(getCatalogAuditOptions *catalogmanagementv1.GetCatalogAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(getCatalogAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogAuditWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCatalogAuditWithContext(ctx context.Context, getCatalogAuditOptions *catalogmanagementv1.GetCatalogAuditOptions) (*catalogmanagementv1.AuditLog, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getCatalogAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListEnterpriseAudits provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListEnterpriseAudits(listEnterpriseAuditsOptions *catalogmanagementv1.ListEnterpriseAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(listEnterpriseAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListEnterpriseAuditsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListEnterpriseAuditsWithContext(ctx context.Context, listEnterpriseAuditsOptions *catalogmanagementv1.ListEnterpriseAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listEnterpriseAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetEnterpriseAudit provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetEnterpriseAudit(getEnterpriseAuditOptions *catalogmanagementv1.GetEnterpriseAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(getEnterpriseAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetEnterpriseAuditWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetEnterpriseAuditWithContext(ctx context.Context, getEnterpriseAuditOptions *catalogmanagementv1.GetEnterpriseAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getEnterpriseAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetConsumptionOfferings provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetConsumptionOfferings(getConsumptionOfferingsOptions *catalogmanagementv1.GetConsumptionOfferingsOptions) (*catalogmanagementv1.OfferingSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getConsumptionOfferingsOptions)
+	var r0 *catalogmanagementv1.OfferingSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetConsumptionOfferingsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetConsumptionOfferingsWithContext(ctx context.Context, getConsumptionOfferingsOptions *catalogmanagementv1.GetConsumptionOfferingsOptions) (*catalogmanagementv1.OfferingSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getConsumptionOfferingsOptions)
+	var r0 *catalogmanagementv1.OfferingSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListOfferings provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListOfferings(listOfferingsOptions *catalogmanagementv1.ListOfferingsOptions) (*catalogmanagementv1.OfferingSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(listOfferingsOptions)
+	var r0 *catalogmanagementv1.OfferingSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListOfferingsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListOfferingsWithContext(ctx context.Context, listOfferingsOptions *catalogmanagementv1.ListOfferingsOptions) (*catalogmanagementv1.OfferingSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listOfferingsOptions)
+	var r0 *catalogmanagementv1.OfferingSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateOffering(createOfferingOptions *catalogmanagementv1.CreateOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(createOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateOfferingWithContext(ctx context.Context, createOfferingOptions *catalogmanagementv1.CreateOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ImportOfferingVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ImportOfferingVersion(importOfferingVersionOptions *catalogmanagementv1.ImportOfferingVersionOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(importOfferingVersionOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ImportOfferingVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ImportOfferingVersionWithContext(ctx context.Context, importOfferingVersionOptions *catalogmanagementv1.ImportOfferingVersionOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, importOfferingVersionOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ImportOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ImportOffering(importOfferingOptions *catalogmanagementv1.ImportOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(importOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ImportOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ImportOfferingWithContext(ctx context.Context, importOfferingOptions *catalogmanagementv1.ImportOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, importOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReloadOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReloadOffering(reloadOfferingOptions *catalogmanagementv1.ReloadOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(reloadOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReloadOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReloadOfferingWithContext(ctx context.Context, reloadOfferingOptions *catalogmanagementv1.ReloadOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, reloadOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOffering(getOfferingOptions *catalogmanagementv1.GetOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingWithContext(ctx context.Context, getOfferingOptions *catalogmanagementv1.GetOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReplaceOffering(replaceOfferingOptions *catalogmanagementv1.ReplaceOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReplaceOfferingWithContext(ctx context.Context, replaceOfferingOptions *catalogmanagementv1.ReplaceOfferingOptions) (*catalogmanagementv1.Offering, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateOffering(updateOfferingOptions *catalogmanagementv1.UpdateOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(updateOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateOfferingWithContext(ctx context.Context, updateOfferingOptions *catalogmanagementv1.UpdateOfferingOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateOfferingOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteOffering(deleteOfferingOptions *catalogmanagementv1.DeleteOfferingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteOfferingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteOfferingWithContext(ctx context.Context, deleteOfferingOptions *catalogmanagementv1.DeleteOfferingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteOfferingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetOfferingStats provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingStats(getOfferingStatsOptions *catalogmanagementv1.GetOfferingStatsOptions) (*catalogmanagementv1.MetricStats, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingStatsOptions)
+	var r0 *catalogmanagementv1.MetricStats
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.MetricStats)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingStatsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingStatsWithContext(ctx context.Context, getOfferingStatsOptions *catalogmanagementv1.GetOfferingStatsOptions) (*catalogmanagementv1.MetricStats, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingStatsOptions)
+	var r0 *catalogmanagementv1.MetricStats
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.MetricStats)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListOfferingAudits provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListOfferingAudits(listOfferingAuditsOptions *catalogmanagementv1.ListOfferingAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(listOfferingAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListOfferingAuditsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListOfferingAuditsWithContext(ctx context.Context, listOfferingAuditsOptions *catalogmanagementv1.ListOfferingAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listOfferingAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingAudit provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingAudit(getOfferingAuditOptions *catalogmanagementv1.GetOfferingAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingAuditWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingAuditWithContext(ctx context.Context, getOfferingAuditOptions *catalogmanagementv1.GetOfferingAuditOptions) (*catalogmanagementv1.AuditLog, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SetOfferingPublish provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetOfferingPublish(setOfferingPublishOptions *catalogmanagementv1.SetOfferingPublishOptions) (*catalogmanagementv1.ApprovalResult, *core.DetailedResponse, error) {
+	ret := _m.Called(setOfferingPublishOptions)
+	var r0 *catalogmanagementv1.ApprovalResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ApprovalResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SetOfferingPublishWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetOfferingPublishWithContext(ctx context.Context, setOfferingPublishOptions *catalogmanagementv1.SetOfferingPublishOptions) (*catalogmanagementv1.ApprovalResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, setOfferingPublishOptions)
+	var r0 *catalogmanagementv1.ApprovalResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ApprovalResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeprecateOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeprecateOffering(deprecateOfferingOptions *catalogmanagementv1.DeprecateOfferingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deprecateOfferingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeprecateOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeprecateOfferingWithContext(ctx context.Context, deprecateOfferingOptions *catalogmanagementv1.DeprecateOfferingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deprecateOfferingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ShareOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ShareOffering(shareOfferingOptions *catalogmanagementv1.ShareOfferingOptions) (*catalogmanagementv1.ShareSetting, *core.DetailedResponse, error) {
+	ret := _m.Called(shareOfferingOptions)
+	var r0 *catalogmanagementv1.ShareSetting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ShareSetting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ShareOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ShareOfferingWithContext(ctx context.Context, shareOfferingOptions *catalogmanagementv1.ShareOfferingOptions) (*catalogmanagementv1.ShareSetting, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, shareOfferingOptions)
+	var r0 *catalogmanagementv1.ShareSetting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ShareSetting)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingAccess provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingAccess(getOfferingAccessOptions *catalogmanagementv1.GetOfferingAccessOptions) (*catalogmanagementv1.Access, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingAccessOptions)
+	var r0 *catalogmanagementv1.Access
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Access)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingAccessWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingAccessWithContext(ctx context.Context, getOfferingAccessOptions *catalogmanagementv1.GetOfferingAccessOptions) (*catalogmanagementv1.Access, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingAccessOptions)
+	var r0 *catalogmanagementv1.Access
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Access)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateOfferingAccess provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateOfferingAccess(updateOfferingAccessOptions *catalogmanagementv1.UpdateOfferingAccessOptions) (*catalogmanagementv1.Access, *core.DetailedResponse, error) {
+	ret := _m.Called(updateOfferingAccessOptions)
+	var r0 *catalogmanagementv1.Access
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Access)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateOfferingAccessWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateOfferingAccessWithContext(ctx context.Context, updateOfferingAccessOptions *catalogmanagementv1.UpdateOfferingAccessOptions) (*catalogmanagementv1.Access, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateOfferingAccessOptions)
+	var r0 *catalogmanagementv1.Access
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Access)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingAccessList provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingAccessList(getOfferingAccessListOptions *catalogmanagementv1.GetOfferingAccessListOptions) (*catalogmanagementv1.AccessListResult, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getOfferingAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListResult)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingAccessListWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingAccessListWithContext(ctx context.Context, getOfferingAccessListOptions *catalogmanagementv1.GetOfferingAccessListOptions) (*catalogmanagementv1.AccessListResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteOfferingAccessList provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteOfferingAccessList(deleteOfferingAccessListOptions *catalogmanagementv1.DeleteOfferingAccessListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteOfferingAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteOfferingAccessListWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteOfferingAccessListWithContext(ctx context.Context, deleteOfferingAccessListOptions *catalogmanagementv1.DeleteOfferingAccessListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteOfferingAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddOfferingAccessList provides a mock function with the given fields.
+func (_m *CatalogManagementV1) AddOfferingAccessList(addOfferingAccessListOptions *catalogmanagementv1.AddOfferingAccessListOptions) (*catalogmanagementv1.AccessListResult, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(addOfferingAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListResult)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddOfferingAccessListWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) AddOfferingAccessListWithContext(ctx context.Context, addOfferingAccessListOptions *catalogmanagementv1.AddOfferingAccessListOptions) (*catalogmanagementv1.AccessListResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, addOfferingAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingUpdates provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingUpdates(getOfferingUpdatesOptions *catalogmanagementv1.GetOfferingUpdatesOptions) ([]catalogmanagementv1.VersionUpdateDescriptor, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getOfferingUpdatesOptions)
+	var r0 []catalogmanagementv1.VersionUpdateDescriptor
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.VersionUpdateDescriptor)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingUpdatesWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingUpdatesWithContext(ctx context.Context, getOfferingUpdatesOptions *catalogmanagementv1.GetOfferingUpdatesOptions) ([]catalogmanagementv1.VersionUpdateDescriptor, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingUpdatesOptions)
+	var r0 []catalogmanagementv1.VersionUpdateDescriptor
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.VersionUpdateDescriptor)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingChangeNotices provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingChangeNotices(getOfferingChangeNoticesOptions *catalogmanagementv1.GetOfferingChangeNoticesOptions) (*catalogmanagementv1.ChangeNoticesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingChangeNoticesOptions)
+	var r0 *catalogmanagementv1.ChangeNoticesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ChangeNoticesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingChangeNoticesWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingChangeNoticesWithContext(ctx context.Context, getOfferingChangeNoticesOptions *catalogmanagementv1.GetOfferingChangeNoticesOptions) (*catalogmanagementv1.ChangeNoticesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingChangeNoticesOptions)
+	var r0 *catalogmanagementv1.ChangeNoticesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ChangeNoticesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingSource provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingSource(getOfferingSourceOptions *catalogmanagementv1.GetOfferingSourceOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingSourceOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingSourceWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingSourceWithContext(ctx context.Context, getOfferingSourceOptions *catalogmanagementv1.GetOfferingSourceOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingSourceOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingSourceArchive provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingSourceArchive(getOfferingSourceArchiveOptions *catalogmanagementv1.GetOfferingSourceArchiveOptions) (io.ReadCloser, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getOfferingSourceArchiveOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingSourceArchiveWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingSourceArchiveWithContext(ctx context.Context, getOfferingSourceArchiveOptions *catalogmanagementv1.GetOfferingSourceArchiveOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingSourceArchiveOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingSourceURL provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingSourceURL(getOfferingSourceURLOptions *catalogmanagementv1.GetOfferingSourceURLOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingSourceURLOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingSourceURLWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingSourceURLWithContext(ctx context.Context, getOfferingSourceURLOptions *catalogmanagementv1.GetOfferingSourceURLOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingSourceURLOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetVersions provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetVersions(getVersionsOptions *catalogmanagementv1.GetVersionsOptions) (*catalogmanagementv1.VersionsResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getVersionsOptions)
+	var r0 *catalogmanagementv1.VersionsResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.VersionsResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetVersionsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetVersionsWithContext(ctx context.Context, getVersionsOptions *catalogmanagementv1.GetVersionsOptions) (*catalogmanagementv1.VersionsResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getVersionsOptions)
+	var r0 *catalogmanagementv1.VersionsResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.VersionsResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingAbout provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingAbout(getOfferingAboutOptions *catalogmanagementv1.GetOfferingAboutOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingAboutOptions)
+	var r0 *string
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*string)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingAboutWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingAboutWithContext(ctx context.Context, getOfferingAboutOptions *catalogmanagementv1.GetOfferingAboutOptions) (*string, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingAboutOptions)
+	var r0 *string
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*string)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetIamPermissions provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetIamPermissions(getIamPermissionsOptions *catalogmanagementv1.GetIamPermissionsOptions) ([]catalogmanagementv1.CheckedIamPermission, *core.DetailedResponse, error) {
+	ret := _m.Called(getIamPermissionsOptions)
+	var r0 []catalogmanagementv1.CheckedIamPermission
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.CheckedIamPermission)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetIamPermissionsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetIamPermissionsWithContext(ctx context.Context, getIamPermissionsOptions *catalogmanagementv1.GetIamPermissionsOptions) ([]catalogmanagementv1.CheckedIamPermission, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getIamPermissionsOptions)
+	var r0 []catalogmanagementv1.CheckedIamPermission
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.CheckedIamPermission)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingLicense provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingLicense(getOfferingLicenseOptions *catalogmanagementv1.GetOfferingLicenseOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingLicenseOptions)
+	var r0 *string
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*string)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingLicenseWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingLicenseWithContext(ctx context.Context, getOfferingLicenseOptions *catalogmanagementv1.GetOfferingLicenseOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingLicenseOptions)
+	var r0 *string
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*string)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingContainerImages provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingContainerImages(getOfferingContainerImagesOptions *catalogmanagementv1.GetOfferingContainerImagesOptions) (*catalogmanagementv1.ImageManifest, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingContainerImagesOptions)
+	var r0 *catalogmanagementv1.ImageManifest
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ImageManifest)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingContainerImagesWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingContainerImagesWithContext(ctx context.Context, getOfferingContainerImagesOptions *catalogmanagementv1.GetOfferingContainerImagesOptions) (*catalogmanagementv1.ImageManifest, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingContainerImagesOptions)
+	var r0 *catalogmanagementv1.ImageManifest
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ImageManifest)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ArchiveVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ArchiveVersion(archiveVersionOptions *catalogmanagementv1.ArchiveVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(archiveVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ArchiveVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ArchiveVersionWithContext(ctx context.Context, archiveVersionOptions *catalogmanagementv1.ArchiveVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, archiveVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SetDeprecateVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetDeprecateVersion(setDeprecateVersionOptions *catalogmanagementv1.SetDeprecateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(setDeprecateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SetDeprecateVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetDeprecateVersionWithContext(ctx context.Context, setDeprecateVersionOptions *catalogmanagementv1.SetDeprecateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, setDeprecateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ConsumableVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ConsumableVersion(consumableVersionOptions *catalogmanagementv1.ConsumableVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(consumableVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ConsumableVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ConsumableVersionWithContext(ctx context.Context, consumableVersionOptions *catalogmanagementv1.ConsumableVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, consumableVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// PrereleaseVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PrereleaseVersion(prereleaseVersionOptions *catalogmanagementv1.PrereleaseVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(prereleaseVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// PrereleaseVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PrereleaseVersionWithContext(ctx context.Context, prereleaseVersionOptions *catalogmanagementv1.PrereleaseVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, prereleaseVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// TestVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) TestVersion(testVersionOptions *catalogmanagementv1.TestVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(testVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// TestVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) TestVersionWithContext(ctx context.Context, testVersionOptions *catalogmanagementv1.TestVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, testVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SuspendVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SuspendVersion(suspendVersionOptions *catalogmanagementv1.SuspendVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(suspendVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SuspendVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SuspendVersionWithContext(ctx context.Context, suspendVersionOptions *catalogmanagementv1.SuspendVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, suspendVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CommitVersion(commitVersionOptions *catalogmanagementv1.CommitVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(commitVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CommitVersionWithContext(ctx context.Context, commitVersionOptions *catalogmanagementv1.CommitVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, commitVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CopyVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CopyVersion(copyVersionOptions *catalogmanagementv1.CopyVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(copyVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CopyVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CopyVersionWithContext(ctx context.Context, copyVersionOptions *catalogmanagementv1.CopyVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, copyVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetOfferingWorkingCopy provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingWorkingCopy(getOfferingWorkingCopyOptions *catalogmanagementv1.GetOfferingWorkingCopyOptions) (*catalogmanagementv1.Version, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingWorkingCopyOptions)
+	var r0 *catalogmanagementv1.Version
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Version)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingWorkingCopyWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingWorkingCopyWithContext(ctx context.Context, getOfferingWorkingCopyOptions *catalogmanagementv1.GetOfferingWorkingCopyOptions) (*catalogmanagementv1.Version, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingWorkingCopyOptions)
+	var r0 *catalogmanagementv1.Version
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Version)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CopyFromPreviousVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CopyFromPreviousVersion(copyFromPreviousVersionOptions *catalogmanagementv1.CopyFromPreviousVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(copyFromPreviousVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CopyFromPreviousVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CopyFromPreviousVersionWithContext(ctx context.Context, copyFromPreviousVersionOptions *catalogmanagementv1.CopyFromPreviousVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, copyFromPreviousVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ValidateInputs provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ValidateInputs(validateInputsOptions *catalogmanagementv1.ValidateInputsOptions) (*catalogmanagementv1.VersionInputValidationResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(validateInputsOptions)
+	var r0 *catalogmanagementv1.VersionInputValidationResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.VersionInputValidationResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ValidateInputsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ValidateInputsWithContext(ctx context.Context, validateInputsOptions *catalogmanagementv1.ValidateInputsOptions) (*catalogmanagementv1.VersionInputValidationResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, validateInputsOptions)
+	var r0 *catalogmanagementv1.VersionInputValidationResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.VersionInputValidationResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetVersion(getVersionOptions *catalogmanagementv1.GetVersionOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(getVersionOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetVersionWithContext(ctx context.Context, getVersionOptions *catalogmanagementv1.GetVersionOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getVersionOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateVersion(updateVersionOptions *catalogmanagementv1.UpdateVersionOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(updateVersionOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) UpdateVersionWithContext(ctx context.Context, updateVersionOptions *catalogmanagementv1.UpdateVersionOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateVersionOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PatchUpdateVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PatchUpdateVersion(patchUpdateVersionOptions *catalogmanagementv1.PatchUpdateVersionOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(patchUpdateVersionOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PatchUpdateVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PatchUpdateVersionWithContext(ctx context.Context, patchUpdateVersionOptions *catalogmanagementv1.PatchUpdateVersionOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, patchUpdateVersionOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteVersion(deleteVersionOptions *catalogmanagementv1.DeleteVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteVersionWithContext(ctx context.Context, deleteVersionOptions *catalogmanagementv1.DeleteVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetVersionDependencies provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetVersionDependencies(getVersionDependenciesOptions *catalogmanagementv1.GetVersionDependenciesOptions) (*catalogmanagementv1.VersionDependant, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getVersionDependenciesOptions)
+	var r0 *catalogmanagementv1.VersionDependant
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.VersionDependant)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetVersionDependenciesWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetVersionDependenciesWithContext(ctx context.Context, getVersionDependenciesOptions *catalogmanagementv1.GetVersionDependenciesOptions) (*catalogmanagementv1.VersionDependant, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getVersionDependenciesOptions)
+	var r0 *catalogmanagementv1.VersionDependant
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.VersionDependant)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeprecateVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeprecateVersion(deprecateVersionOptions *catalogmanagementv1.DeprecateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deprecateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeprecateVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeprecateVersionWithContext(ctx context.Context, deprecateVersionOptions *catalogmanagementv1.DeprecateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deprecateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetCluster provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetCluster(getClusterOptions *catalogmanagementv1.GetClusterOptions) (*catalogmanagementv1.ClusterInfo, *core.DetailedResponse, error) {
+	ret := _m.Called(getClusterOptions)
+	var r0 *catalogmanagementv1.ClusterInfo
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ClusterInfo)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetClusterWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetClusterWithContext(ctx context.Context, getClusterOptions *catalogmanagementv1.GetClusterOptions) (*catalogmanagementv1.ClusterInfo, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getClusterOptions)
+	var r0 *catalogmanagementv1.ClusterInfo
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ClusterInfo)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetNamespaces provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetNamespaces(getNamespacesOptions *catalogmanagementv1.GetNamespacesOptions) (*catalogmanagementv1.NamespaceSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getNamespacesOptions)
+	var r0 *catalogmanagementv1.NamespaceSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.NamespaceSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetNamespacesWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetNamespacesWithContext(ctx context.Context, getNamespacesOptions *catalogmanagementv1.GetNamespacesOptions) (*catalogmanagementv1.NamespaceSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getNamespacesOptions)
+	var r0 *catalogmanagementv1.NamespaceSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.NamespaceSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeployOperators provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeployOperators(deployOperatorsOptions *catalogmanagementv1.DeployOperatorsOptions) ([]catalogmanagementv1.OperatorDeployResult, *core.DetailedResponse, error) {
+	ret := _m.Called(deployOperatorsOptions)
+	var r0 []catalogmanagementv1.OperatorDeployResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.OperatorDeployResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeployOperatorsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeployOperatorsWithContext(ctx context.Context, deployOperatorsOptions *catalogmanagementv1.DeployOperatorsOptions) ([]catalogmanagementv1.OperatorDeployResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deployOperatorsOptions)
+	var r0 []catalogmanagementv1.OperatorDeployResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.OperatorDeployResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListOperators provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListOperators(listOperatorsOptions *catalogmanagementv1.ListOperatorsOptions) ([]catalogmanagementv1.OperatorDeployResult, *core.DetailedResponse, error) {
+	ret := _m.Called(listOperatorsOptions)
+	var r0 []catalogmanagementv1.OperatorDeployResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.OperatorDeployResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListOperatorsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListOperatorsWithContext(ctx context.Context, listOperatorsOptions *catalogmanagementv1.ListOperatorsOptions) ([]catalogmanagementv1.OperatorDeployResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listOperatorsOptions)
+	var r0 []catalogmanagementv1.OperatorDeployResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.OperatorDeployResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceOperators provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReplaceOperators(replaceOperatorsOptions *catalogmanagementv1.ReplaceOperatorsOptions) ([]catalogmanagementv1.OperatorDeployResult, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceOperatorsOptions)
+	var r0 []catalogmanagementv1.OperatorDeployResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.OperatorDeployResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceOperatorsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReplaceOperatorsWithContext(ctx context.Context, replaceOperatorsOptions *catalogmanagementv1.ReplaceOperatorsOptions) ([]catalogmanagementv1.OperatorDeployResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceOperatorsOptions)
+	var r0 []catalogmanagementv1.OperatorDeployResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.([]catalogmanagementv1.OperatorDeployResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteOperators provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteOperators(deleteOperatorsOptions *catalogmanagementv1.DeleteOperatorsOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteOperatorsOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteOperatorsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteOperatorsWithContext(ctx context.Context, deleteOperatorsOptions *catalogmanagementv1.DeleteOperatorsOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteOperatorsOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// InstallVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) InstallVersion(installVersionOptions *catalogmanagementv1.InstallVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(installVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// InstallVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) InstallVersionWithContext(ctx context.Context, installVersionOptions *catalogmanagementv1.InstallVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, installVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// PreinstallVersion provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PreinstallVersion(preinstallVersionOptions *catalogmanagementv1.PreinstallVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(preinstallVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// PreinstallVersionWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PreinstallVersionWithContext(ctx context.Context, preinstallVersionOptions *catalogmanagementv1.PreinstallVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, preinstallVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetPreinstall provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetPreinstall(getPreinstallOptions *catalogmanagementv1.GetPreinstallOptions) (*catalogmanagementv1.InstallStatus, *core.DetailedResponse, error) {
+	ret := _m.Called(getPreinstallOptions)
+	var r0 *catalogmanagementv1.InstallStatus
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.InstallStatus)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPreinstallWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetPreinstallWithContext(ctx context.Context, getPreinstallOptions *catalogmanagementv1.GetPreinstallOptions) (*catalogmanagementv1.InstallStatus, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getPreinstallOptions)
+	var r0 *catalogmanagementv1.InstallStatus
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.InstallStatus)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ValidateInstall provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ValidateInstall(validateInstallOptions *catalogmanagementv1.ValidateInstallOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(validateInstallOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ValidateInstallWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ValidateInstallWithContext(ctx context.Context, validateInstallOptions *catalogmanagementv1.ValidateInstallOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, validateInstallOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetValidationStatus provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetValidationStatus(getValidationStatusOptions *catalogmanagementv1.GetValidationStatusOptions) (*catalogmanagementv1.Validation, *core.DetailedResponse, error) {
+	ret := _m.Called(getValidationStatusOptions)
+	var r0 *catalogmanagementv1.Validation
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Validation)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetValidationStatusWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetValidationStatusWithContext(ctx context.Context, getValidationStatusOptions *catalogmanagementv1.GetValidationStatusOptions) (*catalogmanagementv1.Validation, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getValidationStatusOptions)
+	var r0 *catalogmanagementv1.Validation
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Validation)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SearchObjects provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SearchObjects(searchObjectsOptions *catalogmanagementv1.SearchObjectsOptions) (*catalogmanagementv1.ObjectSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(searchObjectsOptions)
+	var r0 *catalogmanagementv1.ObjectSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ObjectSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SearchObjectsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SearchObjectsWithContext(ctx context.Context, searchObjectsOptions *catalogmanagementv1.SearchObjectsOptions) (*catalogmanagementv1.ObjectSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, searchObjectsOptions)
+	var r0 *catalogmanagementv1.ObjectSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ObjectSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListObjects provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListObjects(listObjectsOptions *catalogmanagementv1.ListObjectsOptions) (*catalogmanagementv1.ObjectListResult, *core.DetailedResponse, error) {
+	ret := _m.Called(listObjectsOptions)
+	var r0 *catalogmanagementv1.ObjectListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ObjectListResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListObjectsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListObjectsWithContext(ctx context.Context, listObjectsOptions *catalogmanagementv1.ListObjectsOptions) (*catalogmanagementv1.ObjectListResult, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, listObjectsOptions)
+	var r0 *catalogmanagementv1.ObjectListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ObjectListResult)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateObject provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateObject(createObjectOptions *catalogmanagementv1.CreateObjectOptions) (*catalogmanagementv1.CatalogObject, *core.DetailedResponse, error) {
+	ret := _m.Called(createObjectOptions)
+	var r0 *catalogmanagementv1.CatalogObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.CatalogObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateObjectWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateObjectWithContext(ctx context.Context, createObjectOptions *catalogmanagementv1.CreateObjectOptions) (*catalogmanagementv1.CatalogObject, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, createObjectOptions)
+	var r0 *catalogmanagementv1.CatalogObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.CatalogObject)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetObject provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObject(getObjectOptions *catalogmanagementv1.GetObjectOptions) (*catalogmanagementv1.CatalogObject, *core.DetailedResponse, error) {
+	ret := _m.Called(getObjectOptions)
+	var r0 *catalogmanagementv1.CatalogObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.CatalogObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetObjectWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObjectWithContext(ctx context.Context, getObjectOptions *catalogmanagementv1.GetObjectOptions) (*catalogmanagementv1.CatalogObject, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getObjectOptions)
+	var r0 *catalogmanagementv1.CatalogObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.CatalogObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceObject provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReplaceObject(replaceObjectOptions *catalogmanagementv1.ReplaceObjectOptions) (*catalogmanagementv1.CatalogObject, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceObjectOptions)
+	var r0 *catalogmanagementv1.CatalogObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.CatalogObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceObjectWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ReplaceObjectWithContext(ctx context.Context, replaceObjectOptions *catalogmanagementv1.ReplaceObjectOptions) (*catalogmanagementv1.CatalogObject, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceObjectOptions)
+	var r0 *catalogmanagementv1.CatalogObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.CatalogObject)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteObject provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteObject(deleteObjectOptions *catalogmanagementv1.DeleteObjectOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteObjectOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteObjectWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteObjectWithContext(ctx context.Context, deleteObjectOptions *catalogmanagementv1.DeleteObjectOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteObjectOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListObjectAudits provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListObjectAudits(listObjectAuditsOptions *catalogmanagementv1.ListObjectAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(listObjectAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListObjectAuditsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListObjectAuditsWithContext(ctx context.Context, listObjectAuditsOptions *catalogmanagementv1.ListObjectAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, listObjectAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetObjectAudit provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObjectAudit(getObjectAuditOptions *catalogmanagementv1.GetObjectAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(getObjectAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetObjectAuditWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObjectAuditWithContext(ctx context.Context, getObjectAuditOptions *catalogmanagementv1.GetObjectAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getObjectAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ConsumableShareObject provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ConsumableShareObject(consumableShareObjectOptions *catalogmanagementv1.ConsumableShareObjectOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(consumableShareObjectOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ConsumableShareObjectWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ConsumableShareObjectWithContext(ctx context.Context, consumableShareObjectOptions *catalogmanagementv1.ConsumableShareObjectOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, consumableShareObjectOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ShareObject provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ShareObject(shareObjectOptions *catalogmanagementv1.ShareObjectOptions) (*catalogmanagementv1.ShareSetting, *core.DetailedResponse, error) {
+	ret := _m.Called(shareObjectOptions)
+	var r0 *catalogmanagementv1.ShareSetting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ShareSetting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ShareObjectWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ShareObjectWithContext(ctx context.Context, shareObjectOptions *catalogmanagementv1.ShareObjectOptions) (*catalogmanagementv1.ShareSetting, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, shareObjectOptions)
+	var r0 *catalogmanagementv1.ShareSetting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ShareSetting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetObjectAccessList provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObjectAccessList(getObjectAccessListOptions *catalogmanagementv1.GetObjectAccessListOptions) (*catalogmanagementv1.AccessListResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getObjectAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetObjectAccessListWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObjectAccessListWithContext(ctx context.Context, getObjectAccessListOptions *catalogmanagementv1.GetObjectAccessListOptions) (*catalogmanagementv1.AccessListResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getObjectAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetObjectAccess provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObjectAccess(getObjectAccessOptions *catalogmanagementv1.GetObjectAccessOptions) (*catalogmanagementv1.Access, *core.DetailedResponse, error) {
+	ret := _m.Called(getObjectAccessOptions)
+	var r0 *catalogmanagementv1.Access
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Access)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetObjectAccessWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObjectAccessWithContext(ctx context.Context, getObjectAccessOptions *catalogmanagementv1.GetObjectAccessOptions) (*catalogmanagementv1.Access, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getObjectAccessOptions)
+	var r0 *catalogmanagementv1.Access
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Access)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateObjectAccess provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateObjectAccess(createObjectAccessOptions *catalogmanagementv1.CreateObjectAccessOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(createObjectAccessOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateObjectAccessWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateObjectAccessWithContext(ctx context.Context, createObjectAccessOptions *catalogmanagementv1.CreateObjectAccessOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createObjectAccessOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteObjectAccess provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteObjectAccess(deleteObjectAccessOptions *catalogmanagementv1.DeleteObjectAccessOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteObjectAccessOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteObjectAccessWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteObjectAccessWithContext(ctx context.Context, deleteObjectAccessOptions *catalogmanagementv1.DeleteObjectAccessOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteObjectAccessOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetObjectAccessListDeprecated provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObjectAccessListDeprecated(getObjectAccessListDeprecatedOptions *catalogmanagementv1.GetObjectAccessListDeprecatedOptions) (*catalogmanagementv1.ObjectAccessListResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getObjectAccessListDeprecatedOptions)
+	var r0 *catalogmanagementv1.ObjectAccessListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ObjectAccessListResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetObjectAccessListDeprecatedWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetObjectAccessListDeprecatedWithContext(ctx context.Context, getObjectAccessListDeprecatedOptions *catalogmanagementv1.GetObjectAccessListDeprecatedOptions) (*catalogmanagementv1.ObjectAccessListResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getObjectAccessListDeprecatedOptions)
+	var r0 *catalogmanagementv1.ObjectAccessListResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.ObjectAccessListResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteObjectAccessList provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteObjectAccessList(deleteObjectAccessListOptions *catalogmanagementv1.DeleteObjectAccessListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteObjectAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteObjectAccessListWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteObjectAccessListWithContext(ctx context.Context, deleteObjectAccessListOptions *catalogmanagementv1.DeleteObjectAccessListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteObjectAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddObjectAccessList provides a mock function with the given fields.
+func (_m *CatalogManagementV1) AddObjectAccessList(addObjectAccessListOptions *catalogmanagementv1.AddObjectAccessListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(addObjectAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddObjectAccessListWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) AddObjectAccessListWithContext(ctx context.Context, addObjectAccessListOptions *catalogmanagementv1.AddObjectAccessListOptions) (*catalogmanagementv1.AccessListBulkResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, addObjectAccessListOptions)
+	var r0 *catalogmanagementv1.AccessListBulkResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AccessListBulkResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateOfferingInstance provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateOfferingInstance(createOfferingInstanceOptions *catalogmanagementv1.CreateOfferingInstanceOptions) (*catalogmanagementv1.OfferingInstance, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(createOfferingInstanceOptions)
+	var r0 *catalogmanagementv1.OfferingInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingInstance)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateOfferingInstanceWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) CreateOfferingInstanceWithContext(ctx context.Context, createOfferingInstanceOptions *catalogmanagementv1.CreateOfferingInstanceOptions) (*catalogmanagementv1.OfferingInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createOfferingInstanceOptions)
+	var r0 *catalogmanagementv1.OfferingInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingInstance provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingInstance(getOfferingInstanceOptions *catalogmanagementv1.GetOfferingInstanceOptions) (*catalogmanagementv1.OfferingInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingInstanceOptions)
+	var r0 *catalogmanagementv1.OfferingInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingInstanceWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingInstanceWithContext(ctx context.Context, getOfferingInstanceOptions *catalogmanagementv1.GetOfferingInstanceOptions) (*catalogmanagementv1.OfferingInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingInstanceOptions)
+	var r0 *catalogmanagementv1.OfferingInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PutOfferingInstance provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PutOfferingInstance(putOfferingInstanceOptions *catalogmanagementv1.PutOfferingInstanceOptions) (*catalogmanagementv1.OfferingInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(putOfferingInstanceOptions)
+	var r0 *catalogmanagementv1.OfferingInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PutOfferingInstanceWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PutOfferingInstanceWithContext(ctx context.Context, putOfferingInstanceOptions *catalogmanagementv1.PutOfferingInstanceOptions) (*catalogmanagementv1.OfferingInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, putOfferingInstanceOptions)
+	var r0 *catalogmanagementv1.OfferingInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.OfferingInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteOfferingInstance provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteOfferingInstance(deleteOfferingInstanceOptions *catalogmanagementv1.DeleteOfferingInstanceOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteOfferingInstanceOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteOfferingInstanceWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeleteOfferingInstanceWithContext(ctx context.Context, deleteOfferingInstanceOptions *catalogmanagementv1.DeleteOfferingInstanceOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteOfferingInstanceOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListOfferingInstanceAudits provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListOfferingInstanceAudits(listOfferingInstanceAuditsOptions *catalogmanagementv1.ListOfferingInstanceAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(listOfferingInstanceAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListOfferingInstanceAuditsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListOfferingInstanceAuditsWithContext(ctx context.Context, listOfferingInstanceAuditsOptions *catalogmanagementv1.ListOfferingInstanceAuditsOptions) (*catalogmanagementv1.AuditLogs, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listOfferingInstanceAuditsOptions)
+	var r0 *catalogmanagementv1.AuditLogs
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLogs)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingInstanceAudit provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingInstanceAudit(getOfferingInstanceAuditOptions *catalogmanagementv1.GetOfferingInstanceAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(getOfferingInstanceAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOfferingInstanceAuditWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetOfferingInstanceAuditWithContext(ctx context.Context, getOfferingInstanceAuditOptions *catalogmanagementv1.GetOfferingInstanceAuditOptions) (*catalogmanagementv1.AuditLog, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOfferingInstanceAuditOptions)
+	var r0 *catalogmanagementv1.AuditLog
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.AuditLog)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPlan provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetPlan(getPlanOptions *catalogmanagementv1.GetPlanOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(getPlanOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPlanWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) GetPlanWithContext(ctx context.Context, getPlanOptions *catalogmanagementv1.GetPlanOptions) (*catalogmanagementv1.Offering, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPlanOptions)
+	var r0 *catalogmanagementv1.Offering
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Offering)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeletePlan provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeletePlan(deletePlanOptions *catalogmanagementv1.DeletePlanOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deletePlanOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeletePlanWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) DeletePlanWithContext(ctx context.Context, deletePlanOptions *catalogmanagementv1.DeletePlanOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deletePlanOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ConsumablePlan provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ConsumablePlan(consumablePlanOptions *catalogmanagementv1.ConsumablePlanOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(consumablePlanOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ConsumablePlanWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ConsumablePlanWithContext(ctx context.Context, consumablePlanOptions *catalogmanagementv1.ConsumablePlanOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, consumablePlanOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SetDeprecatePlan provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetDeprecatePlan(setDeprecatePlanOptions *catalogmanagementv1.SetDeprecatePlanOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(setDeprecatePlanOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SetDeprecatePlanWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetDeprecatePlanWithContext(ctx context.Context, setDeprecatePlanOptions *catalogmanagementv1.SetDeprecatePlanOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, setDeprecatePlanOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// PreviewRegions provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PreviewRegions(previewRegionsOptions *catalogmanagementv1.PreviewRegionsOptions) (*catalogmanagementv1.RegionsSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(previewRegionsOptions)
+	var r0 *catalogmanagementv1.RegionsSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.RegionsSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// PreviewRegionsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) PreviewRegionsWithContext(ctx context.Context, previewRegionsOptions *catalogmanagementv1.PreviewRegionsOptions) (*catalogmanagementv1.RegionsSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, previewRegionsOptions)
+	var r0 *catalogmanagementv1.RegionsSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.RegionsSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRegions provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListRegions(listRegionsOptions *catalogmanagementv1.ListRegionsOptions) (*catalogmanagementv1.RegionsSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(listRegionsOptions)
+	var r0 *catalogmanagementv1.RegionsSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.RegionsSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRegionsWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) ListRegionsWithContext(ctx context.Context, listRegionsOptions *catalogmanagementv1.ListRegionsOptions) (*catalogmanagementv1.RegionsSearchResult, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, listRegionsOptions)
+	var r0 *catalogmanagementv1.RegionsSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.RegionsSearchResult)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddPlan provides a mock function with the given fields.
+func (_m *CatalogManagementV1) AddPlan(catalogID string, offeringID string, plan *catalogmanagementv1.Plan, headers map[string]string) (*catalogmanagementv1.Plan, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(catalogID, offeringID, plan, headers)
+	var r0 *catalogmanagementv1.Plan
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Plan)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddPlanWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) AddPlanWithContext(ctx context.Context, catalogID string, offeringID string, plan *catalogmanagementv1.Plan, headers map[string]string) (*catalogmanagementv1.Plan, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, catalogID, offeringID, plan, headers)
+	var r0 *catalogmanagementv1.Plan
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*catalogmanagementv1.Plan)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SetValidatePlan provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetValidatePlan(planID string, headers map[string]string) (*core.DetailedResponse, error) {
+	ret := _m.Called(planID, headers)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SetValidatePlanWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetValidatePlanWithContext(ctx context.Context, planID string, headers map[string]string) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, planID, headers)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SetAllowPublishPlan provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetAllowPublishPlan(planID string, headers map[string]string) (*core.DetailedResponse, error) {
+	ret := _m.Called(planID, headers)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SetAllowPublishPlanWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetAllowPublishPlanWithContext(ctx context.Context, planID string, headers map[string]string) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, planID, headers)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SetAllowPublishOffering provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetAllowPublishOffering(catalogID string, offeringID string, approvalType string, setting bool, headers map[string]string) (*core.DetailedResponse, error) {
+	ret := _m.Called(catalogID, offeringID, approvalType, setting, headers)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// SetAllowPublishOfferingWithContext provides a mock function with the given fields.
+func (_m *CatalogManagementV1) SetAllowPublishOfferingWithContext(ctx context.Context, catalogID string, offeringID string, approvalType string, setting bool, headers map[string]string) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, catalogID, offeringID, approvalType, setting, headers)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
@@ -0,0 +1,553 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// OnboardingStep identifies one step of an OnboardingPipeline.
+type OnboardingStep string
+
+// Constants associated with OnboardingStep, in the order in which the steps run.
+const (
+	OnboardingStepImportConst         OnboardingStep = "import"
+	OnboardingStepValidateConst       OnboardingStep = "validate"
+	OnboardingStepWaitValidationConst OnboardingStep = "wait_validation"
+	OnboardingStepValidatePlansConst  OnboardingStep = "validate_plans"
+	OnboardingStepConsumableConst     OnboardingStep = "consumable"
+	OnboardingStepAllowPublishConst   OnboardingStep = "allow_publish"
+	OnboardingStepShareConst          OnboardingStep = "share"
+)
+
+// OnboardingSteps lists every onboarding step in execution order.
+var OnboardingSteps = []OnboardingStep{
+	OnboardingStepImportConst,
+	OnboardingStepValidateConst,
+	OnboardingStepWaitValidationConst,
+	OnboardingStepValidatePlansConst,
+	OnboardingStepConsumableConst,
+	OnboardingStepAllowPublishConst,
+	OnboardingStepShareConst,
+}
+
+// Constants associated with OnboardingStepResult.Status.
+const (
+	OnboardingStepResultStatusSucceededConst = "succeeded"
+	OnboardingStepResultStatusSkippedConst   = "skipped"
+	OnboardingStepResultStatusFailedConst    = "failed"
+)
+
+// Constants associated with Validation.State.
+const (
+	ValidationStateInProgressConst = "in_progress"
+	ValidationStateValidConst      = "valid"
+	ValidationStateInvalidConst    = "invalid"
+	ValidationStateExpiredConst    = "expired"
+)
+
+// Constants associated with State.Current.
+const (
+	StateCurrentNewConst        = "new"
+	StateCurrentValidatedConst  = "validated"
+	StateCurrentConsumableConst = "consumable"
+)
+
+const (
+	defaultValidationPollInterval = 30 * time.Second
+	defaultValidationTimeout      = 2 * time.Hour
+)
+
+// OnboardingStepResult : The outcome of running one onboarding step.
+type OnboardingStepResult struct {
+	// The step that was run.
+	Step OnboardingStep `json:"step"`
+
+	// One of succeeded, skipped or failed.
+	Status string `json:"status"`
+
+	// Additional detail, such as why the step was skipped or the final validation state.
+	Message string `json:"message,omitempty"`
+
+	// The error that caused the step to fail.
+	Error string `json:"error,omitempty"`
+
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+// OnboardingPipelineOptions : The options used to construct an OnboardingPipeline.
+type OnboardingPipelineOptions struct {
+	// Catalog identifier.
+	CatalogID *string `json:"catalog_id" validate:"required,ne="`
+
+	// Offering identification.
+	OfferingID *string `json:"offering_id" validate:"required,ne="`
+
+	// Options for the import step. CatalogIdentifier and OfferingID are filled in from the pipeline when unset.
+	// When nil, VersionLocator must be set and the import step is skipped.
+	ImportOptions *ImportOfferingVersionOptions `json:"import_options,omitempty" validate:"-"`
+
+	// Locator of an already imported version, used when resuming a pipeline without an import step.
+	VersionLocator *string `json:"version_locator,omitempty"`
+
+	// IAM refresh token used by the validation steps.
+	XAuthRefreshToken *string `json:"X-Auth-Refresh-Token,omitempty"`
+
+	// Options for the validate step. VersionLocID and XAuthRefreshToken are filled in from the pipeline.
+	ValidateInstallOptions *ValidateInstallOptions `json:"validate_install_options,omitempty" validate:"-"`
+
+	// How often validation status is polled. Defaults to 30 seconds.
+	ValidationPollInterval time.Duration `json:"validation_poll_interval,omitempty"`
+
+	// How long to wait for validation to finish. Defaults to two hours.
+	ValidationTimeout time.Duration `json:"validation_timeout,omitempty"`
+
+	// Plans to mark as validated.
+	PlanIDs []string `json:"plan_ids,omitempty"`
+
+	// Approval types passed to SetAllowPublishOffering, e.g. "pc_managed" or "publish_approved".
+	ApprovalTypes []string `json:"approval_types,omitempty"`
+
+	// Options for the share step. CatalogIdentifier and OfferingID are filled in from the pipeline.
+	// The share step is skipped when nil.
+	ShareOptions *ShareOfferingOptions `json:"share_options,omitempty" validate:"-"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// OnboardingPipeline drives an offering version from import to published as a sequence of explicit steps.
+// Each step checks the state of the version before acting and records its result, so that a pipeline that
+// failed part way can be resumed by calling Run again, or restarted at any step with RunFrom.
+type OnboardingPipeline struct {
+	client  *CatalogManagementV1
+	options OnboardingPipelineOptions
+
+	// Locator of the version being onboarded, set by the import step.
+	VersionLocator string
+
+	// Results of each step that has been run, in the order they were run.
+	Results []OnboardingStepResult
+}
+
+// NewOnboardingPipeline returns a new OnboardingPipeline for the specified offering.
+func (catalogManagement *CatalogManagementV1) NewOnboardingPipeline(options *OnboardingPipelineOptions) (pipeline *OnboardingPipeline, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if options.ImportOptions == nil && core.StringNilMapper(options.VersionLocator) == "" {
+		err = core.SDKErrorf(nil, "one of 'ImportOptions' or 'VersionLocator' must be set", "missing-version", common.GetComponentInfo())
+		return
+	}
+
+	pipeline = &OnboardingPipeline{
+		client:         catalogManagement,
+		options:        *options,
+		VersionLocator: core.StringNilMapper(options.VersionLocator),
+	}
+	if pipeline.options.ValidationPollInterval <= 0 {
+		pipeline.options.ValidationPollInterval = defaultValidationPollInterval
+	}
+	if pipeline.options.ValidationTimeout <= 0 {
+		pipeline.options.ValidationTimeout = defaultValidationTimeout
+	}
+	return
+}
+
+// Run runs every step that has not yet succeeded or been skipped, stopping at the first failure.
+func (pipeline *OnboardingPipeline) Run(ctx context.Context) error {
+	for _, step := range OnboardingSteps {
+		if result := pipeline.LastResult(step); result != nil && result.Status != OnboardingStepResultStatusFailedConst {
+			continue
+		}
+		if err := pipeline.RunStep(ctx, step); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunFrom runs the specified step and every step after it, regardless of earlier results.
+func (pipeline *OnboardingPipeline) RunFrom(ctx context.Context, step OnboardingStep) error {
+	start := -1
+	for i, s := range OnboardingSteps {
+		if s == step {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return core.SDKErrorf(nil, fmt.Sprintf("unknown onboarding step '%s'", step), "unknown-step", common.GetComponentInfo())
+	}
+	for _, s := range OnboardingSteps[start:] {
+		if err := pipeline.RunStep(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunStep runs a single step and records its result.
+func (pipeline *OnboardingPipeline) RunStep(ctx context.Context, step OnboardingStep) (err error) {
+	result := OnboardingStepResult{
+		Step:      step,
+		StartedAt: time.Now(),
+	}
+
+	var message string
+	var skipped bool
+	switch step {
+	case OnboardingStepImportConst:
+		message, skipped, err = pipeline.importVersion(ctx)
+	case OnboardingStepValidateConst:
+		message, skipped, err = pipeline.validate(ctx)
+	case OnboardingStepWaitValidationConst:
+		message, skipped, err = pipeline.waitValidation(ctx)
+	case OnboardingStepValidatePlansConst:
+		message, skipped, err = pipeline.validatePlans(ctx)
+	case OnboardingStepConsumableConst:
+		message, skipped, err = pipeline.consumable(ctx)
+	case OnboardingStepAllowPublishConst:
+		message, skipped, err = pipeline.allowPublish(ctx)
+	case OnboardingStepShareConst:
+		message, skipped, err = pipeline.share(ctx)
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unknown onboarding step '%s'", step), "unknown-step", common.GetComponentInfo())
+	}
+
+	result.FinishedAt = time.Now()
+	result.Message = message
+	switch {
+	case err != nil:
+		result.Status = OnboardingStepResultStatusFailedConst
+		result.Error = err.Error()
+	case skipped:
+		result.Status = OnboardingStepResultStatusSkippedConst
+	default:
+		result.Status = OnboardingStepResultStatusSucceededConst
+	}
+	pipeline.Results = append(pipeline.Results, result)
+	return
+}
+
+// LastResult returns the most recent result recorded for the specified step, or nil if it has not run.
+func (pipeline *OnboardingPipeline) LastResult(step OnboardingStep) *OnboardingStepResult {
+	for i := len(pipeline.Results) - 1; i >= 0; i-- {
+		if pipeline.Results[i].Step == step {
+			return &pipeline.Results[i]
+		}
+	}
+	return nil
+}
+
+func (pipeline *OnboardingPipeline) importVersion(ctx context.Context) (message string, skipped bool, err error) {
+	if pipeline.options.ImportOptions == nil {
+		return "no import options; using existing version " + pipeline.VersionLocator, true, nil
+	}
+
+	importOptions := *pipeline.options.ImportOptions
+	if importOptions.CatalogIdentifier == nil {
+		importOptions.CatalogIdentifier = pipeline.options.CatalogID
+	}
+	if importOptions.OfferingID == nil {
+		importOptions.OfferingID = pipeline.options.OfferingID
+	}
+	if importOptions.Headers == nil {
+		importOptions.Headers = pipeline.options.Headers
+	}
+	offering, _, err := pipeline.client.ImportOfferingVersionWithContext(ctx, &importOptions)
+	if err != nil {
+		return
+	}
+
+	semver := core.StringNilMapper(importOptions.TargetVersion)
+	if semver == "" {
+		semver = core.StringNilMapper(importOptions.Version)
+	}
+	version := importedVersion(offering, semver)
+	if version == nil || version.VersionLocator == nil {
+		err = core.SDKErrorf(nil, "unable to find the imported version in the import response", "version-not-found", common.GetComponentInfo())
+		return
+	}
+	pipeline.VersionLocator = *version.VersionLocator
+	message = "imported version " + pipeline.VersionLocator
+	return
+}
+
+func (pipeline *OnboardingPipeline) validate(ctx context.Context) (message string, skipped bool, err error) {
+	version, err := pipeline.getVersion(ctx)
+	if err != nil {
+		return
+	}
+	switch validationState(version) {
+	case ValidationStateValidConst:
+		return "version is already validated", true, nil
+	case ValidationStateInProgressConst:
+		return "validation is already in progress", true, nil
+	}
+	if err = pipeline.requireRefreshToken(); err != nil {
+		return
+	}
+
+	validateOptions := &ValidateInstallOptions{}
+	if pipeline.options.ValidateInstallOptions != nil {
+		*validateOptions = *pipeline.options.ValidateInstallOptions
+	}
+	validateOptions.VersionLocID = core.StringPtr(pipeline.VersionLocator)
+	validateOptions.XAuthRefreshToken = pipeline.options.XAuthRefreshToken
+	if validateOptions.Headers == nil {
+		validateOptions.Headers = pipeline.options.Headers
+	}
+	_, err = pipeline.client.ValidateInstallWithContext(ctx, validateOptions)
+	if err != nil {
+		return
+	}
+	message = "validation requested"
+	return
+}
+
+func (pipeline *OnboardingPipeline) waitValidation(ctx context.Context) (message string, skipped bool, err error) {
+	if err = pipeline.requireVersion(); err != nil {
+		return
+	}
+	if err = pipeline.requireRefreshToken(); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pipeline.options.ValidationTimeout)
+	defer cancel()
+
+	options := pipeline.client.NewGetValidationStatusOptions(pipeline.VersionLocator, *pipeline.options.XAuthRefreshToken)
+	options.SetHeaders(pipeline.options.Headers)
+	for {
+		var validation *Validation
+		validation, _, err = pipeline.client.GetValidationStatusWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+
+		state := core.StringNilMapper(validation.State)
+		switch state {
+		case ValidationStateValidConst:
+			message = "validation succeeded"
+			return
+		case ValidationStateInvalidConst, ValidationStateExpiredConst:
+			err = core.SDKErrorf(nil, fmt.Sprintf("validation finished in state '%s': %s", state, core.StringNilMapper(validation.Message)),
+				"validation-failed", common.GetComponentInfo())
+			return
+		case "":
+			err = core.SDKErrorf(nil, "validation has not been requested for this version", "validation-not-requested", common.GetComponentInfo())
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			err = core.SDKErrorf(ctx.Err(), fmt.Sprintf("timed out waiting for validation; last state '%s'", state), "validation-timeout", common.GetComponentInfo())
+			return
+		case <-time.After(pipeline.options.ValidationPollInterval):
+		}
+	}
+}
+
+func (pipeline *OnboardingPipeline) validatePlans(ctx context.Context) (message string, skipped bool, err error) {
+	if len(pipeline.options.PlanIDs) == 0 {
+		return "no plans configured", true, nil
+	}
+	for _, planID := range pipeline.options.PlanIDs {
+		_, err = pipeline.client.SetValidatePlanWithContext(ctx, planID, pipeline.options.Headers)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("error validating plan '%s'", planID), "validate-plan-error", common.GetComponentInfo())
+			return
+		}
+	}
+	message = fmt.Sprintf("validated %d plan(s)", len(pipeline.options.PlanIDs))
+	return
+}
+
+func (pipeline *OnboardingPipeline) consumable(ctx context.Context) (message string, skipped bool, err error) {
+	version, err := pipeline.getVersion(ctx)
+	if err != nil {
+		return
+	}
+	if isConsumable(version) {
+		return "version is already consumable", true, nil
+	}
+	if state := validationState(version); state != ValidationStateValidConst {
+		err = core.SDKErrorf(nil, fmt.Sprintf("version must be validated before it can be made consumable; validation state is '%s'", state),
+			"invalid-state", common.GetComponentInfo())
+		return
+	}
+
+	options := pipeline.client.NewConsumableVersionOptions(pipeline.VersionLocator)
+	options.Headers = pipeline.options.Headers
+	_, err = pipeline.client.ConsumableVersionWithContext(ctx, options)
+	if err != nil {
+		return
+	}
+	message = "version is consumable"
+	return
+}
+
+func (pipeline *OnboardingPipeline) allowPublish(ctx context.Context) (message string, skipped bool, err error) {
+	if len(pipeline.options.ApprovalTypes) == 0 {
+		return "no approval types configured", true, nil
+	}
+	if err = pipeline.requireConsumable(ctx); err != nil {
+		return
+	}
+	for _, approvalType := range pipeline.options.ApprovalTypes {
+		_, err = pipeline.client.SetAllowPublishOfferingWithContext(ctx, *pipeline.options.CatalogID, *pipeline.options.OfferingID,
+			approvalType, true, pipeline.options.Headers)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("error setting approval '%s'", approvalType), "allow-publish-error", common.GetComponentInfo())
+			return
+		}
+	}
+	message = fmt.Sprintf("set %d approval(s)", len(pipeline.options.ApprovalTypes))
+	return
+}
+
+func (pipeline *OnboardingPipeline) share(ctx context.Context) (message string, skipped bool, err error) {
+	if pipeline.options.ShareOptions == nil {
+		return "no share options configured", true, nil
+	}
+	if err = pipeline.requireConsumable(ctx); err != nil {
+		return
+	}
+
+	shareOptions := *pipeline.options.ShareOptions
+	shareOptions.CatalogIdentifier = pipeline.options.CatalogID
+	shareOptions.OfferingID = pipeline.options.OfferingID
+	if shareOptions.Headers == nil {
+		shareOptions.Headers = pipeline.options.Headers
+	}
+	_, _, err = pipeline.client.ShareOfferingWithContext(ctx, &shareOptions)
+	if err != nil {
+		return
+	}
+	message = "offering shared"
+	return
+}
+
+func (pipeline *OnboardingPipeline) requireVersion() error {
+	if pipeline.VersionLocator == "" {
+		return core.SDKErrorf(nil, "no version to onboard; run the import step first", "missing-version", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func (pipeline *OnboardingPipeline) requireRefreshToken() error {
+	if core.StringNilMapper(pipeline.options.XAuthRefreshToken) == "" {
+		return core.SDKErrorf(nil, "'XAuthRefreshToken' is required for validation", "missing-refresh-token", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func (pipeline *OnboardingPipeline) requireConsumable(ctx context.Context) error {
+	version, err := pipeline.getVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if !isConsumable(version) {
+		return core.SDKErrorf(nil, "version must be consumable before the offering can be published", "invalid-state", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// getVersion retrieves the version being onboarded.
+func (pipeline *OnboardingPipeline) getVersion(ctx context.Context) (version *Version, err error) {
+	if err = pipeline.requireVersion(); err != nil {
+		return
+	}
+	options := pipeline.client.NewGetVersionOptions(pipeline.VersionLocator)
+	options.Headers = pipeline.options.Headers
+	offering, _, err := pipeline.client.GetVersionWithContext(ctx, options)
+	if err != nil {
+		return
+	}
+	version = findVersion(offering, pipeline.VersionLocator)
+	if version == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("version '%s' not found in offering", pipeline.VersionLocator), "version-not-found", common.GetComponentInfo())
+	}
+	return
+}
+
+// findVersion returns the version of "offering" with the specified locator.
+func findVersion(offering *Offering, versionLocator string) *Version {
+	if offering == nil {
+		return nil
+	}
+	for i := range offering.Kinds {
+		for j := range offering.Kinds[i].Versions {
+			version := &offering.Kinds[i].Versions[j]
+			if core.StringNilMapper(version.VersionLocator) == versionLocator {
+				return version
+			}
+		}
+	}
+	return nil
+}
+
+// importedVersion returns the version of "offering" matching "semver", or the most recently
+// created version if "semver" is empty.
+func importedVersion(offering *Offering, semver string) (newest *Version) {
+	if offering == nil {
+		return nil
+	}
+	for i := range offering.Kinds {
+		for j := range offering.Kinds[i].Versions {
+			version := &offering.Kinds[i].Versions[j]
+			if semver != "" {
+				if core.StringNilMapper(version.Version) == semver {
+					return version
+				}
+				continue
+			}
+			if newest == nil || (version.Created != nil && (newest.Created == nil || time.Time(*version.Created).After(time.Time(*newest.Created)))) {
+				newest = version
+			}
+		}
+	}
+	return
+}
+
+func validationState(version *Version) string {
+	if version.Validation == nil {
+		return ""
+	}
+	return core.StringNilMapper(version.Validation.State)
+}
+
+func isConsumable(version *Version) bool {
+	if version.State != nil && core.StringNilMapper(version.State.Current) == StateCurrentConsumableConst {
+		return true
+	}
+	return version.IsConsumable != nil && *version.IsConsumable
+}
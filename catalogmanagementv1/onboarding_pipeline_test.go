@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`OnboardingPipeline`, func() {
+	var testServer *httptest.Server
+	var calls map[string]int
+	var validationPolls int
+	var consumable bool
+	var failConsumable bool
+
+	BeforeEach(func() {
+		calls = make(map[string]int)
+		validationPolls = 0
+		consumable = false
+		failConsumable = false
+
+		offering := func() map[string]interface{} {
+			validation := map[string]interface{}{}
+			if calls["POST /versions/cat.v1/validation/install"] > 0 {
+				validation["state"] = "in_progress"
+				if validationPolls > 1 {
+					validation["state"] = "valid"
+				}
+			}
+			state := map[string]interface{}{"current": "new"}
+			if consumable {
+				state["current"] = "consumable"
+			}
+			return map[string]interface{}{
+				"id": "off",
+				"kinds": []interface{}{
+					map[string]interface{}{
+						"versions": []interface{}{
+							map[string]interface{}{"version": "0.9.0", "version_locator": "cat.v0", "created": "2026-01-01T00:00:00Z"},
+							map[string]interface{}{"version": "1.0.0", "version_locator": "cat.v1", "created": "2026-02-01T00:00:00Z",
+								"validation": validation, "state": state},
+						},
+					},
+				},
+			}
+		}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			key := req.Method + " " + req.URL.EscapedPath()
+			calls[key]++
+			res.Header().Set("Content-type", "application/json")
+			switch key {
+			case "POST /catalogs/cat/offerings/off/version", "GET /versions/cat.v1":
+				_ = json.NewEncoder(res).Encode(offering())
+			case "POST /versions/cat.v1/validation/install":
+				Expect(req.Header.Get("X-Auth-Refresh-Token")).To(Equal("token"))
+				res.WriteHeader(202)
+			case "GET /versions/cat.v1/validation/install":
+				validationPolls++
+				_ = json.NewEncoder(res).Encode(offering()["kinds"].([]interface{})[0].(map[string]interface{})["versions"].([]interface{})[1].(map[string]interface{})["validation"])
+			case "POST /plans/plan1/validate/true", "POST /catalogs/cat/offerings/off/publish/pc_managed/true":
+				res.WriteHeader(200)
+				_, _ = res.Write([]byte(`{}`))
+			case "POST /versions/cat.v1/consume-publish":
+				if failConsumable {
+					failConsumable = false
+					res.WriteHeader(500)
+					_, _ = res.Write([]byte(`{"message": "boom"}`))
+					return
+				}
+				consumable = true
+				res.WriteHeader(202)
+			case "POST /catalogs/cat/offerings/off/share":
+				_ = json.NewEncoder(res).Encode(map[string]interface{}{"ibm": true})
+			default:
+				Fail("unexpected request " + key)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newPipeline := func() *catalogmanagementv1.OnboardingPipeline {
+		service, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		pipeline, err := service.NewOnboardingPipeline(&catalogmanagementv1.OnboardingPipelineOptions{
+			CatalogID:              core.StringPtr("cat"),
+			OfferingID:             core.StringPtr("off"),
+			ImportOptions:          &catalogmanagementv1.ImportOfferingVersionOptions{Zipurl: core.StringPtr("https://example.com/v1.tgz")},
+			XAuthRefreshToken:      core.StringPtr("token"),
+			ValidationPollInterval: time.Millisecond,
+			PlanIDs:                []string{"plan1"},
+			ApprovalTypes:          []string{"pc_managed"},
+			ShareOptions:           &catalogmanagementv1.ShareOfferingOptions{IBM: core.BoolPtr(true)},
+		})
+		Expect(err).To(BeNil())
+		return pipeline
+	}
+
+	It(`Runs every step from import to share`, func() {
+		pipeline := newPipeline()
+		Expect(pipeline.Run(context.Background())).To(Succeed())
+		Expect(pipeline.VersionLocator).To(Equal("cat.v1"))
+		Expect(pipeline.Results).To(HaveLen(len(catalogmanagementv1.OnboardingSteps)))
+		for i, result := range pipeline.Results {
+			Expect(result.Step).To(Equal(catalogmanagementv1.OnboardingSteps[i]))
+			Expect(result.Status).To(Equal(catalogmanagementv1.OnboardingStepResultStatusSucceededConst))
+		}
+		Expect(validationPolls).To(Equal(2))
+		Expect(calls["POST /catalogs/cat/offerings/off/share"]).To(Equal(1))
+	})
+
+	It(`Resumes after a failed step without repeating earlier steps`, func() {
+		failConsumable = true
+		pipeline := newPipeline()
+		err := pipeline.Run(context.Background())
+		Expect(err).ToNot(BeNil())
+		result := pipeline.LastResult(catalogmanagementv1.OnboardingStepConsumableConst)
+		Expect(result.Status).To(Equal(catalogmanagementv1.OnboardingStepResultStatusFailedConst))
+		Expect(pipeline.LastResult(catalogmanagementv1.OnboardingStepShareConst)).To(BeNil())
+
+		Expect(pipeline.Run(context.Background())).To(Succeed())
+		Expect(calls["POST /catalogs/cat/offerings/off/version"]).To(Equal(1))
+		Expect(calls["POST /versions/cat.v1/validation/install"]).To(Equal(1))
+		Expect(calls["POST /versions/cat.v1/consume-publish"]).To(Equal(2))
+		Expect(pipeline.LastResult(catalogmanagementv1.OnboardingStepShareConst).Status).To(Equal(catalogmanagementv1.OnboardingStepResultStatusSucceededConst))
+	})
+
+	It(`Refuses to publish a version that is not consumable`, func() {
+		pipeline := newPipeline()
+		Expect(pipeline.RunStep(context.Background(), catalogmanagementv1.OnboardingStepImportConst)).To(Succeed())
+		err := pipeline.RunFrom(context.Background(), catalogmanagementv1.OnboardingStepAllowPublishConst)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("must be consumable"))
+		Expect(calls["POST /catalogs/cat/offerings/off/publish/pc_managed/true"]).To(Equal(0))
+	})
+})
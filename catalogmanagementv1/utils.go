@@ -24,7 +24,7 @@ func (catalogManagement *CatalogManagementV1) AddPlanWithContext(ctx context.Con
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "addPlan"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/catalogs/{catalogID}/offerings/{offeringID}/plans`, pathParamsMap)
 	if err != nil {
@@ -58,7 +58,7 @@ func (catalogManagement *CatalogManagementV1) AddPlanWithContext(ctx context.Con
 	response, err = catalogManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -88,7 +88,7 @@ func (catalogManagement *CatalogManagementV1) SetValidatePlanWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "setValidatePlan"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/plans/{planLocID}/validate/true`, pathParamsMap)
 	if err != nil {
@@ -122,7 +122,7 @@ func (catalogManagement *CatalogManagementV1) SetValidatePlanWithContext(ctx con
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_allow_publish_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -143,7 +143,7 @@ func (catalogManagement *CatalogManagementV1) SetAllowPublishPlanWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "setAllowPublishPlan"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, `/plans/{planLocID}/publish/publish_approved/true`, pathParamsMap)
 	if err != nil {
@@ -177,7 +177,7 @@ func (catalogManagement *CatalogManagementV1) SetAllowPublishPlanWithContext(ctx
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_allow_publish_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -199,7 +199,7 @@ func (catalogManagement *CatalogManagementV1) SetAllowPublishOfferingWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "catalog_management", "V1", "setAllowPublishOffering"))
 	builder.EnableGzipCompression = catalogManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(catalogManagement.Service.Options.URL, fmt.Sprintf("/catalogs/{catalogID}/offerings/{offeringID}/publish/%s/%v", approvalType, setting), pathParamsMap)
 	if err != nil {
@@ -233,7 +233,7 @@ func (catalogManagement *CatalogManagementV1) SetAllowPublishOfferingWithContext
 	response, err = catalogManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_allow_publish_offering", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -0,0 +1,511 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// VersionChangeCategory groups related changes in a VersionDiff.
+type VersionChangeCategory string
+
+// Constants associated with VersionChangeCategory, in the order they appear in a changelog.
+const (
+	VersionChangeCategoryVersionConst      VersionChangeCategory = "version"
+	VersionChangeCategoryInputsConst       VersionChangeCategory = "inputs"
+	VersionChangeCategoryOutputsConst      VersionChangeCategory = "outputs"
+	VersionChangeCategoryPermissionsConst  VersionChangeCategory = "permissions"
+	VersionChangeCategoryDependenciesConst VersionChangeCategory = "dependencies"
+	VersionChangeCategoryLicensesConst     VersionChangeCategory = "licenses"
+)
+
+var versionChangeCategories = []VersionChangeCategory{
+	VersionChangeCategoryVersionConst,
+	VersionChangeCategoryInputsConst,
+	VersionChangeCategoryOutputsConst,
+	VersionChangeCategoryPermissionsConst,
+	VersionChangeCategoryDependenciesConst,
+	VersionChangeCategoryLicensesConst,
+}
+
+var versionChangeCategoryTitles = map[VersionChangeCategory]string{
+	VersionChangeCategoryVersionConst:      "Version",
+	VersionChangeCategoryInputsConst:       "Inputs",
+	VersionChangeCategoryOutputsConst:      "Outputs",
+	VersionChangeCategoryPermissionsConst:  "IAM permissions",
+	VersionChangeCategoryDependenciesConst: "Dependencies",
+	VersionChangeCategoryLicensesConst:     "Licenses",
+}
+
+// Constants associated with VersionChange.Kind.
+const (
+	VersionChangeKindAddedConst   = "added"
+	VersionChangeKindRemovedConst = "removed"
+	VersionChangeKindChangedConst = "changed"
+)
+
+// VersionChange : A single difference between two versions.
+type VersionChange struct {
+	// The category of the change.
+	Category VersionChangeCategory `json:"category"`
+
+	// One of added, removed or changed.
+	Kind string `json:"kind"`
+
+	// The input, output, service, dependency or license that changed.
+	Key string `json:"key"`
+
+	// For changed items, the property that changed.
+	Field string `json:"field,omitempty"`
+
+	// The previous value, for removed and changed items.
+	Old interface{} `json:"old,omitempty"`
+
+	// The new value, for added and changed items.
+	New interface{} `json:"new,omitempty"`
+}
+
+// VersionDiff : A categorized changelog between two versions of an offering.
+type VersionDiff struct {
+	// Semantic version of the base version.
+	From string `json:"from"`
+
+	// Semantic version of the compared version.
+	To string `json:"to"`
+
+	// The changes, ordered by category, key and field.
+	Changes []VersionChange `json:"changes"`
+}
+
+// DiffVersions compares two versions and returns the changes needed to go from "from" to "to".
+func DiffVersions(from *Version, to *Version) *VersionDiff {
+	if from == nil {
+		from = &Version{}
+	}
+	if to == nil {
+		to = &Version{}
+	}
+
+	diff := &VersionDiff{
+		From:    core.StringNilMapper(from.Version),
+		To:      core.StringNilMapper(to.Version),
+		Changes: []VersionChange{},
+	}
+	diff.diffVersion(from, to)
+	diff.diffInputs(from.Configuration, to.Configuration)
+	diff.diffOutputs(from.Outputs, to.Outputs)
+	diff.diffPermissions(from.IamPermissions, to.IamPermissions)
+	diff.diffDependencies(versionDependencies(from), versionDependencies(to))
+	diff.diffLicenses(from.Licenses, to.Licenses)
+
+	categoryOrder := make(map[VersionChangeCategory]int, len(versionChangeCategories))
+	for i, category := range versionChangeCategories {
+		categoryOrder[category] = i
+	}
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		a, b := diff.Changes[i], diff.Changes[j]
+		if a.Category != b.Category {
+			return categoryOrder[a.Category] < categoryOrder[b.Category]
+		}
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		return a.Field < b.Field
+	})
+	return diff
+}
+
+// DiffWorkingCopy compares a version with its working copy, as returned by GetOfferingWorkingCopy.
+// Note that GetOfferingWorkingCopy creates the working copy if one does not already exist; use DiffVersions
+// to compare a working copy that has already been retrieved.
+func (catalogManagement *CatalogManagementV1) DiffWorkingCopy(ctx context.Context, versionLocID string) (diff *VersionDiff, err error) {
+	offering, _, err := catalogManagement.GetVersionWithContext(ctx, catalogManagement.NewGetVersionOptions(versionLocID))
+	if err != nil {
+		return
+	}
+	parent := findVersion(offering, versionLocID)
+	if parent == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("version '%s' not found in offering", versionLocID), "version-not-found", common.GetComponentInfo())
+		return
+	}
+
+	workingCopy, _, err := catalogManagement.GetOfferingWorkingCopyWithContext(ctx, catalogManagement.NewGetOfferingWorkingCopyOptions(versionLocID))
+	if err != nil {
+		return
+	}
+
+	diff = DiffVersions(parent, workingCopy)
+	return
+}
+
+// HasChanges returns true if the versions differ.
+func (diff *VersionDiff) HasChanges() bool {
+	return len(diff.Changes) > 0
+}
+
+// ChangesIn returns the changes in the specified category.
+func (diff *VersionDiff) ChangesIn(category VersionChangeCategory) (changes []VersionChange) {
+	for _, change := range diff.Changes {
+		if change.Category == category {
+			changes = append(changes, change)
+		}
+	}
+	return
+}
+
+// JSON returns the diff as indented JSON.
+func (diff *VersionDiff) JSON() ([]byte, error) {
+	return json.MarshalIndent(diff, "", "  ")
+}
+
+// Markdown returns the diff as a markdown changelog with one section per category.
+func (diff *VersionDiff) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Changes from %s to %s\n", markdownVersion(diff.From), markdownVersion(diff.To))
+	if !diff.HasChanges() {
+		b.WriteString("\nNo changes.\n")
+		return b.String()
+	}
+	for _, category := range versionChangeCategories {
+		changes := diff.ChangesIn(category)
+		if len(changes) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", versionChangeCategoryTitles[category])
+		for _, change := range changes {
+			switch change.Kind {
+			case VersionChangeKindAddedConst:
+				fmt.Fprintf(&b, "- Added `%s`", change.Key)
+				if change.New != nil {
+					fmt.Fprintf(&b, ": %s", markdownValue(change.New))
+				}
+			case VersionChangeKindRemovedConst:
+				fmt.Fprintf(&b, "- Removed `%s`", change.Key)
+				if change.Old != nil {
+					fmt.Fprintf(&b, ": %s", markdownValue(change.Old))
+				}
+			default:
+				fmt.Fprintf(&b, "- Changed `%s` %s: %s → %s", change.Key, change.Field, markdownValue(change.Old), markdownValue(change.New))
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func (diff *VersionDiff) add(category VersionChangeCategory, kind string, key string, field string, oldValue interface{}, newValue interface{}) {
+	diff.Changes = append(diff.Changes, VersionChange{
+		Category: category,
+		Kind:     kind,
+		Key:      key,
+		Field:    field,
+		Old:      oldValue,
+		New:      newValue,
+	})
+}
+
+// compareFields records a change for each field whose value differs between "from" and "to".
+func (diff *VersionDiff) compareFields(category VersionChangeCategory, key string, from map[string]interface{}, to map[string]interface{}) {
+	for field, oldValue := range from {
+		if newValue := to[field]; !sameValue(oldValue, newValue) {
+			diff.add(category, VersionChangeKindChangedConst, key, field, oldValue, newValue)
+		}
+	}
+}
+
+func (diff *VersionDiff) diffVersion(from *Version, to *Version) {
+	diff.compareFields(VersionChangeCategoryVersionConst, "version", map[string]interface{}{
+		"version": from.Version,
+		"flavor":  flavorName(from.Flavor),
+		"install": installType(from),
+	}, map[string]interface{}{
+		"version": to.Version,
+		"flavor":  flavorName(to.Flavor),
+		"install": installType(to),
+	})
+}
+
+func (diff *VersionDiff) diffInputs(from []Configuration, to []Configuration) {
+	fields := func(c *Configuration) map[string]interface{} {
+		return map[string]interface{}{
+			"type":          c.Type,
+			"default_value": c.DefaultValue,
+			"required":      c.Required,
+			"hidden":        c.Hidden,
+			"description":   c.Description,
+			"display_name":  c.DisplayName,
+			"options":       c.Options,
+		}
+	}
+
+	oldByKey := make(map[string]*Configuration, len(from))
+	for i := range from {
+		oldByKey[core.StringNilMapper(from[i].Key)] = &from[i]
+	}
+	newByKey := make(map[string]*Configuration, len(to))
+	for i := range to {
+		input := &to[i]
+		key := core.StringNilMapper(input.Key)
+		newByKey[key] = input
+		if previous, ok := oldByKey[key]; ok {
+			diff.compareFields(VersionChangeCategoryInputsConst, key, fields(previous), fields(input))
+		} else {
+			diff.add(VersionChangeCategoryInputsConst, VersionChangeKindAddedConst, key, "", nil, inputSummary(input))
+		}
+	}
+	for key, input := range oldByKey {
+		if _, ok := newByKey[key]; !ok {
+			diff.add(VersionChangeCategoryInputsConst, VersionChangeKindRemovedConst, key, "", inputSummary(input), nil)
+		}
+	}
+}
+
+func (diff *VersionDiff) diffOutputs(from []Output, to []Output) {
+	oldByKey := make(map[string]*Output, len(from))
+	for i := range from {
+		oldByKey[core.StringNilMapper(from[i].Key)] = &from[i]
+	}
+	newByKey := make(map[string]*Output, len(to))
+	for i := range to {
+		output := &to[i]
+		key := core.StringNilMapper(output.Key)
+		newByKey[key] = output
+		if previous, ok := oldByKey[key]; ok {
+			diff.compareFields(VersionChangeCategoryOutputsConst, key,
+				map[string]interface{}{"description": previous.Description},
+				map[string]interface{}{"description": output.Description})
+		} else {
+			diff.add(VersionChangeCategoryOutputsConst, VersionChangeKindAddedConst, key, "", nil, nil)
+		}
+	}
+	for key := range oldByKey {
+		if _, ok := newByKey[key]; !ok {
+			diff.add(VersionChangeCategoryOutputsConst, VersionChangeKindRemovedConst, key, "", nil, nil)
+		}
+	}
+}
+
+// diffPermissions compares the roles required for each service, and for each resource within a service.
+func (diff *VersionDiff) diffPermissions(from []IamPermission, to []IamPermission) {
+	oldRoles, newRoles := permissionRoles(from), permissionRoles(to)
+	for key, roles := range newRoles {
+		previous, ok := oldRoles[key]
+		if !ok {
+			diff.add(VersionChangeCategoryPermissionsConst, VersionChangeKindAddedConst, key, "", nil, roles)
+			continue
+		}
+		if added := subtract(roles, previous); len(added) > 0 {
+			diff.add(VersionChangeCategoryPermissionsConst, VersionChangeKindChangedConst, key, "roles_added", nil, added)
+		}
+		if removed := subtract(previous, roles); len(removed) > 0 {
+			diff.add(VersionChangeCategoryPermissionsConst, VersionChangeKindChangedConst, key, "roles_removed", removed, nil)
+		}
+	}
+	for key, roles := range oldRoles {
+		if _, ok := newRoles[key]; !ok {
+			diff.add(VersionChangeCategoryPermissionsConst, VersionChangeKindRemovedConst, key, "", roles, nil)
+		}
+	}
+}
+
+func (diff *VersionDiff) diffDependencies(from []OfferingReference, to []OfferingReference) {
+	fields := func(d *OfferingReference) map[string]interface{} {
+		return map[string]interface{}{
+			"version":        d.Version,
+			"flavors":        d.Flavors,
+			"default_flavor": d.DefaultFlavor,
+			"optional":       d.Optional,
+			"on_by_default":  d.OnByDefault,
+		}
+	}
+
+	oldByKey := make(map[string]*OfferingReference, len(from))
+	for i := range from {
+		oldByKey[dependencyKey(&from[i])] = &from[i]
+	}
+	newByKey := make(map[string]*OfferingReference, len(to))
+	for i := range to {
+		dependency := &to[i]
+		key := dependencyKey(dependency)
+		newByKey[key] = dependency
+		if previous, ok := oldByKey[key]; ok {
+			diff.compareFields(VersionChangeCategoryDependenciesConst, key, fields(previous), fields(dependency))
+		} else {
+			diff.add(VersionChangeCategoryDependenciesConst, VersionChangeKindAddedConst, key, "", nil, dependency.Version)
+		}
+	}
+	for key, dependency := range oldByKey {
+		if _, ok := newByKey[key]; !ok {
+			diff.add(VersionChangeCategoryDependenciesConst, VersionChangeKindRemovedConst, key, "", dependency.Version, nil)
+		}
+	}
+}
+
+func (diff *VersionDiff) diffLicenses(from []License, to []License) {
+	key := func(l *License) string {
+		if l.ID != nil {
+			return *l.ID
+		}
+		return core.StringNilMapper(l.Name)
+	}
+	fields := func(l *License) map[string]interface{} {
+		return map[string]interface{}{
+			"name": l.Name,
+			"type": l.Type,
+			"url":  l.URL,
+		}
+	}
+
+	oldByKey := make(map[string]*License, len(from))
+	for i := range from {
+		oldByKey[key(&from[i])] = &from[i]
+	}
+	newByKey := make(map[string]*License, len(to))
+	for i := range to {
+		license := &to[i]
+		k := key(license)
+		newByKey[k] = license
+		if previous, ok := oldByKey[k]; ok {
+			diff.compareFields(VersionChangeCategoryLicensesConst, k, fields(previous), fields(license))
+		} else {
+			diff.add(VersionChangeCategoryLicensesConst, VersionChangeKindAddedConst, k, "", nil, license.Type)
+		}
+	}
+	for k, license := range oldByKey {
+		if _, ok := newByKey[k]; !ok {
+			diff.add(VersionChangeCategoryLicensesConst, VersionChangeKindRemovedConst, k, "", license.Type, nil)
+		}
+	}
+}
+
+// permissionRoles maps each service (and each "service/resource") to the role CRNs it requires.
+func permissionRoles(permissions []IamPermission) map[string][]string {
+	roles := make(map[string][]string)
+	for _, permission := range permissions {
+		service := core.StringNilMapper(permission.ServiceName)
+		roles[service] = append(roles[service], permission.RoleCrns...)
+		for _, resource := range permission.Resources {
+			key := service + "/" + core.StringNilMapper(resource.Name)
+			roles[key] = append(roles[key], resource.RoleCrns...)
+		}
+	}
+	return roles
+}
+
+// versionDependencies returns the dependencies declared in the version's solution info.
+func versionDependencies(version *Version) []OfferingReference {
+	if version.SolutionInfo == nil {
+		return nil
+	}
+	return version.SolutionInfo.Dependencies
+}
+
+func dependencyKey(d *OfferingReference) string {
+	name := core.StringNilMapper(d.Name)
+	if name == "" {
+		name = core.StringNilMapper(d.ID)
+	}
+	if d.CatalogID != nil {
+		name = *d.CatalogID + "/" + name
+	}
+	if d.Kind != nil {
+		name += " (" + *d.Kind + ")"
+	}
+	return name
+}
+
+func inputSummary(c *Configuration) map[string]interface{} {
+	summary := map[string]interface{}{}
+	if c.Type != nil {
+		summary["type"] = *c.Type
+	}
+	if c.DefaultValue != nil {
+		summary["default_value"] = c.DefaultValue
+	}
+	if c.Required != nil && *c.Required {
+		summary["required"] = true
+	}
+	return summary
+}
+
+func flavorName(flavor *Flavor) *string {
+	if flavor == nil {
+		return nil
+	}
+	return flavor.Name
+}
+
+func installType(version *Version) *string {
+	if version.SolutionInfo == nil {
+		return nil
+	}
+	return version.SolutionInfo.InstallType
+}
+
+// subtract returns the elements of "a" that are not in "b".
+func subtract(a []string, b []string) (result []string) {
+	present := make(map[string]bool, len(b))
+	for _, s := range b {
+		present[s] = true
+	}
+	for _, s := range a {
+		if !present[s] {
+			result = append(result, s)
+			present[s] = true
+		}
+	}
+	return
+}
+
+// sameValue compares two values by their JSON encoding, treating nil pointers, nil values and empty slices alike.
+func sameValue(a interface{}, b interface{}) bool {
+	return normalizedJSON(a) == normalizedJSON(b)
+}
+
+func normalizedJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	s := string(b)
+	if s == "[]" || s == "{}" {
+		return "null"
+	}
+	return s
+}
+
+func markdownValue(v interface{}) string {
+	s := normalizedJSON(v)
+	if s == "null" {
+		return "_none_"
+	}
+	return "`" + s + "`"
+}
+
+func markdownVersion(v string) string {
+	if v == "" {
+		return "_unversioned_"
+	}
+	return v
+}
@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package catalogmanagementv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DiffVersions`, func() {
+	from := &catalogmanagementv1.Version{
+		Version: core.StringPtr("1.0.0"),
+		Configuration: []catalogmanagementv1.Configuration{
+			{Key: core.StringPtr("region"), Type: core.StringPtr("string"), DefaultValue: "us-south"},
+			{Key: core.StringPtr("legacy"), Type: core.StringPtr("boolean")},
+		},
+		Outputs: []catalogmanagementv1.Output{{Key: core.StringPtr("url")}},
+		IamPermissions: []catalogmanagementv1.IamPermission{
+			{ServiceName: core.StringPtr("is"), RoleCrns: []string{"crn:v1:bluemix:public:iam::::role:Viewer"}},
+		},
+		SolutionInfo: &catalogmanagementv1.SolutionInfo{
+			Dependencies: []catalogmanagementv1.OfferingReference{
+				{Name: core.StringPtr("base"), Version: core.StringPtr(">=1.0.0")},
+			},
+		},
+		Licenses: []catalogmanagementv1.License{{ID: core.StringPtr("apache"), Type: core.StringPtr("Apache-2.0")}},
+	}
+	to := &catalogmanagementv1.Version{
+		Version: core.StringPtr("1.1.0"),
+		Configuration: []catalogmanagementv1.Configuration{
+			{Key: core.StringPtr("region"), Type: core.StringPtr("string"), DefaultValue: "eu-de"},
+			{Key: core.StringPtr("prefix"), Type: core.StringPtr("string"), Required: core.BoolPtr(true)},
+		},
+		Outputs: []catalogmanagementv1.Output{{Key: core.StringPtr("url")}},
+		IamPermissions: []catalogmanagementv1.IamPermission{
+			{ServiceName: core.StringPtr("is"), RoleCrns: []string{"crn:v1:bluemix:public:iam::::role:Editor"}},
+		},
+		SolutionInfo: &catalogmanagementv1.SolutionInfo{
+			Dependencies: []catalogmanagementv1.OfferingReference{
+				{Name: core.StringPtr("base"), Version: core.StringPtr(">=2.0.0")},
+			},
+		},
+		Licenses: []catalogmanagementv1.License{{ID: core.StringPtr("apache"), Type: core.StringPtr("Apache-2.0")}},
+	}
+
+	It(`Categorizes changes between two versions`, func() {
+		diff := catalogmanagementv1.DiffVersions(from, to)
+		Expect(diff.From).To(Equal("1.0.0"))
+		Expect(diff.To).To(Equal("1.1.0"))
+
+		Expect(diff.ChangesIn(catalogmanagementv1.VersionChangeCategoryVersionConst)).To(HaveLen(1))
+
+		inputs := diff.ChangesIn(catalogmanagementv1.VersionChangeCategoryInputsConst)
+		Expect(inputs).To(HaveLen(3))
+		Expect(inputs[0].Key).To(Equal("legacy"))
+		Expect(inputs[0].Kind).To(Equal(catalogmanagementv1.VersionChangeKindRemovedConst))
+		Expect(inputs[1].Key).To(Equal("prefix"))
+		Expect(inputs[1].Kind).To(Equal(catalogmanagementv1.VersionChangeKindAddedConst))
+		Expect(inputs[2].Key).To(Equal("region"))
+		Expect(inputs[2].Field).To(Equal("default_value"))
+		Expect(inputs[2].Old).To(Equal("us-south"))
+		Expect(inputs[2].New).To(Equal("eu-de"))
+
+		Expect(diff.ChangesIn(catalogmanagementv1.VersionChangeCategoryOutputsConst)).To(BeEmpty())
+		Expect(diff.ChangesIn(catalogmanagementv1.VersionChangeCategoryLicensesConst)).To(BeEmpty())
+
+		permissions := diff.ChangesIn(catalogmanagementv1.VersionChangeCategoryPermissionsConst)
+		Expect(permissions).To(HaveLen(2))
+		Expect(permissions[0].Field).To(Equal("roles_added"))
+		Expect(permissions[1].Field).To(Equal("roles_removed"))
+
+		dependencies := diff.ChangesIn(catalogmanagementv1.VersionChangeCategoryDependenciesConst)
+		Expect(dependencies).To(HaveLen(1))
+		Expect(dependencies[0].Field).To(Equal("version"))
+	})
+
+	It(`Renders markdown and JSON changelogs`, func() {
+		diff := catalogmanagementv1.DiffVersions(from, to)
+
+		markdown := diff.Markdown()
+		Expect(markdown).To(HavePrefix("# Changes from 1.0.0 to 1.1.0\n"))
+		Expect(markdown).To(ContainSubstring("## Inputs"))
+		Expect(markdown).To(ContainSubstring("- Removed `legacy`"))
+		Expect(markdown).To(ContainSubstring("- Changed `region` default_value: `\"us-south\"` → `\"eu-de\"`"))
+		Expect(markdown).To(ContainSubstring("## Dependencies"))
+		Expect(markdown).ToNot(ContainSubstring("## Licenses"))
+
+		b, err := diff.JSON()
+		Expect(err).To(BeNil())
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal(b, &decoded)).To(Succeed())
+		Expect(decoded["changes"]).To(HaveLen(len(diff.Changes)))
+	})
+
+	It(`Reports no changes for identical versions`, func() {
+		diff := catalogmanagementv1.DiffVersions(from, from)
+		Expect(diff.HasChanges()).To(BeFalse())
+		Expect(diff.Markdown()).To(ContainSubstring("No changes."))
+	})
+
+	It(`Compares a working copy with its parent version`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /versions/cat.v1":
+				_ = json.NewEncoder(res).Encode(map[string]interface{}{
+					"kinds": []interface{}{map[string]interface{}{"versions": []interface{}{from}}},
+				})
+			case "POST /versions/cat.v1/workingcopy":
+				_ = json.NewEncoder(res).Encode(to)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.Path)
+			}
+		}))
+		defer testServer.Close()
+
+		service, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		from.VersionLocator = core.StringPtr("cat.v1")
+		defer func() { from.VersionLocator = nil }()
+		diff, err := service.DiffWorkingCopy(context.Background(), "cat.v1")
+		Expect(err).To(BeNil())
+		Expect(diff.HasChanges()).To(BeTrue())
+		Expect(diff.To).To(Equal("1.1.0"))
+	})
+})
@@ -0,0 +1,344 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The names of the attributes of IAM policy resources and context-based restrictions that correspond to the
+// segments of a CRN. See CRN.Attributes.
+const (
+	CRNAttributeAccountID       = "accountId"
+	CRNAttributeServiceName     = "serviceName"
+	CRNAttributeRegion          = "region"
+	CRNAttributeServiceInstance = "serviceInstance"
+	CRNAttributeResourceType    = "resourceType"
+	CRNAttributeResource        = "resource"
+)
+
+// CRNAttributeNames : The names of the CRN attributes, in the order of the segments of CRNs.
+var CRNAttributeNames = []string{
+	CRNAttributeAccountID,
+	CRNAttributeServiceName,
+	CRNAttributeRegion,
+	CRNAttributeServiceInstance,
+	CRNAttributeResourceType,
+	CRNAttributeResource,
+}
+
+// The number of segments of a CRN, including the "crn" prefix.
+const crnSegments = 10
+
+var (
+	crnCNamePattern       = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	crnServiceNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-.]*$`)
+	crnLocationPattern    = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	crnScopePattern       = regexp.MustCompile(`^[aops]/[^/\s]+$`)
+	crnValuePattern       = regexp.MustCompile(`^[^\s]+$`)
+	crnCTypes             = []string{"public", "dedicated", "local"}
+)
+
+// CRN : A Cloud Resource Name, such as
+// "crn:v1:bluemix:public:cloud-object-storage:global:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:1a0ec336-f391-4091-a6fb-5e084a4c56f4::".
+//
+// A CRN may also be a mask, whose empty segments match any value, and whose segments may contain the wildcards "*"
+// (any sequence of characters) and "?" (any character), as in the CRN masks of notifications and the "stringMatch"
+// attributes of IAM policies.
+type CRN struct {
+	Version         string
+	CName           string
+	CType           string
+	ServiceName     string
+	Location        string
+	Scope           string
+	ServiceInstance string
+	ResourceType    string
+	Resource        string
+}
+
+// NewCRN returns a CRN of the public IBM Cloud for the specified service, to complete with the With* methods:
+//
+//	crn := common.NewCRN("cloud-object-storage").WithLocation("global").WithAccount(accountID).WithServiceInstance(guid)
+func NewCRN(serviceName string) *CRN {
+	return &CRN{Version: "v1", CName: "bluemix", CType: "public", ServiceName: serviceName}
+}
+
+// ParseCRN parses and validates a CRN. Masks are rejected; see ParseCRNMask.
+func ParseCRN(s string) (*CRN, error) {
+	return parseCRN(s, false)
+}
+
+// ParseCRNMask parses and validates a CRN mask.
+func ParseCRNMask(s string) (*CRN, error) {
+	return parseCRN(s, true)
+}
+
+func parseCRN(s string, mask bool) (crn *CRN, err error) {
+	segments := strings.Split(s, ":")
+	if len(segments) != crnSegments || segments[0] != "crn" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a CRN: a CRN has %d segments separated by ':' and starts with 'crn:'", s, crnSegments),
+			"invalid-crn", GetComponentInfo())
+		return
+	}
+	crn = &CRN{
+		Version:         segments[1],
+		CName:           segments[2],
+		CType:           segments[3],
+		ServiceName:     segments[4],
+		Location:        segments[5],
+		Scope:           segments[6],
+		ServiceInstance: segments[7],
+		ResourceType:    segments[8],
+		Resource:        segments[9],
+	}
+	err = crn.validate(mask)
+	if err != nil {
+		crn = nil
+	}
+	return
+}
+
+// String returns the CRN in its text form.
+func (crn *CRN) String() string {
+	return strings.Join([]string{"crn", crn.Version, crn.CName, crn.CType, crn.ServiceName, crn.Location, crn.Scope,
+		crn.ServiceInstance, crn.ResourceType, crn.Resource}, ":")
+}
+
+// MarshalText returns the text form of the CRN, so that CRNs are strings in JSON.
+func (crn CRN) MarshalText() ([]byte, error) {
+	return []byte(crn.String()), nil
+}
+
+// UnmarshalText parses a CRN mask, which includes CRNs.
+func (crn *CRN) UnmarshalText(text []byte) error {
+	parsed, err := ParseCRNMask(string(text))
+	if err != nil {
+		return err
+	}
+	*crn = *parsed
+	return nil
+}
+
+// Validate checks that the CRN is complete and that every segment is well formed.
+func (crn *CRN) Validate() error {
+	return crn.validate(false)
+}
+
+// ValidateMask checks that every segment of the CRN mask is well formed.
+func (crn *CRN) ValidateMask() error {
+	return crn.validate(true)
+}
+
+func (crn *CRN) validate(mask bool) error {
+	checks := []struct {
+		name     string
+		value    string
+		required bool
+		valid    func(string) bool
+	}{
+		{"version", crn.Version, true, func(v string) bool { return v == "v1" }},
+		{"cname", crn.CName, true, crnCNamePattern.MatchString},
+		{"ctype", crn.CType, true, func(v string) bool { return ContainsString(crnCTypes, v) }},
+		{"service name", crn.ServiceName, true, crnServiceNamePattern.MatchString},
+		{"location", crn.Location, false, crnLocationPattern.MatchString},
+		{"scope", crn.Scope, false, crnScopePattern.MatchString},
+		{"service instance", crn.ServiceInstance, false, crnValuePattern.MatchString},
+		{"resource type", crn.ResourceType, false, crnValuePattern.MatchString},
+		{"resource", crn.Resource, false, crnValuePattern.MatchString},
+	}
+	for _, check := range checks {
+		var problem string
+		switch {
+		case strings.Contains(check.value, ":"):
+			problem = "contains ':'"
+		case mask && (check.value == "" || strings.ContainsAny(check.value, "*?")):
+			// Wildcards match any value.
+		case check.value == "":
+			if check.required {
+				problem = "is required"
+			}
+		case strings.ContainsAny(check.value, "*?"):
+			problem = "contains a wildcard, which is only allowed in CRN masks"
+		case !check.valid(check.value):
+			problem = fmt.Sprintf("'%s' is not valid", check.value)
+		}
+		if problem != "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("the %s of CRN '%s' %s", check.name, crn.String(), problem), "invalid-crn",
+				GetComponentInfo())
+		}
+	}
+	return nil
+}
+
+// WithLocation returns a copy of the CRN with the specified location, such as "us-south" or "global".
+func (crn *CRN) WithLocation(location string) *CRN {
+	copied := *crn
+	copied.Location = location
+	return &copied
+}
+
+// WithAccount returns a copy of the CRN scoped to the specified account.
+func (crn *CRN) WithAccount(accountID string) *CRN {
+	copied := *crn
+	copied.Scope = "a/" + accountID
+	return &copied
+}
+
+// WithServiceInstance returns a copy of the CRN with the specified service instance.
+func (crn *CRN) WithServiceInstance(serviceInstance string) *CRN {
+	copied := *crn
+	copied.ServiceInstance = serviceInstance
+	return &copied
+}
+
+// WithResource returns a copy of the CRN with the specified resource type and resource.
+func (crn *CRN) WithResource(resourceType string, resource string) *CRN {
+	copied := *crn
+	copied.ResourceType = resourceType
+	copied.Resource = resource
+	return &copied
+}
+
+// AccountID returns the account of the scope of the CRN, or an empty string if the CRN is not scoped to an account.
+func (crn *CRN) AccountID() string {
+	if strings.HasPrefix(crn.Scope, "a/") {
+		return crn.Scope[2:]
+	}
+	return ""
+}
+
+// IsMask reports whether the CRN has wildcards or lacks required segments, and so is only valid as a mask.
+func (crn *CRN) IsMask() bool {
+	return crn.Validate() != nil && crn.ValidateMask() == nil
+}
+
+// Matches reports whether a CRN matches this CRN used as a mask. Empty segments of the mask match any value.
+func (crn *CRN) Matches(other *CRN) bool {
+	pairs := [][2]string{
+		{crn.Version, other.Version},
+		{crn.CName, other.CName},
+		{crn.CType, other.CType},
+		{crn.ServiceName, other.ServiceName},
+		{crn.Location, other.Location},
+		{crn.Scope, other.Scope},
+		{crn.ServiceInstance, other.ServiceInstance},
+		{crn.ResourceType, other.ResourceType},
+		{crn.Resource, other.Resource},
+	}
+	for _, pair := range pairs {
+		if pair[0] != "" && !wildcardMatch(pair[0], pair[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// CRNMatches reports whether a CRN matches a CRN mask. Masks and CRNs that are not valid, such as the truncated
+// masks found in some notifications, are compared segment by segment in the same way.
+func CRNMatches(mask string, crn string) bool {
+	parsedMask, maskErr := ParseCRNMask(mask)
+	parsedCRN, crnErr := ParseCRNMask(crn)
+	if maskErr == nil && crnErr == nil {
+		return parsedMask.Matches(parsedCRN)
+	}
+	crnSegments := strings.Split(crn, ":")
+	for i, segment := range strings.Split(mask, ":") {
+		if segment == "" {
+			continue
+		}
+		if i >= len(crnSegments) || !wildcardMatch(segment, crnSegments[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Attributes returns the non-empty segments of the CRN as the attributes of IAM policy resources and context-based
+// restrictions, keyed by the CRNAttribute* constants. Values with wildcards must be matched with the
+// "stringMatch" operator. The account ID attribute is only set for CRNs scoped to an account.
+func (crn *CRN) Attributes() map[string]string {
+	attributes := map[string]string{}
+	for name, value := range map[string]string{
+		CRNAttributeAccountID:       crn.AccountID(),
+		CRNAttributeServiceName:     crn.ServiceName,
+		CRNAttributeRegion:          crn.Location,
+		CRNAttributeServiceInstance: crn.ServiceInstance,
+		CRNAttributeResourceType:    crn.ResourceType,
+		CRNAttributeResource:        crn.Resource,
+	} {
+		if value != "" {
+			attributes[name] = value
+		}
+	}
+	return attributes
+}
+
+// CRNFromAttributes returns the CRN mask of the public IBM Cloud selected by the attributes of an IAM policy
+// resource or context-based restriction. Attributes that are not segments of CRNs, such as "resourceGroupId", are
+// ignored, and the segments without attributes are empty.
+func CRNFromAttributes(attributes map[string]string) (crn *CRN, err error) {
+	crn = NewCRN(attributes[CRNAttributeServiceName])
+	crn.Location = attributes[CRNAttributeRegion]
+	if accountID := attributes[CRNAttributeAccountID]; accountID != "" {
+		crn.Scope = "a/" + accountID
+	}
+	crn.ServiceInstance = attributes[CRNAttributeServiceInstance]
+	crn.ResourceType = attributes[CRNAttributeResourceType]
+	crn.Resource = attributes[CRNAttributeResource]
+	err = crn.ValidateMask()
+	if err != nil {
+		crn = nil
+	}
+	return
+}
+
+// HasWildcards reports whether an attribute value contains the wildcards "*" or "?".
+func HasWildcards(value string) bool {
+	return strings.ContainsAny(value, "*?")
+}
+
+// wildcardMatch reports whether a value matches a pattern in which "*" matches any sequence of characters and "?"
+// matches any character.
+func wildcardMatch(pattern string, value string) bool {
+	p, v := 0, 0
+	star, match := -1, 0
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, match = p, v
+			p++
+		case star >= 0:
+			p = star + 1
+			match++
+			v = match
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:1a0ec336-f391-4091-a6fb-5e084a4c56f4:bucket:my-bucket"
+
+func TestParseCRN(t *testing.T) {
+	crn, err := ParseCRN(testCRN)
+	assert.Nil(t, err)
+	assert.Equal(t, &CRN{
+		Version:         "v1",
+		CName:           "bluemix",
+		CType:           "public",
+		ServiceName:     "cloud-object-storage",
+		Location:        "global",
+		Scope:           "a/59bcbfa6ea2f006b4ed7094c1a08dcdd",
+		ServiceInstance: "1a0ec336-f391-4091-a6fb-5e084a4c56f4",
+		ResourceType:    "bucket",
+		Resource:        "my-bucket",
+	}, crn)
+	assert.Equal(t, testCRN, crn.String())
+	assert.Equal(t, "59bcbfa6ea2f006b4ed7094c1a08dcdd", crn.AccountID())
+	assert.False(t, crn.IsMask())
+
+	crn, err = ParseCRN("crn:v1:bluemix:public:iam::::role:Viewer")
+	assert.Nil(t, err)
+	assert.Equal(t, "", crn.AccountID())
+}
+
+func TestParseCRNErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"arn:v1:bluemix:public:kms:us-south:a/abc:instance::",
+		"crn:v1:bluemix:public:kms:us-south:a/abc:instance:",
+		"crn:v1:bluemix:public:kms:us-south:a/abc:instance:key:id:extra",
+		"crn:v2:bluemix:public:kms:us-south:a/abc:instance::",
+		"crn:v1::public:kms:us-south:a/abc:instance::",
+		"crn:v1:bluemix:private:kms:us-south:a/abc:instance::",
+		"crn:v1:bluemix:public::us-south:a/abc:instance::",
+		"crn:v1:bluemix:public:Key Protect:us-south:a/abc:instance::",
+		"crn:v1:bluemix:public:kms:US-South:a/abc:instance::",
+		"crn:v1:bluemix:public:kms:us-south:abc:instance::",
+		"crn:v1:bluemix:public:kms:us-south:x/abc:instance::",
+		"crn:v1:bluemix:public:kms:us-south:a/abc:my instance::",
+		"crn:v1:bluemix:public:kms:*:a/abc:instance::",
+	} {
+		crn, err := ParseCRN(s)
+		assert.Nil(t, crn, s)
+		assert.NotNil(t, err, s)
+	}
+}
+
+func TestNewCRN(t *testing.T) {
+	crn := NewCRN("cloud-object-storage").
+		WithLocation("global").
+		WithAccount("59bcbfa6ea2f006b4ed7094c1a08dcdd").
+		WithServiceInstance("1a0ec336-f391-4091-a6fb-5e084a4c56f4").
+		WithResource("bucket", "my-bucket")
+	assert.Nil(t, crn.Validate())
+	assert.Equal(t, testCRN, crn.String())
+
+	// The builders return copies.
+	base := NewCRN("kms")
+	base.WithLocation("us-south")
+	assert.Equal(t, "", base.Location)
+}
+
+func TestCRNMasks(t *testing.T) {
+	mask, err := ParseCRNMask("crn:v1:bluemix:public:cloud-object-storage:::::my-*")
+	assert.Nil(t, err)
+	assert.True(t, mask.IsMask())
+	assert.NotNil(t, mask.Validate())
+
+	_, err = ParseCRNMask("crn:v1:bluemix:public:cloud-object-storage:us south::::")
+	assert.NotNil(t, err)
+
+	assert.True(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:::::", testCRN))
+	assert.True(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:*:*:*:*:*", testCRN))
+	assert.True(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:global::::my-*", testCRN))
+	assert.True(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:::::my-buck?t", testCRN))
+	assert.True(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:::::*/*", testCRN+"/folder"))
+	assert.False(t, CRNMatches("crn:v1:bluemix:public:kms:::::", testCRN))
+	assert.False(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:us-south::::", testCRN))
+	assert.False(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:::::other-*", testCRN))
+	assert.False(t, CRNMatches("not a mask", testCRN))
+	assert.False(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:::::", "not a CRN"))
+
+	// Masks that are not valid are compared segment by segment.
+	assert.True(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage", testCRN))
+	assert.True(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:global:*", testCRN))
+	assert.False(t, CRNMatches("crn:v1:bluemix:public:kms", testCRN))
+	assert.False(t, CRNMatches("crn:v1:bluemix:public:cloud-object-storage:global::::my-bucket:extra", testCRN))
+}
+
+func TestWildcardMatch(t *testing.T) {
+	assert.True(t, wildcardMatch("", ""))
+	assert.True(t, wildcardMatch("*", ""))
+	assert.True(t, wildcardMatch("a*b*c", "axxbyyc"))
+	assert.True(t, wildcardMatch("a*c", "abcbc"))
+	assert.True(t, wildcardMatch("?b?", "abc"))
+	assert.False(t, wildcardMatch("a*c", "abcb"))
+	assert.False(t, wildcardMatch("?", ""))
+	assert.False(t, wildcardMatch("abc", "ab"))
+}
+
+func TestCRNAttributes(t *testing.T) {
+	crn, err := ParseCRN(testCRN)
+	assert.Nil(t, err)
+	attributes := crn.Attributes()
+	assert.Equal(t, map[string]string{
+		CRNAttributeAccountID:       "59bcbfa6ea2f006b4ed7094c1a08dcdd",
+		CRNAttributeServiceName:     "cloud-object-storage",
+		CRNAttributeRegion:          "global",
+		CRNAttributeServiceInstance: "1a0ec336-f391-4091-a6fb-5e084a4c56f4",
+		CRNAttributeResourceType:    "bucket",
+		CRNAttributeResource:        "my-bucket",
+	}, attributes)
+
+	attributes["resourceGroupId"] = "ignored"
+	fromAttributes, err := CRNFromAttributes(attributes)
+	assert.Nil(t, err)
+	assert.Equal(t, crn, fromAttributes)
+
+	mask, err := CRNFromAttributes(map[string]string{CRNAttributeServiceName: "kms", CRNAttributeAccountID: "abc"})
+	assert.Nil(t, err)
+	assert.Equal(t, "crn:v1:bluemix:public:kms::a/abc:::", mask.String())
+	assert.True(t, mask.Matches(mustParseCRN(t, "crn:v1:bluemix:public:kms:us-south:a/abc:instance::")))
+
+	_, err = CRNFromAttributes(map[string]string{CRNAttributeServiceName: "kms", CRNAttributeRegion: "US South"})
+	assert.NotNil(t, err)
+}
+
+func TestCRNJSON(t *testing.T) {
+	var value struct {
+		CRN *CRN `json:"crn"`
+	}
+	err := json.Unmarshal([]byte(`{"crn": "`+testCRN+`"}`), &value)
+	assert.Nil(t, err)
+	assert.Equal(t, "my-bucket", value.CRN.Resource)
+
+	data, err := json.Marshal(value)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"crn":"`+testCRN+`"}`, string(data))
+
+	err = json.Unmarshal([]byte(`{"crn": "not a CRN"}`), &value)
+	assert.NotNil(t, err)
+}
+
+func mustParseCRN(t *testing.T, s string) *CRN {
+	crn, err := ParseCRN(s)
+	assert.Nil(t, err)
+	return crn
+}
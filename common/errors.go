@@ -0,0 +1,348 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The kinds of errors returned by the services. The errors returned by the operations of the service clients
+// for error responses carry a ServiceError, so they match their kind with errors.Is:
+//
+//	if errors.Is(err, common.ErrNotFound) {
+//		...
+//	}
+var (
+	// The request is invalid (400 or 422).
+	ErrBadRequest = errors.New("bad request")
+
+	// The credentials are missing, invalid or expired (401).
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// The caller is not allowed to perform the operation (403).
+	ErrForbidden = errors.New("forbidden")
+
+	// The resource does not exist (404 or 410).
+	ErrNotFound = errors.New("not found")
+
+	// The request conflicts with the state of the resource, for example because the resource already exists (409).
+	ErrConflict = errors.New("conflict")
+
+	// The ETag given in the If-Match header is out of date (412). Errors of this kind also match ErrConflict.
+	ErrPreconditionFailed = errors.New("precondition failed")
+
+	// The resource is locked against changes (423, or a "locked" error code).
+	ErrLocked = errors.New("locked")
+
+	// A quota or limit of the account has been reached (a "quota" or "limit exceeded" error code).
+	ErrQuotaExceeded = errors.New("quota exceeded")
+
+	// Too many requests were sent (429, or a "rate limit" or "too many requests" error code).
+	ErrRateLimited = errors.New("rate limited")
+
+	// The service failed or is unavailable (5xx).
+	ErrServerError = errors.New("server error")
+)
+
+// ServiceError : An error response returned by a service, classified by kind. It is a core.Problem that stands
+// for the core.HTTPProblem of the response, so the operations return it as the cause of their errors.
+type ServiceError struct {
+	// The kind of the error, one of the Err* errors of this package, or nil if the error could not be classified.
+	Kind error
+
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The error code returned by the service, and its message, if any.
+	Code    string
+	Message string
+
+	// The name of the service and the ID of the operation that returned the error, such as "global_tagging" and
+	// "list_tags".
+	ServiceName string
+	OperationID string
+
+	// The transaction ID of the request, to give to IBM Cloud support.
+	TransactionID string
+
+	// The full response.
+	Response *core.DetailedResponse
+
+	// The problem that describes the error response.
+	Problem *core.HTTPProblem
+}
+
+// Error returns the message of the problem.
+func (e *ServiceError) Error() string {
+	return e.Problem.Error()
+}
+
+// GetConsoleMessage returns the console message of the problem.
+func (e *ServiceError) GetConsoleMessage() string {
+	return e.Problem.GetConsoleMessage()
+}
+
+// GetDebugMessage returns the debug message of the problem.
+func (e *ServiceError) GetDebugMessage() string {
+	return e.Problem.GetDebugMessage()
+}
+
+// GetID returns the ID of the problem.
+func (e *ServiceError) GetID() string {
+	return e.Problem.GetID()
+}
+
+// Unwrap returns the problem.
+func (e *ServiceError) Unwrap() error {
+	return e.Problem
+}
+
+// Is reports whether the error is of the kind "target".
+func (e *ServiceError) Is(target error) bool {
+	if e.Kind == nil {
+		return false
+	}
+	return target == e.Kind || (e.Kind == ErrPreconditionFailed && target == ErrConflict)
+}
+
+// UnmarshalBody unmarshals the body of the response, or one of its properties, into models of a service package
+// such as iamidentityv1.ExceptionResponse, in the same way as core.UnmarshalModel.
+func (e *ServiceError) UnmarshalBody(propertyName string, result interface{}, unmarshaller core.ModelUnmarshaller) (err error) {
+	var data []byte
+	if e.Response != nil && e.Response.Result != nil {
+		data, err = json.Marshal(e.Response.Result)
+	} else if e.Response != nil {
+		data = e.Response.RawResult
+	}
+	var rawBody map[string]json.RawMessage
+	if err == nil {
+		err = json.Unmarshal(data, &rawBody)
+	}
+	if err == nil {
+		err = core.UnmarshalModel(rawBody, propertyName, result, unmarshaller)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unmarshal-error-body", GetComponentInfo())
+	}
+	return
+}
+
+var errorCodes = struct {
+	sync.RWMutex
+	services map[string]map[string]error
+}{services: map[string]map[string]error{}}
+
+// RegisterErrorCodes maps error codes returned by a service to kinds of errors. The service packages register the
+// codes they document; codes that are not registered are classified by their wording and by the status code.
+func RegisterErrorCodes(serviceName string, codes map[string]error) {
+	errorCodes.Lock()
+	defer errorCodes.Unlock()
+	if errorCodes.services[serviceName] == nil {
+		errorCodes.services[serviceName] = map[string]error{}
+	}
+	for code, kind := range codes {
+		errorCodes.services[serviceName][code] = kind
+	}
+}
+
+// AsServiceError returns the ServiceError that describes an error returned by an operation, or false if the error
+// is not an error response of a service. The error may also be a core.HTTPProblem, or any error that wraps one.
+func AsServiceError(err error) (*ServiceError, bool) {
+	var serviceError *ServiceError
+	if errors.As(err, &serviceError) {
+		return serviceError, true
+	}
+	httpProblem := httpProblemOf(err)
+	if httpProblem == nil || httpProblem.Response == nil {
+		return nil, false
+	}
+
+	serviceError = &ServiceError{
+		StatusCode:  httpProblem.Response.GetStatusCode(),
+		OperationID: httpProblem.OperationID,
+		Response:    httpProblem.Response,
+		Problem:     httpProblem,
+	}
+	if httpProblem.Component != nil {
+		serviceError.ServiceName = httpProblem.Component.Name
+	}
+	for _, header := range []string{"Transaction-Id", "X-Correlation-Id", "X-Request-Id"} {
+		if value := httpProblem.Response.GetHeaders().Get(header); value != "" {
+			serviceError.TransactionID = value
+			break
+		}
+	}
+	if body, ok := httpProblem.Response.GetResultAsMap(); ok {
+		serviceError.Code, serviceError.Message = errorCodeAndMessage(body)
+	}
+	serviceError.Kind = classify(serviceError.ServiceName, serviceError.Code, serviceError.StatusCode)
+	return serviceError, true
+}
+
+// httpProblemOf returns the core.HTTPProblem of an error, or nil. The errors returned by core.BaseService.Request
+// keep it in a private field, which core.SDKErrorf exposes as the cause of the problem it returns.
+func httpProblemOf(err error) *core.HTTPProblem {
+	var httpProblem *core.HTTPProblem
+	if errors.As(err, &httpProblem) {
+		return httpProblem
+	}
+	var sdkProblem *core.SDKProblem
+	if errors.As(err, &sdkProblem) && errors.As(core.SDKErrorf(sdkProblem, "", "", GetComponentInfo()), &httpProblem) {
+		return httpProblem
+	}
+	return nil
+}
+
+// ClassifyError returns a ServiceError for an error that describes an error response, and any other error
+// unchanged. The operations of the service clients classify the errors of the responses they receive, so it is only
+// needed for the errors of requests sent without them.
+func ClassifyError(err error) error {
+	if serviceError, ok := AsServiceError(err); ok {
+		return serviceError
+	}
+	return err
+}
+
+// AsNotFound returns the ServiceError of an error of kind ErrNotFound.
+func AsNotFound(err error) (*ServiceError, bool) {
+	return asKind(err, ErrNotFound)
+}
+
+// AsConflict returns the ServiceError of an error of kind ErrConflict, which includes ErrPreconditionFailed.
+func AsConflict(err error) (*ServiceError, bool) {
+	return asKind(err, ErrConflict)
+}
+
+// AsLocked returns the ServiceError of an error of kind ErrLocked.
+func AsLocked(err error) (*ServiceError, bool) {
+	return asKind(err, ErrLocked)
+}
+
+// AsQuotaExceeded returns the ServiceError of an error of kind ErrQuotaExceeded.
+func AsQuotaExceeded(err error) (*ServiceError, bool) {
+	return asKind(err, ErrQuotaExceeded)
+}
+
+// AsRateLimited returns the ServiceError of an error of kind ErrRateLimited.
+func AsRateLimited(err error) (*ServiceError, bool) {
+	return asKind(err, ErrRateLimited)
+}
+
+func asKind(err error, kind error) (*ServiceError, bool) {
+	serviceError, ok := AsServiceError(err)
+	if !ok || !errors.Is(serviceError, kind) {
+		return nil, false
+	}
+	return serviceError, true
+}
+
+// errorCodeAndMessage returns the error code and message of an error response, from the "errors" array of IBM
+// Cloud API errors or from top-level properties.
+func errorCodeAndMessage(body map[string]interface{}) (code string, message string) {
+	properties := []map[string]interface{}{}
+	if errs, ok := body["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			properties = append(properties, first)
+		}
+	}
+	properties = append(properties, body)
+
+	for _, property := range properties {
+		for _, name := range []string{"code", "error_code", "errorCode"} {
+			if value, ok := property[name]; ok && code == "" && value != nil {
+				code = fmt.Sprint(value)
+			}
+		}
+		for _, name := range []string{"message", "description", "error", "errorMessage"} {
+			if value, ok := property[name].(string); ok && message == "" {
+				message = value
+			}
+		}
+	}
+	return
+}
+
+// Words of error codes that identify a kind of error, in order of precedence. The codes are lowercased and
+// stripped of separators before they are compared.
+var errorCodeWords = []struct {
+	word string
+	kind error
+}{
+	{"locked", ErrLocked},
+	{"toomanyrequests", ErrRateLimited},
+	{"ratelimit", ErrRateLimited},
+	{"quota", ErrQuotaExceeded},
+	{"limitexceeded", ErrQuotaExceeded},
+	{"notfound", ErrNotFound},
+	{"precondition", ErrPreconditionFailed},
+	{"etag", ErrPreconditionFailed},
+	{"conflict", ErrConflict},
+	{"alreadyexists", ErrConflict},
+}
+
+func classify(serviceName string, code string, statusCode int) error {
+	if statusCode < 400 {
+		return nil
+	}
+	if code != "" {
+		errorCodes.RLock()
+		kind, found := errorCodes.services[serviceName][code]
+		errorCodes.RUnlock()
+		if found {
+			return kind
+		}
+		if statusCode == http.StatusTooManyRequests {
+			return ErrRateLimited
+		}
+		normalized := strings.NewReplacer("_", "", "-", "", " ", "", ".", "").Replace(strings.ToLower(code))
+		for _, entry := range errorCodeWords {
+			if strings.Contains(normalized, entry.word) {
+				return entry.kind
+			}
+		}
+	}
+
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrBadRequest
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound, http.StatusGone:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+	case http.StatusLocked:
+		return ErrLocked
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	}
+	if statusCode >= 500 {
+		return ErrServerError
+	}
+	return nil
+}
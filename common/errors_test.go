@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// requestError returns the error of a request answered with the given status and body, as returned by the
+// operations of service clients.
+func requestError(t *testing.T, serviceName string, status int, body string) error {
+	return core.SDKErrorf(ClassifyError(sendRequest(t, serviceName, status, body)), "", "http-request-err", GetComponentInfo())
+}
+
+// sendRequest returns the error of a request answered with the given status and body, as returned by
+// core.BaseService.Request and enriched by the operations of service clients.
+func sendRequest(t *testing.T, serviceName string, status int, body string) error {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("Transaction-Id", "tx-1")
+		res.WriteHeader(status)
+		fmt.Fprint(res, body)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), "/v1/things/1", nil)
+	assert.Nil(t, err)
+	req, err := builder.Build()
+	assert.Nil(t, err)
+	_, err = service.Request(req, nil)
+	core.EnrichHTTPProblem(err, "get_thing", core.NewProblemComponent(serviceName, "1.0.0"))
+	return err
+}
+
+func TestClassifyError(t *testing.T) {
+	err := requestError(t, "things", http.StatusNotFound, `{"errors": [{"code": "thing_missing", "message": "No such thing"}]}`)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrConflict))
+	assert.True(t, errors.Is(ClassifyError(err), ErrNotFound))
+
+	serviceError, ok := AsNotFound(err)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, serviceError.StatusCode)
+	assert.Equal(t, "thing_missing", serviceError.Code)
+	assert.Equal(t, "No such thing", serviceError.Message)
+	assert.Equal(t, "things", serviceError.ServiceName)
+	assert.Equal(t, "get_thing", serviceError.OperationID)
+	assert.Equal(t, "tx-1", serviceError.TransactionID)
+	assert.Equal(t, err.Error(), serviceError.Error())
+	var httpProblem *core.HTTPProblem
+	assert.True(t, errors.As(err, &httpProblem))
+	assert.Equal(t, httpProblem, serviceError.Problem)
+
+	// The kind survives the wrapping of the error by helpers.
+	assert.True(t, errors.Is(core.SDKErrorf(err, "", "helper-error", GetComponentInfo()), ErrNotFound))
+	assert.True(t, errors.Is(core.RepurposeSDKProblem(err, "helper-error"), ErrNotFound))
+
+	// Classifying an error does not change the problem that describes it.
+	raw := sendRequest(t, "things", http.StatusNotFound, `{"errors": [{"code": "thing_missing", "message": "No such thing"}]}`)
+	plain := core.SDKErrorf(raw, "", "http-request-err", GetComponentInfo())
+	classified := core.SDKErrorf(ClassifyError(raw), "", "http-request-err", GetComponentInfo())
+	assert.False(t, errors.Is(plain, ErrNotFound))
+	assert.True(t, errors.Is(classified, ErrNotFound))
+	assert.Equal(t, plain.GetID(), classified.GetID())
+	assert.Equal(t, plain.GetConsoleMessage(), classified.GetConsoleMessage())
+
+	// Precondition failures are conflicts too.
+	err = requestError(t, "things", http.StatusPreconditionFailed, `{"code": "if_match_failed"}`)
+	assert.True(t, errors.Is(ClassifyError(err), ErrPreconditionFailed))
+	_, ok = AsConflict(err)
+	assert.True(t, ok)
+	_, ok = AsNotFound(err)
+	assert.False(t, ok)
+
+	// Error codes take precedence over status codes.
+	err = requestError(t, "things", http.StatusBadRequest, `{"error_code": "RC-ResourceInstanceLocked"}`)
+	_, ok = AsLocked(err)
+	assert.True(t, ok)
+	err = requestError(t, "things", http.StatusForbidden, `{"errors": [{"code": "account_quota_exceeded"}]}`)
+	_, ok = AsQuotaExceeded(err)
+	assert.True(t, ok)
+	RegisterErrorCodes("things", map[string]error{"too_busy": ErrRateLimited})
+	err = requestError(t, "things", http.StatusServiceUnavailable, `{"code": "too_busy"}`)
+	_, ok = AsRateLimited(err)
+	assert.True(t, ok)
+	err = requestError(t, "others", http.StatusServiceUnavailable, `{"code": "too_busy"}`)
+	assert.True(t, errors.Is(ClassifyError(err), ErrServerError))
+
+	// Other errors are not service errors.
+	err = errors.New("connection refused")
+	_, ok = AsServiceError(err)
+	assert.False(t, ok)
+	assert.Equal(t, err, ClassifyError(err))
+}
+
+func TestClassifyErrorCodes(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		kind   error
+	}{
+		{http.StatusTooManyRequests, `{"code": "rate_limit_exceeded"}`, ErrRateLimited},
+		{http.StatusForbidden, `{"code": "rate_limit_exceeded"}`, ErrRateLimited},
+		{http.StatusTooManyRequests, `{"code": "RequestLimitExceeded"}`, ErrRateLimited},
+		{http.StatusForbidden, `{"code": "RequestLimitExceeded"}`, ErrQuotaExceeded},
+		{http.StatusTooManyRequests, `{"code": "account_quota_exceeded"}`, ErrRateLimited},
+		{http.StatusBadRequest, `{"code": "too_many_requests"}`, ErrRateLimited},
+		{http.StatusConflict, `{"code": "instance_locked"}`, ErrLocked},
+		{http.StatusNotFound, `{"code": "unknown"}`, ErrNotFound},
+	}
+	for _, test := range tests {
+		err := requestError(t, "codes", test.status, test.body)
+		assert.True(t, errors.Is(ClassifyError(err), test.kind), "%d %s", test.status, test.body)
+	}
+}
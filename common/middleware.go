@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Header that carries the attempt number of a request from the retryable client to middleware. It is removed
+// before the request is sent.
+const headerNameSdkAttempt = "X-Ibm-Sdk-Attempt"
+
+// operationKey : The context key of the operation of a request.
+type operationKey struct{}
+
+// operation : The service and operation of a request sent by a service client.
+type operation struct {
+	serviceName    string
+	serviceVersion string
+	operationId    string
+}
+
+// WithOperation returns a copy of "ctx" that carries the service and operation of the request it is used for,
+// as returned by RequestOperation. The operations of the service clients set it on the context of each request.
+func WithOperation(ctx context.Context, serviceName string, serviceVersion string, operationId string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation{serviceName: serviceName, serviceVersion: serviceVersion, operationId: operationId})
+}
+
+// Handler : Sends an HTTP request and returns the response received for it.
+type Handler func(req *http.Request) (*http.Response, error)
+
+// Middleware : Wraps the Handler that sends the requests of a service client, to add behavior such as
+// audit logging, header stamping, request signing or fault injection to every operation.
+//
+// A middleware must not modify the request it receives; to change it, pass a copy (see http.Request.Clone)
+// to "next". The response is the raw HTTP response, before the client reads it into a core.DetailedResponse,
+// so a middleware that reads the body must replace it for the client.
+type Middleware func(next Handler) Handler
+
+// HandlerFunc : Adapts an http.RoundTripper to a Handler.
+func HandlerFunc(transport http.RoundTripper) Handler {
+	return transport.RoundTrip
+}
+
+// middlewareTransport applies a chain of middleware to the requests sent through a transport.
+type middlewareTransport struct {
+	base  http.RoundTripper
+	chain []Middleware
+}
+
+func (transport *middlewareTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	handler := func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get(headerNameSdkAttempt) != "" {
+			req = req.Clone(req.Context())
+			req.Header.Del(headerNameSdkAttempt)
+		}
+		return transport.base.RoundTrip(req)
+	}
+	for i := len(transport.chain) - 1; i >= 0; i-- {
+		handler = transport.chain[i](handler)
+	}
+	return handler(req)
+}
+
+// UseMiddleware installs middleware on the HTTP client of a service. Middleware wrap one another in the order
+// in which they are installed: the first one sees each request first and each response last. When automatic
+// retries are enabled, middleware see every attempt.
+//
+// The middleware wrap the transport of the client; call DisableSSLVerification, if needed, before UseMiddleware.
+func UseMiddleware(service *core.BaseService, middleware ...Middleware) {
+	if len(middleware) == 0 {
+		return
+	}
+	current := service.GetHTTPClient()
+	if current == nil {
+		current = core.DefaultHTTPClient()
+	}
+	// The client may be shared with clones of the service, so it is replaced rather than modified.
+	transport := &middlewareTransport{base: current.Transport, chain: middleware}
+	if installed, ok := current.Transport.(*middlewareTransport); ok {
+		transport.base = installed.base
+		transport.chain = append(append([]Middleware{}, installed.chain...), middleware...)
+	}
+	if transport.base == nil {
+		transport.base = http.DefaultTransport
+	}
+	client := *current
+	client.Transport = transport
+	service.SetHTTPClient(&client)
+}
+
+// RequestOperation returns the service and operation of a request sent by a service client, such as
+// "global_tagging", "V1" and "ListTags", from the context of the request (see WithOperation). The values are
+// empty for requests that are not sent by an operation of a service client.
+func RequestOperation(req *http.Request) (serviceName string, serviceVersion string, operationId string) {
+	op, _ := req.Context().Value(operationKey{}).(operation)
+	return op.serviceName, op.serviceVersion, op.operationId
+}
+
+// HeaderMiddleware returns a Middleware that sets the specified headers on every request.
+func HeaderMiddleware(headers map[string]string) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			for name, value := range headers {
+				req.Header.Set(name, value)
+			}
+			return next(req)
+		}
+	}
+}
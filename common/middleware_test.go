@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUseMiddleware(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		attempts++
+		res.Header().Set("X-Seen-Tenant", req.Header.Get("X-Tenant"))
+		if attempts == 1 {
+			res.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	clone := service.Clone()
+	service.EnableRetries(2, 0)
+
+	calls := []string{}
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				res, err := next(req)
+				calls = append(calls, name+" response")
+				return res, err
+			}
+		}
+	}
+	UseMiddleware(service, trace("outer"))
+	UseMiddleware(service, trace("inner"), HeaderMiddleware(map[string]string{"X-Tenant": "acme"}))
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), "/", nil)
+	assert.Nil(t, err)
+	req, err := builder.Build()
+	assert.Nil(t, err)
+	response, err := service.Request(req, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "acme", response.Headers.Get("X-Seen-Tenant"))
+
+	// The middleware wrap each attempt made by the retryable client.
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, []string{
+		"outer request", "inner request", "inner response", "outer response",
+		"outer request", "inner request", "inner response", "outer response",
+	}, calls)
+
+	// Clones of the service made before UseMiddleware are not affected.
+	_, isMiddleware := clone.GetHTTPClient().Transport.(*middlewareTransport)
+	assert.False(t, isMiddleware)
+}
+
+// getTag sends a request the way an operation of a service client does.
+func getTag(service *core.BaseService) error {
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(WithOperation(context.Background(), "global_tagging", "V1", "GetTag"))
+	_, err := builder.ResolveRequestURL(service.GetServiceURL(), "/v3/tags/prod", nil)
+	if err != nil {
+		return err
+	}
+	for name, value := range GetSdkHeaders("global_tagging", "V1", "GetTag") {
+		builder.AddHeader(name, value)
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return err
+	}
+	_, err = service.Request(req, nil)
+	return err
+}
+
+func TestRequestOperation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+
+	seen := [][]string{}
+	UseMiddleware(service, func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			serviceName, serviceVersion, operationId := RequestOperation(req)
+			seen = append(seen, []string{serviceName, serviceVersion, operationId})
+			return next(req)
+		}
+	})
+
+	// The middleware of a client sees the operation of its requests.
+	assert.Nil(t, getTag(service))
+	assert.Equal(t, [][]string{{"global_tagging", "V1", "GetTag"}}, seen)
+
+	// Requests that are not sent by an operation have no operation.
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.Nil(t, err)
+	serviceName, serviceVersion, operationId := RequestOperation(req)
+	assert.Empty(t, serviceName+serviceVersion+operationId)
+}
@@ -0,0 +1,360 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// RateLimit : A token-bucket limit: requests are sent at most at Rate per second on average, with bursts of up to
+// Burst requests.
+type RateLimit struct {
+	// The sustained number of requests per second.
+	Rate float64 `validate:"gt=0"`
+
+	// The maximum number of requests sent at once. Defaults to 1.
+	Burst int `validate:"gte=0"`
+}
+
+// RateLimiterOptions : The options of NewRateLimiter.
+type RateLimiterOptions struct {
+	// The limit of the requests to each service, such as "global_tagging". Requests are not limited when nil,
+	// but are still held back after a 429 response.
+	Service *RateLimit
+
+	// The limits of individual operations, identified by their operation ID ("ListTags") or by the service name
+	// and operation ID ("global_tagging.ListTags"). They apply in addition to the Service limit.
+	Operations map[string]RateLimit `validate:"omitempty,dive"`
+
+	// The longest time a request may wait for the limiter; a request that would wait longer fails.
+	// Requests wait as long as needed, or until their context is done, when zero.
+	MaxWait time.Duration
+
+	// When a 429 response is received, the rate of the limit is divided by two, down to MinRate, and recovers
+	// gradually with each successful response. Defaults to a tenth of the configured rate.
+	MinRate float64
+
+	// Disables the reduction of rates after 429 responses. Retry-After and rate-limit headers are still honored.
+	DisableAdaptation bool
+
+	// The provider of the meter that records the waits of requests. Defaults to the global provider,
+	// otel.GetMeterProvider().
+	MeterProvider metric.MeterProvider
+}
+
+// RateLimitStats : The activity of one limit of a RateLimiter.
+type RateLimitStats struct {
+	// The number of requests admitted by the limit.
+	Requests int64
+
+	// The number of 429 responses received for the requests.
+	Throttled int64
+
+	// The number of requests that waited, and the total and longest time they waited.
+	Delayed   int64
+	TotalWait time.Duration
+	MaxWait   time.Duration
+
+	// The current rate of the limit, in requests per second; +Inf for a service without a configured limit.
+	Rate float64
+}
+
+// RateLimiter : A client-side rate limiter for service clients, installed with the Use method of the clients:
+//
+//	client.Use(limiter.Middleware())
+//
+// A limiter may be shared by several clients, including clones, to enforce a limit across all of them.
+// The limiter holds requests back for the time given by the Retry-After header, or by the RateLimit-Reset or
+// X-RateLimit-Reset header when no requests remain, of 429 and other responses, and lowers its rate after each
+// 429 response. Install it after enabling automatic retries so that it also paces retried requests.
+type RateLimiter struct {
+	options *RateLimiterOptions
+	mutex   sync.Mutex
+	buckets map[string]*tokenBucket
+	wait    metric.Float64Histogram
+}
+
+// NewRateLimiter : constructs an instance of RateLimiter with the specified options.
+func NewRateLimiter(options *RateLimiterOptions) (limiter *RateLimiter, err error) {
+	if options == nil {
+		options = &RateLimiterOptions{}
+	}
+	err = core.ValidateStruct(options, "options")
+	if err == nil && options.Service != nil {
+		err = core.ValidateStruct(options.Service, "options.Service")
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", GetComponentInfo())
+		return
+	}
+	meterProvider := options.MeterProvider
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+	limiter = &RateLimiter{options: options, buckets: map[string]*tokenBucket{}}
+	limiter.wait, err = meterProvider.Meter(instrumentationName, metric.WithInstrumentationVersion(Version)).Float64Histogram(
+		"ibm.sdk.rate_limit.wait", metric.WithDescription("The time requests waited for the client-side rate limiter."), metric.WithUnit("s"))
+	if err != nil {
+		limiter = nil
+		err = core.SDKErrorf(err, "", "telemetry-error", GetComponentInfo())
+		return
+	}
+	return
+}
+
+// Stats returns the activity of each limit, by service name (or host name, for requests that were not sent
+// by a service client) or by operation, in the form used in RateLimiterOptions.Operations.
+func (limiter *RateLimiter) Stats() map[string]RateLimitStats {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	stats := make(map[string]RateLimitStats, len(limiter.buckets))
+	for key, bucket := range limiter.buckets {
+		bucket.mutex.Lock()
+		bucketStats := bucket.stats
+		bucketStats.Rate = bucket.rate
+		bucket.mutex.Unlock()
+		stats[key] = bucketStats
+	}
+	return stats
+}
+
+// Middleware returns the Middleware that applies the limiter to requests.
+func (limiter *RateLimiter) Middleware() Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			buckets := limiter.bucketsFor(req)
+			for i, bucket := range buckets {
+				if err := limiter.take(req.Context(), bucket); err != nil {
+					for _, taken := range buckets[:i] {
+						taken.cancel()
+					}
+					return nil, err
+				}
+			}
+
+			res, err := next(req)
+			if err != nil {
+				return res, err
+			}
+			pause := pauseFor(res, time.Now())
+			for _, bucket := range buckets {
+				bucket.observe(res.StatusCode, pause, limiter.options)
+			}
+			return res, err
+		}
+	}
+}
+
+// bucketsFor returns the buckets that apply to a request: the bucket of its operation, if it has a limit,
+// and the bucket of its service.
+func (limiter *RateLimiter) bucketsFor(req *http.Request) []*tokenBucket {
+	serviceName, _, operation := RequestOperation(req)
+	if serviceName == "" {
+		serviceName = req.URL.Hostname()
+	}
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	buckets := []*tokenBucket{}
+	if operation != "" {
+		for _, key := range []string{serviceName + "." + operation, operation} {
+			if limit, found := limiter.options.Operations[key]; found {
+				buckets = append(buckets, limiter.bucket(key, &limit))
+				break
+			}
+		}
+	}
+	return append(buckets, limiter.bucket(serviceName, limiter.options.Service))
+}
+
+func (limiter *RateLimiter) bucket(key string, limit *RateLimit) *tokenBucket {
+	bucket, found := limiter.buckets[key]
+	if !found {
+		bucket = newTokenBucket(key, limit, time.Now())
+		limiter.buckets[key] = bucket
+	}
+	return bucket
+}
+
+// take waits until the bucket admits a request.
+func (limiter *RateLimiter) take(ctx context.Context, bucket *tokenBucket) error {
+	wait := bucket.reserve(time.Now())
+	if wait <= 0 {
+		bucket.record(0)
+		return nil
+	}
+	if limiter.options.MaxWait > 0 && wait > limiter.options.MaxWait {
+		bucket.cancel()
+		return core.SDKErrorf(nil, fmt.Sprintf("rate limit '%s' would delay the request by %s, more than the maximum of %s",
+			bucket.key, wait.Round(time.Millisecond), limiter.options.MaxWait), "rate-limit-wait", GetComponentInfo())
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		bucket.record(wait)
+		limiter.wait.Record(ctx, wait.Seconds(), metric.WithAttributes(attribute.String("ibm.sdk.rate_limit", bucket.key)))
+		return nil
+	case <-ctx.Done():
+		bucket.cancel()
+		return ctx.Err()
+	}
+}
+
+// tokenBucket implements a token bucket in which tokens may be borrowed: a request that finds the bucket empty
+// takes a token anyway and waits for the time it takes to refill it.
+type tokenBucket struct {
+	key     string
+	mutex   sync.Mutex
+	rate    float64
+	maxRate float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	stats   RateLimitStats
+}
+
+func newTokenBucket(key string, limit *RateLimit, now time.Time) *tokenBucket {
+	bucket := &tokenBucket{key: key, rate: math.Inf(1), burst: 1, last: now}
+	if limit != nil {
+		bucket.rate = limit.Rate
+		if limit.Burst > 0 {
+			bucket.burst = float64(limit.Burst)
+		}
+	}
+	bucket.maxRate = bucket.rate
+	bucket.tokens = bucket.burst
+	return bucket
+}
+
+// refill adds the tokens accumulated since the last refill. The last refill may be in the future
+// while the bucket is paused.
+func (bucket *tokenBucket) refill(now time.Time) {
+	if now.After(bucket.last) {
+		bucket.tokens = math.Min(bucket.burst, bucket.tokens+bucket.rate*now.Sub(bucket.last).Seconds())
+		bucket.last = now
+	}
+}
+
+// reserve takes a token and returns how long the request must wait for it.
+func (bucket *tokenBucket) reserve(now time.Time) time.Duration {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	bucket.refill(now)
+	bucket.tokens--
+	wait := time.Duration(0)
+	if bucket.last.After(now) {
+		wait = bucket.last.Sub(now)
+	}
+	if bucket.tokens < 0 && !math.IsInf(bucket.rate, 1) {
+		wait += time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+	}
+	if math.IsInf(bucket.rate, 1) && bucket.tokens < 0 {
+		bucket.tokens = 0
+	}
+	return wait
+}
+
+// cancel returns the token of a request that did not wait for it.
+func (bucket *tokenBucket) cancel() {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	bucket.tokens = math.Min(bucket.burst, bucket.tokens+1)
+}
+
+func (bucket *tokenBucket) record(wait time.Duration) {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	bucket.stats.Requests++
+	if wait > 0 {
+		bucket.stats.Delayed++
+		bucket.stats.TotalWait += wait
+		if wait > bucket.stats.MaxWait {
+			bucket.stats.MaxWait = wait
+		}
+	}
+}
+
+// observe adapts the bucket to a response: it pauses the bucket until "pause", if set, and lowers its rate after a
+// 429 response or raises it back towards the configured rate after a successful one.
+func (bucket *tokenBucket) observe(statusCode int, pause time.Time, options *RateLimiterOptions) {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	if !pause.IsZero() {
+		bucket.refill(time.Now())
+		if pause.After(bucket.last) {
+			bucket.last = pause
+			bucket.tokens = math.Min(bucket.tokens, 1)
+		}
+	}
+	if math.IsInf(bucket.maxRate, 1) || options.DisableAdaptation {
+		if statusCode == http.StatusTooManyRequests {
+			bucket.stats.Throttled++
+		}
+		return
+	}
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		bucket.stats.Throttled++
+		minRate := options.MinRate
+		if minRate <= 0 {
+			minRate = bucket.maxRate / 10
+		}
+		bucket.rate = math.Max(minRate, bucket.rate/2)
+	case statusCode < 400:
+		bucket.rate = math.Min(bucket.maxRate, bucket.rate+bucket.maxRate/20)
+	}
+}
+
+// pauseFor returns the time until which requests should be held back according to the headers of a response,
+// or the zero time. It honors Retry-After (in seconds or as an HTTP date), and RateLimit-Reset or
+// X-RateLimit-Reset (in seconds, or as a Unix time) when the matching Remaining header is 0.
+func pauseFor(res *http.Response, now time.Time) time.Time {
+	if value := res.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds >= 0 {
+			return now.Add(time.Duration(seconds * float64(time.Second)))
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return date
+		}
+	}
+	for _, prefix := range []string{"RateLimit-", "X-RateLimit-"} {
+		remaining := res.Header.Get(prefix + "Remaining")
+		reset, err := strconv.ParseFloat(res.Header.Get(prefix+"Reset"), 64)
+		if remaining != "0" || err != nil || reset < 0 {
+			continue
+		}
+		// Values that can only be Unix times are absolute; smaller ones are delays.
+		if reset > 1e9 {
+			return time.Unix(int64(reset), 0)
+		}
+		return now.Add(time.Duration(reset * float64(time.Second)))
+	}
+	return time.Time{}
+}
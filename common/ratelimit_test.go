@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// attachTag and listTags send requests the way the operations of a service client do.
+func attachTag(t *testing.T, service *core.BaseService) error {
+	return sendRateLimited(t, service, "AttachTag")
+}
+
+func listTags(t *testing.T, service *core.BaseService) error {
+	return sendRateLimited(t, service, "ListTags")
+}
+
+func sendRateLimited(t *testing.T, service *core.BaseService, operationId string) error {
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(WithOperation(context.Background(), "global_tagging", "V1", operationId))
+	_, err := builder.ResolveRequestURL(service.GetServiceURL(), "/v3/tags", nil)
+	assert.Nil(t, err)
+	for name, value := range GetSdkHeaders("global_tagging", "V1", operationId) {
+		builder.AddHeader(name, value)
+	}
+	req, err := builder.Build()
+	assert.Nil(t, err)
+	_, err = service.Request(req, nil)
+	return err
+}
+
+func TestRateLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	reader := sdkmetric.NewManualReader()
+	limiter, err := NewRateLimiter(&RateLimiterOptions{
+		Service:       &RateLimit{Rate: 1000, Burst: 10},
+		Operations:    map[string]RateLimit{"global_tagging.AttachTag": {Rate: 20}},
+		MeterProvider: sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	})
+	assert.Nil(t, err)
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	UseMiddleware(service, limiter.Middleware())
+	clone := service.Clone()
+
+	// The operation limit is shared by the clone.
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		client := service
+		if i%2 == 1 {
+			client = clone
+		}
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, attachTag(t, client))
+		}()
+	}
+	wg.Wait()
+	assert.GreaterOrEqual(t, time.Since(start), 240*time.Millisecond)
+	assert.Nil(t, listTags(t, service))
+
+	stats := limiter.Stats()
+	assert.Equal(t, int64(6), stats["global_tagging.AttachTag"].Requests)
+	assert.Equal(t, int64(5), stats["global_tagging.AttachTag"].Delayed)
+	assert.Equal(t, int64(7), stats["global_tagging"].Requests)
+	assert.Equal(t, float64(1000), stats["global_tagging"].Rate)
+
+	var metrics metricdata.ResourceMetrics
+	assert.Nil(t, reader.Collect(context.Background(), &metrics))
+	assert.Len(t, metrics.ScopeMetrics, 1)
+	waits := metrics.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, "ibm.sdk.rate_limit.wait", waits.Name)
+	assert.Equal(t, uint64(5), waits.Data.(metricdata.Histogram[float64]).DataPoints[0].Count)
+}
+
+func TestRateLimiterThrottled(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts == 1 {
+			res.Header().Set("Retry-After", "1")
+			res.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter, err := NewRateLimiter(&RateLimiterOptions{Service: &RateLimit{Rate: 100, Burst: 5}, MinRate: 20})
+	assert.Nil(t, err)
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	UseMiddleware(service, limiter.Middleware())
+
+	// The request that follows a 429 response waits for the time given by Retry-After.
+	assert.NotNil(t, listTags(t, service))
+	start := time.Now()
+	assert.Nil(t, listTags(t, service))
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+
+	stats := limiter.Stats()["global_tagging"]
+	assert.Equal(t, int64(1), stats.Throttled)
+	assert.Equal(t, int64(1), stats.Delayed)
+	assert.Equal(t, float64(55), stats.Rate)
+
+	// Requests that would wait longer than MaxWait fail.
+	limiter, err = NewRateLimiter(&RateLimiterOptions{Service: &RateLimit{Rate: 1}, MaxWait: 100 * time.Millisecond})
+	assert.Nil(t, err)
+	service, err = core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	UseMiddleware(service, limiter.Middleware())
+	assert.Nil(t, listTags(t, service))
+	err = listTags(t, service)
+	assert.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "rate limit 'global_tagging' would delay the request"))
+
+	_, err = NewRateLimiter(&RateLimiterOptions{Service: &RateLimit{Rate: 0}})
+	assert.NotNil(t, err)
+}
+
+func TestPauseFor(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	response := func(headers map[string]string) *http.Response {
+		res := &http.Response{Header: http.Header{}}
+		for name, value := range headers {
+			res.Header.Set(name, value)
+		}
+		return res
+	}
+
+	assert.Equal(t, now.Add(2*time.Second), pauseFor(response(map[string]string{"Retry-After": "2"}), now))
+	assert.Equal(t, now.Add(time.Minute), pauseFor(response(map[string]string{
+		"Retry-After": now.Add(time.Minute).Format(http.TimeFormat),
+	}), now))
+	assert.Equal(t, now.Add(3*time.Second), pauseFor(response(map[string]string{
+		"RateLimit-Remaining": "0", "RateLimit-Reset": "3",
+	}), now))
+	assert.Equal(t, time.Unix(1800000000, 0), pauseFor(response(map[string]string{
+		"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1800000000",
+	}), now))
+	assert.True(t, pauseFor(response(map[string]string{
+		"X-RateLimit-Remaining": "5", "X-RateLimit-Reset": "3",
+	}), now).IsZero())
+}
@@ -0,0 +1,249 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultRegionConcurrency is the number of regions queried at the same time by FanOutRegions when no limit is given.
+const DefaultRegionConcurrency = DefaultConcurrency
+
+// RegionError : The failure of an operation in one region.
+type RegionError struct {
+	Region string
+	Err    error
+}
+
+func (e *RegionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Region, e.Err.Error())
+}
+
+func (e *RegionError) Unwrap() error {
+	return e.Err
+}
+
+// MultiRegionError : The failures of an operation that was run in several regions. Results from the
+// other regions are still returned alongside it.
+type MultiRegionError struct {
+	Errors []*RegionError
+}
+
+func (e *MultiRegionError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, regionError := range e.Errors {
+		messages = append(messages, regionError.Error())
+	}
+	return fmt.Sprintf("the operation failed in %d region(s): %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+func (e *MultiRegionError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, regionError := range e.Errors {
+		errs = append(errs, regionError)
+	}
+	return errs
+}
+
+// Regions returns the regions in which the operation failed.
+func (e *MultiRegionError) Regions() []string {
+	regions := make([]string, 0, len(e.Errors))
+	for _, regionError := range e.Errors {
+		regions = append(regions, regionError.Region)
+	}
+	return regions
+}
+
+// FanOutRegions calls "fn" for every region, running at most "concurrency" calls at the same time
+// (DefaultRegionConcurrency if "concurrency" is not positive). It returns nil if every call succeeded,
+// and otherwise a *MultiRegionError whose errors are in the order of "regions".
+func FanOutRegions(ctx context.Context, regions []string, concurrency int, fn func(ctx context.Context, region string) error) error {
+	errs := ForEach(ctx, len(regions), concurrency, func(ctx context.Context, i int) error {
+		return fn(ctx, regions[i])
+	})
+
+	multiRegionError := &MultiRegionError{}
+	for i, err := range errs {
+		if err != nil {
+			multiRegionError.Errors = append(multiRegionError.Errors, &RegionError{Region: regions[i], Err: err})
+		}
+	}
+	if len(multiRegionError.Errors) > 0 {
+		return multiRegionError
+	}
+	return nil
+}
+
+// KnownRegions : The IBM Cloud regions probed by SupportedRegions.
+var KnownRegions = []string{
+	"au-syd", "br-sao", "ca-mon", "ca-tor", "eu-de", "eu-es", "eu-fr2", "eu-gb",
+	"in-che", "in-mum", "jp-osa", "jp-tok", "us-east", "us-south",
+}
+
+// SupportedRegions returns the regions of KnownRegions for which "serviceURL", typically the
+// GetServiceURLForRegion function of a regional service, returns a URL.
+func SupportedRegions(serviceURL func(region string) (string, error)) []string {
+	regions := []string{}
+	for _, region := range KnownRegions {
+		if _, err := serviceURL(region); err == nil {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// MultiRegionClient : The clients of a service in several regions, which are queried concurrently with
+// FanOutRegions by ListAcrossRegions, FindInRegions and GetAcrossRegions.
+type MultiRegionClient[C any] struct {
+	// The queried regions, in the order in which merged results are returned.
+	Regions []string
+
+	// The client of each region.
+	Clients map[string]C
+
+	// The maximum number of regions queried at the same time. Defaults to DefaultRegionConcurrency.
+	Concurrency int
+}
+
+// NewMultiRegionClient : Creates a client for every region with "newClient", using the URL that "serviceURL"
+// returns for the region, or for "private.<region>" if "private" is set.
+func NewMultiRegionClient[C any](regions []string, private bool, serviceURL func(region string) (string, error),
+	newClient func(url string) (C, error)) (client *MultiRegionClient[C], err error) {
+	clients := make(map[string]C, len(regions))
+	for _, region := range regions {
+		endpoint := region
+		if private {
+			endpoint = "private." + region
+		}
+		var url string
+		url, err = serviceURL(endpoint)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "invalid-region")
+			return
+		}
+		clients[region], err = newClient(url)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "new-client-error")
+			return
+		}
+	}
+	client = &MultiRegionClient[C]{Regions: regions, Clients: clients}
+	return
+}
+
+// Client returns the client of the specified region, or the zero value if the region is not queried.
+func (client *MultiRegionClient[C]) Client(region string) C {
+	return client.Clients[region]
+}
+
+// Regional : A result, with the region that returned it.
+type Regional[T any] struct {
+	Region string
+	Value  *T
+}
+
+// ListAcrossRegions calls "list" in every region and merges the results. An item returned by several regions, as
+// identified by "id", is reported once, with the first region, in the order of Regions, that returned it. When
+// some regions fail, the items of the others are returned with a *MultiRegionError.
+func ListAcrossRegions[C any, T any](ctx context.Context, client *MultiRegionClient[C],
+	list func(ctx context.Context, client C) ([]T, error), id func(item *T) string) (items []Regional[T], err error) {
+	results := make(map[string][]T, len(client.Regions))
+	var mutex sync.Mutex
+	err = FanOutRegions(ctx, client.Regions, client.Concurrency, func(ctx context.Context, region string) error {
+		result, err := list(ctx, client.Clients[region])
+		if err != nil {
+			return err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		results[region] = result
+		return nil
+	})
+
+	items = []Regional[T]{}
+	seen := map[string]bool{}
+	for _, region := range client.Regions {
+		for i := range results[region] {
+			item := &results[region][i]
+			if key := id(item); !seen[key] {
+				seen[key] = true
+				items = append(items, Regional[T]{Region: region, Value: item})
+			}
+		}
+	}
+	return
+}
+
+// FindInRegions calls "get" in every region and returns the result of the first region, in the order of Regions,
+// that has it, or nil if no region has it. Regions that respond with 404 Not Found are not reported as failures.
+func FindInRegions[C any, T any](ctx context.Context, client *MultiRegionClient[C],
+	get func(ctx context.Context, client C) (*T, *core.DetailedResponse, error)) (item *Regional[T], err error) {
+	results := make(map[string]*T, len(client.Regions))
+	var mutex sync.Mutex
+	err = FanOutRegions(ctx, client.Regions, client.Concurrency, func(ctx context.Context, region string) error {
+		result, response, err := get(ctx, client.Clients[region])
+		if err != nil {
+			if response != nil && response.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		results[region] = result
+		return nil
+	})
+
+	for _, region := range client.Regions {
+		if result, found := results[region]; found {
+			item = &Regional[T]{Region: region, Value: result}
+			return
+		}
+	}
+	return
+}
+
+// GetAcrossRegions calls "get" in every region and returns the results in the order of Regions.
+func GetAcrossRegions[C any, T any](ctx context.Context, client *MultiRegionClient[C],
+	get func(ctx context.Context, client C) (*T, *core.DetailedResponse, error)) (items []Regional[T], err error) {
+	results := make(map[string]*T, len(client.Regions))
+	var mutex sync.Mutex
+	err = FanOutRegions(ctx, client.Regions, client.Concurrency, func(ctx context.Context, region string) error {
+		result, _, err := get(ctx, client.Clients[region])
+		if err != nil {
+			return err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		results[region] = result
+		return nil
+	})
+
+	items = []Regional[T]{}
+	for _, region := range client.Regions {
+		if result, found := results[region]; found {
+			items = append(items, Regional[T]{Region: region, Value: result})
+		}
+	}
+	return
+}
@@ -0,0 +1,160 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFanOutRegions(t *testing.T) {
+	var running, maxRunning int32
+	failure := errors.New("unavailable")
+	regions := []string{"us-south", "eu-de", "jp-tok", "au-syd"}
+
+	err := FanOutRegions(context.Background(), regions, 2, func(ctx context.Context, region string) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if region == "eu-de" || region == "au-syd" {
+			return failure
+		}
+		return nil
+	})
+
+	assert.LessOrEqual(t, maxRunning, int32(2))
+	var multiRegionError *MultiRegionError
+	assert.True(t, errors.As(err, &multiRegionError))
+	assert.Equal(t, []string{"eu-de", "au-syd"}, multiRegionError.Regions())
+	assert.True(t, errors.Is(err, failure))
+	assert.Contains(t, err.Error(), "eu-de: unavailable")
+
+	err = FanOutRegions(context.Background(), regions, 0, func(ctx context.Context, region string) error {
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func TestSupportedRegions(t *testing.T) {
+	regions := SupportedRegions(func(region string) (string, error) {
+		if region != "us-south" && region != "eu-de" {
+			return "", core.SDKErrorf(nil, "unknown region", "invalid-region", GetComponentInfo())
+		}
+		return "https://" + region + ".example.com", nil
+	})
+	assert.Equal(t, []string{"eu-de", "us-south"}, regions)
+}
+
+func TestNewMultiRegionClient(t *testing.T) {
+	serviceURL := func(region string) (string, error) {
+		if strings.Contains(region, "mars") {
+			return "", core.SDKErrorf(nil, "unknown region", "invalid-region", GetComponentInfo())
+		}
+		return "https://" + region + ".example.com", nil
+	}
+	newClient := func(url string) (string, error) {
+		return url, nil
+	}
+
+	client, err := NewMultiRegionClient([]string{"us-south", "eu-de"}, true, serviceURL, newClient)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"us-south", "eu-de"}, client.Regions)
+	assert.Equal(t, "https://private.eu-de.example.com", client.Client("eu-de"))
+	assert.Equal(t, "", client.Client("jp-tok"))
+
+	_, err = NewMultiRegionClient([]string{"us-south", "mars-north"}, false, serviceURL, newClient)
+	assert.NotNil(t, err)
+}
+
+// regionalItem : An item of a fake regional service.
+type regionalItem struct {
+	ID string
+}
+
+func TestMultiRegionClient(t *testing.T) {
+	failure := errors.New("unavailable")
+	notFound := core.SDKErrorf(nil, "not found", "not-found", GetComponentInfo())
+	items := map[string][]regionalItem{
+		"us-south": {{ID: "a"}},
+		"eu-de":    {{ID: "b"}, {ID: "a"}},
+		"jp-tok":   {},
+	}
+	client := &MultiRegionClient[string]{
+		Regions: []string{"us-south", "eu-de", "jp-tok", "au-syd"},
+		Clients: map[string]string{"us-south": "us-south", "eu-de": "eu-de", "jp-tok": "jp-tok", "au-syd": "au-syd"},
+	}
+	list := func(ctx context.Context, region string) ([]regionalItem, error) {
+		if region == "au-syd" {
+			return nil, failure
+		}
+		return items[region], nil
+	}
+	get := func(id string) func(ctx context.Context, region string) (*regionalItem, *core.DetailedResponse, error) {
+		return func(ctx context.Context, region string) (*regionalItem, *core.DetailedResponse, error) {
+			if region == "au-syd" {
+				return nil, &core.DetailedResponse{StatusCode: http.StatusServiceUnavailable}, failure
+			}
+			for i := range items[region] {
+				if items[region][i].ID == id {
+					return &items[region][i], &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+				}
+			}
+			return nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, notFound
+		}
+	}
+
+	merged, err := ListAcrossRegions(context.Background(), client, list, func(item *regionalItem) string { return item.ID })
+	var multiRegionError *MultiRegionError
+	assert.True(t, errors.As(err, &multiRegionError))
+	assert.Equal(t, []string{"au-syd"}, multiRegionError.Regions())
+	assert.Len(t, merged, 2)
+	assert.Equal(t, "us-south", merged[0].Region)
+	assert.Equal(t, "a", merged[0].Value.ID)
+	assert.Equal(t, "eu-de", merged[1].Region)
+	assert.Equal(t, "b", merged[1].Value.ID)
+
+	found, err := FindInRegions(context.Background(), client, get("b"))
+	assert.True(t, errors.As(err, &multiRegionError))
+	assert.Equal(t, []string{"au-syd"}, multiRegionError.Regions())
+	assert.Equal(t, "eu-de", found.Region)
+
+	client.Regions = client.Regions[:3]
+	found, err = FindInRegions(context.Background(), client, get("c"))
+	assert.Nil(t, err)
+	assert.Nil(t, found)
+
+	all, err := GetAcrossRegions(context.Background(), client, get("a"))
+	assert.True(t, errors.As(err, &multiRegionError))
+	assert.Equal(t, []string{"jp-tok"}, multiRegionError.Regions())
+	assert.Len(t, all, 2)
+	assert.Equal(t, "us-south", all[0].Region)
+	assert.Equal(t, "eu-de", all[1].Region)
+}
@@ -0,0 +1,253 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/IBM/platform-services-go-sdk"
+
+	// The maximum number of bytes of an error response read to find its error code.
+	maxErrorBodySize = 64 * 1024
+)
+
+// Attributes of the spans and metrics recorded by Telemetry, in addition to the OpenTelemetry HTTP client attributes.
+const (
+	TelemetryAttributeServiceConst        = "ibm.sdk.service"
+	TelemetryAttributeServiceVersionConst = "ibm.sdk.service_version"
+	TelemetryAttributeOperationConst      = "ibm.sdk.operation"
+	TelemetryAttributeErrorCodeConst      = "ibm.sdk.error_code"
+)
+
+// TelemetryOptions : The options of NewTelemetry.
+type TelemetryOptions struct {
+	// The provider of the tracer. Defaults to the global provider, otel.GetTracerProvider().
+	TracerProvider trace.TracerProvider
+
+	// The provider of the meter. Defaults to the global provider, otel.GetMeterProvider().
+	MeterProvider metric.MeterProvider
+
+	// The propagator that injects the trace context into outgoing requests. Defaults to the global
+	// propagator, otel.GetTextMapPropagator().
+	Propagator propagation.TextMapPropagator
+}
+
+// Telemetry : OpenTelemetry instrumentation of service clients. Install it on a client with the client's
+// UseTelemetry method (or with common.UseTelemetry).
+//
+// Every HTTP request sent by the client is recorded as a client span named after the service and operation,
+// such as "global_tagging.ListTags", with the HTTP status code, the retry count (http.request.resend_count)
+// and, for failed requests, the error code returned by the service. The trace context is propagated in the
+// request headers. The requests are also counted by the metrics "ibm.sdk.requests" and "ibm.sdk.errors", and
+// their latency recorded by the histogram "ibm.sdk.request.duration".
+type Telemetry struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	requests   metric.Int64Counter
+	errors     metric.Int64Counter
+	duration   metric.Float64Histogram
+}
+
+// NewTelemetry : constructs an instance of Telemetry with the specified options.
+func NewTelemetry(options *TelemetryOptions) (telemetry *Telemetry, err error) {
+	if options == nil {
+		options = &TelemetryOptions{}
+	}
+	tracerProvider := options.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	meterProvider := options.MeterProvider
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+	propagator := options.Propagator
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+
+	meter := meterProvider.Meter(instrumentationName, metric.WithInstrumentationVersion(Version))
+	telemetry = &Telemetry{
+		tracer:     tracerProvider.Tracer(instrumentationName, trace.WithInstrumentationVersion(Version)),
+		propagator: propagator,
+	}
+	telemetry.requests, err = meter.Int64Counter("ibm.sdk.requests",
+		metric.WithDescription("The number of HTTP requests sent by the SDK."), metric.WithUnit("{request}"))
+	if err == nil {
+		telemetry.errors, err = meter.Int64Counter("ibm.sdk.errors",
+			metric.WithDescription("The number of HTTP requests sent by the SDK that failed."), metric.WithUnit("{request}"))
+	}
+	if err == nil {
+		telemetry.duration, err = meter.Float64Histogram("ibm.sdk.request.duration",
+			metric.WithDescription("The duration of the HTTP requests sent by the SDK."), metric.WithUnit("s"))
+	}
+	if err != nil {
+		telemetry = nil
+		err = core.SDKErrorf(err, "", "telemetry-error", GetComponentInfo())
+		return
+	}
+	return
+}
+
+// UseTelemetry installs the telemetry middleware on the HTTP client of a service. Enable automatic retries,
+// if needed, before installing telemetry so that retried requests are recorded with their retry count.
+func UseTelemetry(service *core.BaseService, telemetry *Telemetry) {
+	if transport, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		previous := transport.Client.RequestLogHook
+		transport.Client.RequestLogHook = func(logger retryablehttp.Logger, req *http.Request, attempt int) {
+			req.Header.Set(headerNameSdkAttempt, strconv.Itoa(attempt))
+			if previous != nil {
+				previous(logger, req, attempt)
+			}
+		}
+	}
+	UseMiddleware(service, telemetry.Middleware())
+}
+
+// Middleware returns the Middleware that records the telemetry of requests.
+func (telemetry *Telemetry) Middleware() Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			serviceName, serviceVersion, operation := RequestOperation(req)
+			attempt, _ := strconv.Atoi(req.Header.Get(headerNameSdkAttempt))
+
+			spanName := req.Method
+			if operation != "" {
+				spanName = serviceName + "." + operation
+			}
+			base := []attribute.KeyValue{
+				attribute.String(TelemetryAttributeServiceConst, serviceName),
+				attribute.String(TelemetryAttributeServiceVersionConst, serviceVersion),
+				attribute.String(TelemetryAttributeOperationConst, operation),
+				attribute.String("http.request.method", req.Method),
+			}
+			ctx, span := telemetry.tracer.Start(req.Context(), spanName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(base...),
+				trace.WithAttributes(
+					attribute.String("server.address", req.URL.Hostname()),
+					attribute.String("url.full", redactedURL(req)),
+				))
+			defer span.End()
+			if attempt > 0 {
+				span.SetAttributes(attribute.Int("http.request.resend_count", attempt))
+			}
+			req = req.WithContext(ctx)
+			telemetry.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			start := time.Now()
+			res, err := next(req)
+			elapsed := time.Since(start).Seconds()
+
+			measured := append([]attribute.KeyValue{}, base...)
+			failed := false
+			if err != nil {
+				failed = true
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				measured = append(measured, attribute.String("error.type", "transport"))
+			} else {
+				status := attribute.Int("http.response.status_code", res.StatusCode)
+				span.SetAttributes(status)
+				measured = append(measured, status)
+				if res.StatusCode >= 400 {
+					failed = true
+					errorType := attribute.String("error.type", strconv.Itoa(res.StatusCode))
+					span.SetAttributes(errorType)
+					span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+					measured = append(measured, errorType)
+					if code := readErrorCode(res); code != "" {
+						span.SetAttributes(attribute.String(TelemetryAttributeErrorCodeConst, code))
+					}
+				}
+			}
+
+			attributes := metric.WithAttributes(measured...)
+			telemetry.requests.Add(ctx, 1, attributes)
+			if failed {
+				telemetry.errors.Add(ctx, 1, attributes)
+			}
+			telemetry.duration.Record(ctx, elapsed, attributes)
+			return res, err
+		}
+	}
+}
+
+// redactedURL returns the URL of the request without its query, which may contain identifiers or secrets.
+func redactedURL(req *http.Request) string {
+	u := *req.URL
+	u.RawQuery = ""
+	u.User = nil
+	return u.String()
+}
+
+// readErrorCode returns the error code in the body of an error response, as found in the "errors" array of
+// IBM Cloud API errors or in a top-level "code" or "error_code" property. The body is restored for the client.
+func readErrorCode(res *http.Response) string {
+	if res.Body == nil || !strings.Contains(res.Header.Get("Content-Type"), "json") {
+		return ""
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), res.Body), res.Body}
+	if err != nil {
+		return ""
+	}
+
+	// Codes are strings in most services, but numbers in some.
+	var body struct {
+		Errors []struct {
+			Code interface{} `json:"code"`
+		} `json:"errors"`
+		Code      interface{} `json:"code"`
+		ErrorCode interface{} `json:"error_code"`
+	}
+	if json.Unmarshal(data, &body) != nil {
+		return ""
+	}
+	candidates := []interface{}{body.Code, body.ErrorCode}
+	if len(body.Errors) > 0 {
+		candidates = append([]interface{}{body.Errors[0].Code}, candidates...)
+	}
+	for _, code := range candidates {
+		if code != nil && code != "" {
+			return fmt.Sprint(code)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,122 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTelemetry(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		attempts++
+		assert.Empty(t, req.Header.Get(headerNameSdkAttempt))
+		assert.NotEmpty(t, req.Header.Get("Traceparent"))
+		res.Header().Set("Content-Type", "application/json")
+		if attempts == 1 {
+			res.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(res, `{"errors": [{"code": "service_unavailable", "message": "try again"}]}`)
+			return
+		}
+		fmt.Fprint(res, `{"items": []}`)
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	telemetry, err := NewTelemetry(&TelemetryOptions{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		Propagator:     propagation.TraceContext{},
+	})
+	assert.Nil(t, err)
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	service.EnableRetries(2, 0)
+	UseTelemetry(service, telemetry)
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(WithOperation(context.Background(), "global_tagging", "V1", "ListTags"))
+	_, err = builder.ResolveRequestURL(service.GetServiceURL(), "/v3/tags", nil)
+	assert.Nil(t, err)
+	builder.AddQuery("account_id", "secret")
+	for name, value := range GetSdkHeaders("global_tagging", "V1", "ListTags") {
+		builder.AddHeader(name, value)
+	}
+	req, err := builder.Build()
+	assert.Nil(t, err)
+	var result map[string]interface{}
+	_, err = service.Request(req, &result)
+	assert.Nil(t, err)
+
+	ended := spans.Ended()
+	assert.Len(t, ended, 2)
+	for i, span := range ended {
+		assert.Equal(t, "global_tagging.ListTags", span.Name())
+		attributes := attribute.NewSet(span.Attributes()...)
+		operation, _ := attributes.Value(TelemetryAttributeOperationConst)
+		assert.Equal(t, "ListTags", operation.AsString())
+		url, _ := attributes.Value("url.full")
+		assert.Equal(t, server.URL+"/v3/tags", url.AsString())
+		resendCount, found := attributes.Value("http.request.resend_count")
+		assert.Equal(t, i > 0, found)
+		if found {
+			assert.Equal(t, int64(1), resendCount.AsInt64())
+		}
+	}
+	first := attribute.NewSet(ended[0].Attributes()...)
+	status, _ := first.Value("http.response.status_code")
+	assert.Equal(t, int64(503), status.AsInt64())
+	errorCode, _ := first.Value(TelemetryAttributeErrorCodeConst)
+	assert.Equal(t, "service_unavailable", errorCode.AsString())
+	assert.Equal(t, codes.Error, ended[0].Status().Code)
+	assert.Equal(t, codes.Unset, ended[1].Status().Code)
+
+	var metrics metricdata.ResourceMetrics
+	assert.Nil(t, reader.Collect(context.Background(), &metrics))
+	totals := map[string]int64{}
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, point := range data.DataPoints {
+					totals[m.Name] += point.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, point := range data.DataPoints {
+					totals[m.Name] += int64(point.Count)
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{"ibm.sdk.requests": 2, "ibm.sdk.errors": 1, "ibm.sdk.request.duration": 2}, totals)
+}
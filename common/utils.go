@@ -17,9 +17,18 @@
 package common
 
 import (
+	"context"
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
 )
 
+// DefaultConcurrency is the number of calls run at the same time by ForEach when no limit is given.
+const DefaultConcurrency = 8
+
 // ToJSON marshals the specified object and returns the resulting JSON string
 func ToJSON(obj interface{}) string {
 	b, err := json.MarshalIndent(obj, "", "  ")
@@ -28,3 +37,65 @@ func ToJSON(obj interface{}) string {
 	}
 	return string(b)
 }
+
+// WriteFileAtomic writes data to the file at path through a temporary file in the same directory, which is renamed
+// over the file once it is complete, so readers never see a partially written file.
+func WriteFileAtomic(path string, data []byte) (err error) {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-file-error", GetComponentInfo())
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-file-error", GetComponentInfo())
+	}
+	return nil
+}
+
+// ContainsString reports whether value is one of values.
+func ContainsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ForEach calls "fn" for the indexes 0 to n-1, running at most "concurrency" calls at the same time
+// (DefaultConcurrency if "concurrency" is not positive), and returns the errors by index. Once "ctx" is done, the
+// calls not yet started are skipped and their error is the error of "ctx".
+func ForEach(ctx context.Context, n int, concurrency int, fn func(ctx context.Context, i int) error) []error {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	errs := make([]error, n)
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			for ; i < n; i++ {
+				errs[i] = err
+			}
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() { <-semaphore; wg.Done() }()
+			errs[i] = fn(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
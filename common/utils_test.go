@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, err := os.MkdirTemp("", "write-file-atomic")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "state.json")
+	assert.Nil(t, WriteFileAtomic(path, []byte(`{"version": 1}`)))
+	assert.Nil(t, WriteFileAtomic(path, []byte(`{"version": 2}`)))
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"version": 2}`, string(data))
+
+	// The temporary files are removed.
+	entries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+
+	err = WriteFileAtomic(filepath.Join(dir, "missing", "state.json"), nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "missing")
+}
+
+func TestContainsString(t *testing.T) {
+	assert.True(t, ContainsString([]string{"a", "b"}, "b"))
+	assert.False(t, ContainsString([]string{"a", "b"}, "c"))
+	assert.False(t, ContainsString(nil, ""))
+}
+
+func TestForEach(t *testing.T) {
+	var running, maxRunning int32
+	failure := errors.New("failed")
+	errs := ForEach(context.Background(), 6, 2, func(ctx context.Context, i int) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if i == 3 {
+			return failure
+		}
+		return nil
+	})
+	assert.Equal(t, []error{nil, nil, nil, failure, nil, nil}, errs)
+	assert.Equal(t, int32(2), maxRunning)
+
+	// The calls not started when the context is done are skipped.
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	errs = ForEach(ctx, 4, 1, func(ctx context.Context, i int) error {
+		atomic.AddInt32(&calls, 1)
+		cancel()
+		return nil
+	})
+	assert.Equal(t, int32(1), calls)
+	assert.Equal(t, []error{nil, context.Canceled, context.Canceled, context.Canceled}, errs)
+}
@@ -190,7 +190,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) CreateZoneWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "CreateZone"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/zones`, nil)
 	if err != nil {
@@ -247,7 +247,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) CreateZoneWithContex
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_zone", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -284,7 +284,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListZonesWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "ListZones"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/zones`, nil)
 	if err != nil {
@@ -326,7 +326,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListZonesWithContext
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_zones", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -367,7 +367,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetZoneWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "GetZone"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/zones/{zone_id}`, pathParamsMap)
 	if err != nil {
@@ -401,7 +401,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetZoneWithContext(c
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_zone", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -443,7 +443,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ReplaceZoneWithConte
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "ReplaceZone"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/zones/{zone_id}`, pathParamsMap)
 	if err != nil {
@@ -503,7 +503,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ReplaceZoneWithConte
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_zone", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -544,7 +544,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) DeleteZoneWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "DeleteZone"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/zones/{zone_id}`, pathParamsMap)
 	if err != nil {
@@ -576,7 +576,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) DeleteZoneWithContex
 	response, err = contextBasedRestrictions.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_zone", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -600,7 +600,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListAvailableService
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "ListAvailableServicerefTargets"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/zones/serviceref_targets`, nil)
 	if err != nil {
@@ -638,7 +638,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListAvailableService
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_available_serviceref_targets", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -679,7 +679,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetServicerefTargetW
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "GetServicerefTarget"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/zones/serviceref_targets/{service_name}`, pathParamsMap)
 	if err != nil {
@@ -713,7 +713,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetServicerefTargetW
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_serviceref_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -745,7 +745,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) CreateRuleWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "CreateRule"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/rules`, nil)
 	if err != nil {
@@ -802,7 +802,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) CreateRuleWithContex
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -839,7 +839,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListRulesWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "ListRules"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/rules`, nil)
 	if err != nil {
@@ -905,7 +905,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListRulesWithContext
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_rules", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -946,7 +946,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetRuleWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "GetRule"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -980,7 +980,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetRuleWithContext(c
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1022,7 +1022,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ReplaceRuleWithConte
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "ReplaceRule"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -1082,7 +1082,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ReplaceRuleWithConte
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1123,7 +1123,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) DeleteRuleWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "DeleteRule"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -1155,7 +1155,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) DeleteRuleWithContex
 	response, err = contextBasedRestrictions.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1188,7 +1188,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetAccountSettingsWi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "GetAccountSettings"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/account_settings/{account_id}`, pathParamsMap)
 	if err != nil {
@@ -1222,7 +1222,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetAccountSettingsWi
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1254,7 +1254,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListAvailableService
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "context_based_restrictions", "V1", "ListAvailableServiceOperations"))
 	builder.EnableGzipCompression = contextBasedRestrictions.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(contextBasedRestrictions.Service.Options.URL, `/v1/operations`, nil)
 	if err != nil {
@@ -1298,7 +1298,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListAvailableService
 	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_available_service_operations", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,88 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ContextBasedRestrictionsV1Intf : The operations of the ContextBasedRestrictionsV1 service client.
+// The interface is satisfied by *ContextBasedRestrictionsV1 and by mocks.ContextBasedRestrictionsV1, allowing consumers to substitute a mock for the client in tests.
+type ContextBasedRestrictionsV1Intf interface {
+	// CreateZone : Create a network zone
+	CreateZone(createZoneOptions *CreateZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+	// CreateZoneWithContext is an alternate form of the CreateZone method which supports a Context parameter
+	CreateZoneWithContext(ctx context.Context, createZoneOptions *CreateZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+	// ListZones : List network zones
+	ListZones(listZonesOptions *ListZonesOptions) (result *ZoneList, response *core.DetailedResponse, err error)
+	// ListZonesWithContext is an alternate form of the ListZones method which supports a Context parameter
+	ListZonesWithContext(ctx context.Context, listZonesOptions *ListZonesOptions) (result *ZoneList, response *core.DetailedResponse, err error)
+	// GetZone : Get a network zone
+	GetZone(getZoneOptions *GetZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+	// GetZoneWithContext is an alternate form of the GetZone method which supports a Context parameter
+	GetZoneWithContext(ctx context.Context, getZoneOptions *GetZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+	// ReplaceZone : Replace a network zone
+	ReplaceZone(replaceZoneOptions *ReplaceZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+	// ReplaceZoneWithContext is an alternate form of the ReplaceZone method which supports a Context parameter
+	ReplaceZoneWithContext(ctx context.Context, replaceZoneOptions *ReplaceZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+	// DeleteZone : Delete a network zone
+	DeleteZone(deleteZoneOptions *DeleteZoneOptions) (response *core.DetailedResponse, err error)
+	// DeleteZoneWithContext is an alternate form of the DeleteZone method which supports a Context parameter
+	DeleteZoneWithContext(ctx context.Context, deleteZoneOptions *DeleteZoneOptions) (response *core.DetailedResponse, err error)
+	// ListAvailableServicerefTargets : List available service reference targets
+	ListAvailableServicerefTargets(listAvailableServicerefTargetsOptions *ListAvailableServicerefTargetsOptions) (result *ServiceRefTargetList, response *core.DetailedResponse, err error)
+	// ListAvailableServicerefTargetsWithContext is an alternate form of the ListAvailableServicerefTargets method which supports a Context parameter
+	ListAvailableServicerefTargetsWithContext(ctx context.Context, listAvailableServicerefTargetsOptions *ListAvailableServicerefTargetsOptions) (result *ServiceRefTargetList, response *core.DetailedResponse, err error)
+	// GetServicerefTarget : Get service reference target for a specified service name
+	GetServicerefTarget(getServicerefTargetOptions *GetServicerefTargetOptions) (result *ServiceRefTarget, response *core.DetailedResponse, err error)
+	// GetServicerefTargetWithContext is an alternate form of the GetServicerefTarget method which supports a Context parameter
+	GetServicerefTargetWithContext(ctx context.Context, getServicerefTargetOptions *GetServicerefTargetOptions) (result *ServiceRefTarget, response *core.DetailedResponse, err error)
+	// CreateRule : Create a rule
+	CreateRule(createRuleOptions *CreateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+	// CreateRuleWithContext is an alternate form of the CreateRule method which supports a Context parameter
+	CreateRuleWithContext(ctx context.Context, createRuleOptions *CreateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+	// ListRules : List rules
+	ListRules(listRulesOptions *ListRulesOptions) (result *RuleList, response *core.DetailedResponse, err error)
+	// ListRulesWithContext is an alternate form of the ListRules method which supports a Context parameter
+	ListRulesWithContext(ctx context.Context, listRulesOptions *ListRulesOptions) (result *RuleList, response *core.DetailedResponse, err error)
+	// GetRule : Get a rule
+	GetRule(getRuleOptions *GetRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+	// GetRuleWithContext is an alternate form of the GetRule method which supports a Context parameter
+	GetRuleWithContext(ctx context.Context, getRuleOptions *GetRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+	// ReplaceRule : Replace a rule
+	ReplaceRule(replaceRuleOptions *ReplaceRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+	// ReplaceRuleWithContext is an alternate form of the ReplaceRule method which supports a Context parameter
+	ReplaceRuleWithContext(ctx context.Context, replaceRuleOptions *ReplaceRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+	// DeleteRule : Delete a rule
+	DeleteRule(deleteRuleOptions *DeleteRuleOptions) (response *core.DetailedResponse, err error)
+	// DeleteRuleWithContext is an alternate form of the DeleteRule method which supports a Context parameter
+	DeleteRuleWithContext(ctx context.Context, deleteRuleOptions *DeleteRuleOptions) (response *core.DetailedResponse, err error)
+	// GetAccountSettings : Get account settings
+	GetAccountSettings(getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+	// GetAccountSettingsWithContext is an alternate form of the GetAccountSettings method which supports a Context parameter
+	GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+	// ListAvailableServiceOperations : List available service operations
+	ListAvailableServiceOperations(listAvailableServiceOperationsOptions *ListAvailableServiceOperationsOptions) (result *OperationsList, response *core.DetailedResponse, err error)
+	// ListAvailableServiceOperationsWithContext is an alternate form of the ListAvailableServiceOperations method which supports a Context parameter
+	ListAvailableServiceOperationsWithContext(ctx context.Context, listAvailableServiceOperationsOptions *ListAvailableServiceOperationsOptions) (result *OperationsList, response *core.DetailedResponse, err error)
+}
+
+var _ ContextBasedRestrictionsV1Intf = (*ContextBasedRestrictionsV1)(nil)
@@ -0,0 +1,35 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package contextbasedrestrictionsv1
+
+import (
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Use installs middleware that wraps every HTTP request sent by the client and the response received for it.
+// See common.Middleware and common.UseMiddleware.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) Use(middleware ...common.Middleware) {
+	common.UseMiddleware(contextBasedRestrictions.Service, middleware...)
+}
+
+// UseTelemetry installs OpenTelemetry instrumentation that records a span and metrics for every request sent by the client.
+// See common.Telemetry.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) UseTelemetry(telemetry *common.Telemetry) {
+	common.UseTelemetry(contextBasedRestrictions.Service, telemetry)
+}
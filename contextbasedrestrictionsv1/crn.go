@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The operators of the resource attributes of rules.
+const (
+	crnAttributeOperatorStringEquals = "stringEquals"
+	crnAttributeOperatorStringMatch  = "stringMatch"
+)
+
+// CRNResourceAttributes returns the attributes of a rule resource that restrict the resource identified by a CRN,
+// or the resources matching a CRN mask.
+func CRNResourceAttributes(crn *common.CRN) (attributes []ResourceAttribute) {
+	values := crn.Attributes()
+	for _, name := range common.CRNAttributeNames {
+		if value, found := values[name]; found {
+			attribute := ResourceAttribute{Name: core.StringPtr(name), Value: core.StringPtr(value)}
+			if common.HasWildcards(value) {
+				attribute.Operator = core.StringPtr(crnAttributeOperatorStringMatch)
+			}
+			attributes = append(attributes, attribute)
+		}
+	}
+	return
+}
+
+// CRNResource returns the rule resource that restricts the resource identified by a CRN, or the resources
+// matching a CRN mask.
+func CRNResource(crn *common.CRN) *Resource {
+	return &Resource{Attributes: CRNResourceAttributes(crn)}
+}
+
+// CRNFromResource returns the CRN mask selected by the attributes of a rule resource. Attributes with operators
+// other than "stringEquals" and "stringMatch" are ignored.
+func CRNFromResource(resource *Resource) (*common.CRN, error) {
+	values := map[string]string{}
+	for _, attribute := range resource.Attributes {
+		operator := core.StringNilMapper(attribute.Operator)
+		if (operator == "" || operator == crnAttributeOperatorStringEquals || operator == crnAttributeOperatorStringMatch) &&
+			attribute.Name != nil && attribute.Value != nil {
+			values[*attribute.Name] = *attribute.Value
+		}
+	}
+	crn, err := common.CRNFromAttributes(values)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "invalid-crn-attributes")
+	}
+	return crn, err
+}
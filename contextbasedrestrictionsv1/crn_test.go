@@ -0,0 +1,48 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CRN rule resources`, func() {
+	It(`Converts CRNs to rule resources and back`, func() {
+		mask := common.NewCRN("cloud-object-storage").WithAccount("abc").WithResource("bucket", "logs-*")
+		resource := contextbasedrestrictionsv1.CRNResource(mask)
+		Expect(resource.Attributes).To(Equal([]contextbasedrestrictionsv1.ResourceAttribute{
+			{Name: core.StringPtr("accountId"), Value: core.StringPtr("abc")},
+			{Name: core.StringPtr("serviceName"), Value: core.StringPtr("cloud-object-storage")},
+			{Name: core.StringPtr("resourceType"), Value: core.StringPtr("bucket")},
+			{Name: core.StringPtr("resource"), Value: core.StringPtr("logs-*"), Operator: core.StringPtr("stringMatch")},
+		}))
+
+		crn, err := contextbasedrestrictionsv1.CRNFromResource(resource)
+		Expect(err).To(BeNil())
+		Expect(crn).To(Equal(mask))
+	})
+	It(`Rejects invalid attributes`, func() {
+		_, err := contextbasedrestrictionsv1.CRNFromResource(&contextbasedrestrictionsv1.Resource{
+			Attributes: []contextbasedrestrictionsv1.ResourceAttribute{{Name: core.StringPtr("region"), Value: core.StringPtr("US South")}},
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
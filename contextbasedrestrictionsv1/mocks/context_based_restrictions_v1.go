@@ -0,0 +1,439 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the ContextBasedRestrictionsV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// ContextBasedRestrictionsV1 : A testify mock of the ContextBasedRestrictionsV1 service client.
+type ContextBasedRestrictionsV1 struct {
+	mock.Mock
+}
+
+var _ contextbasedrestrictionsv1.ContextBasedRestrictionsV1Intf = (*ContextBasedRestrictionsV1)(nil)
+
+// CreateZone provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) CreateZone(createZoneOptions *contextbasedrestrictionsv1.CreateZoneOptions) (*contextbasedrestrictionsv1.Zone, *core.DetailedResponse, error) {
+	ret := _m.Called(createZoneOptions)
+	var r0 *contextbasedrestrictionsv1.Zone
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Zone)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateZoneWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) CreateZoneWithContext(ctx context.Context, createZoneOptions *contextbasedrestrictionsv1.CreateZoneOptions) (*contextbasedrestrictionsv1.Zone, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createZoneOptions)
+	var r0 *contextbasedrestrictionsv1.Zone
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Zone)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListZones provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ListZones(listZonesOptions *contextbasedrestrictionsv1.ListZonesOptions) (*contextbasedrestrictionsv1.ZoneList, *core.DetailedResponse, error) {
+	ret := _m.Called(listZonesOptions)
+	var r0 *contextbasedrestrictionsv1.ZoneList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.ZoneList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListZonesWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ListZonesWithContext(ctx context.Context, listZonesOptions *contextbasedrestrictionsv1.ListZonesOptions) (*contextbasedrestrictionsv1.ZoneList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listZonesOptions)
+	var r0 *contextbasedrestrictionsv1.ZoneList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.ZoneList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetZone provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) GetZone(getZoneOptions *contextbasedrestrictionsv1.GetZoneOptions) (*contextbasedrestrictionsv1.Zone, *core.DetailedResponse, error) {
+	ret := _m.Called(getZoneOptions)
+	var r0 *contextbasedrestrictionsv1.Zone
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Zone)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetZoneWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) GetZoneWithContext(ctx context.Context, getZoneOptions *contextbasedrestrictionsv1.GetZoneOptions) (*contextbasedrestrictionsv1.Zone, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getZoneOptions)
+	var r0 *contextbasedrestrictionsv1.Zone
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Zone)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceZone provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ReplaceZone(replaceZoneOptions *contextbasedrestrictionsv1.ReplaceZoneOptions) (*contextbasedrestrictionsv1.Zone, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceZoneOptions)
+	var r0 *contextbasedrestrictionsv1.Zone
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Zone)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceZoneWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ReplaceZoneWithContext(ctx context.Context, replaceZoneOptions *contextbasedrestrictionsv1.ReplaceZoneOptions) (*contextbasedrestrictionsv1.Zone, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceZoneOptions)
+	var r0 *contextbasedrestrictionsv1.Zone
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Zone)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteZone provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) DeleteZone(deleteZoneOptions *contextbasedrestrictionsv1.DeleteZoneOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteZoneOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteZoneWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) DeleteZoneWithContext(ctx context.Context, deleteZoneOptions *contextbasedrestrictionsv1.DeleteZoneOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteZoneOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListAvailableServicerefTargets provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ListAvailableServicerefTargets(listAvailableServicerefTargetsOptions *contextbasedrestrictionsv1.ListAvailableServicerefTargetsOptions) (*contextbasedrestrictionsv1.ServiceRefTargetList, *core.DetailedResponse, error) {
+	ret := _m.Called(listAvailableServicerefTargetsOptions)
+	var r0 *contextbasedrestrictionsv1.ServiceRefTargetList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.ServiceRefTargetList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAvailableServicerefTargetsWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ListAvailableServicerefTargetsWithContext(ctx context.Context, listAvailableServicerefTargetsOptions *contextbasedrestrictionsv1.ListAvailableServicerefTargetsOptions) (*contextbasedrestrictionsv1.ServiceRefTargetList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAvailableServicerefTargetsOptions)
+	var r0 *contextbasedrestrictionsv1.ServiceRefTargetList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.ServiceRefTargetList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetServicerefTarget provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) GetServicerefTarget(getServicerefTargetOptions *contextbasedrestrictionsv1.GetServicerefTargetOptions) (*contextbasedrestrictionsv1.ServiceRefTarget, *core.DetailedResponse, error) {
+	ret := _m.Called(getServicerefTargetOptions)
+	var r0 *contextbasedrestrictionsv1.ServiceRefTarget
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.ServiceRefTarget)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetServicerefTargetWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) GetServicerefTargetWithContext(ctx context.Context, getServicerefTargetOptions *contextbasedrestrictionsv1.GetServicerefTargetOptions) (*contextbasedrestrictionsv1.ServiceRefTarget, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getServicerefTargetOptions)
+	var r0 *contextbasedrestrictionsv1.ServiceRefTarget
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.ServiceRefTarget)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRule provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) CreateRule(createRuleOptions *contextbasedrestrictionsv1.CreateRuleOptions) (*contextbasedrestrictionsv1.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(createRuleOptions)
+	var r0 *contextbasedrestrictionsv1.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRuleWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) CreateRuleWithContext(ctx context.Context, createRuleOptions *contextbasedrestrictionsv1.CreateRuleOptions) (*contextbasedrestrictionsv1.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRuleOptions)
+	var r0 *contextbasedrestrictionsv1.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRules provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ListRules(listRulesOptions *contextbasedrestrictionsv1.ListRulesOptions) (*contextbasedrestrictionsv1.RuleList, *core.DetailedResponse, error) {
+	ret := _m.Called(listRulesOptions)
+	var r0 *contextbasedrestrictionsv1.RuleList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.RuleList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRulesWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ListRulesWithContext(ctx context.Context, listRulesOptions *contextbasedrestrictionsv1.ListRulesOptions) (*contextbasedrestrictionsv1.RuleList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listRulesOptions)
+	var r0 *contextbasedrestrictionsv1.RuleList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.RuleList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRule provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) GetRule(getRuleOptions *contextbasedrestrictionsv1.GetRuleOptions) (*contextbasedrestrictionsv1.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(getRuleOptions)
+	var r0 *contextbasedrestrictionsv1.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRuleWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) GetRuleWithContext(ctx context.Context, getRuleOptions *contextbasedrestrictionsv1.GetRuleOptions) (*contextbasedrestrictionsv1.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRuleOptions)
+	var r0 *contextbasedrestrictionsv1.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceRule provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ReplaceRule(replaceRuleOptions *contextbasedrestrictionsv1.ReplaceRuleOptions) (*contextbasedrestrictionsv1.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceRuleOptions)
+	var r0 *contextbasedrestrictionsv1.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceRuleWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ReplaceRuleWithContext(ctx context.Context, replaceRuleOptions *contextbasedrestrictionsv1.ReplaceRuleOptions) (*contextbasedrestrictionsv1.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceRuleOptions)
+	var r0 *contextbasedrestrictionsv1.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteRule provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) DeleteRule(deleteRuleOptions *contextbasedrestrictionsv1.DeleteRuleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRuleOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteRuleWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) DeleteRuleWithContext(ctx context.Context, deleteRuleOptions *contextbasedrestrictionsv1.DeleteRuleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRuleOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetAccountSettings provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) GetAccountSettings(getAccountSettingsOptions *contextbasedrestrictionsv1.GetAccountSettingsOptions) (*contextbasedrestrictionsv1.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountSettingsOptions)
+	var r0 *contextbasedrestrictionsv1.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSettingsWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *contextbasedrestrictionsv1.GetAccountSettingsOptions) (*contextbasedrestrictionsv1.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountSettingsOptions)
+	var r0 *contextbasedrestrictionsv1.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAvailableServiceOperations provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ListAvailableServiceOperations(listAvailableServiceOperationsOptions *contextbasedrestrictionsv1.ListAvailableServiceOperationsOptions) (*contextbasedrestrictionsv1.OperationsList, *core.DetailedResponse, error) {
+	ret := _m.Called(listAvailableServiceOperationsOptions)
+	var r0 *contextbasedrestrictionsv1.OperationsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.OperationsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAvailableServiceOperationsWithContext provides a mock function with the given fields.
+func (_m *ContextBasedRestrictionsV1) ListAvailableServiceOperationsWithContext(ctx context.Context, listAvailableServiceOperationsOptions *contextbasedrestrictionsv1.ListAvailableServiceOperationsOptions) (*contextbasedrestrictionsv1.OperationsList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAvailableServiceOperationsOptions)
+	var r0 *contextbasedrestrictionsv1.OperationsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*contextbasedrestrictionsv1.OperationsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
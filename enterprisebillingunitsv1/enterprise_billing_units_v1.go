@@ -182,7 +182,7 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) GetBillingUnitWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_billing_units", "V1", "GetBillingUnit"))
 	builder.EnableGzipCompression = enterpriseBillingUnits.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseBillingUnits.Service.Options.URL, `/v1/billing-units/{billing_unit_id}`, pathParamsMap)
 	if err != nil {
@@ -207,6 +207,8 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) GetBillingUnitWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = enterpriseBillingUnits.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_billing_unit", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -235,7 +237,7 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) ListBillingUnitsWithCont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_billing_units", "V1", "ListBillingUnits"))
 	builder.EnableGzipCompression = enterpriseBillingUnits.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseBillingUnits.Service.Options.URL, `/v1/billing-units`, nil)
 	if err != nil {
@@ -276,6 +278,8 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) ListBillingUnitsWithCont
 	var rawResponse map[string]json.RawMessage
 	response, err = enterpriseBillingUnits.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "list_billing_units", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -308,7 +312,7 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) ListBillingOptionsWithCo
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_billing_units", "V1", "ListBillingOptions"))
 	builder.EnableGzipCompression = enterpriseBillingUnits.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseBillingUnits.Service.Options.URL, `/v1/billing-options`, nil)
 	if err != nil {
@@ -341,6 +345,8 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) ListBillingOptionsWithCo
 	var rawResponse map[string]json.RawMessage
 	response, err = enterpriseBillingUnits.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "list_billing_options", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -374,7 +380,7 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) GetCreditPoolsWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_billing_units", "V1", "GetCreditPools"))
 	builder.EnableGzipCompression = enterpriseBillingUnits.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseBillingUnits.Service.Options.URL, `/v1/credit-pools`, nil)
 	if err != nil {
@@ -413,6 +419,8 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) GetCreditPoolsWithContex
 	var rawResponse map[string]json.RawMessage
 	response, err = enterpriseBillingUnits.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_credit_pools", core.NewProblemComponent(DefaultServiceName, "1.0.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
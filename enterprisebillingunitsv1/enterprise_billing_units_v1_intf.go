@@ -0,0 +1,48 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package enterprisebillingunitsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// EnterpriseBillingUnitsV1Intf : The operations of the EnterpriseBillingUnitsV1 service client.
+// The interface is satisfied by *EnterpriseBillingUnitsV1 and by mocks.EnterpriseBillingUnitsV1, allowing consumers to substitute a mock for the client in tests.
+type EnterpriseBillingUnitsV1Intf interface {
+	// GetBillingUnit : Get billing unit by ID
+	GetBillingUnit(getBillingUnitOptions *GetBillingUnitOptions) (result *BillingUnit, response *core.DetailedResponse, err error)
+	// GetBillingUnitWithContext is an alternate form of the GetBillingUnit method which supports a Context parameter
+	GetBillingUnitWithContext(ctx context.Context, getBillingUnitOptions *GetBillingUnitOptions) (result *BillingUnit, response *core.DetailedResponse, err error)
+	// ListBillingUnits : List billing units
+	ListBillingUnits(listBillingUnitsOptions *ListBillingUnitsOptions) (result *BillingUnitsList, response *core.DetailedResponse, err error)
+	// ListBillingUnitsWithContext is an alternate form of the ListBillingUnits method which supports a Context parameter
+	ListBillingUnitsWithContext(ctx context.Context, listBillingUnitsOptions *ListBillingUnitsOptions) (result *BillingUnitsList, response *core.DetailedResponse, err error)
+	// ListBillingOptions : List billing options
+	ListBillingOptions(listBillingOptionsOptions *ListBillingOptionsOptions) (result *BillingOptionsList, response *core.DetailedResponse, err error)
+	// ListBillingOptionsWithContext is an alternate form of the ListBillingOptions method which supports a Context parameter
+	ListBillingOptionsWithContext(ctx context.Context, listBillingOptionsOptions *ListBillingOptionsOptions) (result *BillingOptionsList, response *core.DetailedResponse, err error)
+	// GetCreditPools : Get credit pools
+	GetCreditPools(getCreditPoolsOptions *GetCreditPoolsOptions) (result *CreditPoolsList, response *core.DetailedResponse, err error)
+	// GetCreditPoolsWithContext is an alternate form of the GetCreditPools method which supports a Context parameter
+	GetCreditPoolsWithContext(ctx context.Context, getCreditPoolsOptions *GetCreditPoolsOptions) (result *CreditPoolsList, response *core.DetailedResponse, err error)
+}
+
+var _ EnterpriseBillingUnitsV1Intf = (*EnterpriseBillingUnitsV1)(nil)
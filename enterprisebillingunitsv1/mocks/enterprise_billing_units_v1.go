@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the EnterpriseBillingUnitsV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// EnterpriseBillingUnitsV1 : A testify mock of the EnterpriseBillingUnitsV1 service client.
+type EnterpriseBillingUnitsV1 struct {
+	mock.Mock
+}
+
+var _ enterprisebillingunitsv1.EnterpriseBillingUnitsV1Intf = (*EnterpriseBillingUnitsV1)(nil)
+
+// GetBillingUnit provides a mock function with the given fields.
+func (_m *EnterpriseBillingUnitsV1) GetBillingUnit(getBillingUnitOptions *enterprisebillingunitsv1.GetBillingUnitOptions) (*enterprisebillingunitsv1.BillingUnit, *core.DetailedResponse, error) {
+	ret := _m.Called(getBillingUnitOptions)
+	var r0 *enterprisebillingunitsv1.BillingUnit
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisebillingunitsv1.BillingUnit)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetBillingUnitWithContext provides a mock function with the given fields.
+func (_m *EnterpriseBillingUnitsV1) GetBillingUnitWithContext(ctx context.Context, getBillingUnitOptions *enterprisebillingunitsv1.GetBillingUnitOptions) (*enterprisebillingunitsv1.BillingUnit, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getBillingUnitOptions)
+	var r0 *enterprisebillingunitsv1.BillingUnit
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisebillingunitsv1.BillingUnit)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListBillingUnits provides a mock function with the given fields.
+func (_m *EnterpriseBillingUnitsV1) ListBillingUnits(listBillingUnitsOptions *enterprisebillingunitsv1.ListBillingUnitsOptions) (*enterprisebillingunitsv1.BillingUnitsList, *core.DetailedResponse, error) {
+	ret := _m.Called(listBillingUnitsOptions)
+	var r0 *enterprisebillingunitsv1.BillingUnitsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisebillingunitsv1.BillingUnitsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListBillingUnitsWithContext provides a mock function with the given fields.
+func (_m *EnterpriseBillingUnitsV1) ListBillingUnitsWithContext(ctx context.Context, listBillingUnitsOptions *enterprisebillingunitsv1.ListBillingUnitsOptions) (*enterprisebillingunitsv1.BillingUnitsList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listBillingUnitsOptions)
+	var r0 *enterprisebillingunitsv1.BillingUnitsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisebillingunitsv1.BillingUnitsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListBillingOptions provides a mock function with the given fields.
+func (_m *EnterpriseBillingUnitsV1) ListBillingOptions(listBillingOptionsOptions *enterprisebillingunitsv1.ListBillingOptionsOptions) (*enterprisebillingunitsv1.BillingOptionsList, *core.DetailedResponse, error) {
+	ret := _m.Called(listBillingOptionsOptions)
+	var r0 *enterprisebillingunitsv1.BillingOptionsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisebillingunitsv1.BillingOptionsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListBillingOptionsWithContext provides a mock function with the given fields.
+func (_m *EnterpriseBillingUnitsV1) ListBillingOptionsWithContext(ctx context.Context, listBillingOptionsOptions *enterprisebillingunitsv1.ListBillingOptionsOptions) (*enterprisebillingunitsv1.BillingOptionsList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listBillingOptionsOptions)
+	var r0 *enterprisebillingunitsv1.BillingOptionsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisebillingunitsv1.BillingOptionsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCreditPools provides a mock function with the given fields.
+func (_m *EnterpriseBillingUnitsV1) GetCreditPools(getCreditPoolsOptions *enterprisebillingunitsv1.GetCreditPoolsOptions) (*enterprisebillingunitsv1.CreditPoolsList, *core.DetailedResponse, error) {
+	ret := _m.Called(getCreditPoolsOptions)
+	var r0 *enterprisebillingunitsv1.CreditPoolsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisebillingunitsv1.CreditPoolsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCreditPoolsWithContext provides a mock function with the given fields.
+func (_m *EnterpriseBillingUnitsV1) GetCreditPoolsWithContext(ctx context.Context, getCreditPoolsOptions *enterprisebillingunitsv1.GetCreditPoolsOptions) (*enterprisebillingunitsv1.CreditPoolsList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCreditPoolsOptions)
+	var r0 *enterprisebillingunitsv1.CreditPoolsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisebillingunitsv1.CreditPoolsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
@@ -198,7 +198,7 @@ func (enterpriseManagement *EnterpriseManagementV1) CreateEnterpriseWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "CreateEnterprise"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/enterprises`, nil)
 	if err != nil {
@@ -246,7 +246,7 @@ func (enterpriseManagement *EnterpriseManagementV1) CreateEnterpriseWithContext(
 	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_enterprise", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -285,7 +285,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ListEnterprisesWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "ListEnterprises"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/enterprises`, nil)
 	if err != nil {
@@ -329,7 +329,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ListEnterprisesWithContext(c
 	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_enterprises", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -371,7 +371,7 @@ func (enterpriseManagement *EnterpriseManagementV1) GetEnterpriseWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "GetEnterprise"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/enterprises/{enterprise_id}`, pathParamsMap)
 	if err != nil {
@@ -399,7 +399,7 @@ func (enterpriseManagement *EnterpriseManagementV1) GetEnterpriseWithContext(ctx
 	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_enterprise", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -441,7 +441,7 @@ func (enterpriseManagement *EnterpriseManagementV1) UpdateEnterpriseWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "UpdateEnterprise"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/enterprises/{enterprise_id}`, pathParamsMap)
 	if err != nil {
@@ -484,7 +484,7 @@ func (enterpriseManagement *EnterpriseManagementV1) UpdateEnterpriseWithContext(
 	response, err = enterpriseManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_enterprise", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -524,7 +524,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ImportAccountToEnterpriseWit
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "ImportAccountToEnterprise"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/enterprises/{enterprise_id}/import/accounts/{account_id}`, pathParamsMap)
 	if err != nil {
@@ -564,7 +564,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ImportAccountToEnterpriseWit
 	response, err = enterpriseManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "import_account_to_enterprise", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -596,7 +596,7 @@ func (enterpriseManagement *EnterpriseManagementV1) CreateAccountWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "CreateAccount"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/accounts`, nil)
 	if err != nil {
@@ -647,7 +647,7 @@ func (enterpriseManagement *EnterpriseManagementV1) CreateAccountWithContext(ctx
 	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -687,7 +687,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ListAccountsWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "ListAccounts"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/accounts`, nil)
 	if err != nil {
@@ -734,7 +734,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ListAccountsWithContext(ctx
 	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_accounts", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -776,7 +776,7 @@ func (enterpriseManagement *EnterpriseManagementV1) GetAccountWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "GetAccount"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/accounts/{account_id}`, pathParamsMap)
 	if err != nil {
@@ -804,7 +804,7 @@ func (enterpriseManagement *EnterpriseManagementV1) GetAccountWithContext(ctx co
 	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -845,7 +845,7 @@ func (enterpriseManagement *EnterpriseManagementV1) UpdateAccountWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "UpdateAccount"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/accounts/{account_id}`, pathParamsMap)
 	if err != nil {
@@ -882,7 +882,7 @@ func (enterpriseManagement *EnterpriseManagementV1) UpdateAccountWithContext(ctx
 	response, err = enterpriseManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -916,7 +916,7 @@ func (enterpriseManagement *EnterpriseManagementV1) DeleteAccountWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "DeleteAccount"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/accounts/{account_id}`, pathParamsMap)
 	if err != nil {
@@ -942,7 +942,7 @@ func (enterpriseManagement *EnterpriseManagementV1) DeleteAccountWithContext(ctx
 	response, err = enterpriseManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -973,7 +973,7 @@ func (enterpriseManagement *EnterpriseManagementV1) CreateAccountGroupWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "CreateAccountGroup"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/account-groups`, nil)
 	if err != nil {
@@ -1018,7 +1018,7 @@ func (enterpriseManagement *EnterpriseManagementV1) CreateAccountGroupWithContex
 	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1059,7 +1059,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ListAccountGroupsWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "ListAccountGroups"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/account-groups`, nil)
 	if err != nil {
@@ -1106,7 +1106,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ListAccountGroupsWithContext
 	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_account_groups", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1148,7 +1148,7 @@ func (enterpriseManagement *EnterpriseManagementV1) GetAccountGroupWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "GetAccountGroup"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/account-groups/{account_group_id}`, pathParamsMap)
 	if err != nil {
@@ -1176,7 +1176,7 @@ func (enterpriseManagement *EnterpriseManagementV1) GetAccountGroupWithContext(c
 	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1218,7 +1218,7 @@ func (enterpriseManagement *EnterpriseManagementV1) UpdateAccountGroupWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "UpdateAccountGroup"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/account-groups/{account_group_id}`, pathParamsMap)
 	if err != nil {
@@ -1258,7 +1258,7 @@ func (enterpriseManagement *EnterpriseManagementV1) UpdateAccountGroupWithContex
 	response, err = enterpriseManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1293,7 +1293,7 @@ func (enterpriseManagement *EnterpriseManagementV1) DeleteAccountGroupWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_management", "V1", "DeleteAccountGroup"))
 	builder.EnableGzipCompression = enterpriseManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseManagement.Service.Options.URL, `/account-groups/{account_group_id}`, pathParamsMap)
 	if err != nil {
@@ -1319,7 +1319,7 @@ func (enterpriseManagement *EnterpriseManagementV1) DeleteAccountGroupWithContex
 	response, err = enterpriseManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_account_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package enterprisemanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// EnterpriseManagementV1Intf : The operations of the EnterpriseManagementV1 service client.
+// The interface is satisfied by *EnterpriseManagementV1 and by mocks.EnterpriseManagementV1, allowing consumers to substitute a mock for the client in tests.
+type EnterpriseManagementV1Intf interface {
+	// CreateEnterprise : Create an enterprise
+	CreateEnterprise(createEnterpriseOptions *CreateEnterpriseOptions) (result *CreateEnterpriseResponse, response *core.DetailedResponse, err error)
+	// CreateEnterpriseWithContext is an alternate form of the CreateEnterprise method which supports a Context parameter
+	CreateEnterpriseWithContext(ctx context.Context, createEnterpriseOptions *CreateEnterpriseOptions) (result *CreateEnterpriseResponse, response *core.DetailedResponse, err error)
+	// ListEnterprises : List enterprises
+	ListEnterprises(listEnterprisesOptions *ListEnterprisesOptions) (result *ListEnterprisesResponse, response *core.DetailedResponse, err error)
+	// ListEnterprisesWithContext is an alternate form of the ListEnterprises method which supports a Context parameter
+	ListEnterprisesWithContext(ctx context.Context, listEnterprisesOptions *ListEnterprisesOptions) (result *ListEnterprisesResponse, response *core.DetailedResponse, err error)
+	// GetEnterprise : Get enterprise by ID
+	GetEnterprise(getEnterpriseOptions *GetEnterpriseOptions) (result *Enterprise, response *core.DetailedResponse, err error)
+	// GetEnterpriseWithContext is an alternate form of the GetEnterprise method which supports a Context parameter
+	GetEnterpriseWithContext(ctx context.Context, getEnterpriseOptions *GetEnterpriseOptions) (result *Enterprise, response *core.DetailedResponse, err error)
+	// UpdateEnterprise : Update an enterprise
+	UpdateEnterprise(updateEnterpriseOptions *UpdateEnterpriseOptions) (response *core.DetailedResponse, err error)
+	// UpdateEnterpriseWithContext is an alternate form of the UpdateEnterprise method which supports a Context parameter
+	UpdateEnterpriseWithContext(ctx context.Context, updateEnterpriseOptions *UpdateEnterpriseOptions) (response *core.DetailedResponse, err error)
+	// ImportAccountToEnterprise : Import an account into an enterprise
+	ImportAccountToEnterprise(importAccountToEnterpriseOptions *ImportAccountToEnterpriseOptions) (response *core.DetailedResponse, err error)
+	// ImportAccountToEnterpriseWithContext is an alternate form of the ImportAccountToEnterprise method which supports a Context parameter
+	ImportAccountToEnterpriseWithContext(ctx context.Context, importAccountToEnterpriseOptions *ImportAccountToEnterpriseOptions) (response *core.DetailedResponse, err error)
+	// CreateAccount : Create a new account in an enterprise
+	CreateAccount(createAccountOptions *CreateAccountOptions) (result *CreateAccountResponse, response *core.DetailedResponse, err error)
+	// CreateAccountWithContext is an alternate form of the CreateAccount method which supports a Context parameter
+	CreateAccountWithContext(ctx context.Context, createAccountOptions *CreateAccountOptions) (result *CreateAccountResponse, response *core.DetailedResponse, err error)
+	// ListAccounts : List accounts
+	ListAccounts(listAccountsOptions *ListAccountsOptions) (result *ListAccountsResponse, response *core.DetailedResponse, err error)
+	// ListAccountsWithContext is an alternate form of the ListAccounts method which supports a Context parameter
+	ListAccountsWithContext(ctx context.Context, listAccountsOptions *ListAccountsOptions) (result *ListAccountsResponse, response *core.DetailedResponse, err error)
+	// GetAccount : Get account by ID
+	GetAccount(getAccountOptions *GetAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+	// GetAccountWithContext is an alternate form of the GetAccount method which supports a Context parameter
+	GetAccountWithContext(ctx context.Context, getAccountOptions *GetAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+	// UpdateAccount : Move an account within the enterprise
+	UpdateAccount(updateAccountOptions *UpdateAccountOptions) (response *core.DetailedResponse, err error)
+	// UpdateAccountWithContext is an alternate form of the UpdateAccount method which supports a Context parameter
+	UpdateAccountWithContext(ctx context.Context, updateAccountOptions *UpdateAccountOptions) (response *core.DetailedResponse, err error)
+	// DeleteAccount : Remove an account from its enterprise
+	DeleteAccount(deleteAccountOptions *DeleteAccountOptions) (response *core.DetailedResponse, err error)
+	// DeleteAccountWithContext is an alternate form of the DeleteAccount method which supports a Context parameter
+	DeleteAccountWithContext(ctx context.Context, deleteAccountOptions *DeleteAccountOptions) (response *core.DetailedResponse, err error)
+	// CreateAccountGroup : Create an account group
+	CreateAccountGroup(createAccountGroupOptions *CreateAccountGroupOptions) (result *CreateAccountGroupResponse, response *core.DetailedResponse, err error)
+	// CreateAccountGroupWithContext is an alternate form of the CreateAccountGroup method which supports a Context parameter
+	CreateAccountGroupWithContext(ctx context.Context, createAccountGroupOptions *CreateAccountGroupOptions) (result *CreateAccountGroupResponse, response *core.DetailedResponse, err error)
+	// ListAccountGroups : List account groups
+	ListAccountGroups(listAccountGroupsOptions *ListAccountGroupsOptions) (result *ListAccountGroupsResponse, response *core.DetailedResponse, err error)
+	// ListAccountGroupsWithContext is an alternate form of the ListAccountGroups method which supports a Context parameter
+	ListAccountGroupsWithContext(ctx context.Context, listAccountGroupsOptions *ListAccountGroupsOptions) (result *ListAccountGroupsResponse, response *core.DetailedResponse, err error)
+	// GetAccountGroup : Get account group by ID
+	GetAccountGroup(getAccountGroupOptions *GetAccountGroupOptions) (result *AccountGroup, response *core.DetailedResponse, err error)
+	// GetAccountGroupWithContext is an alternate form of the GetAccountGroup method which supports a Context parameter
+	GetAccountGroupWithContext(ctx context.Context, getAccountGroupOptions *GetAccountGroupOptions) (result *AccountGroup, response *core.DetailedResponse, err error)
+	// UpdateAccountGroup : Update an account group
+	UpdateAccountGroup(updateAccountGroupOptions *UpdateAccountGroupOptions) (response *core.DetailedResponse, err error)
+	// UpdateAccountGroupWithContext is an alternate form of the UpdateAccountGroup method which supports a Context parameter
+	UpdateAccountGroupWithContext(ctx context.Context, updateAccountGroupOptions *UpdateAccountGroupOptions) (response *core.DetailedResponse, err error)
+	// DeleteAccountGroup : Delete an account group from the enterprise
+	DeleteAccountGroup(deleteAccountGroupOptions *DeleteAccountGroupOptions) (response *core.DetailedResponse, err error)
+	// DeleteAccountGroupWithContext is an alternate form of the DeleteAccountGroup method which supports a Context parameter
+	DeleteAccountGroupWithContext(ctx context.Context, deleteAccountGroupOptions *DeleteAccountGroupOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ EnterpriseManagementV1Intf = (*EnterpriseManagementV1)(nil)
@@ -0,0 +1,446 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the EnterpriseManagementV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// EnterpriseManagementV1 : A testify mock of the EnterpriseManagementV1 service client.
+type EnterpriseManagementV1 struct {
+	mock.Mock
+}
+
+var _ enterprisemanagementv1.EnterpriseManagementV1Intf = (*EnterpriseManagementV1)(nil)
+
+// CreateEnterprise provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) CreateEnterprise(createEnterpriseOptions *enterprisemanagementv1.CreateEnterpriseOptions) (*enterprisemanagementv1.CreateEnterpriseResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createEnterpriseOptions)
+	var r0 *enterprisemanagementv1.CreateEnterpriseResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.CreateEnterpriseResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateEnterpriseWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) CreateEnterpriseWithContext(ctx context.Context, createEnterpriseOptions *enterprisemanagementv1.CreateEnterpriseOptions) (*enterprisemanagementv1.CreateEnterpriseResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createEnterpriseOptions)
+	var r0 *enterprisemanagementv1.CreateEnterpriseResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.CreateEnterpriseResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListEnterprises provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) ListEnterprises(listEnterprisesOptions *enterprisemanagementv1.ListEnterprisesOptions) (*enterprisemanagementv1.ListEnterprisesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listEnterprisesOptions)
+	var r0 *enterprisemanagementv1.ListEnterprisesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.ListEnterprisesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListEnterprisesWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) ListEnterprisesWithContext(ctx context.Context, listEnterprisesOptions *enterprisemanagementv1.ListEnterprisesOptions) (*enterprisemanagementv1.ListEnterprisesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listEnterprisesOptions)
+	var r0 *enterprisemanagementv1.ListEnterprisesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.ListEnterprisesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetEnterprise provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) GetEnterprise(getEnterpriseOptions *enterprisemanagementv1.GetEnterpriseOptions) (*enterprisemanagementv1.Enterprise, *core.DetailedResponse, error) {
+	ret := _m.Called(getEnterpriseOptions)
+	var r0 *enterprisemanagementv1.Enterprise
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.Enterprise)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetEnterpriseWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) GetEnterpriseWithContext(ctx context.Context, getEnterpriseOptions *enterprisemanagementv1.GetEnterpriseOptions) (*enterprisemanagementv1.Enterprise, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getEnterpriseOptions)
+	var r0 *enterprisemanagementv1.Enterprise
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.Enterprise)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateEnterprise provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) UpdateEnterprise(updateEnterpriseOptions *enterprisemanagementv1.UpdateEnterpriseOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(updateEnterpriseOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateEnterpriseWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) UpdateEnterpriseWithContext(ctx context.Context, updateEnterpriseOptions *enterprisemanagementv1.UpdateEnterpriseOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateEnterpriseOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ImportAccountToEnterprise provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) ImportAccountToEnterprise(importAccountToEnterpriseOptions *enterprisemanagementv1.ImportAccountToEnterpriseOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(importAccountToEnterpriseOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ImportAccountToEnterpriseWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) ImportAccountToEnterpriseWithContext(ctx context.Context, importAccountToEnterpriseOptions *enterprisemanagementv1.ImportAccountToEnterpriseOptions) (*core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, importAccountToEnterpriseOptions)
+	var r0 *core.
+		DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.
+			DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateAccount provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) CreateAccount(createAccountOptions *enterprisemanagementv1.CreateAccountOptions) (*enterprisemanagementv1.CreateAccountResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createAccountOptions)
+	var r0 *enterprisemanagementv1.CreateAccountResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.CreateAccountResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAccountWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) CreateAccountWithContext(ctx context.Context, createAccountOptions *enterprisemanagementv1.CreateAccountOptions) (*enterprisemanagementv1.CreateAccountResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createAccountOptions)
+	var r0 *enterprisemanagementv1.CreateAccountResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.CreateAccountResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccounts provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) ListAccounts(listAccountsOptions *enterprisemanagementv1.ListAccountsOptions) (*enterprisemanagementv1.ListAccountsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listAccountsOptions)
+	var r0 *enterprisemanagementv1.ListAccountsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.ListAccountsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccountsWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) ListAccountsWithContext(ctx context.Context, listAccountsOptions *enterprisemanagementv1.ListAccountsOptions) (*enterprisemanagementv1.ListAccountsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAccountsOptions)
+	var r0 *enterprisemanagementv1.ListAccountsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.ListAccountsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccount provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) GetAccount(getAccountOptions *enterprisemanagementv1.GetAccountOptions) (*enterprisemanagementv1.Account, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountOptions)
+	var r0 *enterprisemanagementv1.Account
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.Account)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) GetAccountWithContext(ctx context.Context, getAccountOptions *enterprisemanagementv1.GetAccountOptions) (*enterprisemanagementv1.Account, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountOptions)
+	var r0 *enterprisemanagementv1.Account
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.Account)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccount provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) UpdateAccount(updateAccountOptions *enterprisemanagementv1.UpdateAccountOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(updateAccountOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateAccountWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) UpdateAccountWithContext(ctx context.Context, updateAccountOptions *enterprisemanagementv1.UpdateAccountOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAccountOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAccount provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) DeleteAccount(deleteAccountOptions *enterprisemanagementv1.DeleteAccountOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteAccountOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAccountWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) DeleteAccountWithContext(ctx context.Context, deleteAccountOptions *enterprisemanagementv1.DeleteAccountOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAccountOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateAccountGroup provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) CreateAccountGroup(createAccountGroupOptions *enterprisemanagementv1.CreateAccountGroupOptions) (*enterprisemanagementv1.CreateAccountGroupResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(createAccountGroupOptions)
+	var r0 *enterprisemanagementv1.CreateAccountGroupResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.CreateAccountGroupResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAccountGroupWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) CreateAccountGroupWithContext(ctx context.Context, createAccountGroupOptions *enterprisemanagementv1.CreateAccountGroupOptions) (*enterprisemanagementv1.CreateAccountGroupResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createAccountGroupOptions)
+	var r0 *enterprisemanagementv1.CreateAccountGroupResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.CreateAccountGroupResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccountGroups provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) ListAccountGroups(listAccountGroupsOptions *enterprisemanagementv1.ListAccountGroupsOptions) (*enterprisemanagementv1.ListAccountGroupsResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(listAccountGroupsOptions)
+	var r0 *enterprisemanagementv1.ListAccountGroupsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.ListAccountGroupsResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccountGroupsWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) ListAccountGroupsWithContext(ctx context.Context, listAccountGroupsOptions *enterprisemanagementv1.ListAccountGroupsOptions) (*enterprisemanagementv1.ListAccountGroupsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAccountGroupsOptions)
+	var r0 *enterprisemanagementv1.ListAccountGroupsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.ListAccountGroupsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountGroup provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) GetAccountGroup(getAccountGroupOptions *enterprisemanagementv1.GetAccountGroupOptions) (*enterprisemanagementv1.AccountGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountGroupOptions)
+	var r0 *enterprisemanagementv1.AccountGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.AccountGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountGroupWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) GetAccountGroupWithContext(ctx context.Context, getAccountGroupOptions *enterprisemanagementv1.GetAccountGroupOptions) (*enterprisemanagementv1.AccountGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountGroupOptions)
+	var r0 *enterprisemanagementv1.AccountGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterprisemanagementv1.AccountGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountGroup provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) UpdateAccountGroup(updateAccountGroupOptions *enterprisemanagementv1.UpdateAccountGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(updateAccountGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateAccountGroupWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) UpdateAccountGroupWithContext(ctx context.Context, updateAccountGroupOptions *enterprisemanagementv1.UpdateAccountGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAccountGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAccountGroup provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) DeleteAccountGroup(deleteAccountGroupOptions *enterprisemanagementv1.DeleteAccountGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteAccountGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAccountGroupWithContext provides a mock function with the given fields.
+func (_m *EnterpriseManagementV1) DeleteAccountGroupWithContext(ctx context.Context, deleteAccountGroupOptions *enterprisemanagementv1.DeleteAccountGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAccountGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
@@ -174,7 +174,7 @@ func (enterpriseUsageReports *EnterpriseUsageReportsV1) GetResourceUsageReportWi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "enterprise_usage_reports", "V1", "GetResourceUsageReport"))
 	builder.EnableGzipCompression = enterpriseUsageReports.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(enterpriseUsageReports.Service.Options.URL, `/v1/resource-usage-reports`, nil)
 	if err != nil {
@@ -224,6 +224,8 @@ func (enterpriseUsageReports *EnterpriseUsageReportsV1) GetResourceUsageReportWi
 	var rawResponse map[string]json.RawMessage
 	response, err = enterpriseUsageReports.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_resource_usage_report", core.NewProblemComponent(DefaultServiceName, "1.0.0-beta.1"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
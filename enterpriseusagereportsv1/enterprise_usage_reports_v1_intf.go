@@ -0,0 +1,36 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package enterpriseusagereportsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// EnterpriseUsageReportsV1Intf : The operations of the EnterpriseUsageReportsV1 service client.
+// The interface is satisfied by *EnterpriseUsageReportsV1 and by mocks.EnterpriseUsageReportsV1, allowing consumers to substitute a mock for the client in tests.
+type EnterpriseUsageReportsV1Intf interface {
+	// GetResourceUsageReport : Get usage reports for enterprise entities
+	GetResourceUsageReport(getResourceUsageReportOptions *GetResourceUsageReportOptions) (result *Reports, response *core.DetailedResponse, err error)
+	// GetResourceUsageReportWithContext is an alternate form of the GetResourceUsageReport method which supports a Context parameter
+	GetResourceUsageReportWithContext(ctx context.Context, getResourceUsageReportOptions *GetResourceUsageReportOptions) (result *Reports, response *core.DetailedResponse, err error)
+}
+
+var _ EnterpriseUsageReportsV1Intf = (*EnterpriseUsageReportsV1)(nil)
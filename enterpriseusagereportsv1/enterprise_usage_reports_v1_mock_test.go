@@ -0,0 +1,43 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package enterpriseusagereportsv1_test
+
+import (
+	"errors"
+
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1/mocks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ enterpriseusagereportsv1.EnterpriseUsageReportsV1Intf = (*mocks.EnterpriseUsageReportsV1)(nil)
+
+var _ = Describe(`mocks.EnterpriseUsageReportsV1`, func() {
+	It(`Can be stubbed and invoked through EnterpriseUsageReportsV1Intf`, func() {
+		stub := new(mocks.EnterpriseUsageReportsV1)
+		stub.On("GetResourceUsageReport", mock.Anything).Return(nil, nil, errors.New("boom"))
+
+		var client enterpriseusagereportsv1.EnterpriseUsageReportsV1Intf = stub
+		_, _, err := client.GetResourceUsageReport(nil)
+		Expect(err).To(MatchError("boom"))
+		stub.AssertNumberOfCalls(GinkgoT(), "GetResourceUsageReport", 1)
+	})
+})
@@ -0,0 +1,65 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the EnterpriseUsageReportsV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// EnterpriseUsageReportsV1 : A testify mock of the EnterpriseUsageReportsV1 service client.
+type EnterpriseUsageReportsV1 struct {
+	mock.Mock
+}
+
+var _ enterpriseusagereportsv1.EnterpriseUsageReportsV1Intf = (*EnterpriseUsageReportsV1)(nil)
+
+// GetResourceUsageReport provides a mock function with the given fields.
+func (_m *EnterpriseUsageReportsV1) GetResourceUsageReport(getResourceUsageReportOptions *enterpriseusagereportsv1.GetResourceUsageReportOptions) (*enterpriseusagereportsv1.Reports, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceUsageReportOptions)
+	var r0 *enterpriseusagereportsv1.Reports
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterpriseusagereportsv1.Reports)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceUsageReportWithContext provides a mock function with the given fields.
+func (_m *EnterpriseUsageReportsV1) GetResourceUsageReportWithContext(ctx context.Context, getResourceUsageReportOptions *enterpriseusagereportsv1.GetResourceUsageReportOptions) (*enterpriseusagereportsv1.Reports, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceUsageReportOptions)
+	var r0 *enterpriseusagereportsv1.Reports
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*enterpriseusagereportsv1.Reports)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
@@ -0,0 +1,567 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// CatalogSnapshotVersionConst : The version of the format of the snapshots written by CatalogMirror.
+const CatalogSnapshotVersionConst = 1
+
+// The kind of the catalog entries that describe the deployments of plans in a location.
+const catalogEntryKindDeployment = "deployment"
+
+// CatalogMirrorOptions : The options of NewCatalogMirror.
+type CatalogMirrorOptions struct {
+	// The client used to refresh the mirror.
+	Service GlobalCatalogV1Intf `validate:"required,structonly"`
+
+	// The file in which the snapshot of the catalog is stored.
+	Path string `validate:"required"`
+
+	// A query filter that selects the top-level catalog entries to mirror with their children, such as
+	// "kind:service". All entries are mirrored by default.
+	Q string
+
+	// The scope of the requests, such as "global". See ListCatalogEntriesOptions.Account.
+	Account string
+
+	// Also mirror the visibility of each catalog entry, which takes one more request per entry.
+	IncludeVisibility bool
+
+	// The maximum number of catalog trees fetched at the same time. Defaults to 8.
+	Concurrency int
+
+	// The number of catalog entries requested per page. Defaults to 200.
+	PageSize int64
+}
+
+// MirroredEntry : A catalog entry of a mirror.
+type MirroredEntry struct {
+	// The catalog entry, with all its properties.
+	Entry *CatalogEntry `json:"entry"`
+
+	// The visibility of the catalog entry, if the mirror includes visibility.
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// CatalogSnapshot : The content of the file in which a CatalogMirror stores the catalog.
+type CatalogSnapshot struct {
+	// The version of the format of the snapshot, CatalogSnapshotVersionConst.
+	Version int `json:"version"`
+
+	// The time at which the snapshot was last refreshed.
+	RefreshedAt time.Time `json:"refreshed_at"`
+
+	// The query filter of the mirrored top-level catalog entries.
+	Q string `json:"q,omitempty"`
+
+	// The catalog entries, parents before children.
+	Entries []*MirroredEntry `json:"entries"`
+}
+
+// CatalogRefreshResult : The changes made to a mirror by a refresh.
+type CatalogRefreshResult struct {
+	// The IDs of the catalog entries added, updated and removed.
+	Added   []string
+	Updated []string
+	Removed []string
+
+	// The number of top-level catalog entries whose trees were mirrored again, and of those whose trees were unchanged.
+	Fetched   int
+	Unchanged int
+}
+
+// CatalogMirror : A local copy of the Global Catalog, or of part of it, that is stored in a file and serves
+// lookups offline from an in-memory CatalogIndex.
+//
+// Refresh fetches the top-level catalog entries (services, for example) and lists their trees of children (plans,
+// deployments, ...), since a change of a child does not change the Updated time of its parent. The trees in which no
+// entry was added, removed or updated are kept from the previous snapshot, with their visibility. Use Rebuild to
+// mirror every tree again.
+type CatalogMirror struct {
+	options *CatalogMirrorOptions
+
+	// Serializes refreshes.
+	refreshing sync.Mutex
+
+	mutex    sync.Mutex
+	snapshot *CatalogSnapshot
+	index    *CatalogIndex
+}
+
+// NewCatalogMirror : constructs an instance of CatalogMirror with the specified options, and loads the stored
+// snapshot if it exists.
+func NewCatalogMirror(options *CatalogMirrorOptions) (mirror *CatalogMirror, err error) {
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	mirror = &CatalogMirror{options: options}
+	snapshot := &CatalogSnapshot{Version: CatalogSnapshotVersionConst, Q: options.Q}
+	if _, statErr := os.Stat(options.Path); statErr == nil {
+		snapshot, err = ReadCatalogSnapshot(options.Path)
+		if err != nil {
+			mirror = nil
+			err = core.RepurposeSDKProblem(err, "read-snapshot-error")
+			return
+		}
+	}
+	mirror.setSnapshot(snapshot)
+	return
+}
+
+// Index returns the index of the catalog as of the last refresh. The index is not modified by later refreshes.
+func (mirror *CatalogMirror) Index() *CatalogIndex {
+	mirror.mutex.Lock()
+	defer mirror.mutex.Unlock()
+	return mirror.index
+}
+
+// Refresh updates the mirror incrementally and stores the new snapshot. When it fails, the mirror is unchanged.
+func (mirror *CatalogMirror) Refresh(ctx context.Context) (*CatalogRefreshResult, error) {
+	return mirror.refresh(ctx, false)
+}
+
+// Rebuild fetches every tree of catalog entries again and stores the new snapshot.
+func (mirror *CatalogMirror) Rebuild(ctx context.Context) (*CatalogRefreshResult, error) {
+	return mirror.refresh(ctx, true)
+}
+
+func (mirror *CatalogMirror) refresh(ctx context.Context, full bool) (result *CatalogRefreshResult, err error) {
+	mirror.refreshing.Lock()
+	defer mirror.refreshing.Unlock()
+	mirror.mutex.Lock()
+	previous := mirror.snapshot
+	index := mirror.index
+	mirror.mutex.Unlock()
+	if previous.Q != mirror.options.Q {
+		full = true
+	}
+
+	roots, err := mirror.listRoots(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-catalog-entries-error")
+		return
+	}
+
+	// The trees of the top-level entries are listed concurrently, and mirrored again when they changed.
+	result = &CatalogRefreshResult{}
+	trees := make([][]*MirroredEntry, len(roots))
+	unchanged := make([]bool, len(roots))
+	err = mirror.forEach(ctx, len(roots), func(ctx context.Context, i int) (err error) {
+		entries, err := mirror.listTree(ctx, &roots[i])
+		if err != nil {
+			return
+		}
+		if current := index.Tree(*roots[i].ID); !full && sameTree(current, entries) {
+			trees[i], unchanged[i] = current, true
+			return
+		}
+		trees[i], err = mirror.mirrorEntries(ctx, entries)
+		return
+	})
+	if err != nil {
+		result = nil
+		return
+	}
+	for _, kept := range unchanged {
+		if kept {
+			result.Unchanged++
+		} else {
+			result.Fetched++
+		}
+	}
+
+	snapshot := &CatalogSnapshot{Version: CatalogSnapshotVersionConst, RefreshedAt: time.Now().UTC(), Q: mirror.options.Q}
+	for _, tree := range trees {
+		snapshot.Entries = append(snapshot.Entries, tree...)
+	}
+	next := newCatalogIndex(snapshot)
+	for _, entry := range snapshot.Entries {
+		if current := index.Get(*entry.Entry.ID); current == nil {
+			result.Added = append(result.Added, *entry.Entry.ID)
+		} else if current != entry && !sameTime(current.Entry.Updated, entry.Entry.Updated) {
+			result.Updated = append(result.Updated, *entry.Entry.ID)
+		}
+	}
+	for _, entry := range index.Entries() {
+		if next.Get(*entry.Entry.ID) == nil {
+			result.Removed = append(result.Removed, *entry.Entry.ID)
+		}
+	}
+
+	err = WriteCatalogSnapshot(mirror.options.Path, snapshot)
+	if err != nil {
+		result = nil
+		err = core.RepurposeSDKProblem(err, "write-snapshot-error")
+		return
+	}
+	mirror.mutex.Lock()
+	mirror.snapshot, mirror.index = snapshot, next
+	mirror.mutex.Unlock()
+	return
+}
+
+func (mirror *CatalogMirror) setSnapshot(snapshot *CatalogSnapshot) {
+	mirror.mutex.Lock()
+	defer mirror.mutex.Unlock()
+	mirror.snapshot, mirror.index = snapshot, newCatalogIndex(snapshot)
+}
+
+func (mirror *CatalogMirror) pageSize() int64 {
+	if mirror.options.PageSize > 0 {
+		return mirror.options.PageSize
+	}
+	return 200
+}
+
+// listRoots returns the top-level catalog entries selected by the query filter.
+func (mirror *CatalogMirror) listRoots(ctx context.Context) (roots []CatalogEntry, err error) {
+	for offset := int64(0); ; {
+		options := &ListCatalogEntriesOptions{
+			Include: core.StringPtr("*"),
+			Offset:  core.Int64Ptr(offset),
+			Limit:   core.Int64Ptr(mirror.pageSize()),
+		}
+		if mirror.options.Q != "" {
+			options.Q = core.StringPtr(mirror.options.Q)
+		}
+		if mirror.options.Account != "" {
+			options.Account = core.StringPtr(mirror.options.Account)
+		}
+		var page *EntrySearchResult
+		page, _, err = mirror.options.Service.ListCatalogEntriesWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+		roots = append(roots, page.Resources...)
+		offset += int64(len(page.Resources))
+		if int64(len(page.Resources)) < mirror.pageSize() || (page.Count != nil && offset >= *page.Count) {
+			break
+		}
+	}
+	for _, root := range roots {
+		if root.ID == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("catalog entry '%s' has no ID", core.StringNilMapper(root.Name)), "missing-id", common.GetComponentInfo())
+			return
+		}
+	}
+	return
+}
+
+// listTree returns a top-level catalog entry and all its descendants, parents before children.
+func (mirror *CatalogMirror) listTree(ctx context.Context, root *CatalogEntry) (entries []*CatalogEntry, err error) {
+	seen := map[string]bool{*root.ID: true}
+	parents := []*CatalogEntry{root}
+	entries = []*CatalogEntry{root}
+	for len(parents) > 0 {
+		parent := parents[0]
+		parents = parents[1:]
+		if parent.Kind != nil && *parent.Kind == catalogEntryKindDeployment {
+			continue
+		}
+		var children []CatalogEntry
+		children, err = mirror.listChildren(ctx, *parent.ID)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-child-objects-error")
+			return
+		}
+		for i := range children {
+			child := &children[i]
+			if child.ID == nil || seen[*child.ID] {
+				continue
+			}
+			seen[*child.ID] = true
+			if child.ParentID == nil {
+				child.ParentID = core.StringPtr(*parent.ID)
+			}
+			entries = append(entries, child)
+			parents = append(parents, child)
+		}
+	}
+	return
+}
+
+// mirrorEntries returns the entries of a tree, with their visibility if the mirror includes visibility.
+func (mirror *CatalogMirror) mirrorEntries(ctx context.Context, entries []*CatalogEntry) (tree []*MirroredEntry, err error) {
+	tree = make([]*MirroredEntry, len(entries))
+	for i, entry := range entries {
+		tree[i] = &MirroredEntry{Entry: entry}
+		if mirror.options.IncludeVisibility {
+			options := &GetVisibilityOptions{ID: entry.ID}
+			if mirror.options.Account != "" {
+				options.Account = core.StringPtr(mirror.options.Account)
+			}
+			tree[i].Visibility, _, err = mirror.options.Service.GetVisibilityWithContext(ctx, options)
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "get-visibility-error")
+				return
+			}
+		}
+	}
+	return
+}
+
+func (mirror *CatalogMirror) listChildren(ctx context.Context, id string) (children []CatalogEntry, err error) {
+	for offset := int64(0); ; {
+		options := &GetChildObjectsOptions{
+			ID:      core.StringPtr(id),
+			Kind:    core.StringPtr("*"),
+			Include: core.StringPtr("*"),
+			Offset:  core.Int64Ptr(offset),
+			Limit:   core.Int64Ptr(mirror.pageSize()),
+		}
+		if mirror.options.Account != "" {
+			options.Account = core.StringPtr(mirror.options.Account)
+		}
+		var page *EntrySearchResult
+		page, _, err = mirror.options.Service.GetChildObjectsWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+		children = append(children, page.Resources...)
+		offset += int64(len(page.Resources))
+		if int64(len(page.Resources)) < mirror.pageSize() || (page.Count != nil && offset >= *page.Count) {
+			return
+		}
+	}
+}
+
+// forEach calls fn for 0 to n-1 with at most Concurrency calls at the same time, and returns the first error. The
+// calls not yet started are skipped after an error.
+func (mirror *CatalogMirror) forEach(ctx context.Context, n int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var once sync.Once
+	var firstErr error
+	common.ForEach(ctx, n, mirror.options.Concurrency, func(ctx context.Context, i int) error {
+		err := fn(ctx, i)
+		if err != nil {
+			once.Do(func() { firstErr = err; cancel() })
+		}
+		return err
+	})
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return firstErr
+}
+
+// sameTree reports whether the listed entries of a tree are those of the mirrored tree, with the same Updated times.
+func sameTree(tree []*MirroredEntry, entries []*CatalogEntry) bool {
+	if len(tree) == 0 || len(tree) != len(entries) {
+		return false
+	}
+	updated := make(map[string]*strfmt.DateTime, len(tree))
+	for _, mirrored := range tree {
+		updated[*mirrored.Entry.ID] = mirrored.Entry.Updated
+	}
+	for _, entry := range entries {
+		if current, ok := updated[*entry.ID]; !ok || entry.Updated == nil || !sameTime(current, entry.Updated) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameTime(a, b *strfmt.DateTime) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return time.Time(*a).Equal(time.Time(*b))
+}
+
+// ReadCatalogSnapshot reads a snapshot written by a CatalogMirror.
+func ReadCatalogSnapshot(path string) (snapshot *CatalogSnapshot, err error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		err = json.Unmarshal(data, &snapshot)
+	}
+	if err == nil && snapshot.Version != CatalogSnapshotVersionConst {
+		err = fmt.Errorf("unsupported catalog snapshot version %d", snapshot.Version)
+	}
+	if err != nil {
+		snapshot = nil
+		err = core.SDKErrorf(err, "", "read-snapshot-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// WriteCatalogSnapshot writes a snapshot to a file. The file is replaced atomically, so that readers see either the
+// previous snapshot or the new one.
+func WriteCatalogSnapshot(path string, snapshot *CatalogSnapshot) (err error) {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return core.SDKErrorf(err, "", "marshal-snapshot-error", common.GetComponentInfo())
+	}
+	if err = common.WriteFileAtomic(path, data); err != nil {
+		return core.RepurposeSDKProblem(err, "write-snapshot-error")
+	}
+	return nil
+}
+
+// LoadCatalogIndex reads a snapshot written by a CatalogMirror and indexes it, to look up catalog entries offline.
+func LoadCatalogIndex(path string) (index *CatalogIndex, err error) {
+	snapshot, err := ReadCatalogSnapshot(path)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "read-snapshot-error")
+		return
+	}
+	index = newCatalogIndex(snapshot)
+	return
+}
+
+// CatalogIndex : A read-only, in-memory index of mirrored catalog entries. The lookups return entries in the
+// order of the snapshot, parents before children; the entries must not be modified.
+type CatalogIndex struct {
+	refreshedAt time.Time
+	entries     []*MirroredEntry
+	byID        map[string]*MirroredEntry
+	byName      map[string][]*MirroredEntry
+	byKind      map[string][]*MirroredEntry
+	byParent    map[string][]*MirroredEntry
+	byTag       map[string][]*MirroredEntry
+	byRegion    map[string][]*MirroredEntry
+}
+
+func newCatalogIndex(snapshot *CatalogSnapshot) *CatalogIndex {
+	index := &CatalogIndex{
+		refreshedAt: snapshot.RefreshedAt,
+		byID:        map[string]*MirroredEntry{},
+		byName:      map[string][]*MirroredEntry{},
+		byKind:      map[string][]*MirroredEntry{},
+		byParent:    map[string][]*MirroredEntry{},
+		byTag:       map[string][]*MirroredEntry{},
+		byRegion:    map[string][]*MirroredEntry{},
+	}
+	for _, mirrored := range snapshot.Entries {
+		entry := mirrored.Entry
+		if entry == nil || entry.ID == nil || index.byID[*entry.ID] != nil {
+			continue
+		}
+		index.entries = append(index.entries, mirrored)
+		index.byID[*entry.ID] = mirrored
+		if entry.Name != nil {
+			index.byName[*entry.Name] = append(index.byName[*entry.Name], mirrored)
+		}
+		if entry.Kind != nil {
+			index.byKind[*entry.Kind] = append(index.byKind[*entry.Kind], mirrored)
+		}
+		if entry.ParentID != nil {
+			index.byParent[*entry.ParentID] = append(index.byParent[*entry.ParentID], mirrored)
+		}
+		for _, tag := range entry.Tags {
+			index.byTag[tag] = append(index.byTag[tag], mirrored)
+		}
+		if entry.Metadata != nil && entry.Metadata.Deployment != nil && entry.Metadata.Deployment.Location != nil {
+			location := *entry.Metadata.Deployment.Location
+			index.byRegion[location] = append(index.byRegion[location], mirrored)
+		}
+	}
+	return index
+}
+
+// RefreshedAt returns the time at which the indexed snapshot was refreshed, or the zero time for an empty mirror.
+func (index *CatalogIndex) RefreshedAt() time.Time {
+	return index.refreshedAt
+}
+
+// Len returns the number of indexed catalog entries.
+func (index *CatalogIndex) Len() int {
+	return len(index.entries)
+}
+
+// Entries returns all the indexed catalog entries.
+func (index *CatalogIndex) Entries() []*MirroredEntry {
+	return index.entries
+}
+
+// Get returns the catalog entry with the specified ID, or nil.
+func (index *CatalogIndex) Get(id string) *MirroredEntry {
+	return index.byID[id]
+}
+
+// FindByName returns the catalog entries with the specified programmatic name. Names are unique only among the
+// children of an entry: many services have a plan named "lite", for example.
+func (index *CatalogIndex) FindByName(name string) []*MirroredEntry {
+	return index.byName[name]
+}
+
+// FindByKind returns the catalog entries of the specified kind, such as "service", "plan" or "deployment".
+func (index *CatalogIndex) FindByKind(kind string) []*MirroredEntry {
+	return index.byKind[kind]
+}
+
+// FindByTag returns the catalog entries with the specified tag.
+func (index *CatalogIndex) FindByTag(tag string) []*MirroredEntry {
+	return index.byTag[tag]
+}
+
+// FindByRegion returns the deployments in the specified location, such as "us-south", as given by the deployment
+// metadata of the catalog entries.
+func (index *CatalogIndex) FindByRegion(region string) []*MirroredEntry {
+	return index.byRegion[region]
+}
+
+// Children returns the direct children of the catalog entry with the specified ID.
+func (index *CatalogIndex) Children(id string) []*MirroredEntry {
+	return index.byParent[id]
+}
+
+// Parent returns the parent of the catalog entry with the specified ID, or nil.
+func (index *CatalogIndex) Parent(id string) *MirroredEntry {
+	if entry := index.Get(id); entry != nil && entry.Entry.ParentID != nil {
+		return index.Get(*entry.Entry.ParentID)
+	}
+	return nil
+}
+
+// Tree returns the catalog entry with the specified ID and all its descendants, parents before children.
+func (index *CatalogIndex) Tree(id string) (tree []*MirroredEntry) {
+	root := index.Get(id)
+	if root == nil {
+		return
+	}
+	tree = []*MirroredEntry{root}
+	for i := 0; i < len(tree); i++ {
+		tree = append(tree, index.Children(*tree[i].Entry.ID)...)
+	}
+	return
+}
+
+// Regions returns the locations of the indexed deployments, sorted.
+func (index *CatalogIndex) Regions() []string {
+	regions := make([]string, 0, len(index.byRegion))
+	for region := range index.byRegion {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
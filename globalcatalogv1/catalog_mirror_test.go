@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CatalogMirror`, func() {
+	var (
+		mutex       sync.Mutex
+		requests    []string
+		svc1Updated string
+		planUpdated string
+		withSvc2    bool
+		testServer  *httptest.Server
+		service     *globalcatalogv1.GlobalCatalogV1
+		path        string
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		svc1Updated = "2026-01-01T00:00:00.000Z"
+		planUpdated = "2026-01-01T00:00:00.000Z"
+		withSvc2 = true
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests = append(requests, req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			entry := func(id, kind, parent, updated, extra string) string {
+				return fmt.Sprintf(`{"id": "%s", "name": "%s-name", "kind": "%s", "parent_id": "%s", "updated": "%s", "tags": ["%s-tag"]%s}`,
+					id, id, kind, parent, updated, kind, extra)
+			}
+			switch req.URL.Path {
+			case "/":
+				Expect(req.URL.Query().Get("q")).To(Equal("kind:service"))
+				Expect(req.URL.Query().Get("include")).To(Equal("*"))
+				resources := []string{entry("svc-1", "service", "", svc1Updated, "")}
+				if withSvc2 {
+					resources = append(resources, entry("svc-2", "service", "", "2026-01-01T00:00:00.000Z", ""))
+				}
+				fmt.Fprintf(res, `{"count": %d, "resources": [%s]}`, len(resources), strings.Join(resources, ","))
+			case "/svc-1/*":
+				fmt.Fprintf(res, `{"resources": [%s]}`, entry("plan-1", "plan", "svc-1", planUpdated, ""))
+			case "/plan-1/*":
+				fmt.Fprintf(res, `{"resources": [%s, %s]}`,
+					entry("dep-1", "deployment", "plan-1", svc1Updated, `, "metadata": {"deployment": {"location": "us-south"}}`),
+					entry("dep-2", "deployment", "plan-1", svc1Updated, `, "metadata": {"deployment": {"location": "eu-de"}}`))
+			case "/svc-2/*":
+				fmt.Fprint(res, `{"resources": []}`)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+
+		var err error
+		service, err = globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		dir, err := os.MkdirTemp("", "catalog-mirror")
+		Expect(err).To(BeNil())
+		path = filepath.Join(dir, "catalog.json")
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(filepath.Dir(path))
+	})
+
+	It(`Mirrors the catalog tree and refreshes it incrementally`, func() {
+		mirror, err := globalcatalogv1.NewCatalogMirror(&globalcatalogv1.CatalogMirrorOptions{
+			Service: service,
+			Path:    path,
+			Q:       "kind:service",
+		})
+		Expect(err).To(BeNil())
+		Expect(mirror.Index().Len()).To(Equal(0))
+
+		result, err := mirror.Refresh(context.Background())
+		Expect(err).To(BeNil())
+		Expect(result.Added).To(Equal([]string{"svc-1", "plan-1", "dep-1", "dep-2", "svc-2"}))
+		Expect(result.Fetched).To(Equal(2))
+		Expect(requests).ToNot(ContainElement("/dep-1/*"))
+
+		index := mirror.Index()
+		Expect(index.Len()).To(Equal(5))
+		Expect(*index.Get("plan-1").Entry.Name).To(Equal("plan-1-name"))
+		Expect(*index.Parent("dep-1").Entry.ID).To(Equal("plan-1"))
+		Expect(index.Children("plan-1")).To(HaveLen(2))
+		Expect(index.FindByKind("service")).To(HaveLen(2))
+		Expect(index.FindByName("svc-2-name")).To(HaveLen(1))
+		Expect(index.FindByTag("deployment-tag")).To(HaveLen(2))
+		Expect(*index.FindByRegion("eu-de")[0].Entry.ID).To(Equal("dep-2"))
+		Expect(index.Regions()).To(Equal([]string{"eu-de", "us-south"}))
+		Expect(index.Tree("svc-1")).To(HaveLen(4))
+
+		// Only the trees of updated entries are mirrored again, and removed entries are dropped.
+		requests = nil
+		svc1Updated = "2026-02-01T00:00:00.000Z"
+		planUpdated = svc1Updated
+		withSvc2 = false
+		result, err = mirror.Refresh(context.Background())
+		Expect(err).To(BeNil())
+		Expect(result.Updated).To(Equal([]string{"svc-1", "plan-1", "dep-1", "dep-2"}))
+		Expect(result.Removed).To(Equal([]string{"svc-2"}))
+		Expect(requests).To(Equal([]string{"/", "/svc-1/*", "/plan-1/*"}))
+
+		requests = nil
+		result, err = mirror.Refresh(context.Background())
+		Expect(err).To(BeNil())
+		Expect(result.Unchanged).To(Equal(1))
+		Expect(result.Added).To(BeEmpty())
+		Expect(result.Updated).To(BeEmpty())
+		Expect(requests).To(Equal([]string{"/", "/svc-1/*", "/plan-1/*"}))
+
+		// The snapshot is usable offline.
+		testServer.Close()
+		index, err = globalcatalogv1.LoadCatalogIndex(path)
+		Expect(err).To(BeNil())
+		Expect(index.Len()).To(Equal(4))
+		Expect(index.RefreshedAt().IsZero()).To(BeFalse())
+		mirror, err = globalcatalogv1.NewCatalogMirror(&globalcatalogv1.CatalogMirrorOptions{
+			Service: service,
+			Path:    path,
+			Q:       "kind:service",
+		})
+		Expect(err).To(BeNil())
+		Expect(*mirror.Index().FindByRegion("us-south")[0].Entry.ID).To(Equal("dep-1"))
+		_, err = mirror.Refresh(context.Background())
+		Expect(err).ToNot(BeNil())
+		Expect(mirror.Index().Len()).To(Equal(4))
+	})
+	It(`Mirrors again the tree of an entry when only a child changed`, func() {
+		mirror, err := globalcatalogv1.NewCatalogMirror(&globalcatalogv1.CatalogMirrorOptions{
+			Service: service,
+			Path:    path,
+			Q:       "kind:service",
+		})
+		Expect(err).To(BeNil())
+		_, err = mirror.Refresh(context.Background())
+		Expect(err).To(BeNil())
+
+		planUpdated = "2026-02-01T00:00:00.000Z"
+		result, err := mirror.Refresh(context.Background())
+		Expect(err).To(BeNil())
+		Expect(result.Updated).To(Equal([]string{"plan-1"}))
+		Expect(result.Fetched).To(Equal(1))
+		Expect(result.Unchanged).To(Equal(1))
+		Expect(mirror.Index().Get("plan-1").Entry.Updated.String()).To(Equal(planUpdated))
+		Expect(mirror.Index().Tree("svc-1")).To(HaveLen(4))
+	})
+	It(`Does not validate the fields of the service client`, func() {
+		_, err := globalcatalogv1.NewCatalogMirror(&globalcatalogv1.CatalogMirrorOptions{
+			Service: &taggedGlobalCatalog{GlobalCatalogV1Intf: service},
+			Path:    path,
+		})
+		Expect(err).To(BeNil())
+	})
+})
+
+// taggedGlobalCatalog : A service client with a field that does not pass validation, which must not be
+// reached when the options holding the client are validated.
+type taggedGlobalCatalog struct {
+	globalcatalogv1.GlobalCatalogV1Intf
+	Name *string `validate:"required"`
+}
@@ -0,0 +1,196 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// CatalogPublisherOptions : The options of a CatalogPublisher.
+type CatalogPublisherOptions struct {
+	// The client used to publish the catalog entries.
+	Service GlobalCatalogV1Intf `validate:"required,structonly"`
+
+	// The scope of the requests, such as "global". See CreateCatalogEntryOptions.Account.
+	Account string
+}
+
+// CatalogPublishResult : The changes made by a CatalogPublisher.
+type CatalogPublishResult struct {
+	// The IDs of the catalog entries created, updated, and restored before they were updated.
+	Created  []string
+	Updated  []string
+	Restored []string
+
+	// The IDs of the catalog entries whose visibility was set.
+	Visibility []string
+
+	// The artifacts uploaded, as "<entry ID>/<artifact ID>".
+	Artifacts []string
+}
+
+// CatalogPublisher : Publishes the entries of a CatalogSpec to the Global Catalog.
+//
+// Entries are published parents first. Entries that do not exist are created, entries that exist are updated,
+// and entries that were deleted are restored and then updated. The visibility and artifacts of each entry are
+// set after the entry is published.
+type CatalogPublisher struct {
+	options *CatalogPublisherOptions
+}
+
+// NewCatalogPublisher : constructs an instance of CatalogPublisher with the specified options.
+func NewCatalogPublisher(options *CatalogPublisherOptions) (publisher *CatalogPublisher, err error) {
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	publisher = &CatalogPublisher{options: options}
+	return
+}
+
+// Publish validates the spec and publishes its entries. When it fails, the result lists the changes made before
+// the failure.
+func (publisher *CatalogPublisher) Publish(ctx context.Context, spec *CatalogSpec) (result *CatalogPublishResult, err error) {
+	err = spec.Validate()
+	if err != nil {
+		return
+	}
+	result = &CatalogPublishResult{}
+	for _, entry := range spec.Resolve() {
+		err = publisher.publishEntry(ctx, entry, result)
+		if err == nil {
+			err = publisher.publishVisibility(ctx, entry, result)
+		}
+		for i := 0; err == nil && i < len(entry.Artifacts); i++ {
+			err = publisher.uploadArtifact(ctx, entry, &entry.Artifacts[i], result)
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// publishEntry creates, updates or restores and updates an entry.
+func (publisher *CatalogPublisher) publishEntry(ctx context.Context, entry *ResolvedCatalogEntry, result *CatalogPublishResult) error {
+	service := publisher.options.Service
+	_, response, err := service.GetCatalogEntryWithContext(ctx, &GetCatalogEntryOptions{
+		ID:      core.StringPtr(entry.ID),
+		Account: publisher.account(),
+	})
+	restore := false
+	if err != nil {
+		if _, notFound := common.AsNotFound(err); !notFound {
+			return core.RepurposeSDKProblem(err, "get-catalog-entry-error")
+		}
+		// Deleted entries are reported as gone.
+		restore = response != nil && response.GetStatusCode() == http.StatusGone
+	}
+
+	if err != nil && !restore {
+		createOptions := entry.CreateOptions()
+		createOptions.Account = publisher.account()
+		_, _, err = service.CreateCatalogEntryWithContext(ctx, createOptions)
+		if err == nil {
+			result.Created = append(result.Created, entry.ID)
+			return nil
+		}
+		// The ID of a deleted entry is still in use.
+		if _, conflict := common.AsConflict(err); !conflict {
+			return core.RepurposeSDKProblem(err, "create-catalog-entry-error")
+		}
+		restore = true
+	}
+
+	if restore {
+		_, err = service.RestoreCatalogEntryWithContext(ctx, &RestoreCatalogEntryOptions{
+			ID:      core.StringPtr(entry.ID),
+			Account: publisher.account(),
+		})
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "restore-catalog-entry-error")
+		}
+		result.Restored = append(result.Restored, entry.ID)
+	}
+
+	updateOptions := entry.UpdateOptions()
+	updateOptions.Account = publisher.account()
+	_, _, err = service.UpdateCatalogEntryWithContext(ctx, updateOptions)
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "update-catalog-entry-error")
+	}
+	result.Updated = append(result.Updated, entry.ID)
+	return nil
+}
+
+func (publisher *CatalogPublisher) publishVisibility(ctx context.Context, entry *ResolvedCatalogEntry, result *CatalogPublishResult) error {
+	if entry.Visibility == nil {
+		return nil
+	}
+	_, err := publisher.options.Service.UpdateVisibilityWithContext(ctx, &UpdateVisibilityOptions{
+		ID:           core.StringPtr(entry.ID),
+		Restrictions: entry.Visibility.Restrictions,
+		Extendable:   entry.Visibility.Extendable,
+		Include:      entry.Visibility.Include,
+		Exclude:      entry.Visibility.Exclude,
+		Account:      publisher.account(),
+	})
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "update-visibility-error")
+	}
+	result.Visibility = append(result.Visibility, entry.ID)
+	return nil
+}
+
+func (publisher *CatalogPublisher) uploadArtifact(ctx context.Context, entry *ResolvedCatalogEntry, artifact *CatalogArtifactSpec,
+	result *CatalogPublishResult) error {
+	file, err := os.Open(artifact.File) // #nosec G304
+	if err != nil {
+		return core.SDKErrorf(err, "", "read-artifact-error", common.GetComponentInfo())
+	}
+	defer file.Close() // #nosec G307
+
+	contentType := artifact.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	_, err = publisher.options.Service.UploadArtifactWithContext(ctx, &UploadArtifactOptions{
+		ObjectID:    core.StringPtr(entry.ID),
+		ArtifactID:  core.StringPtr(artifact.ID),
+		Artifact:    file,
+		ContentType: core.StringPtr(contentType),
+		Account:     publisher.account(),
+	})
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "upload-artifact-error")
+	}
+	result.Artifacts = append(result.Artifacts, entry.ID+"/"+artifact.ID)
+	return nil
+}
+
+func (publisher *CatalogPublisher) account() *string {
+	if publisher.options.Account == "" {
+		return nil
+	}
+	return core.StringPtr(publisher.options.Account)
+}
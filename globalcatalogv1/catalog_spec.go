@@ -0,0 +1,286 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"sigs.k8s.io/yaml"
+)
+
+// CatalogChildKinds : The kinds of catalog entries that may be children of each kind of entry in a CatalogSpec.
+// Entries of other kinds have no children.
+var CatalogChildKinds = map[string][]string{
+	"service":          {"plan"},
+	"iaas":             {"plan"},
+	"platform_service": {"plan"},
+	"composite":        {"plan"},
+	"plan":             {"deployment"},
+}
+
+// The kinds of catalog entries that must have a parent.
+var catalogChildOnlyKinds = map[string]bool{"plan": true, "deployment": true}
+
+// Names of catalog entries are CRN segments.
+var catalogEntryNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// CatalogSpec : A tree of catalog entries, such as a service with its plans and deployments, typically loaded from
+// a YAML or JSON file and published with a CatalogPublisher.
+type CatalogSpec struct {
+	// The values of the properties that entries do not set.
+	Defaults *CatalogEntryDefaults `json:"defaults,omitempty"`
+
+	// The top-level entries, with their children.
+	Entries []CatalogEntrySpec `json:"entries" validate:"required,min=1"`
+}
+
+// CatalogEntryDefaults : The default values of the properties of the entries of a CatalogSpec.
+type CatalogEntryDefaults struct {
+	Images   *Image    `json:"images,omitempty"`
+	Provider *Provider `json:"provider,omitempty"`
+	Tags     []string  `json:"tags,omitempty"`
+	Disabled *bool     `json:"disabled,omitempty"`
+	Active   *bool     `json:"active,omitempty"`
+}
+
+// CatalogEntrySpec : A catalog entry of a CatalogSpec. The properties are those of CreateCatalogEntryOptions, except
+// for the parent ID, which is the ID of the enclosing entry.
+type CatalogEntrySpec struct {
+	ID         string              `json:"id" validate:"required"`
+	Name       string              `json:"name" validate:"required"`
+	Kind       string              `json:"kind" validate:"required"`
+	OverviewUI map[string]Overview `json:"overview_ui" validate:"required,min=1,dive"`
+	Images     *Image              `json:"images,omitempty"`
+	Provider   *Provider           `json:"provider,omitempty"`
+	Tags       []string            `json:"tags,omitempty"`
+	Disabled   *bool               `json:"disabled,omitempty"`
+	Active     *bool               `json:"active,omitempty"`
+	Group      *bool               `json:"group,omitempty"`
+	URL        string              `json:"url,omitempty"`
+	Metadata   *ObjectMetadataSet  `json:"metadata,omitempty"`
+
+	// The visibility of the entry, as set by UpdateVisibility. The visibility is left unchanged when nil.
+	Visibility *Visibility `json:"visibility,omitempty"`
+
+	// Files uploaded as artifacts of the entry.
+	Artifacts []CatalogArtifactSpec `json:"artifacts,omitempty"`
+
+	// The children of the entry, whose kinds must be allowed by CatalogChildKinds.
+	Children []CatalogEntrySpec `json:"children,omitempty"`
+}
+
+// CatalogArtifactSpec : A file uploaded as an artifact of a catalog entry.
+type CatalogArtifactSpec struct {
+	// The ID of the artifact, such as "readme.md".
+	ID string `json:"id" validate:"required"`
+
+	// The path of the file, relative to the directory of the spec file when loaded with LoadCatalogSpec.
+	File string `json:"file" validate:"required"`
+
+	// The content type of the artifact. Defaults to "application/octet-stream".
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// ResolvedCatalogEntry : An entry of a CatalogSpec with the defaults applied and its parent ID.
+type ResolvedCatalogEntry struct {
+	*CatalogEntrySpec
+
+	// The ID of the parent entry, or empty for a top-level entry.
+	ParentID string
+}
+
+// ParseCatalogSpec parses a spec in YAML or JSON format and validates it.
+func ParseCatalogSpec(data []byte) (spec *CatalogSpec, err error) {
+	spec = new(CatalogSpec)
+	err = yaml.UnmarshalStrict(data, spec)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+		spec = nil
+		return
+	}
+	err = spec.Validate()
+	if err != nil {
+		spec = nil
+	}
+	return
+}
+
+// LoadCatalogSpec reads a spec in YAML or JSON format from the specified file. The files of artifacts are
+// resolved relative to the directory of the spec file.
+func LoadCatalogSpec(path string) (spec *CatalogSpec, err error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-error", common.GetComponentInfo())
+		return
+	}
+	spec, err = ParseCatalogSpec(data)
+	if err != nil {
+		return
+	}
+	dir := filepath.Dir(path)
+	for _, entry := range spec.Resolve() {
+		for i := range entry.Artifacts {
+			if file := entry.Artifacts[i].File; file != "" && !filepath.IsAbs(file) {
+				entry.Artifacts[i].File = filepath.Join(dir, file)
+			}
+		}
+	}
+	return
+}
+
+// Resolve returns the entries of the spec, parents before children, with the defaults applied. The artifacts of
+// the returned entries are shared with the spec.
+func (spec *CatalogSpec) Resolve() (entries []*ResolvedCatalogEntry) {
+	var walk func(specs []CatalogEntrySpec, parentID string)
+	walk = func(specs []CatalogEntrySpec, parentID string) {
+		for i := range specs {
+			resolved := specs[i]
+			if defaults := spec.Defaults; defaults != nil {
+				if resolved.Images == nil {
+					resolved.Images = defaults.Images
+				}
+				if resolved.Provider == nil {
+					resolved.Provider = defaults.Provider
+				}
+				if resolved.Tags == nil {
+					resolved.Tags = defaults.Tags
+				}
+				if resolved.Disabled == nil {
+					resolved.Disabled = defaults.Disabled
+				}
+				if resolved.Active == nil {
+					resolved.Active = defaults.Active
+				}
+			}
+			entries = append(entries, &ResolvedCatalogEntry{CatalogEntrySpec: &resolved, ParentID: parentID})
+			walk(specs[i].Children, specs[i].ID)
+		}
+	}
+	walk(spec.Entries, "")
+	return
+}
+
+// Validate checks the spec for missing fields, duplicate IDs, invalid names and kinds of children that are not
+// allowed under their parent.
+func (spec *CatalogSpec) Validate() (err error) {
+	err = core.ValidateStruct(spec, "spec")
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+
+	kinds := map[string]string{}
+	for _, entry := range spec.Resolve() {
+		invalid := func(format string, args ...interface{}) error {
+			message := fmt.Sprintf("%s '%s': ", entry.Kind, entry.ID) + fmt.Sprintf(format, args...)
+			return core.SDKErrorf(nil, message, "invalid-catalog-spec", common.GetComponentInfo())
+		}
+
+		err = core.ValidateStruct(entry.CatalogEntrySpec, "entry")
+		if err != nil {
+			return core.SDKErrorf(err, fmt.Sprintf("%s '%s': %s", entry.Kind, entry.ID, err.Error()), "struct-validation-error",
+				common.GetComponentInfo())
+		}
+		if _, found := kinds[entry.ID]; found {
+			return invalid("the ID is used by more than one entry")
+		}
+		kinds[entry.ID] = entry.Kind
+		if !catalogEntryNamePattern.MatchString(entry.Name) {
+			return invalid("name '%s' is not a valid CRN segment", entry.Name)
+		}
+
+		if entry.ParentID == "" && catalogChildOnlyKinds[entry.Kind] {
+			return invalid("a %s must be the child of another entry", entry.Kind)
+		}
+		if entry.ParentID != "" && !common.ContainsString(CatalogChildKinds[kinds[entry.ParentID]], entry.Kind) {
+			return invalid("a %s cannot be a child of a %s", entry.Kind, kinds[entry.ParentID])
+		}
+		if entry.Kind == "deployment" &&
+			(entry.Metadata == nil || entry.Metadata.Deployment == nil || core.StringNilMapper(entry.Metadata.Deployment.Location) == "") {
+			return invalid("metadata.deployment.location is required")
+		}
+
+		if entry.Images == nil {
+			return invalid("images are required")
+		}
+		if entry.Provider == nil {
+			return invalid("provider is required")
+		}
+		artifacts := map[string]bool{}
+		for _, artifact := range entry.Artifacts {
+			if artifact.ID == "" || artifact.File == "" {
+				return invalid("artifacts require an id and a file")
+			}
+			if artifacts[artifact.ID] {
+				return invalid("artifact '%s' is declared more than once", artifact.ID)
+			}
+			artifacts[artifact.ID] = true
+		}
+	}
+	return nil
+}
+
+// CreateOptions returns the options that create a resolved entry.
+func (entry *ResolvedCatalogEntry) CreateOptions() *CreateCatalogEntryOptions {
+	options := &CreateCatalogEntryOptions{
+		ID:         core.StringPtr(entry.ID),
+		Name:       core.StringPtr(entry.Name),
+		Kind:       core.StringPtr(entry.Kind),
+		OverviewUI: entry.OverviewUI,
+		Images:     entry.Images,
+		Disabled:   core.BoolPtr(entry.Disabled != nil && *entry.Disabled),
+		Tags:       entry.Tags,
+		Provider:   entry.Provider,
+		Group:      entry.Group,
+		Active:     entry.Active,
+		Metadata:   entry.Metadata,
+	}
+	if options.Tags == nil {
+		options.Tags = []string{}
+	}
+	if entry.ParentID != "" {
+		options.ParentID = core.StringPtr(entry.ParentID)
+	}
+	if entry.URL != "" {
+		options.URL = core.StringPtr(entry.URL)
+	}
+	return options
+}
+
+// UpdateOptions returns the options that update an existing entry to match a resolved entry.
+func (entry *ResolvedCatalogEntry) UpdateOptions() *UpdateCatalogEntryOptions {
+	create := entry.CreateOptions()
+	return &UpdateCatalogEntryOptions{
+		ID:         create.ID,
+		Name:       create.Name,
+		Kind:       create.Kind,
+		OverviewUI: create.OverviewUI,
+		Images:     create.Images,
+		Disabled:   create.Disabled,
+		Tags:       create.Tags,
+		Provider:   create.Provider,
+		ParentID:   create.ParentID,
+		Group:      create.Group,
+		Active:     create.Active,
+		URL:        create.URL,
+		Metadata:   create.Metadata,
+	}
+}
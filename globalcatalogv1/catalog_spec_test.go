@@ -0,0 +1,246 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const catalogSpecYAML = `
+defaults:
+  images:
+    image: https://example.com/icon.svg
+  provider:
+    name: Example
+    email: support@example.com
+  tags: [example]
+entries:
+- id: svc-1
+  name: example-service
+  kind: service
+  overview_ui:
+    en:
+      display_name: Example Service
+      description: An example service.
+      long_description: An example service.
+  visibility:
+    restrictions: private
+  artifacts:
+  - id: readme.md
+    file: readme.md
+    content_type: text/markdown
+  children:
+  - id: plan-1
+    name: lite
+    kind: plan
+    overview_ui:
+      en:
+        display_name: Lite
+        description: The lite plan.
+        long_description: The lite plan.
+    children:
+    - id: dep-1
+      name: us-south
+      kind: deployment
+      overview_ui:
+        en:
+          display_name: Dallas
+          description: Dallas.
+          long_description: Dallas.
+      metadata:
+        deployment:
+          location: us-south
+`
+
+var _ = Describe(`CatalogSpec`, func() {
+	parse := func(replacements ...string) (*globalcatalogv1.CatalogSpec, error) {
+		return globalcatalogv1.ParseCatalogSpec([]byte(strings.NewReplacer(replacements...).Replace(catalogSpecYAML)))
+	}
+
+	It(`Parses a spec and applies the defaults`, func() {
+		spec, err := parse()
+		Expect(err).To(BeNil())
+		entries := spec.Resolve()
+		Expect(entries).To(HaveLen(3))
+		Expect(entries[1].ID).To(Equal("plan-1"))
+		Expect(entries[1].ParentID).To(Equal("svc-1"))
+		Expect(entries[2].ParentID).To(Equal("plan-1"))
+
+		options := entries[2].CreateOptions()
+		Expect(*options.ParentID).To(Equal("plan-1"))
+		Expect(*options.Provider.Name).To(Equal("Example"))
+		Expect(options.Tags).To(Equal([]string{"example"}))
+		Expect(*options.Disabled).To(BeFalse())
+		Expect(core.ValidateStruct(options, "options")).To(BeNil())
+		Expect(*entries[0].UpdateOptions().Name).To(Equal("example-service"))
+	})
+	It(`Rejects invalid specs`, func() {
+		_, err := parse("kind: plan\n    overview_ui", "kind: service\n    overview_ui")
+		Expect(err.Error()).To(ContainSubstring("a service cannot be a child of a service"))
+		_, err = parse("kind: service", "kind: plan")
+		Expect(err.Error()).To(ContainSubstring("a plan must be the child of another entry"))
+		_, err = parse("location: us-south", "")
+		Expect(err.Error()).To(ContainSubstring("metadata.deployment.location is required"))
+		_, err = parse("id: dep-1", "id: plan-1")
+		Expect(err.Error()).To(ContainSubstring("the ID is used by more than one entry"))
+		_, err = parse("name: lite", "name: lite plan")
+		Expect(err.Error()).To(ContainSubstring("is not a valid CRN segment"))
+		_, err = parse("    email: support@example.com\n", "")
+		Expect(err).ToNot(BeNil())
+		_, err = parse("display_name: Lite", "displayname: Lite")
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe(`CatalogPublisher`, func() {
+	var (
+		requests   []string
+		existing   map[string]int
+		bodies     map[string]map[string]interface{}
+		testServer *httptest.Server
+		service    *globalcatalogv1.GlobalCatalogV1
+		specPath   string
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		bodies = map[string]map[string]interface{}{}
+		existing = map[string]int{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			data, _ := io.ReadAll(req.Body)
+			if strings.Contains(req.URL.Path, "/artifacts/") {
+				Expect(req.Header.Get("Content-Type")).To(Equal("text/markdown"))
+				Expect(string(data)).To(Equal("# Example"))
+			} else if len(data) > 0 {
+				body := map[string]interface{}{}
+				Expect(json.Unmarshal(data, &body)).To(BeNil())
+				bodies[req.Method+" "+req.URL.Path] = body
+			}
+			res.Header().Set("Content-type", "application/json")
+			id := strings.Split(req.URL.Path, "/")[1]
+			switch {
+			case req.Method == http.MethodGet && existing[id] != http.StatusOK:
+				status := existing[id]
+				if status != http.StatusGone && status != http.StatusForbidden {
+					status = http.StatusNotFound
+				}
+				res.WriteHeader(status)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			case req.Method == http.MethodPost && existing[createdID(bodies, req)] == http.StatusConflict:
+				res.WriteHeader(http.StatusConflict)
+				fmt.Fprint(res, `{"message": "conflict"}`)
+			case strings.HasSuffix(req.URL.Path, "/restore") || strings.HasSuffix(req.URL.Path, "/visibility") ||
+				strings.Contains(req.URL.Path, "/artifacts/"):
+				res.WriteHeader(http.StatusOK)
+			default:
+				fmt.Fprintf(res, `{"id": "%s"}`, id)
+			}
+		}))
+
+		var err error
+		service, err = globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		dir, err := os.MkdirTemp("", "catalog-spec")
+		Expect(err).To(BeNil())
+		specPath = filepath.Join(dir, "catalog.yaml")
+		Expect(os.WriteFile(specPath, []byte(catalogSpecYAML), 0600)).To(BeNil())
+		Expect(os.WriteFile(filepath.Join(dir, "readme.md"), []byte("# Example"), 0600)).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(filepath.Dir(specPath))
+	})
+
+	It(`Creates, updates and restores entries`, func() {
+		existing["svc-1"] = http.StatusOK
+		existing["plan-1"] = http.StatusGone
+		existing["dep-1"] = http.StatusConflict
+
+		spec, err := globalcatalogv1.LoadCatalogSpec(specPath)
+		Expect(err).To(BeNil())
+		publisher, err := globalcatalogv1.NewCatalogPublisher(&globalcatalogv1.CatalogPublisherOptions{
+			Service: service,
+			Account: "global",
+		})
+		Expect(err).To(BeNil())
+		result, err := publisher.Publish(context.Background(), spec)
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeEmpty())
+		Expect(result.Restored).To(Equal([]string{"plan-1", "dep-1"}))
+		Expect(result.Updated).To(Equal([]string{"svc-1", "plan-1", "dep-1"}))
+		Expect(result.Visibility).To(Equal([]string{"svc-1"}))
+		Expect(result.Artifacts).To(Equal([]string{"svc-1/readme.md"}))
+		Expect(requests).To(Equal([]string{
+			"GET /svc-1", "PUT /svc-1", "PUT /svc-1/visibility", "PUT /svc-1/artifacts/readme.md",
+			"GET /plan-1", "PUT /plan-1/restore", "PUT /plan-1",
+			"GET /dep-1", "POST /", "PUT /dep-1/restore", "PUT /dep-1",
+		}))
+		Expect(bodies["PUT /dep-1"]["parent_id"]).To(Equal("plan-1"))
+		Expect(bodies["PUT /svc-1/visibility"]["restrictions"]).To(Equal("private"))
+
+		delete(existing, "dep-1")
+		requests = nil
+		result, err = publisher.Publish(context.Background(), spec)
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(Equal([]string{"dep-1"}))
+		Expect(requests[len(requests)-2:]).To(Equal([]string{"GET /dep-1", "POST /"}))
+	})
+	It(`Stops at the first error`, func() {
+		existing["svc-1"] = http.StatusForbidden
+		spec, err := globalcatalogv1.LoadCatalogSpec(specPath)
+		Expect(err).To(BeNil())
+		publisher, err := globalcatalogv1.NewCatalogPublisher(&globalcatalogv1.CatalogPublisherOptions{Service: service})
+		Expect(err).To(BeNil())
+		result, err := publisher.Publish(context.Background(), spec)
+		Expect(err).ToNot(BeNil())
+		Expect(result.Updated).To(BeEmpty())
+		Expect(requests).To(Equal([]string{"GET /svc-1"}))
+
+		_, err = globalcatalogv1.NewCatalogPublisher(&globalcatalogv1.CatalogPublisherOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+
+	It(`Does not validate the fields of the service client`, func() {
+		_, err := globalcatalogv1.NewCatalogPublisher(&globalcatalogv1.CatalogPublisherOptions{
+			Service: &taggedGlobalCatalog{GlobalCatalogV1Intf: service},
+		})
+		Expect(err).To(BeNil())
+	})
+})
+
+// createdID returns the ID in the body of a create request.
+func createdID(bodies map[string]map[string]interface{}, req *http.Request) string {
+	id, _ := bodies[req.Method+" "+req.URL.Path]["id"].(string)
+	return id
+}
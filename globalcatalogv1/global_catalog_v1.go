@@ -193,7 +193,7 @@ func (globalCatalog *GlobalCatalogV1) ListCatalogEntriesWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "ListCatalogEntries"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/`, nil)
 	if err != nil {
@@ -252,7 +252,7 @@ func (globalCatalog *GlobalCatalogV1) ListCatalogEntriesWithContext(ctx context.
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_catalog_entries", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -291,7 +291,7 @@ func (globalCatalog *GlobalCatalogV1) CreateCatalogEntryWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "CreateCatalogEntry"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/`, nil)
 	if err != nil {
@@ -370,7 +370,7 @@ func (globalCatalog *GlobalCatalogV1) CreateCatalogEntryWithContext(ctx context.
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_catalog_entry", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -413,7 +413,7 @@ func (globalCatalog *GlobalCatalogV1) GetCatalogEntryWithContext(ctx context.Con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "GetCatalogEntry"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}`, pathParamsMap)
 	if err != nil {
@@ -457,7 +457,7 @@ func (globalCatalog *GlobalCatalogV1) GetCatalogEntryWithContext(ctx context.Con
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_entry", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -499,7 +499,7 @@ func (globalCatalog *GlobalCatalogV1) UpdateCatalogEntryWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "UpdateCatalogEntry"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}`, pathParamsMap)
 	if err != nil {
@@ -578,7 +578,7 @@ func (globalCatalog *GlobalCatalogV1) UpdateCatalogEntryWithContext(ctx context.
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_catalog_entry", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -621,7 +621,7 @@ func (globalCatalog *GlobalCatalogV1) DeleteCatalogEntryWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "DeleteCatalogEntry"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}`, pathParamsMap)
 	if err != nil {
@@ -654,7 +654,7 @@ func (globalCatalog *GlobalCatalogV1) DeleteCatalogEntryWithContext(ctx context.
 	response, err = globalCatalog.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_catalog_entry", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -689,7 +689,7 @@ func (globalCatalog *GlobalCatalogV1) GetChildObjectsWithContext(ctx context.Con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "GetChildObjects"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}/{kind}`, pathParamsMap)
 	if err != nil {
@@ -745,7 +745,7 @@ func (globalCatalog *GlobalCatalogV1) GetChildObjectsWithContext(ctx context.Con
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_child_objects", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -786,7 +786,7 @@ func (globalCatalog *GlobalCatalogV1) RestoreCatalogEntryWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "RestoreCatalogEntry"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}/restore`, pathParamsMap)
 	if err != nil {
@@ -816,7 +816,7 @@ func (globalCatalog *GlobalCatalogV1) RestoreCatalogEntryWithContext(ctx context
 	response, err = globalCatalog.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "restore_catalog_entry", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -851,7 +851,7 @@ func (globalCatalog *GlobalCatalogV1) GetVisibilityWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "GetVisibility"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}/visibility`, pathParamsMap)
 	if err != nil {
@@ -883,7 +883,7 @@ func (globalCatalog *GlobalCatalogV1) GetVisibilityWithContext(ctx context.Conte
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_visibility", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -925,7 +925,7 @@ func (globalCatalog *GlobalCatalogV1) UpdateVisibilityWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "UpdateVisibility"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}/visibility`, pathParamsMap)
 	if err != nil {
@@ -975,7 +975,7 @@ func (globalCatalog *GlobalCatalogV1) UpdateVisibilityWithContext(ctx context.Co
 	response, err = globalCatalog.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_visibility", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1009,7 +1009,7 @@ func (globalCatalog *GlobalCatalogV1) GetPricingWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "GetPricing"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}/pricing`, pathParamsMap)
 	if err != nil {
@@ -1044,7 +1044,7 @@ func (globalCatalog *GlobalCatalogV1) GetPricingWithContext(ctx context.Context,
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_pricing", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1087,7 +1087,7 @@ func (globalCatalog *GlobalCatalogV1) GetPricingDeploymentsWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "GetPricingDeployments"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}/pricing/deployment`, pathParamsMap)
 	if err != nil {
@@ -1119,7 +1119,7 @@ func (globalCatalog *GlobalCatalogV1) GetPricingDeploymentsWithContext(ctx conte
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_pricing_deployments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1160,7 +1160,7 @@ func (globalCatalog *GlobalCatalogV1) GetAuditLogsWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "GetAuditLogs"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{id}/logs`, pathParamsMap)
 	if err != nil {
@@ -1204,7 +1204,7 @@ func (globalCatalog *GlobalCatalogV1) GetAuditLogsWithContext(ctx context.Contex
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_audit_logs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1245,7 +1245,7 @@ func (globalCatalog *GlobalCatalogV1) ListArtifactsWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "ListArtifacts"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{object_id}/artifacts`, pathParamsMap)
 	if err != nil {
@@ -1277,7 +1277,7 @@ func (globalCatalog *GlobalCatalogV1) ListArtifactsWithContext(ctx context.Conte
 	response, err = globalCatalog.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_artifacts", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1319,7 +1319,7 @@ func (globalCatalog *GlobalCatalogV1) GetArtifactWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "GetArtifact"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{object_id}/artifacts/{artifact_id}`, pathParamsMap)
 	if err != nil {
@@ -1353,7 +1353,7 @@ func (globalCatalog *GlobalCatalogV1) GetArtifactWithContext(ctx context.Context
 	response, err = globalCatalog.Service.Request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_artifact", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1387,7 +1387,7 @@ func (globalCatalog *GlobalCatalogV1) UploadArtifactWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "UploadArtifact"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{object_id}/artifacts/{artifact_id}`, pathParamsMap)
 	if err != nil {
@@ -1426,7 +1426,7 @@ func (globalCatalog *GlobalCatalogV1) UploadArtifactWithContext(ctx context.Cont
 	response, err = globalCatalog.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "upload_artifact", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1460,7 +1460,7 @@ func (globalCatalog *GlobalCatalogV1) DeleteArtifactWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_catalog", "V1", "DeleteArtifact"))
 	builder.EnableGzipCompression = globalCatalog.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalCatalog.Service.Options.URL, `/{object_id}/artifacts/{artifact_id}`, pathParamsMap)
 	if err != nil {
@@ -1490,7 +1490,7 @@ func (globalCatalog *GlobalCatalogV1) DeleteArtifactWithContext(ctx context.Cont
 	response, err = globalCatalog.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_artifact", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
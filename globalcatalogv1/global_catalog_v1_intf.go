@@ -0,0 +1,97 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package globalcatalogv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GlobalCatalogV1Intf : The operations of the GlobalCatalogV1 service client.
+// The interface is satisfied by *GlobalCatalogV1 and by mocks.GlobalCatalogV1, allowing consumers to substitute a mock for the client in tests.
+type GlobalCatalogV1Intf interface {
+	// ListCatalogEntries : Returns parent catalog entries
+	ListCatalogEntries(listCatalogEntriesOptions *ListCatalogEntriesOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+	// ListCatalogEntriesWithContext is an alternate form of the ListCatalogEntries method which supports a Context parameter
+	ListCatalogEntriesWithContext(ctx context.Context, listCatalogEntriesOptions *ListCatalogEntriesOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+	// CreateCatalogEntry : Create a catalog entry
+	CreateCatalogEntry(createCatalogEntryOptions *CreateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+	// CreateCatalogEntryWithContext is an alternate form of the CreateCatalogEntry method which supports a Context parameter
+	CreateCatalogEntryWithContext(ctx context.Context, createCatalogEntryOptions *CreateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+	// GetCatalogEntry : Get a specific catalog object
+	GetCatalogEntry(getCatalogEntryOptions *GetCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+	// GetCatalogEntryWithContext is an alternate form of the GetCatalogEntry method which supports a Context parameter
+	GetCatalogEntryWithContext(ctx context.Context, getCatalogEntryOptions *GetCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+	// UpdateCatalogEntry : Update a catalog entry
+	UpdateCatalogEntry(updateCatalogEntryOptions *UpdateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+	// UpdateCatalogEntryWithContext is an alternate form of the UpdateCatalogEntry method which supports a Context parameter
+	UpdateCatalogEntryWithContext(ctx context.Context, updateCatalogEntryOptions *UpdateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+	// DeleteCatalogEntry : Delete a catalog entry
+	DeleteCatalogEntry(deleteCatalogEntryOptions *DeleteCatalogEntryOptions) (response *core.DetailedResponse, err error)
+	// DeleteCatalogEntryWithContext is an alternate form of the DeleteCatalogEntry method which supports a Context parameter
+	DeleteCatalogEntryWithContext(ctx context.Context, deleteCatalogEntryOptions *DeleteCatalogEntryOptions) (response *core.DetailedResponse, err error)
+	// GetChildObjects : Get child catalog entries of a specific kind
+	GetChildObjects(getChildObjectsOptions *GetChildObjectsOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+	// GetChildObjectsWithContext is an alternate form of the GetChildObjects method which supports a Context parameter
+	GetChildObjectsWithContext(ctx context.Context, getChildObjectsOptions *GetChildObjectsOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+	// RestoreCatalogEntry : Restore archived catalog entry
+	RestoreCatalogEntry(restoreCatalogEntryOptions *RestoreCatalogEntryOptions) (response *core.DetailedResponse, err error)
+	// RestoreCatalogEntryWithContext is an alternate form of the RestoreCatalogEntry method which supports a Context parameter
+	RestoreCatalogEntryWithContext(ctx context.Context, restoreCatalogEntryOptions *RestoreCatalogEntryOptions) (response *core.DetailedResponse, err error)
+	// GetVisibility : Get the visibility constraints for an object
+	GetVisibility(getVisibilityOptions *GetVisibilityOptions) (result *Visibility, response *core.DetailedResponse, err error)
+	// GetVisibilityWithContext is an alternate form of the GetVisibility method which supports a Context parameter
+	GetVisibilityWithContext(ctx context.Context, getVisibilityOptions *GetVisibilityOptions) (result *Visibility, response *core.DetailedResponse, err error)
+	// UpdateVisibility : Update visibility
+	UpdateVisibility(updateVisibilityOptions *UpdateVisibilityOptions) (response *core.DetailedResponse, err error)
+	// UpdateVisibilityWithContext is an alternate form of the UpdateVisibility method which supports a Context parameter
+	UpdateVisibilityWithContext(ctx context.Context, updateVisibilityOptions *UpdateVisibilityOptions) (response *core.DetailedResponse, err error)
+	// GetPricing : Get the pricing for an object
+	GetPricing(getPricingOptions *GetPricingOptions) (result *PricingGet, response *core.DetailedResponse, err error)
+	// GetPricingWithContext is an alternate form of the GetPricing method which supports a Context parameter
+	GetPricingWithContext(ctx context.Context, getPricingOptions *GetPricingOptions) (result *PricingGet, response *core.DetailedResponse, err error)
+	// GetPricingDeployments : Get the pricing deployments for a plan
+	GetPricingDeployments(getPricingDeploymentsOptions *GetPricingDeploymentsOptions) (result *PricingSearchResult, response *core.DetailedResponse, err error)
+	// GetPricingDeploymentsWithContext is an alternate form of the GetPricingDeployments method which supports a Context parameter
+	GetPricingDeploymentsWithContext(ctx context.Context, getPricingDeploymentsOptions *GetPricingDeploymentsOptions) (result *PricingSearchResult, response *core.DetailedResponse, err error)
+	// GetAuditLogs : Get the audit logs for an object
+	GetAuditLogs(getAuditLogsOptions *GetAuditLogsOptions) (result *AuditSearchResult, response *core.DetailedResponse, err error)
+	// GetAuditLogsWithContext is an alternate form of the GetAuditLogs method which supports a Context parameter
+	GetAuditLogsWithContext(ctx context.Context, getAuditLogsOptions *GetAuditLogsOptions) (result *AuditSearchResult, response *core.DetailedResponse, err error)
+	// ListArtifacts : Get artifacts
+	ListArtifacts(listArtifactsOptions *ListArtifactsOptions) (result *Artifacts, response *core.DetailedResponse, err error)
+	// ListArtifactsWithContext is an alternate form of the ListArtifacts method which supports a Context parameter
+	ListArtifactsWithContext(ctx context.Context, listArtifactsOptions *ListArtifactsOptions) (result *Artifacts, response *core.DetailedResponse, err error)
+	// GetArtifact : Get artifact
+	GetArtifact(getArtifactOptions *GetArtifactOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// GetArtifactWithContext is an alternate form of the GetArtifact method which supports a Context parameter
+	GetArtifactWithContext(ctx context.Context, getArtifactOptions *GetArtifactOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	// UploadArtifact : Upload artifact
+	UploadArtifact(uploadArtifactOptions *UploadArtifactOptions) (response *core.DetailedResponse, err error)
+	// UploadArtifactWithContext is an alternate form of the UploadArtifact method which supports a Context parameter
+	UploadArtifactWithContext(ctx context.Context, uploadArtifactOptions *UploadArtifactOptions) (response *core.DetailedResponse, err error)
+	// DeleteArtifact : Delete artifact
+	DeleteArtifact(deleteArtifactOptions *DeleteArtifactOptions) (response *core.DetailedResponse, err error)
+	// DeleteArtifactWithContext is an alternate form of the DeleteArtifact method which supports a Context parameter
+	DeleteArtifactWithContext(ctx context.Context, deleteArtifactOptions *DeleteArtifactOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ GlobalCatalogV1Intf = (*GlobalCatalogV1)(nil)
@@ -0,0 +1,482 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the GlobalCatalogV1 service client.
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// GlobalCatalogV1 : A testify mock of the GlobalCatalogV1 service client.
+type GlobalCatalogV1 struct {
+	mock.Mock
+}
+
+var _ globalcatalogv1.GlobalCatalogV1Intf = (*GlobalCatalogV1)(nil)
+
+// ListCatalogEntries provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) ListCatalogEntries(listCatalogEntriesOptions *globalcatalogv1.ListCatalogEntriesOptions) (*globalcatalogv1.EntrySearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(listCatalogEntriesOptions)
+	var r0 *globalcatalogv1.EntrySearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.EntrySearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListCatalogEntriesWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) ListCatalogEntriesWithContext(ctx context.Context, listCatalogEntriesOptions *globalcatalogv1.ListCatalogEntriesOptions) (*globalcatalogv1.EntrySearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listCatalogEntriesOptions)
+	var r0 *globalcatalogv1.EntrySearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.EntrySearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateCatalogEntry provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) CreateCatalogEntry(createCatalogEntryOptions *globalcatalogv1.CreateCatalogEntryOptions) (*globalcatalogv1.CatalogEntry, *core.DetailedResponse, error) {
+	ret := _m.Called(createCatalogEntryOptions)
+	var r0 *globalcatalogv1.CatalogEntry
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.CatalogEntry)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateCatalogEntryWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) CreateCatalogEntryWithContext(ctx context.Context, createCatalogEntryOptions *globalcatalogv1.CreateCatalogEntryOptions) (*globalcatalogv1.CatalogEntry, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createCatalogEntryOptions)
+	var r0 *globalcatalogv1.CatalogEntry
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.CatalogEntry)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogEntry provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetCatalogEntry(getCatalogEntryOptions *globalcatalogv1.GetCatalogEntryOptions) (*globalcatalogv1.CatalogEntry, *core.DetailedResponse, error) {
+	ret := _m.Called(getCatalogEntryOptions)
+	var r0 *globalcatalogv1.CatalogEntry
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.CatalogEntry)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogEntryWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetCatalogEntryWithContext(ctx context.Context, getCatalogEntryOptions *globalcatalogv1.GetCatalogEntryOptions) (*globalcatalogv1.CatalogEntry, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCatalogEntryOptions)
+	var r0 *globalcatalogv1.CatalogEntry
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.CatalogEntry)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogEntry provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) UpdateCatalogEntry(updateCatalogEntryOptions *globalcatalogv1.UpdateCatalogEntryOptions) (*globalcatalogv1.CatalogEntry, *core.DetailedResponse, error) {
+	ret := _m.Called(updateCatalogEntryOptions)
+	var r0 *globalcatalogv1.CatalogEntry
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.CatalogEntry)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogEntryWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) UpdateCatalogEntryWithContext(ctx context.Context, updateCatalogEntryOptions *globalcatalogv1.UpdateCatalogEntryOptions) (*globalcatalogv1.CatalogEntry, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateCatalogEntryOptions)
+	var r0 *globalcatalogv1.CatalogEntry
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.CatalogEntry)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteCatalogEntry provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) DeleteCatalogEntry(deleteCatalogEntryOptions *globalcatalogv1.DeleteCatalogEntryOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteCatalogEntryOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteCatalogEntryWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) DeleteCatalogEntryWithContext(ctx context.Context, deleteCatalogEntryOptions *globalcatalogv1.DeleteCatalogEntryOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteCatalogEntryOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetChildObjects provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetChildObjects(getChildObjectsOptions *globalcatalogv1.GetChildObjectsOptions) (*globalcatalogv1.EntrySearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getChildObjectsOptions)
+	var r0 *globalcatalogv1.EntrySearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.EntrySearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetChildObjectsWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetChildObjectsWithContext(ctx context.Context, getChildObjectsOptions *globalcatalogv1.GetChildObjectsOptions) (*globalcatalogv1.EntrySearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getChildObjectsOptions)
+	var r0 *globalcatalogv1.EntrySearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.EntrySearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RestoreCatalogEntry provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) RestoreCatalogEntry(restoreCatalogEntryOptions *globalcatalogv1.RestoreCatalogEntryOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(restoreCatalogEntryOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// RestoreCatalogEntryWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) RestoreCatalogEntryWithContext(ctx context.Context, restoreCatalogEntryOptions *globalcatalogv1.RestoreCatalogEntryOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, restoreCatalogEntryOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetVisibility provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetVisibility(getVisibilityOptions *globalcatalogv1.GetVisibilityOptions) (*globalcatalogv1.Visibility, *core.DetailedResponse, error) {
+	ret := _m.Called(getVisibilityOptions)
+	var r0 *globalcatalogv1.Visibility
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.Visibility)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetVisibilityWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetVisibilityWithContext(ctx context.Context, getVisibilityOptions *globalcatalogv1.GetVisibilityOptions) (*globalcatalogv1.Visibility, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getVisibilityOptions)
+	var r0 *globalcatalogv1.Visibility
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.Visibility)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateVisibility provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) UpdateVisibility(updateVisibilityOptions *globalcatalogv1.UpdateVisibilityOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(updateVisibilityOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateVisibilityWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) UpdateVisibilityWithContext(ctx context.Context, updateVisibilityOptions *globalcatalogv1.UpdateVisibilityOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateVisibilityOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetPricing provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetPricing(getPricingOptions *globalcatalogv1.GetPricingOptions) (*globalcatalogv1.PricingGet, *core.DetailedResponse, error) {
+	ret := _m.Called(getPricingOptions)
+	var r0 *globalcatalogv1.PricingGet
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.PricingGet)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPricingWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetPricingWithContext(ctx context.Context, getPricingOptions *globalcatalogv1.GetPricingOptions) (*globalcatalogv1.PricingGet, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPricingOptions)
+	var r0 *globalcatalogv1.PricingGet
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.PricingGet)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPricingDeployments provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetPricingDeployments(getPricingDeploymentsOptions *globalcatalogv1.GetPricingDeploymentsOptions) (*globalcatalogv1.PricingSearchResult, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getPricingDeploymentsOptions)
+	var r0 *globalcatalogv1.PricingSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.PricingSearchResult)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPricingDeploymentsWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetPricingDeploymentsWithContext(ctx context.Context, getPricingDeploymentsOptions *globalcatalogv1.GetPricingDeploymentsOptions) (*globalcatalogv1.PricingSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPricingDeploymentsOptions)
+	var r0 *globalcatalogv1.PricingSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.PricingSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAuditLogs provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetAuditLogs(getAuditLogsOptions *globalcatalogv1.GetAuditLogsOptions) (*globalcatalogv1.AuditSearchResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getAuditLogsOptions)
+	var r0 *globalcatalogv1.AuditSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.AuditSearchResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAuditLogsWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetAuditLogsWithContext(ctx context.Context, getAuditLogsOptions *globalcatalogv1.GetAuditLogsOptions) (*globalcatalogv1.AuditSearchResult, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getAuditLogsOptions)
+	var r0 *globalcatalogv1.AuditSearchResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.AuditSearchResult)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListArtifacts provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) ListArtifacts(listArtifactsOptions *globalcatalogv1.ListArtifactsOptions) (*globalcatalogv1.Artifacts, *core.DetailedResponse, error) {
+	ret := _m.Called(listArtifactsOptions)
+	var r0 *globalcatalogv1.Artifacts
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.Artifacts)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListArtifactsWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) ListArtifactsWithContext(ctx context.Context, listArtifactsOptions *globalcatalogv1.ListArtifactsOptions) (*globalcatalogv1.Artifacts, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listArtifactsOptions)
+	var r0 *globalcatalogv1.Artifacts
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalcatalogv1.Artifacts)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetArtifact provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetArtifact(getArtifactOptions *globalcatalogv1.GetArtifactOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(getArtifactOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetArtifactWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) GetArtifactWithContext(ctx context.Context, getArtifactOptions *globalcatalogv1.GetArtifactOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getArtifactOptions)
+	var r0 io.ReadCloser
+	if v := ret.Get(0); v != nil {
+		r0 = v.(io.ReadCloser)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UploadArtifact provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) UploadArtifact(uploadArtifactOptions *globalcatalogv1.UploadArtifactOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(uploadArtifactOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UploadArtifactWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) UploadArtifactWithContext(ctx context.Context, uploadArtifactOptions *globalcatalogv1.UploadArtifactOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, uploadArtifactOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteArtifact provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) DeleteArtifact(deleteArtifactOptions *globalcatalogv1.DeleteArtifactOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteArtifactOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteArtifactWithContext provides a mock function with the given fields.
+func (_m *GlobalCatalogV1) DeleteArtifactWithContext(ctx context.Context, deleteArtifactOptions *globalcatalogv1.DeleteArtifactOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteArtifactOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
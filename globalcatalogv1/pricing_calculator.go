@@ -0,0 +1,351 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the PriceEstimateItem.TierModel property.
+// How the tiers of the prices of a metric apply to a quantity.
+const (
+	// The whole quantity is charged at the unit price of the tier in which it falls.
+	PricingTierModelSimpleConst = "simple"
+
+	// Each part of the quantity is charged at the unit price of the tier in which it falls.
+	PricingTierModelGraduatedConst = "graduated"
+
+	// The price of the tier in which the quantity falls is charged as a flat amount.
+	PricingTierModelBlockConst = "block"
+)
+
+// PricingCalculatorOptions : The options of NewPricingCalculator.
+type PricingCalculatorOptions struct {
+	// The client used to get the pricing of plans. Optional when Index is set.
+	Service GlobalCatalogV1Intf
+
+	// A mirror of the catalog, whose pricing metadata is used instead of the service when it includes the plan.
+	Index *CatalogIndex
+
+	// The scope of the requests, such as "global". See GetPricingOptions.Account.
+	Account string
+}
+
+// PriceEstimateOptions : The options of PricingCalculator.Estimate.
+type PriceEstimateOptions struct {
+	// The ID of the plan, or of a deployment of the plan for location-specific pricing.
+	PlanID string `validate:"required"`
+
+	// The country, such as "USA", and currency, such as "USD", of the prices. The first currency of the country
+	// is used when Currency is empty.
+	Country  string `validate:"required"`
+	Currency string
+
+	// The region of a global deployment with region pricing. See GetPricingOptions.DeploymentRegion.
+	DeploymentRegion string
+
+	// The projected usage of each metric, by metric ID, charge unit or charge unit name, in the units of the
+	// metric. Metrics without a quantity are not charged.
+	Quantities map[string]float64 `validate:"required"`
+}
+
+// PriceEstimate : An itemized estimate of the cost of a plan for a projected usage.
+type PriceEstimate struct {
+	// The plan, country and currency of the estimate.
+	PlanID   string
+	Country  string
+	Currency string
+
+	// The type of the plan, such as "free" or "paygo".
+	PricingType string
+
+	// The cost of each metric, in the order of the pricing of the plan.
+	Items []PriceEstimateItem
+
+	// The total cost.
+	Total float64
+}
+
+// PriceEstimateItem : The cost of the projected usage of one metric.
+type PriceEstimateItem struct {
+	// The metric ID, or part number, and its charge unit.
+	MetricID       string
+	ChargeUnit     string
+	ChargeUnitName string
+
+	// The tier model applied, one of the PricingTierModel*Const constants.
+	TierModel string
+
+	// The projected quantity, and the quantity charged once the usage cap of the metric is applied.
+	Quantity        float64
+	ChargedQuantity float64
+
+	// The tiers that contributed to the cost.
+	Tiers []PriceEstimateTier
+
+	// The cost of the metric.
+	Cost float64
+}
+
+// PriceEstimateTier : The part of the cost of a metric charged in one price tier.
+type PriceEstimateTier struct {
+	// The range of quantities of the tier; Until is +Inf for the last tier.
+	From  float64
+	Until float64
+
+	// The quantity charged in the tier.
+	Quantity float64
+
+	// The price of the tier, per charge unit quantity of the metric or, for block tiers, for the whole block.
+	Price float64
+
+	// The cost charged in the tier.
+	Cost float64
+}
+
+// PricingCalculator : Estimates the cost of plans from their pricing in the Global Catalog.
+type PricingCalculator struct {
+	options *PricingCalculatorOptions
+}
+
+// NewPricingCalculator : constructs an instance of PricingCalculator with the specified options.
+func NewPricingCalculator(options *PricingCalculatorOptions) (calculator *PricingCalculator, err error) {
+	if options == nil || (options.Service == nil && options.Index == nil) {
+		err = core.SDKErrorf(nil, "a client or a catalog index is required", "missing-pricing-source", common.GetComponentInfo())
+		return
+	}
+	calculator = &PricingCalculator{options: options}
+	return
+}
+
+// Estimate returns the itemized cost of a plan for the projected usage of its metrics.
+func (calculator *PricingCalculator) Estimate(ctx context.Context, options *PriceEstimateOptions) (estimate *PriceEstimate, err error) {
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	var pricingType string
+	var metrics []Metrics
+	if entry := calculator.indexedPricing(options); entry != nil {
+		pricingType, metrics = core.StringNilMapper(entry.Type), entry.Metrics
+	} else if calculator.options.Service != nil {
+		getPricingOptions := &GetPricingOptions{ID: core.StringPtr(options.PlanID)}
+		if calculator.options.Account != "" {
+			getPricingOptions.Account = core.StringPtr(calculator.options.Account)
+		}
+		if options.DeploymentRegion != "" {
+			getPricingOptions.DeploymentRegion = core.StringPtr(options.DeploymentRegion)
+		}
+		var pricing *PricingGet
+		pricing, _, err = calculator.options.Service.GetPricingWithContext(ctx, getPricingOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-pricing-error")
+			return
+		}
+		pricingType, metrics = core.StringNilMapper(pricing.Type), pricing.Metrics
+	} else {
+		err = core.SDKErrorf(nil, fmt.Sprintf("no pricing for plan '%s' in the catalog index", options.PlanID), "missing-pricing", common.GetComponentInfo())
+		return
+	}
+
+	estimate, err = EstimatePrice(metrics, options.Country, options.Currency, options.Quantities)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "estimate-error")
+		return
+	}
+	estimate.PlanID = options.PlanID
+	estimate.PricingType = pricingType
+	return
+}
+
+// indexedPricing returns the pricing of the plan in the catalog index, if any. Region pricing is only available
+// from the service.
+func (calculator *PricingCalculator) indexedPricing(options *PriceEstimateOptions) *CatalogEntryMetadataPricing {
+	if calculator.options.Index == nil || options.DeploymentRegion != "" {
+		return nil
+	}
+	entry := calculator.options.Index.Get(options.PlanID)
+	if entry == nil || entry.Entry.Metadata == nil || entry.Entry.Metadata.Pricing == nil {
+		return nil
+	}
+	return entry.Entry.Metadata.Pricing
+}
+
+// EstimatePrice returns the itemized cost of the projected usage of pricing metrics, such as PricingGet.Metrics.
+// It fails if a quantity matches no metric, or if a charged metric has no price in the country and currency.
+func EstimatePrice(metrics []Metrics, country string, currency string, quantities map[string]float64) (estimate *PriceEstimate, err error) {
+	estimate = &PriceEstimate{Country: country, Currency: currency}
+	used := map[string]bool{}
+	for _, metric := range metrics {
+		quantity, key, found := metricQuantity(&metric, quantities)
+		if !found {
+			continue
+		}
+		used[key] = true
+		if quantity < 0 {
+			estimate = nil
+			err = core.SDKErrorf(nil, fmt.Sprintf("the quantity of metric '%s' is negative", key), "negative-quantity", common.GetComponentInfo())
+			return
+		}
+
+		amount := metricAmount(&metric, country, currency)
+		if amount == nil {
+			estimate = nil
+			err = core.SDKErrorf(nil, fmt.Sprintf("metric '%s' has no price in country '%s' and currency '%s'", key, country, currency),
+				"missing-price", common.GetComponentInfo())
+			return
+		}
+		if estimate.Currency == "" {
+			estimate.Currency = core.StringNilMapper(amount.Currency)
+		}
+
+		item := PriceEstimateItem{
+			MetricID:        core.StringNilMapper(metric.MetricID),
+			ChargeUnit:      core.StringNilMapper(metric.ChargeUnit),
+			ChargeUnitName:  core.StringNilMapper(metric.ChargeUnitName),
+			TierModel:       normalizeTierModel(core.StringNilMapper(metric.TierModel)),
+			Quantity:        quantity,
+			ChargedQuantity: quantity,
+		}
+		if metric.UsageCapQty != nil && *metric.UsageCapQty > 0 {
+			item.ChargedQuantity = math.Min(quantity, float64(*metric.UsageCapQty))
+		}
+		unitQuantity := float64(1)
+		if metric.ChargeUnitQuantity != nil && *metric.ChargeUnitQuantity > 0 {
+			unitQuantity = float64(*metric.ChargeUnitQuantity)
+		}
+		item.Tiers = applyTiers(amount.Prices, item.TierModel, item.ChargedQuantity, unitQuantity)
+		for _, tier := range item.Tiers {
+			item.Cost += tier.Cost
+		}
+		estimate.Items = append(estimate.Items, item)
+		estimate.Total += item.Cost
+	}
+
+	unknown := []string{}
+	for key := range quantities {
+		if !used[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		estimate = nil
+		err = core.SDKErrorf(nil, fmt.Sprintf("no pricing metric matches the quantities of %s", strings.Join(unknown, ", ")),
+			"unknown-metric", common.GetComponentInfo())
+	}
+	return
+}
+
+// metricQuantity returns the projected quantity of a metric, and the key under which it was given.
+func metricQuantity(metric *Metrics, quantities map[string]float64) (float64, string, bool) {
+	for _, key := range []*string{metric.MetricID, metric.ChargeUnit, metric.ChargeUnitName} {
+		if key == nil {
+			continue
+		}
+		if quantity, found := quantities[*key]; found {
+			return quantity, *key, true
+		}
+	}
+	return 0, "", false
+}
+
+// metricAmount returns the prices of a metric in a country and currency, or in the first currency of the country.
+func metricAmount(metric *Metrics, country string, currency string) *Amount {
+	for i, amount := range metric.Amounts {
+		if strings.EqualFold(core.StringNilMapper(amount.Country), country) &&
+			(currency == "" || strings.EqualFold(core.StringNilMapper(amount.Currency), currency)) {
+			return &metric.Amounts[i]
+		}
+	}
+	return nil
+}
+
+// normalizeTierModel maps the tier models of the pricing catalog, such as "Linear", "Granular Tier" or "Step Tier",
+// to the PricingTierModel*Const constants.
+func normalizeTierModel(tierModel string) string {
+	tierModel = strings.ToLower(tierModel)
+	switch {
+	case strings.Contains(tierModel, "granular"), strings.Contains(tierModel, "graduated"):
+		return PricingTierModelGraduatedConst
+	case strings.Contains(tierModel, "block"), strings.Contains(tierModel, "step"):
+		return PricingTierModelBlockConst
+	default:
+		return PricingTierModelSimpleConst
+	}
+}
+
+// applyTiers charges a quantity with prices whose QuantityTier is the upper bound of their tier, in the units of
+// the metric. Prices are per charge unit quantity, except for block tiers.
+func applyTiers(prices []Price, tierModel string, quantity float64, unitQuantity float64) (tiers []PriceEstimateTier) {
+	sorted := make([]Price, 0, len(prices))
+	for _, price := range prices {
+		if price.Price != nil {
+			sorted = append(sorted, price)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return upperBound(&sorted[i]) < upperBound(&sorted[j])
+	})
+
+	from := float64(0)
+	for i := range sorted {
+		until := upperBound(&sorted[i])
+		if i == len(sorted)-1 {
+			until = math.Inf(1)
+		}
+		tier := PriceEstimateTier{From: from, Until: until, Price: *sorted[i].Price}
+		switch tierModel {
+		case PricingTierModelGraduatedConst:
+			tier.Quantity = math.Max(0, math.Min(quantity, until)-from)
+			tier.Cost = tier.Quantity / unitQuantity * tier.Price
+			if tier.Quantity > 0 || (quantity == 0 && i == 0) {
+				tiers = append(tiers, tier)
+			}
+		default:
+			if quantity <= until {
+				tier.Quantity = quantity
+				if tierModel == PricingTierModelBlockConst {
+					if quantity > 0 {
+						tier.Cost = tier.Price
+					}
+				} else {
+					tier.Cost = quantity / unitQuantity * tier.Price
+				}
+				return append(tiers, tier)
+			}
+		}
+		from = until
+	}
+	return
+}
+
+func upperBound(price *Price) float64 {
+	if price.QuantityTier == nil {
+		return math.Inf(1)
+	}
+	return float64(*price.QuantityTier)
+}
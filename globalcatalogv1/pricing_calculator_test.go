@@ -0,0 +1,155 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalcatalogv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PricingCalculator`, func() {
+	const pricingBody = `{
+		"type": "paygo",
+		"metrics": [
+			{"metric_id": "part-api-calls", "charge_unit_name": "API_CALLS", "tier_model": "Granular Tier", "charge_unit_quantity": 1000,
+				"amounts": [
+					{"country": "DEU", "currency": "EUR", "prices": [{"quantity_tier": 10000, "price": 0.1}]},
+					{"country": "USA", "currency": "USD", "prices": [
+						{"quantity_tier": 1000000, "price": 0.5}, {"quantity_tier": 10000, "price": 0}, {"quantity_tier": 999999999, "price": 0.25}]}
+				]},
+			{"metric_id": "part-storage", "charge_unit_name": "GIGABYTE_MONTHS", "tier_model": "Linear", "usage_cap_qty": 40,
+				"amounts": [{"country": "USA", "currency": "USD", "prices": [{"quantity_tier": 999999999, "price": 0.1}]}]},
+			{"metric_id": "part-instances", "charge_unit_name": "INSTANCES", "tier_model": "Block Tier",
+				"amounts": [{"country": "USA", "currency": "USD", "prices": [
+					{"quantity_tier": 1, "price": 10}, {"quantity_tier": 5, "price": 40}, {"quantity_tier": 10, "price": 70}]}]}
+		]
+	}`
+	quantities := map[string]float64{"API_CALLS": 1500000, "part-storage": 50, "INSTANCES": 3}
+
+	It(`Applies graduated, simple and block tiers`, func() {
+		var pricing *globalcatalogv1.PricingGet
+		var raw map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(pricingBody), &raw)).To(Succeed())
+		Expect(core.UnmarshalModel(raw, "", &pricing, globalcatalogv1.UnmarshalPricingGet)).To(Succeed())
+
+		estimate, err := globalcatalogv1.EstimatePrice(pricing.Metrics, "USA", "", quantities)
+		Expect(err).To(BeNil())
+		Expect(estimate.Currency).To(Equal("USD"))
+		Expect(estimate.Items).To(HaveLen(3))
+
+		apiCalls := estimate.Items[0]
+		Expect(apiCalls.TierModel).To(Equal(globalcatalogv1.PricingTierModelGraduatedConst))
+		Expect(apiCalls.Tiers).To(HaveLen(3))
+		Expect(apiCalls.Tiers[1]).To(Equal(globalcatalogv1.PriceEstimateTier{From: 10000, Until: 1000000, Quantity: 990000, Price: 0.5, Cost: 495}))
+		Expect(apiCalls.Tiers[2].Until).To(Equal(math.Inf(1)))
+		Expect(apiCalls.Cost).To(Equal(620.0))
+
+		storage := estimate.Items[1]
+		Expect(storage.TierModel).To(Equal(globalcatalogv1.PricingTierModelSimpleConst))
+		Expect(storage.ChargedQuantity).To(Equal(40.0))
+		Expect(storage.Cost).To(BeNumerically("~", 4))
+
+		instances := estimate.Items[2]
+		Expect(instances.TierModel).To(Equal(globalcatalogv1.PricingTierModelBlockConst))
+		Expect(instances.Cost).To(Equal(40.0))
+		Expect(estimate.Total).To(BeNumerically("~", 664))
+
+		_, err = globalcatalogv1.EstimatePrice(pricing.Metrics, "USA", "USD", map[string]float64{"VCPU_HOURS": 1})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("VCPU_HOURS"))
+		_, err = globalcatalogv1.EstimatePrice(pricing.Metrics, "DEU", "EUR", quantities)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("no price in country 'DEU'"))
+	})
+
+	It(`Gets the pricing of plans from the service or from a catalog index`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/plan-1/pricing"))
+			Expect(req.URL.Query().Get("deployment_region")).To(Equal("us-south"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, pricingBody)
+		}))
+		defer testServer.Close()
+		service, err := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		calculator, err := globalcatalogv1.NewPricingCalculator(&globalcatalogv1.PricingCalculatorOptions{Service: service})
+		Expect(err).To(BeNil())
+		estimate, err := calculator.Estimate(context.Background(), &globalcatalogv1.PriceEstimateOptions{
+			PlanID:           "plan-1",
+			Country:          "USA",
+			Currency:         "USD",
+			DeploymentRegion: "us-south",
+			Quantities:       quantities,
+		})
+		Expect(err).To(BeNil())
+		Expect(estimate.PlanID).To(Equal("plan-1"))
+		Expect(estimate.PricingType).To(Equal("paygo"))
+		Expect(estimate.Total).To(BeNumerically("~", 664))
+
+		// Plans in the index are priced offline.
+		testServer.Close()
+		var pricing *globalcatalogv1.CatalogEntryMetadataPricing
+		Expect(json.Unmarshal([]byte(pricingBody), &pricing)).To(Succeed())
+		dir, err := os.MkdirTemp("", "pricing")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "pricing.json")
+		Expect(globalcatalogv1.WriteCatalogSnapshot(path, &globalcatalogv1.CatalogSnapshot{
+			Version: globalcatalogv1.CatalogSnapshotVersionConst,
+			Entries: []*globalcatalogv1.MirroredEntry{{Entry: &globalcatalogv1.CatalogEntry{
+				ID:       core.StringPtr("plan-1"),
+				Kind:     core.StringPtr("plan"),
+				Metadata: &globalcatalogv1.CatalogEntryMetadata{Pricing: pricing},
+			}}},
+		})).To(Succeed())
+		index, err := globalcatalogv1.LoadCatalogIndex(path)
+		Expect(err).To(BeNil())
+		calculator, err = globalcatalogv1.NewPricingCalculator(&globalcatalogv1.PricingCalculatorOptions{Index: index})
+		Expect(err).To(BeNil())
+		estimate, err = calculator.Estimate(context.Background(), &globalcatalogv1.PriceEstimateOptions{
+			PlanID:     "plan-1",
+			Country:    "USA",
+			Quantities: quantities,
+		})
+		Expect(err).To(BeNil())
+		Expect(estimate.Total).To(BeNumerically("~", 664))
+		_, err = calculator.Estimate(context.Background(), &globalcatalogv1.PriceEstimateOptions{
+			PlanID:     "plan-2",
+			Country:    "USA",
+			Quantities: quantities,
+		})
+		Expect(err).ToNot(BeNil())
+
+		_, err = globalcatalogv1.NewPricingCalculator(&globalcatalogv1.PricingCalculatorOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+})
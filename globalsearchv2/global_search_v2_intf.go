@@ -0,0 +1,36 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package globalsearchv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GlobalSearchV2Intf : The operations of the GlobalSearchV2 service client.
+// The interface is satisfied by *GlobalSearchV2 and by mocks.GlobalSearchV2, allowing consumers to substitute a mock for the client in tests.
+type GlobalSearchV2Intf interface {
+	// Search : Find instances of resources (v3)
+	Search(searchOptions *SearchOptions) (result *ScanResult, response *core.DetailedResponse, err error)
+	// SearchWithContext is an alternate form of the Search method which supports a Context parameter
+	SearchWithContext(ctx context.Context, searchOptions *SearchOptions) (result *ScanResult, response *core.DetailedResponse, err error)
+}
+
+var _ GlobalSearchV2Intf = (*GlobalSearchV2)(nil)
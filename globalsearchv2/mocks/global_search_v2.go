@@ -0,0 +1,65 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the GlobalSearchV2 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/stretchr/testify/mock"
+)
+
+// GlobalSearchV2 : A testify mock of the GlobalSearchV2 service client.
+type GlobalSearchV2 struct {
+	mock.Mock
+}
+
+var _ globalsearchv2.GlobalSearchV2Intf = (*GlobalSearchV2)(nil)
+
+// Search provides a mock function with the given fields.
+func (_m *GlobalSearchV2) Search(searchOptions *globalsearchv2.SearchOptions) (*globalsearchv2.ScanResult, *core.DetailedResponse, error) {
+	ret := _m.Called(searchOptions)
+	var r0 *globalsearchv2.ScanResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalsearchv2.ScanResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SearchWithContext provides a mock function with the given fields.
+func (_m *GlobalSearchV2) SearchWithContext(ctx context.Context, searchOptions *globalsearchv2.SearchOptions) (*globalsearchv2.ScanResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, searchOptions)
+	var r0 *globalsearchv2.ScanResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globalsearchv2.ScanResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
@@ -195,7 +195,7 @@ func (globalTagging *GlobalTaggingV1) ListTagsWithContext(ctx context.Context, l
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_tagging", "V1", "ListTags"))
 	builder.EnableGzipCompression = globalTagging.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalTagging.Service.Options.URL, `/v3/tags`, nil)
 	if err != nil {
@@ -260,7 +260,7 @@ func (globalTagging *GlobalTaggingV1) ListTagsWithContext(ctx context.Context, l
 	response, err = globalTagging.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_tags", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -299,7 +299,7 @@ func (globalTagging *GlobalTaggingV1) CreateTagWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_tagging", "V1", "CreateTag"))
 	builder.EnableGzipCompression = globalTagging.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalTagging.Service.Options.URL, `/v3/tags`, nil)
 	if err != nil {
@@ -351,7 +351,7 @@ func (globalTagging *GlobalTaggingV1) CreateTagWithContext(ctx context.Context,
 	response, err = globalTagging.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_tag", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -383,7 +383,7 @@ func (globalTagging *GlobalTaggingV1) DeleteTagAllWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_tagging", "V1", "DeleteTagAll"))
 	builder.EnableGzipCompression = globalTagging.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalTagging.Service.Options.URL, `/v3/tags`, nil)
 	if err != nil {
@@ -427,7 +427,7 @@ func (globalTagging *GlobalTaggingV1) DeleteTagAllWithContext(ctx context.Contex
 	response, err = globalTagging.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tag_all", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -468,7 +468,7 @@ func (globalTagging *GlobalTaggingV1) DeleteTagWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_tagging", "V1", "DeleteTag"))
 	builder.EnableGzipCompression = globalTagging.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalTagging.Service.Options.URL, `/v3/tags/{tag_name}`, pathParamsMap)
 	if err != nil {
@@ -512,7 +512,7 @@ func (globalTagging *GlobalTaggingV1) DeleteTagWithContext(ctx context.Context,
 	response, err = globalTagging.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tag", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -550,7 +550,7 @@ func (globalTagging *GlobalTaggingV1) AttachTagWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_tagging", "V1", "AttachTag"))
 	builder.EnableGzipCompression = globalTagging.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalTagging.Service.Options.URL, `/v3/tags/attach`, nil)
 	if err != nil {
@@ -617,7 +617,7 @@ func (globalTagging *GlobalTaggingV1) AttachTagWithContext(ctx context.Context,
 	response, err = globalTagging.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "attach_tag", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -654,7 +654,7 @@ func (globalTagging *GlobalTaggingV1) DetachTagWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "global_tagging", "V1", "DetachTag"))
 	builder.EnableGzipCompression = globalTagging.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(globalTagging.Service.Options.URL, `/v3/tags/detach`, nil)
 	if err != nil {
@@ -715,7 +715,7 @@ func (globalTagging *GlobalTaggingV1) DetachTagWithContext(ctx context.Context,
 	response, err = globalTagging.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "detach_tag", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
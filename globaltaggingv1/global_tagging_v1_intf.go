@@ -0,0 +1,56 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package globaltaggingv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GlobalTaggingV1Intf : The operations of the GlobalTaggingV1 service client.
+// The interface is satisfied by *GlobalTaggingV1 and by mocks.GlobalTaggingV1, allowing consumers to substitute a mock for the client in tests.
+type GlobalTaggingV1Intf interface {
+	// ListTags : Get all tags
+	ListTags(listTagsOptions *ListTagsOptions) (result *TagList, response *core.DetailedResponse, err error)
+	// ListTagsWithContext is an alternate form of the ListTags method which supports a Context parameter
+	ListTagsWithContext(ctx context.Context, listTagsOptions *ListTagsOptions) (result *TagList, response *core.DetailedResponse, err error)
+	// CreateTag : Create an access management tag
+	CreateTag(createTagOptions *CreateTagOptions) (result *CreateTagResults, response *core.DetailedResponse, err error)
+	// CreateTagWithContext is an alternate form of the CreateTag method which supports a Context parameter
+	CreateTagWithContext(ctx context.Context, createTagOptions *CreateTagOptions) (result *CreateTagResults, response *core.DetailedResponse, err error)
+	// DeleteTagAll : Delete all unused tags
+	DeleteTagAll(deleteTagAllOptions *DeleteTagAllOptions) (result *DeleteTagsResult, response *core.DetailedResponse, err error)
+	// DeleteTagAllWithContext is an alternate form of the DeleteTagAll method which supports a Context parameter
+	DeleteTagAllWithContext(ctx context.Context, deleteTagAllOptions *DeleteTagAllOptions) (result *DeleteTagsResult, response *core.DetailedResponse, err error)
+	// DeleteTag : Delete an unused tag
+	DeleteTag(deleteTagOptions *DeleteTagOptions) (result *DeleteTagResults, response *core.DetailedResponse, err error)
+	// DeleteTagWithContext is an alternate form of the DeleteTag method which supports a Context parameter
+	DeleteTagWithContext(ctx context.Context, deleteTagOptions *DeleteTagOptions) (result *DeleteTagResults, response *core.DetailedResponse, err error)
+	// AttachTag : Attach tags
+	AttachTag(attachTagOptions *AttachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+	// AttachTagWithContext is an alternate form of the AttachTag method which supports a Context parameter
+	AttachTagWithContext(ctx context.Context, attachTagOptions *AttachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+	// DetachTag : Detach tags
+	DetachTag(detachTagOptions *DetachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+	// DetachTagWithContext is an alternate form of the DetachTag method which supports a Context parameter
+	DetachTagWithContext(ctx context.Context, detachTagOptions *DetachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+}
+
+var _ GlobalTaggingV1Intf = (*GlobalTaggingV1)(nil)
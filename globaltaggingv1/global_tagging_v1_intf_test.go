@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1/mocks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe(`GlobalTaggingV1Intf`, func() {
+	countTags := func(client globaltaggingv1.GlobalTaggingV1Intf) (int64, error) {
+		result, _, err := client.ListTags(&globaltaggingv1.ListTagsOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return *result.TotalCount, nil
+	}
+
+	It(`Is satisfied by the generated mock`, func() {
+		client := new(mocks.GlobalTaggingV1)
+		client.On("ListTags", mock.Anything).Return(&globaltaggingv1.TagList{TotalCount: core.Int64Ptr(3)}, &core.DetailedResponse{StatusCode: 200}, nil).Once()
+		client.On("ListTags", mock.Anything).Return(nil, nil, errors.New("boom")).Once()
+
+		count, err := countTags(client)
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(3)))
+
+		_, err = countTags(client)
+		Expect(err).To(MatchError("boom"))
+		client.AssertNumberOfCalls(GinkgoT(), "ListTags", 2)
+	})
+})
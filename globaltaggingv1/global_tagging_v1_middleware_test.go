@@ -0,0 +1,67 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1 middleware`, func() {
+	It(`Wraps every request and response`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Header.Get("X-Audit-Id")).To(Equal("audit-1"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"total_count": 0, "offset": 0, "limit": 1, "items": []}`)
+		}))
+		defer testServer.Close()
+
+		client, err := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		statuses := []int{}
+		operations := [][]string{}
+		client.Use(common.HeaderMiddleware(map[string]string{"X-Audit-Id": "audit-1"}), func(next common.Handler) common.Handler {
+			return func(req *http.Request) (*http.Response, error) {
+				serviceName, serviceVersion, operationId := common.RequestOperation(req)
+				operations = append(operations, []string{serviceName, serviceVersion, operationId})
+				res, err := next(req)
+				if err == nil {
+					statuses = append(statuses, res.StatusCode)
+				}
+				return res, err
+			}
+		})
+
+		_, response, err := client.ListTags(client.NewListTagsOptions())
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(statuses).To(Equal([]int{200}))
+		Expect(operations).To(Equal([][]string{{"global_tagging", "V1", "ListTags"}}))
+	})
+})
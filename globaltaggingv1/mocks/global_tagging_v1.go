@@ -0,0 +1,218 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the GlobalTaggingV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// GlobalTaggingV1 : A testify mock of the GlobalTaggingV1 service client.
+type GlobalTaggingV1 struct {
+	mock.Mock
+}
+
+var _ globaltaggingv1.GlobalTaggingV1Intf = (*GlobalTaggingV1)(nil)
+
+// ListTags provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) ListTags(listTagsOptions *globaltaggingv1.ListTagsOptions) (*globaltaggingv1.TagList, *core.DetailedResponse, error) {
+	ret := _m.Called(listTagsOptions)
+	var r0 *globaltaggingv1.TagList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.TagList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTagsWithContext provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) ListTagsWithContext(ctx context.Context, listTagsOptions *globaltaggingv1.ListTagsOptions) (*globaltaggingv1.TagList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listTagsOptions)
+	var r0 *globaltaggingv1.TagList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.TagList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTag provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) CreateTag(createTagOptions *globaltaggingv1.CreateTagOptions) (*globaltaggingv1.CreateTagResults, *core.DetailedResponse, error) {
+	ret := _m.Called(createTagOptions)
+	var r0 *globaltaggingv1.CreateTagResults
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.CreateTagResults)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTagWithContext provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) CreateTagWithContext(ctx context.Context, createTagOptions *globaltaggingv1.CreateTagOptions) (*globaltaggingv1.CreateTagResults, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createTagOptions)
+	var r0 *globaltaggingv1.CreateTagResults
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.CreateTagResults)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTagAll provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) DeleteTagAll(deleteTagAllOptions *globaltaggingv1.DeleteTagAllOptions) (*globaltaggingv1.DeleteTagsResult, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteTagAllOptions)
+	var r0 *globaltaggingv1.DeleteTagsResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.DeleteTagsResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTagAllWithContext provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) DeleteTagAllWithContext(ctx context.Context, deleteTagAllOptions *globaltaggingv1.DeleteTagAllOptions) (*globaltaggingv1.DeleteTagsResult, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteTagAllOptions)
+	var r0 *globaltaggingv1.DeleteTagsResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.DeleteTagsResult)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTag provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) DeleteTag(deleteTagOptions *globaltaggingv1.DeleteTagOptions) (*globaltaggingv1.DeleteTagResults, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteTagOptions)
+	var r0 *globaltaggingv1.DeleteTagResults
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.DeleteTagResults)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTagWithContext provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) DeleteTagWithContext(ctx context.Context, deleteTagOptions *globaltaggingv1.DeleteTagOptions) (*globaltaggingv1.DeleteTagResults, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteTagOptions)
+	var r0 *globaltaggingv1.DeleteTagResults
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.DeleteTagResults)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AttachTag provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) AttachTag(attachTagOptions *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	ret := _m.Called(attachTagOptions)
+	var r0 *globaltaggingv1.TagResults
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.TagResults)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AttachTagWithContext provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) AttachTagWithContext(ctx context.Context, attachTagOptions *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, attachTagOptions)
+	var r0 *globaltaggingv1.TagResults
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.TagResults)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DetachTag provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) DetachTag(detachTagOptions *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	ret := _m.Called(detachTagOptions)
+	var r0 *globaltaggingv1.TagResults
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.TagResults)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DetachTagWithContext provides a mock function with the given fields.
+func (_m *GlobalTaggingV1) DetachTagWithContext(ctx context.Context, detachTagOptions *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, detachTagOptions)
+	var r0 *globaltaggingv1.TagResults
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*globaltaggingv1.TagResults)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
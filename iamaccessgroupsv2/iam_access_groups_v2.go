@@ -197,7 +197,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateAccessGroupWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "CreateAccessGroup"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups`, nil)
 	if err != nil {
@@ -244,7 +244,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateAccessGroupWithContext(ctx conte
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_access_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -284,7 +284,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupsWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "ListAccessGroups"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups`, nil)
 	if err != nil {
@@ -344,7 +344,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupsWithContext(ctx contex
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_access_groups", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -387,7 +387,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAccessGroupWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "GetAccessGroup"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}`, pathParamsMap)
 	if err != nil {
@@ -425,7 +425,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAccessGroupWithContext(ctx context.
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_access_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -467,7 +467,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateAccessGroupWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "UpdateAccessGroup"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}`, pathParamsMap)
 	if err != nil {
@@ -515,7 +515,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateAccessGroupWithContext(ctx conte
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_access_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -558,7 +558,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteAccessGroupWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "DeleteAccessGroup"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}`, pathParamsMap)
 	if err != nil {
@@ -591,7 +591,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteAccessGroupWithContext(ctx conte
 	response, err = iamAccessGroups.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_access_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -627,7 +627,7 @@ func (iamAccessGroups *IamAccessGroupsV2) IsMemberOfAccessGroupWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.HEAD)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "IsMemberOfAccessGroup"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/members/{iam_id}`, pathParamsMap)
 	if err != nil {
@@ -656,7 +656,7 @@ func (iamAccessGroups *IamAccessGroupsV2) IsMemberOfAccessGroupWithContext(ctx c
 	response, err = iamAccessGroups.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "is_member_of_access_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -693,7 +693,7 @@ func (iamAccessGroups *IamAccessGroupsV2) AddMembersToAccessGroupWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "AddMembersToAccessGroup"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/members`, pathParamsMap)
 	if err != nil {
@@ -735,7 +735,7 @@ func (iamAccessGroups *IamAccessGroupsV2) AddMembersToAccessGroupWithContext(ctx
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_members_to_access_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -779,7 +779,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupMembersWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "ListAccessGroupMembers"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/members`, pathParamsMap)
 	if err != nil {
@@ -829,7 +829,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupMembersWithContext(ctx
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_access_group_members", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -873,7 +873,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveMemberFromAccessGroupWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "RemoveMemberFromAccessGroup"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/members/{iam_id}`, pathParamsMap)
 	if err != nil {
@@ -902,7 +902,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveMemberFromAccessGroupWithContext
 	response, err = iamAccessGroups.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "remove_member_from_access_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -938,7 +938,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveMembersFromAccessGroupWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "RemoveMembersFromAccessGroup"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/members/delete`, pathParamsMap)
 	if err != nil {
@@ -980,7 +980,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveMembersFromAccessGroupWithContex
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "remove_members_from_access_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1023,7 +1023,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveMemberFromAllAccessGroupsWithCon
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "RemoveMemberFromAllAccessGroups"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/_allgroups/members/{iam_id}`, pathParamsMap)
 	if err != nil {
@@ -1056,7 +1056,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveMemberFromAllAccessGroupsWithCon
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "remove_member_from_all_access_groups", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1098,7 +1098,7 @@ func (iamAccessGroups *IamAccessGroupsV2) AddMemberToMultipleAccessGroupsWithCon
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "AddMemberToMultipleAccessGroups"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/_allgroups/members/{iam_id}`, pathParamsMap)
 	if err != nil {
@@ -1145,7 +1145,7 @@ func (iamAccessGroups *IamAccessGroupsV2) AddMemberToMultipleAccessGroupsWithCon
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_member_to_multiple_access_groups", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1190,7 +1190,7 @@ func (iamAccessGroups *IamAccessGroupsV2) AddAccessGroupRuleWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "AddAccessGroupRule"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/rules`, pathParamsMap)
 	if err != nil {
@@ -1241,7 +1241,7 @@ func (iamAccessGroups *IamAccessGroupsV2) AddAccessGroupRuleWithContext(ctx cont
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_access_group_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1283,7 +1283,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupRulesWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "ListAccessGroupRules"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/rules`, pathParamsMap)
 	if err != nil {
@@ -1314,7 +1314,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupRulesWithContext(ctx co
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_access_group_rules", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1357,7 +1357,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAccessGroupRuleWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "GetAccessGroupRule"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -1388,7 +1388,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAccessGroupRuleWithContext(ctx cont
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_access_group_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1431,7 +1431,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ReplaceAccessGroupRuleWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "ReplaceAccessGroupRule"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -1485,7 +1485,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ReplaceAccessGroupRuleWithContext(ctx
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_access_group_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1528,7 +1528,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveAccessGroupRuleWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "RemoveAccessGroupRule"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/{access_group_id}/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -1557,7 +1557,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveAccessGroupRuleWithContext(ctx c
 	response, err = iamAccessGroups.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "remove_access_group_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1586,7 +1586,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAccountSettingsWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "GetAccountSettings"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/settings`, nil)
 	if err != nil {
@@ -1619,7 +1619,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAccountSettingsWithContext(ctx cont
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1658,7 +1658,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateAccountSettingsWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "UpdateAccountSettings"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v2/groups/settings`, nil)
 	if err != nil {
@@ -1702,7 +1702,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateAccountSettingsWithContext(ctx c
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1741,7 +1741,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateTemplateWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "CreateTemplate"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates`, nil)
 	if err != nil {
@@ -1795,7 +1795,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateTemplateWithContext(ctx context.
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1832,7 +1832,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListTemplatesWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "ListTemplates"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates`, nil)
 	if err != nil {
@@ -1874,7 +1874,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListTemplatesWithContext(ctx context.C
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_templates", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1915,7 +1915,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateTemplateVersionWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "CreateTemplateVersion"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates/{template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -1966,7 +1966,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateTemplateVersionWithContext(ctx c
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2007,7 +2007,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListTemplateVersionsWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "ListTemplateVersions"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates/{template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -2042,7 +2042,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListTemplateVersionsWithContext(ctx co
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_template_versions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2084,7 +2084,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetTemplateVersionWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "GetTemplateVersion"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates/{template_id}/versions/{version_num}`, pathParamsMap)
 	if err != nil {
@@ -2119,7 +2119,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetTemplateVersionWithContext(ctx cont
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2162,7 +2162,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateTemplateVersionWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "UpdateTemplateVersion"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates/{template_id}/versions/{version_num}`, pathParamsMap)
 	if err != nil {
@@ -2216,7 +2216,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateTemplateVersionWithContext(ctx c
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2258,7 +2258,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteTemplateVersionWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "DeleteTemplateVersion"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates/{template_id}/versions/{version_num}`, pathParamsMap)
 	if err != nil {
@@ -2287,7 +2287,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteTemplateVersionWithContext(ctx c
 	response, err = iamAccessGroups.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2322,7 +2322,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CommitTemplateWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "CommitTemplate"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates/{template_id}/versions/{version_num}/commit`, pathParamsMap)
 	if err != nil {
@@ -2354,7 +2354,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CommitTemplateWithContext(ctx context.
 	response, err = iamAccessGroups.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2387,7 +2387,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetLatestTemplateVersionWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "GetLatestTemplateVersion"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates/{template_id}`, pathParamsMap)
 	if err != nil {
@@ -2422,7 +2422,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetLatestTemplateVersionWithContext(ct
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_latest_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2464,7 +2464,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteTemplateWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "DeleteTemplate"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_templates/{template_id}`, pathParamsMap)
 	if err != nil {
@@ -2493,7 +2493,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteTemplateWithContext(ctx context.
 	response, err = iamAccessGroups.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2523,7 +2523,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateAssignmentWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "CreateAssignment"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_assignments`, nil)
 	if err != nil {
@@ -2574,7 +2574,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateAssignmentWithContext(ctx contex
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2611,7 +2611,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAssignmentsWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "ListAssignments"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_assignments`, nil)
 	if err != nil {
@@ -2662,7 +2662,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAssignmentsWithContext(ctx context
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_assignments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2703,7 +2703,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAssignmentWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "GetAssignment"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -2738,7 +2738,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAssignmentWithContext(ctx context.C
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2779,7 +2779,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateAssignmentWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "UpdateAssignment"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -2821,7 +2821,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateAssignmentWithContext(ctx contex
 	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2862,7 +2862,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteAssignmentWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_access_groups", "V2", "DeleteAssignment"))
 	builder.EnableGzipCompression = iamAccessGroups.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamAccessGroups.Service.Options.URL, `/v1/group_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -2891,7 +2891,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteAssignmentWithContext(ctx contex
 	response, err = iamAccessGroups.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
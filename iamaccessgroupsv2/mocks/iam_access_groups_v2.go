@@ -0,0 +1,1018 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the IamAccessGroupsV2 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/stretchr/testify/mock"
+)
+
+// IamAccessGroupsV2 : A testify mock of the IamAccessGroupsV2 service client.
+type IamAccessGroupsV2 struct {
+	mock.Mock
+}
+
+var _ iamaccessgroupsv2.IamAccessGroupsV2Intf = (*IamAccessGroupsV2)(nil)
+
+// CreateAccessGroup provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CreateAccessGroup(createAccessGroupOptions *iamaccessgroupsv2.CreateAccessGroupOptions) (*iamaccessgroupsv2.Group, *core.DetailedResponse, error) {
+	ret := _m.Called(createAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.Group
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Group)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAccessGroupWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CreateAccessGroupWithContext(ctx context.Context, createAccessGroupOptions *iamaccessgroupsv2.CreateAccessGroupOptions) (*iamaccessgroupsv2.Group, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, createAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.Group
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Group)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccessGroups provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListAccessGroups(listAccessGroupsOptions *iamaccessgroupsv2.ListAccessGroupsOptions) (*iamaccessgroupsv2.GroupsList, *core.DetailedResponse, error) {
+	ret := _m.Called(listAccessGroupsOptions)
+	var r0 *iamaccessgroupsv2.GroupsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.GroupsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccessGroupsWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListAccessGroupsWithContext(ctx context.Context, listAccessGroupsOptions *iamaccessgroupsv2.ListAccessGroupsOptions) (*iamaccessgroupsv2.GroupsList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAccessGroupsOptions)
+	var r0 *iamaccessgroupsv2.GroupsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.GroupsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccessGroup provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetAccessGroup(getAccessGroupOptions *iamaccessgroupsv2.GetAccessGroupOptions) (*iamaccessgroupsv2.Group, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.Group
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Group)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccessGroupWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetAccessGroupWithContext(ctx context.Context, getAccessGroupOptions *iamaccessgroupsv2.GetAccessGroupOptions) (*iamaccessgroupsv2.Group, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.Group
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Group)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccessGroup provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) UpdateAccessGroup(updateAccessGroupOptions *iamaccessgroupsv2.UpdateAccessGroupOptions) (*iamaccessgroupsv2.Group, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.Group
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Group)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccessGroupWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) UpdateAccessGroupWithContext(ctx context.Context, updateAccessGroupOptions *iamaccessgroupsv2.UpdateAccessGroupOptions) (*iamaccessgroupsv2.Group, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.Group
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Group)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAccessGroup provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) DeleteAccessGroup(deleteAccessGroupOptions *iamaccessgroupsv2.DeleteAccessGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteAccessGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAccessGroupWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) DeleteAccessGroupWithContext(ctx context.Context, deleteAccessGroupOptions *iamaccessgroupsv2.DeleteAccessGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAccessGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// IsMemberOfAccessGroup provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) IsMemberOfAccessGroup(isMemberOfAccessGroupOptions *iamaccessgroupsv2.IsMemberOfAccessGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(isMemberOfAccessGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// IsMemberOfAccessGroupWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) IsMemberOfAccessGroupWithContext(ctx context.Context, isMemberOfAccessGroupOptions *iamaccessgroupsv2.IsMemberOfAccessGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, isMemberOfAccessGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// AddMembersToAccessGroup provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) AddMembersToAccessGroup(addMembersToAccessGroupOptions *iamaccessgroupsv2.AddMembersToAccessGroupOptions) (*iamaccessgroupsv2.AddGroupMembersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(addMembersToAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.AddGroupMembersResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.AddGroupMembersResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddMembersToAccessGroupWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) AddMembersToAccessGroupWithContext(ctx context.Context, addMembersToAccessGroupOptions *iamaccessgroupsv2.AddMembersToAccessGroupOptions) (*iamaccessgroupsv2.AddGroupMembersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, addMembersToAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.AddGroupMembersResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.AddGroupMembersResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccessGroupMembers provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListAccessGroupMembers(listAccessGroupMembersOptions *iamaccessgroupsv2.ListAccessGroupMembersOptions) (*iamaccessgroupsv2.GroupMembersList, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(listAccessGroupMembersOptions)
+	var r0 *iamaccessgroupsv2.GroupMembersList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.GroupMembersList)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccessGroupMembersWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListAccessGroupMembersWithContext(ctx context.Context, listAccessGroupMembersOptions *iamaccessgroupsv2.ListAccessGroupMembersOptions) (*iamaccessgroupsv2.GroupMembersList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAccessGroupMembersOptions)
+	var r0 *iamaccessgroupsv2.GroupMembersList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.GroupMembersList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RemoveMemberFromAccessGroup provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) RemoveMemberFromAccessGroup(removeMemberFromAccessGroupOptions *iamaccessgroupsv2.RemoveMemberFromAccessGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(removeMemberFromAccessGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// RemoveMemberFromAccessGroupWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) RemoveMemberFromAccessGroupWithContext(ctx context.Context, removeMemberFromAccessGroupOptions *iamaccessgroupsv2.RemoveMemberFromAccessGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, removeMemberFromAccessGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// RemoveMembersFromAccessGroup provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) RemoveMembersFromAccessGroup(removeMembersFromAccessGroupOptions *iamaccessgroupsv2.RemoveMembersFromAccessGroupOptions) (*iamaccessgroupsv2.DeleteGroupBulkMembersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(removeMembersFromAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.DeleteGroupBulkMembersResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.DeleteGroupBulkMembersResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RemoveMembersFromAccessGroupWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) RemoveMembersFromAccessGroupWithContext(ctx context.Context, removeMembersFromAccessGroupOptions *iamaccessgroupsv2.RemoveMembersFromAccessGroupOptions) (*iamaccessgroupsv2.DeleteGroupBulkMembersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, removeMembersFromAccessGroupOptions)
+	var r0 *iamaccessgroupsv2.DeleteGroupBulkMembersResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.DeleteGroupBulkMembersResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RemoveMemberFromAllAccessGroups provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) RemoveMemberFromAllAccessGroups(removeMemberFromAllAccessGroupsOptions *iamaccessgroupsv2.RemoveMemberFromAllAccessGroupsOptions) (*iamaccessgroupsv2.DeleteFromAllGroupsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(removeMemberFromAllAccessGroupsOptions)
+	var r0 *iamaccessgroupsv2.DeleteFromAllGroupsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.DeleteFromAllGroupsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RemoveMemberFromAllAccessGroupsWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) RemoveMemberFromAllAccessGroupsWithContext(ctx context.Context, removeMemberFromAllAccessGroupsOptions *iamaccessgroupsv2.RemoveMemberFromAllAccessGroupsOptions) (*iamaccessgroupsv2.DeleteFromAllGroupsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, removeMemberFromAllAccessGroupsOptions)
+	var r0 *iamaccessgroupsv2.DeleteFromAllGroupsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.DeleteFromAllGroupsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddMemberToMultipleAccessGroups provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) AddMemberToMultipleAccessGroups(addMemberToMultipleAccessGroupsOptions *iamaccessgroupsv2.AddMemberToMultipleAccessGroupsOptions) (*iamaccessgroupsv2.AddMembershipMultipleGroupsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(addMemberToMultipleAccessGroupsOptions)
+	var r0 *iamaccessgroupsv2.AddMembershipMultipleGroupsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.AddMembershipMultipleGroupsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddMemberToMultipleAccessGroupsWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) AddMemberToMultipleAccessGroupsWithContext(ctx context.Context, addMemberToMultipleAccessGroupsOptions *iamaccessgroupsv2.AddMemberToMultipleAccessGroupsOptions) (*iamaccessgroupsv2.AddMembershipMultipleGroupsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, addMemberToMultipleAccessGroupsOptions)
+	var r0 *iamaccessgroupsv2.AddMembershipMultipleGroupsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.AddMembershipMultipleGroupsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddAccessGroupRule provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) AddAccessGroupRule(addAccessGroupRuleOptions *iamaccessgroupsv2.AddAccessGroupRuleOptions) (*iamaccessgroupsv2.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(addAccessGroupRuleOptions)
+	var r0 *iamaccessgroupsv2.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddAccessGroupRuleWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) AddAccessGroupRuleWithContext(ctx context.Context, addAccessGroupRuleOptions *iamaccessgroupsv2.AddAccessGroupRuleOptions) (*iamaccessgroupsv2.Rule, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, addAccessGroupRuleOptions)
+	var r0 *iamaccessgroupsv2.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Rule)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccessGroupRules provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListAccessGroupRules(listAccessGroupRulesOptions *iamaccessgroupsv2.ListAccessGroupRulesOptions) (*iamaccessgroupsv2.RulesList, *core.DetailedResponse, error) {
+	ret := _m.Called(listAccessGroupRulesOptions)
+	var r0 *iamaccessgroupsv2.RulesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.RulesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccessGroupRulesWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListAccessGroupRulesWithContext(ctx context.Context, listAccessGroupRulesOptions *iamaccessgroupsv2.ListAccessGroupRulesOptions) (*iamaccessgroupsv2.RulesList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAccessGroupRulesOptions)
+	var r0 *iamaccessgroupsv2.RulesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.RulesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccessGroupRule provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetAccessGroupRule(getAccessGroupRuleOptions *iamaccessgroupsv2.GetAccessGroupRuleOptions) (*iamaccessgroupsv2.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccessGroupRuleOptions)
+	var r0 *iamaccessgroupsv2.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccessGroupRuleWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetAccessGroupRuleWithContext(ctx context.Context, getAccessGroupRuleOptions *iamaccessgroupsv2.GetAccessGroupRuleOptions) (*iamaccessgroupsv2.Rule, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccessGroupRuleOptions)
+	var r0 *iamaccessgroupsv2.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Rule)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceAccessGroupRule provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ReplaceAccessGroupRule(replaceAccessGroupRuleOptions *iamaccessgroupsv2.ReplaceAccessGroupRuleOptions) (*iamaccessgroupsv2.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceAccessGroupRuleOptions)
+	var r0 *iamaccessgroupsv2.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceAccessGroupRuleWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ReplaceAccessGroupRuleWithContext(ctx context.Context, replaceAccessGroupRuleOptions *iamaccessgroupsv2.ReplaceAccessGroupRuleOptions) (*iamaccessgroupsv2.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceAccessGroupRuleOptions)
+	var r0 *iamaccessgroupsv2.Rule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.Rule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RemoveAccessGroupRule provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) RemoveAccessGroupRule(removeAccessGroupRuleOptions *iamaccessgroupsv2.RemoveAccessGroupRuleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(removeAccessGroupRuleOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// RemoveAccessGroupRuleWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) RemoveAccessGroupRuleWithContext(ctx context.Context, removeAccessGroupRuleOptions *iamaccessgroupsv2.RemoveAccessGroupRuleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, removeAccessGroupRuleOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetAccountSettings provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetAccountSettings(getAccountSettingsOptions *iamaccessgroupsv2.GetAccountSettingsOptions) (*iamaccessgroupsv2.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountSettingsOptions)
+	var r0 *iamaccessgroupsv2.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSettingsWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *iamaccessgroupsv2.GetAccountSettingsOptions) (*iamaccessgroupsv2.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountSettingsOptions)
+	var r0 *iamaccessgroupsv2.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettings provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) UpdateAccountSettings(updateAccountSettingsOptions *iamaccessgroupsv2.UpdateAccountSettingsOptions) (*iamaccessgroupsv2.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAccountSettingsOptions)
+	var r0 *iamaccessgroupsv2.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettingsWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) UpdateAccountSettingsWithContext(ctx context.Context, updateAccountSettingsOptions *iamaccessgroupsv2.UpdateAccountSettingsOptions) (*iamaccessgroupsv2.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAccountSettingsOptions)
+	var r0 *iamaccessgroupsv2.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTemplate provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CreateTemplate(createTemplateOptions *iamaccessgroupsv2.CreateTemplateOptions) (*iamaccessgroupsv2.TemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createTemplateOptions)
+	var r0 *iamaccessgroupsv2.TemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTemplateWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CreateTemplateWithContext(ctx context.Context, createTemplateOptions *iamaccessgroupsv2.CreateTemplateOptions) (*iamaccessgroupsv2.TemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createTemplateOptions)
+	var r0 *iamaccessgroupsv2.TemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTemplates provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListTemplates(listTemplatesOptions *iamaccessgroupsv2.ListTemplatesOptions) (*iamaccessgroupsv2.ListTemplatesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listTemplatesOptions)
+	var r0 *iamaccessgroupsv2.ListTemplatesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.ListTemplatesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTemplatesWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListTemplatesWithContext(ctx context.Context, listTemplatesOptions *iamaccessgroupsv2.ListTemplatesOptions) (*iamaccessgroupsv2.ListTemplatesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listTemplatesOptions)
+	var r0 *iamaccessgroupsv2.ListTemplatesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.ListTemplatesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTemplateVersion provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CreateTemplateVersion(createTemplateVersionOptions *iamaccessgroupsv2.CreateTemplateVersionOptions) (*iamaccessgroupsv2.TemplateVersionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createTemplateVersionOptions)
+	var r0 *iamaccessgroupsv2.TemplateVersionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateVersionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CreateTemplateVersionWithContext(ctx context.Context, createTemplateVersionOptions *iamaccessgroupsv2.CreateTemplateVersionOptions) (*iamaccessgroupsv2.TemplateVersionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createTemplateVersionOptions)
+	var r0 *iamaccessgroupsv2.TemplateVersionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateVersionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTemplateVersions provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListTemplateVersions(listTemplateVersionsOptions *iamaccessgroupsv2.ListTemplateVersionsOptions) (*iamaccessgroupsv2.ListTemplateVersionsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listTemplateVersionsOptions)
+	var r0 *iamaccessgroupsv2.ListTemplateVersionsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.ListTemplateVersionsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTemplateVersionsWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListTemplateVersionsWithContext(ctx context.Context, listTemplateVersionsOptions *iamaccessgroupsv2.ListTemplateVersionsOptions) (*iamaccessgroupsv2.ListTemplateVersionsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listTemplateVersionsOptions)
+	var r0 *iamaccessgroupsv2.ListTemplateVersionsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.ListTemplateVersionsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTemplateVersion provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetTemplateVersion(getTemplateVersionOptions *iamaccessgroupsv2.GetTemplateVersionOptions) (*iamaccessgroupsv2.TemplateVersionResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getTemplateVersionOptions)
+	var r0 *iamaccessgroupsv2.TemplateVersionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateVersionResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetTemplateVersionWithContext(ctx context.Context, getTemplateVersionOptions *iamaccessgroupsv2.GetTemplateVersionOptions) (*iamaccessgroupsv2.TemplateVersionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getTemplateVersionOptions)
+	var r0 *iamaccessgroupsv2.TemplateVersionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateVersionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateTemplateVersion provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) UpdateTemplateVersion(updateTemplateVersionOptions *iamaccessgroupsv2.UpdateTemplateVersionOptions) (*iamaccessgroupsv2.TemplateVersionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updateTemplateVersionOptions)
+	var r0 *iamaccessgroupsv2.TemplateVersionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateVersionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) UpdateTemplateVersionWithContext(ctx context.Context, updateTemplateVersionOptions *iamaccessgroupsv2.UpdateTemplateVersionOptions) (*iamaccessgroupsv2.TemplateVersionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateTemplateVersionOptions)
+	var r0 *iamaccessgroupsv2.TemplateVersionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateVersionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTemplateVersion provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) DeleteTemplateVersion(deleteTemplateVersionOptions *iamaccessgroupsv2.DeleteTemplateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteTemplateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) DeleteTemplateVersionWithContext(ctx context.Context, deleteTemplateVersionOptions *iamaccessgroupsv2.DeleteTemplateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteTemplateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitTemplate provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CommitTemplate(commitTemplateOptions *iamaccessgroupsv2.CommitTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(commitTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitTemplateWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CommitTemplateWithContext(ctx context.Context, commitTemplateOptions *iamaccessgroupsv2.CommitTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, commitTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetLatestTemplateVersion provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetLatestTemplateVersion(getLatestTemplateVersionOptions *iamaccessgroupsv2.GetLatestTemplateVersionOptions) (*iamaccessgroupsv2.TemplateVersionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getLatestTemplateVersionOptions)
+	var r0 *iamaccessgroupsv2.TemplateVersionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateVersionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLatestTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetLatestTemplateVersionWithContext(ctx context.Context, getLatestTemplateVersionOptions *iamaccessgroupsv2.GetLatestTemplateVersionOptions) (*iamaccessgroupsv2.TemplateVersionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getLatestTemplateVersionOptions)
+	var r0 *iamaccessgroupsv2.TemplateVersionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateVersionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTemplate provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) DeleteTemplate(deleteTemplateOptions *iamaccessgroupsv2.DeleteTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteTemplateWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) DeleteTemplateWithContext(ctx context.Context, deleteTemplateOptions *iamaccessgroupsv2.DeleteTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateAssignment provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CreateAssignment(createAssignmentOptions *iamaccessgroupsv2.CreateAssignmentOptions) (*iamaccessgroupsv2.TemplateAssignmentResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(createAssignmentOptions)
+	var r0 *iamaccessgroupsv2.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateAssignmentResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) CreateAssignmentWithContext(ctx context.Context, createAssignmentOptions *iamaccessgroupsv2.CreateAssignmentOptions) (*iamaccessgroupsv2.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createAssignmentOptions)
+	var r0 *iamaccessgroupsv2.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAssignments provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListAssignments(listAssignmentsOptions *iamaccessgroupsv2.ListAssignmentsOptions) (*iamaccessgroupsv2.ListTemplateAssignmentResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(listAssignmentsOptions)
+	var r0 *iamaccessgroupsv2.ListTemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.ListTemplateAssignmentResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAssignmentsWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) ListAssignmentsWithContext(ctx context.Context, listAssignmentsOptions *iamaccessgroupsv2.ListAssignmentsOptions) (*iamaccessgroupsv2.ListTemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAssignmentsOptions)
+	var r0 *iamaccessgroupsv2.ListTemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.ListTemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAssignment provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetAssignment(getAssignmentOptions *iamaccessgroupsv2.GetAssignmentOptions) (*iamaccessgroupsv2.TemplateAssignmentVerboseResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getAssignmentOptions)
+	var r0 *iamaccessgroupsv2.TemplateAssignmentVerboseResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateAssignmentVerboseResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) GetAssignmentWithContext(ctx context.Context, getAssignmentOptions *iamaccessgroupsv2.GetAssignmentOptions) (*iamaccessgroupsv2.TemplateAssignmentVerboseResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAssignmentOptions)
+	var r0 *iamaccessgroupsv2.TemplateAssignmentVerboseResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateAssignmentVerboseResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAssignment provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) UpdateAssignment(updateAssignmentOptions *iamaccessgroupsv2.UpdateAssignmentOptions) (*iamaccessgroupsv2.TemplateAssignmentVerboseResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAssignmentOptions)
+	var r0 *iamaccessgroupsv2.TemplateAssignmentVerboseResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateAssignmentVerboseResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) UpdateAssignmentWithContext(ctx context.Context, updateAssignmentOptions *iamaccessgroupsv2.UpdateAssignmentOptions) (*iamaccessgroupsv2.TemplateAssignmentVerboseResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAssignmentOptions)
+	var r0 *iamaccessgroupsv2.TemplateAssignmentVerboseResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamaccessgroupsv2.TemplateAssignmentVerboseResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAssignment provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) DeleteAssignment(deleteAssignmentOptions *iamaccessgroupsv2.DeleteAssignmentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteAssignmentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamAccessGroupsV2) DeleteAssignmentWithContext(ctx context.Context, deleteAssignmentOptions *iamaccessgroupsv2.DeleteAssignmentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAssignmentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
@@ -0,0 +1,34 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamidentityv1
+
+import (
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The API definition of the service documents no error codes to register with common.RegisterErrorCodes, so the
+// errors of its operations are classified by the wording of their codes and by their status codes.
+
+// AsExceptionResponse returns the body of an error response returned by an operation of the service, or false if
+// "err" is not an error response or its body is not an ExceptionResponse.
+func AsExceptionResponse(err error) (exceptionResponse *ExceptionResponse, ok bool) {
+	serviceError, ok := common.AsServiceError(err)
+	if !ok || serviceError.UnmarshalBody("", &exceptionResponse, UnmarshalExceptionResponse) != nil {
+		return nil, false
+	}
+	return exceptionResponse, exceptionResponse != nil && len(exceptionResponse.Errors) > 0
+}
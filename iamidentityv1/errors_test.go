@@ -0,0 +1,58 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamidentityv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AsExceptionResponse`, func() {
+	It(`Returns the body of error responses`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"status_code": "404", "trace": "trace-1", "errors": [{"code": "not_found", "message_code": "BXNIM0102E", "message": "API key not found."}]}`)
+		}))
+		defer testServer.Close()
+
+		iamIdentityService, err := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		_, _, err = iamIdentityService.GetAPIKey(iamIdentityService.NewGetAPIKeyOptions("key-1"))
+		Expect(err).ToNot(BeNil())
+
+		Expect(errors.Is(err, common.ErrNotFound)).To(BeTrue())
+		exceptionResponse, ok := iamidentityv1.AsExceptionResponse(err)
+		Expect(ok).To(BeTrue())
+		Expect(*exceptionResponse.Trace).To(Equal("trace-1"))
+		Expect(*exceptionResponse.Errors[0].MessageCode).To(Equal("BXNIM0102E"))
+
+		_, ok = iamidentityv1.AsExceptionResponse(errors.New("connection refused"))
+		Expect(ok).To(BeFalse())
+	})
+})
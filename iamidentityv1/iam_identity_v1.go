@@ -191,7 +191,7 @@ func (iamIdentity *IamIdentityV1) ListServiceIdsWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListServiceIds"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceids/`, nil)
 	if err != nil {
@@ -250,7 +250,7 @@ func (iamIdentity *IamIdentityV1) ListServiceIdsWithContext(ctx context.Context,
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_service_ids", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -288,7 +288,7 @@ func (iamIdentity *IamIdentityV1) CreateServiceIDWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateServiceID"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceids/`, nil)
 	if err != nil {
@@ -349,7 +349,7 @@ func (iamIdentity *IamIdentityV1) CreateServiceIDWithContext(ctx context.Context
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_service_id", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -392,7 +392,7 @@ func (iamIdentity *IamIdentityV1) GetServiceIDWithContext(ctx context.Context, g
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetServiceID"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceids/{id}`, pathParamsMap)
 	if err != nil {
@@ -430,7 +430,7 @@ func (iamIdentity *IamIdentityV1) GetServiceIDWithContext(ctx context.Context, g
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_service_id", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -475,7 +475,7 @@ func (iamIdentity *IamIdentityV1) UpdateServiceIDWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateServiceID"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceids/{id}`, pathParamsMap)
 	if err != nil {
@@ -527,7 +527,7 @@ func (iamIdentity *IamIdentityV1) UpdateServiceIDWithContext(ctx context.Context
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_service_id", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -571,7 +571,7 @@ func (iamIdentity *IamIdentityV1) DeleteServiceIDWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteServiceID"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceids/{id}`, pathParamsMap)
 	if err != nil {
@@ -597,7 +597,7 @@ func (iamIdentity *IamIdentityV1) DeleteServiceIDWithContext(ctx context.Context
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_service_id", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -631,7 +631,7 @@ func (iamIdentity *IamIdentityV1) LockServiceIDWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "LockServiceID"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceids/{id}/lock`, pathParamsMap)
 	if err != nil {
@@ -657,7 +657,7 @@ func (iamIdentity *IamIdentityV1) LockServiceIDWithContext(ctx context.Context,
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "lock_service_id", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -691,7 +691,7 @@ func (iamIdentity *IamIdentityV1) UnlockServiceIDWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UnlockServiceID"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceids/{id}/lock`, pathParamsMap)
 	if err != nil {
@@ -717,7 +717,7 @@ func (iamIdentity *IamIdentityV1) UnlockServiceIDWithContext(ctx context.Context
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "unlock_service_id", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -741,7 +741,7 @@ func (iamIdentity *IamIdentityV1) ListServiceIDGroupWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListServiceIDGroup"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceid_groups`, nil)
 	if err != nil {
@@ -773,7 +773,7 @@ func (iamIdentity *IamIdentityV1) ListServiceIDGroupWithContext(ctx context.Cont
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_service_id_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -810,7 +810,7 @@ func (iamIdentity *IamIdentityV1) CreateServiceIDGroupWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateServiceIDGroup"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceid_groups`, nil)
 	if err != nil {
@@ -855,7 +855,7 @@ func (iamIdentity *IamIdentityV1) CreateServiceIDGroupWithContext(ctx context.Co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_service_id_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -896,7 +896,7 @@ func (iamIdentity *IamIdentityV1) GetServiceIDGroupWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetServiceIDGroup"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceid_groups/{id}`, pathParamsMap)
 	if err != nil {
@@ -924,7 +924,7 @@ func (iamIdentity *IamIdentityV1) GetServiceIDGroupWithContext(ctx context.Conte
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_service_id_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -965,7 +965,7 @@ func (iamIdentity *IamIdentityV1) UpdateServiceIDGroupWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateServiceIDGroup"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceid_groups/{id}`, pathParamsMap)
 	if err != nil {
@@ -1010,7 +1010,7 @@ func (iamIdentity *IamIdentityV1) UpdateServiceIDGroupWithContext(ctx context.Co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_service_id_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1051,7 +1051,7 @@ func (iamIdentity *IamIdentityV1) DeleteServiceIDGroupWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteServiceIDGroup"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/serviceid_groups/{id}`, pathParamsMap)
 	if err != nil {
@@ -1077,7 +1077,7 @@ func (iamIdentity *IamIdentityV1) DeleteServiceIDGroupWithContext(ctx context.Co
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_service_id_group", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1102,7 +1102,7 @@ func (iamIdentity *IamIdentityV1) ListAPIKeysWithContext(ctx context.Context, li
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListAPIKeys"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys`, nil)
 	if err != nil {
@@ -1164,7 +1164,7 @@ func (iamIdentity *IamIdentityV1) ListAPIKeysWithContext(ctx context.Context, li
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_api_keys", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1202,7 +1202,7 @@ func (iamIdentity *IamIdentityV1) CreateAPIKeyWithContext(ctx context.Context, c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateAPIKey"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys`, nil)
 	if err != nil {
@@ -1271,7 +1271,7 @@ func (iamIdentity *IamIdentityV1) CreateAPIKeyWithContext(ctx context.Context, c
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_api_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1304,7 +1304,7 @@ func (iamIdentity *IamIdentityV1) GetAPIKeysDetailsWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetAPIKeysDetails"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys/details`, nil)
 	if err != nil {
@@ -1339,7 +1339,7 @@ func (iamIdentity *IamIdentityV1) GetAPIKeysDetailsWithContext(ctx context.Conte
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_api_keys_details", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1381,7 +1381,7 @@ func (iamIdentity *IamIdentityV1) GetAPIKeyWithContext(ctx context.Context, getA
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetAPIKey"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys/{id}`, pathParamsMap)
 	if err != nil {
@@ -1416,7 +1416,7 @@ func (iamIdentity *IamIdentityV1) GetAPIKeyWithContext(ctx context.Context, getA
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_api_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1460,7 +1460,7 @@ func (iamIdentity *IamIdentityV1) UpdateAPIKeyWithContext(ctx context.Context, u
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateAPIKey"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys/{id}`, pathParamsMap)
 	if err != nil {
@@ -1514,7 +1514,7 @@ func (iamIdentity *IamIdentityV1) UpdateAPIKeyWithContext(ctx context.Context, u
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_api_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1556,7 +1556,7 @@ func (iamIdentity *IamIdentityV1) DeleteAPIKeyWithContext(ctx context.Context, d
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteAPIKey"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys/{id}`, pathParamsMap)
 	if err != nil {
@@ -1582,7 +1582,7 @@ func (iamIdentity *IamIdentityV1) DeleteAPIKeyWithContext(ctx context.Context, d
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_api_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1616,7 +1616,7 @@ func (iamIdentity *IamIdentityV1) LockAPIKeyWithContext(ctx context.Context, loc
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "LockAPIKey"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys/{id}/lock`, pathParamsMap)
 	if err != nil {
@@ -1642,7 +1642,7 @@ func (iamIdentity *IamIdentityV1) LockAPIKeyWithContext(ctx context.Context, loc
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "lock_api_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1676,7 +1676,7 @@ func (iamIdentity *IamIdentityV1) UnlockAPIKeyWithContext(ctx context.Context, u
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UnlockAPIKey"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys/{id}/lock`, pathParamsMap)
 	if err != nil {
@@ -1702,7 +1702,7 @@ func (iamIdentity *IamIdentityV1) UnlockAPIKeyWithContext(ctx context.Context, u
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "unlock_api_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1736,7 +1736,7 @@ func (iamIdentity *IamIdentityV1) DisableAPIKeyWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DisableAPIKey"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys/{id}/disable`, pathParamsMap)
 	if err != nil {
@@ -1762,7 +1762,7 @@ func (iamIdentity *IamIdentityV1) DisableAPIKeyWithContext(ctx context.Context,
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "disable_api_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1796,7 +1796,7 @@ func (iamIdentity *IamIdentityV1) EnableAPIKeyWithContext(ctx context.Context, e
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "EnableAPIKey"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/apikeys/{id}/disable`, pathParamsMap)
 	if err != nil {
@@ -1822,7 +1822,7 @@ func (iamIdentity *IamIdentityV1) EnableAPIKeyWithContext(ctx context.Context, e
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "enable_api_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1851,7 +1851,7 @@ func (iamIdentity *IamIdentityV1) CreateProfileWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateProfile"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles`, nil)
 	if err != nil {
@@ -1899,7 +1899,7 @@ func (iamIdentity *IamIdentityV1) CreateProfileWithContext(ctx context.Context,
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_profile", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1937,7 +1937,7 @@ func (iamIdentity *IamIdentityV1) ListProfilesWithContext(ctx context.Context, l
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListProfiles"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles`, nil)
 	if err != nil {
@@ -1988,7 +1988,7 @@ func (iamIdentity *IamIdentityV1) ListProfilesWithContext(ctx context.Context, l
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_profiles", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2030,7 +2030,7 @@ func (iamIdentity *IamIdentityV1) GetProfileWithContext(ctx context.Context, get
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetProfile"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}`, pathParamsMap)
 	if err != nil {
@@ -2062,7 +2062,7 @@ func (iamIdentity *IamIdentityV1) GetProfileWithContext(ctx context.Context, get
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2103,7 +2103,7 @@ func (iamIdentity *IamIdentityV1) UpdateProfileWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateProfile"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}`, pathParamsMap)
 	if err != nil {
@@ -2151,7 +2151,7 @@ func (iamIdentity *IamIdentityV1) UpdateProfileWithContext(ctx context.Context,
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_profile", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2193,7 +2193,7 @@ func (iamIdentity *IamIdentityV1) DeleteProfileWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteProfile"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}`, pathParamsMap)
 	if err != nil {
@@ -2219,7 +2219,7 @@ func (iamIdentity *IamIdentityV1) DeleteProfileWithContext(ctx context.Context,
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_profile", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2252,7 +2252,7 @@ func (iamIdentity *IamIdentityV1) CreateClaimRuleWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateClaimRule"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/rules`, pathParamsMap)
 	if err != nil {
@@ -2309,7 +2309,7 @@ func (iamIdentity *IamIdentityV1) CreateClaimRuleWithContext(ctx context.Context
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_claim_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2351,7 +2351,7 @@ func (iamIdentity *IamIdentityV1) ListClaimRulesWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListClaimRules"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/rules`, pathParamsMap)
 	if err != nil {
@@ -2379,7 +2379,7 @@ func (iamIdentity *IamIdentityV1) ListClaimRulesWithContext(ctx context.Context,
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_claim_rules", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2421,7 +2421,7 @@ func (iamIdentity *IamIdentityV1) GetClaimRuleWithContext(ctx context.Context, g
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetClaimRule"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/rules/{rule-id}`, pathParamsMap)
 	if err != nil {
@@ -2449,7 +2449,7 @@ func (iamIdentity *IamIdentityV1) GetClaimRuleWithContext(ctx context.Context, g
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_claim_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2491,7 +2491,7 @@ func (iamIdentity *IamIdentityV1) UpdateClaimRuleWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateClaimRule"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/rules/{rule-id}`, pathParamsMap)
 	if err != nil {
@@ -2551,7 +2551,7 @@ func (iamIdentity *IamIdentityV1) UpdateClaimRuleWithContext(ctx context.Context
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_claim_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2594,7 +2594,7 @@ func (iamIdentity *IamIdentityV1) DeleteClaimRuleWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteClaimRule"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/rules/{rule-id}`, pathParamsMap)
 	if err != nil {
@@ -2620,7 +2620,7 @@ func (iamIdentity *IamIdentityV1) DeleteClaimRuleWithContext(ctx context.Context
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_claim_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2654,7 +2654,7 @@ func (iamIdentity *IamIdentityV1) CreateLinkWithContext(ctx context.Context, cre
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateLink"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/links`, pathParamsMap)
 	if err != nil {
@@ -2702,7 +2702,7 @@ func (iamIdentity *IamIdentityV1) CreateLinkWithContext(ctx context.Context, cre
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_link", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2743,7 +2743,7 @@ func (iamIdentity *IamIdentityV1) ListLinksWithContext(ctx context.Context, list
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListLinks"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/links`, pathParamsMap)
 	if err != nil {
@@ -2771,7 +2771,7 @@ func (iamIdentity *IamIdentityV1) ListLinksWithContext(ctx context.Context, list
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_links", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2812,7 +2812,7 @@ func (iamIdentity *IamIdentityV1) DeleteLinkByParametersWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteLinkByParameters"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/links`, pathParamsMap)
 	if err != nil {
@@ -2855,7 +2855,7 @@ func (iamIdentity *IamIdentityV1) DeleteLinkByParametersWithContext(ctx context.
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_link_by_parameters", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2889,7 +2889,7 @@ func (iamIdentity *IamIdentityV1) GetLinkWithContext(ctx context.Context, getLin
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetLink"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/links/{link-id}`, pathParamsMap)
 	if err != nil {
@@ -2917,7 +2917,7 @@ func (iamIdentity *IamIdentityV1) GetLinkWithContext(ctx context.Context, getLin
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_link", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2959,7 +2959,7 @@ func (iamIdentity *IamIdentityV1) DeleteLinkWithContext(ctx context.Context, del
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteLink"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/links/{link-id}`, pathParamsMap)
 	if err != nil {
@@ -2985,7 +2985,7 @@ func (iamIdentity *IamIdentityV1) DeleteLinkWithContext(ctx context.Context, del
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_link", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3018,7 +3018,7 @@ func (iamIdentity *IamIdentityV1) GetProfileIdentitiesWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetProfileIdentities"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/identities`, pathParamsMap)
 	if err != nil {
@@ -3046,7 +3046,7 @@ func (iamIdentity *IamIdentityV1) GetProfileIdentitiesWithContext(ctx context.Co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile_identities", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3087,7 +3087,7 @@ func (iamIdentity *IamIdentityV1) SetProfileIdentitiesWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "SetProfileIdentities"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/identities`, pathParamsMap)
 	if err != nil {
@@ -3129,7 +3129,7 @@ func (iamIdentity *IamIdentityV1) SetProfileIdentitiesWithContext(ctx context.Co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_profile_identities", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3175,7 +3175,7 @@ func (iamIdentity *IamIdentityV1) SetProfileIdentityWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "SetProfileIdentity"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/identities/{identity-type}`, pathParamsMap)
 	if err != nil {
@@ -3223,7 +3223,7 @@ func (iamIdentity *IamIdentityV1) SetProfileIdentityWithContext(ctx context.Cont
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_profile_identity", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3266,7 +3266,7 @@ func (iamIdentity *IamIdentityV1) GetProfileIdentityWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetProfileIdentity"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/identities/{identity-type}/{identifier-id}`, pathParamsMap)
 	if err != nil {
@@ -3294,7 +3294,7 @@ func (iamIdentity *IamIdentityV1) GetProfileIdentityWithContext(ctx context.Cont
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile_identity", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3341,7 +3341,7 @@ func (iamIdentity *IamIdentityV1) DeleteProfileIdentityWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteProfileIdentity"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profiles/{profile-id}/identities/{identity-type}/{identifier-id}`, pathParamsMap)
 	if err != nil {
@@ -3367,7 +3367,7 @@ func (iamIdentity *IamIdentityV1) DeleteProfileIdentityWithContext(ctx context.C
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_profile_identity", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3401,7 +3401,7 @@ func (iamIdentity *IamIdentityV1) CreateReportWithContext(ctx context.Context, c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateReport"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/activity/accounts/{account_id}/report`, pathParamsMap)
 	if err != nil {
@@ -3436,7 +3436,7 @@ func (iamIdentity *IamIdentityV1) CreateReportWithContext(ctx context.Context, c
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_report", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3479,7 +3479,7 @@ func (iamIdentity *IamIdentityV1) GetReportWithContext(ctx context.Context, getR
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetReport"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/activity/accounts/{account_id}/report/{reference}`, pathParamsMap)
 	if err != nil {
@@ -3507,7 +3507,7 @@ func (iamIdentity *IamIdentityV1) GetReportWithContext(ctx context.Context, getR
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_report", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3548,7 +3548,7 @@ func (iamIdentity *IamIdentityV1) GetAccountSettingsWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetAccountSettings"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/accounts/{account_id}/settings/identity`, pathParamsMap)
 	if err != nil {
@@ -3583,7 +3583,7 @@ func (iamIdentity *IamIdentityV1) GetAccountSettingsWithContext(ctx context.Cont
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getAccountSettings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3625,7 +3625,7 @@ func (iamIdentity *IamIdentityV1) UpdateAccountSettingsWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateAccountSettings"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/accounts/{account_id}/settings/identity`, pathParamsMap)
 	if err != nil {
@@ -3700,7 +3700,7 @@ func (iamIdentity *IamIdentityV1) UpdateAccountSettingsWithContext(ctx context.C
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "updateAccountSettings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3741,7 +3741,7 @@ func (iamIdentity *IamIdentityV1) GetEffectiveAccountSettingsWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetEffectiveAccountSettings"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/accounts/{account_id}/effective_settings/identity`, pathParamsMap)
 	if err != nil {
@@ -3776,7 +3776,7 @@ func (iamIdentity *IamIdentityV1) GetEffectiveAccountSettingsWithContext(ctx con
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_effective_account_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3817,7 +3817,7 @@ func (iamIdentity *IamIdentityV1) GetMfaStatusWithContext(ctx context.Context, g
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetMfaStatus"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/mfa/accounts/{account_id}/status`, pathParamsMap)
 	if err != nil {
@@ -3847,7 +3847,7 @@ func (iamIdentity *IamIdentityV1) GetMfaStatusWithContext(ctx context.Context, g
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_mfa_status", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3889,7 +3889,7 @@ func (iamIdentity *IamIdentityV1) CreateMfaReportWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateMfaReport"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/mfa/accounts/{account_id}/report`, pathParamsMap)
 	if err != nil {
@@ -3921,7 +3921,7 @@ func (iamIdentity *IamIdentityV1) CreateMfaReportWithContext(ctx context.Context
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_mfa_report", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3964,7 +3964,7 @@ func (iamIdentity *IamIdentityV1) GetMfaReportWithContext(ctx context.Context, g
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetMfaReport"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/mfa/accounts/{account_id}/report/{reference}`, pathParamsMap)
 	if err != nil {
@@ -3992,7 +3992,7 @@ func (iamIdentity *IamIdentityV1) GetMfaReportWithContext(ctx context.Context, g
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_mfa_report", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4081,7 +4081,7 @@ func (iamIdentity *IamIdentityV1) UpdatePreferenceOnScopeAccountWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdatePreferenceOnScopeAccount"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/preferences/accounts/{account_id}/identities/{iam_id}/{service}/{preference_id}`, pathParamsMap)
 	if err != nil {
@@ -4123,7 +4123,7 @@ func (iamIdentity *IamIdentityV1) UpdatePreferenceOnScopeAccountWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_preference_on_scope_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4169,7 +4169,7 @@ func (iamIdentity *IamIdentityV1) DeletePreferencesOnScopeAccountWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeletePreferencesOnScopeAccount"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/preferences/accounts/{account_id}/identities/{iam_id}/{service}/{preference_id}`, pathParamsMap)
 	if err != nil {
@@ -4195,7 +4195,7 @@ func (iamIdentity *IamIdentityV1) DeletePreferencesOnScopeAccountWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_preferences_on_scope_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4247,7 +4247,7 @@ func (iamIdentity *IamIdentityV1) GetPreferencesOnScopeAccountWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetPreferencesOnScopeAccount"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/preferences/accounts/{account_id}/identities/{iam_id}/{service}/{preference_id}`, pathParamsMap)
 	if err != nil {
@@ -4275,7 +4275,7 @@ func (iamIdentity *IamIdentityV1) GetPreferencesOnScopeAccountWithContext(ctx co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_preferences_on_scope_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4322,7 +4322,7 @@ func (iamIdentity *IamIdentityV1) GetAllPreferencesOnScopeAccountWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetAllPreferencesOnScopeAccount"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/preferences/accounts/{account_id}/identities/{iam_id}`, pathParamsMap)
 	if err != nil {
@@ -4350,7 +4350,7 @@ func (iamIdentity *IamIdentityV1) GetAllPreferencesOnScopeAccountWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_all_preferences_on_scope_account", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4382,7 +4382,7 @@ func (iamIdentity *IamIdentityV1) ListProfileTemplatesWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListProfileTemplates"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates`, nil)
 	if err != nil {
@@ -4429,7 +4429,7 @@ func (iamIdentity *IamIdentityV1) ListProfileTemplatesWithContext(ctx context.Co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_profile_templates", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4466,7 +4466,7 @@ func (iamIdentity *IamIdentityV1) CreateProfileTemplateWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateProfileTemplate"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates`, nil)
 	if err != nil {
@@ -4520,7 +4520,7 @@ func (iamIdentity *IamIdentityV1) CreateProfileTemplateWithContext(ctx context.C
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_profile_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4561,7 +4561,7 @@ func (iamIdentity *IamIdentityV1) GetLatestProfileTemplateVersionWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetLatestProfileTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates/{template_id}`, pathParamsMap)
 	if err != nil {
@@ -4593,7 +4593,7 @@ func (iamIdentity *IamIdentityV1) GetLatestProfileTemplateVersionWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_latest_profile_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4635,7 +4635,7 @@ func (iamIdentity *IamIdentityV1) DeleteAllVersionsOfProfileTemplateWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteAllVersionsOfProfileTemplate"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates/{template_id}`, pathParamsMap)
 	if err != nil {
@@ -4661,7 +4661,7 @@ func (iamIdentity *IamIdentityV1) DeleteAllVersionsOfProfileTemplateWithContext(
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_all_versions_of_profile_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4694,7 +4694,7 @@ func (iamIdentity *IamIdentityV1) ListVersionsOfProfileTemplateWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListVersionsOfProfileTemplate"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates/{template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -4738,7 +4738,7 @@ func (iamIdentity *IamIdentityV1) ListVersionsOfProfileTemplateWithContext(ctx c
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_versions_of_profile_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4779,7 +4779,7 @@ func (iamIdentity *IamIdentityV1) CreateProfileTemplateVersionWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateProfileTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates/{template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -4833,7 +4833,7 @@ func (iamIdentity *IamIdentityV1) CreateProfileTemplateVersionWithContext(ctx co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_profile_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4875,7 +4875,7 @@ func (iamIdentity *IamIdentityV1) GetProfileTemplateVersionWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetProfileTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates/{template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -4907,7 +4907,7 @@ func (iamIdentity *IamIdentityV1) GetProfileTemplateVersionWithContext(ctx conte
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4949,7 +4949,7 @@ func (iamIdentity *IamIdentityV1) UpdateProfileTemplateVersionWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateProfileTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates/{template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -5006,7 +5006,7 @@ func (iamIdentity *IamIdentityV1) UpdateProfileTemplateVersionWithContext(ctx co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_profile_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5049,7 +5049,7 @@ func (iamIdentity *IamIdentityV1) DeleteProfileTemplateVersionWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteProfileTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates/{template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -5075,7 +5075,7 @@ func (iamIdentity *IamIdentityV1) DeleteProfileTemplateVersionWithContext(ctx co
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_profile_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5110,7 +5110,7 @@ func (iamIdentity *IamIdentityV1) CommitProfileTemplateWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CommitProfileTemplate"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_templates/{template_id}/versions/{version}/commit`, pathParamsMap)
 	if err != nil {
@@ -5136,7 +5136,7 @@ func (iamIdentity *IamIdentityV1) CommitProfileTemplateWithContext(ctx context.C
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_profile_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5160,7 +5160,7 @@ func (iamIdentity *IamIdentityV1) ListTrustedProfileAssignmentsWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListTrustedProfileAssignments"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_assignments/`, nil)
 	if err != nil {
@@ -5219,7 +5219,7 @@ func (iamIdentity *IamIdentityV1) ListTrustedProfileAssignmentsWithContext(ctx c
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_trusted_profile_assignments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5256,7 +5256,7 @@ func (iamIdentity *IamIdentityV1) CreateTrustedProfileAssignmentWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateTrustedProfileAssignment"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_assignments/`, nil)
 	if err != nil {
@@ -5304,7 +5304,7 @@ func (iamIdentity *IamIdentityV1) CreateTrustedProfileAssignmentWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_trusted_profile_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5345,7 +5345,7 @@ func (iamIdentity *IamIdentityV1) GetTrustedProfileAssignmentWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetTrustedProfileAssignment"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -5377,7 +5377,7 @@ func (iamIdentity *IamIdentityV1) GetTrustedProfileAssignmentWithContext(ctx con
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_trusted_profile_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5418,7 +5418,7 @@ func (iamIdentity *IamIdentityV1) DeleteTrustedProfileAssignmentWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteTrustedProfileAssignment"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -5446,7 +5446,7 @@ func (iamIdentity *IamIdentityV1) DeleteTrustedProfileAssignmentWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_trusted_profile_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5488,7 +5488,7 @@ func (iamIdentity *IamIdentityV1) UpdateTrustedProfileAssignmentWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateTrustedProfileAssignment"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/profile_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -5530,7 +5530,7 @@ func (iamIdentity *IamIdentityV1) UpdateTrustedProfileAssignmentWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_trusted_profile_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5562,7 +5562,7 @@ func (iamIdentity *IamIdentityV1) ListAccountSettingsTemplatesWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListAccountSettingsTemplates"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates`, nil)
 	if err != nil {
@@ -5609,7 +5609,7 @@ func (iamIdentity *IamIdentityV1) ListAccountSettingsTemplatesWithContext(ctx co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_account_settings_templates", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5646,7 +5646,7 @@ func (iamIdentity *IamIdentityV1) CreateAccountSettingsTemplateWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateAccountSettingsTemplate"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates`, nil)
 	if err != nil {
@@ -5694,7 +5694,7 @@ func (iamIdentity *IamIdentityV1) CreateAccountSettingsTemplateWithContext(ctx c
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account_settings_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5735,7 +5735,7 @@ func (iamIdentity *IamIdentityV1) GetLatestAccountSettingsTemplateVersionWithCon
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetLatestAccountSettingsTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates/{template_id}`, pathParamsMap)
 	if err != nil {
@@ -5767,7 +5767,7 @@ func (iamIdentity *IamIdentityV1) GetLatestAccountSettingsTemplateVersionWithCon
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_latest_account_settings_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5809,7 +5809,7 @@ func (iamIdentity *IamIdentityV1) DeleteAllVersionsOfAccountSettingsTemplateWith
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteAllVersionsOfAccountSettingsTemplate"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates/{template_id}`, pathParamsMap)
 	if err != nil {
@@ -5835,7 +5835,7 @@ func (iamIdentity *IamIdentityV1) DeleteAllVersionsOfAccountSettingsTemplateWith
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_all_versions_of_account_settings_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5868,7 +5868,7 @@ func (iamIdentity *IamIdentityV1) ListVersionsOfAccountSettingsTemplateWithConte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListVersionsOfAccountSettingsTemplate"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates/{template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -5912,7 +5912,7 @@ func (iamIdentity *IamIdentityV1) ListVersionsOfAccountSettingsTemplateWithConte
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_versions_of_account_settings_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5953,7 +5953,7 @@ func (iamIdentity *IamIdentityV1) CreateAccountSettingsTemplateVersionWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateAccountSettingsTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates/{template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -6001,7 +6001,7 @@ func (iamIdentity *IamIdentityV1) CreateAccountSettingsTemplateVersionWithContex
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account_settings_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6043,7 +6043,7 @@ func (iamIdentity *IamIdentityV1) GetAccountSettingsTemplateVersionWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetAccountSettingsTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates/{template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -6075,7 +6075,7 @@ func (iamIdentity *IamIdentityV1) GetAccountSettingsTemplateVersionWithContext(c
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_settings_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6117,7 +6117,7 @@ func (iamIdentity *IamIdentityV1) UpdateAccountSettingsTemplateVersionWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateAccountSettingsTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates/{template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -6168,7 +6168,7 @@ func (iamIdentity *IamIdentityV1) UpdateAccountSettingsTemplateVersionWithContex
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account_settings_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6210,7 +6210,7 @@ func (iamIdentity *IamIdentityV1) DeleteAccountSettingsTemplateVersionWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteAccountSettingsTemplateVersion"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates/{template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -6236,7 +6236,7 @@ func (iamIdentity *IamIdentityV1) DeleteAccountSettingsTemplateVersionWithContex
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_account_settings_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -6271,7 +6271,7 @@ func (iamIdentity *IamIdentityV1) CommitAccountSettingsTemplateWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CommitAccountSettingsTemplate"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_templates/{template_id}/versions/{version}/commit`, pathParamsMap)
 	if err != nil {
@@ -6297,7 +6297,7 @@ func (iamIdentity *IamIdentityV1) CommitAccountSettingsTemplateWithContext(ctx c
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_account_settings_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -6321,7 +6321,7 @@ func (iamIdentity *IamIdentityV1) ListAccountSettingsAssignmentsWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListAccountSettingsAssignments"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_assignments/`, nil)
 	if err != nil {
@@ -6380,7 +6380,7 @@ func (iamIdentity *IamIdentityV1) ListAccountSettingsAssignmentsWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_account_settings_assignments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6417,7 +6417,7 @@ func (iamIdentity *IamIdentityV1) CreateAccountSettingsAssignmentWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateAccountSettingsAssignment"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_assignments/`, nil)
 	if err != nil {
@@ -6465,7 +6465,7 @@ func (iamIdentity *IamIdentityV1) CreateAccountSettingsAssignmentWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account_settings_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6506,7 +6506,7 @@ func (iamIdentity *IamIdentityV1) GetAccountSettingsAssignmentWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetAccountSettingsAssignment"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -6538,7 +6538,7 @@ func (iamIdentity *IamIdentityV1) GetAccountSettingsAssignmentWithContext(ctx co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_settings_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6580,7 +6580,7 @@ func (iamIdentity *IamIdentityV1) DeleteAccountSettingsAssignmentWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteAccountSettingsAssignment"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -6608,7 +6608,7 @@ func (iamIdentity *IamIdentityV1) DeleteAccountSettingsAssignmentWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_account_settings_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6650,7 +6650,7 @@ func (iamIdentity *IamIdentityV1) UpdateAccountSettingsAssignmentWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateAccountSettingsAssignment"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/account_settings_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -6692,7 +6692,7 @@ func (iamIdentity *IamIdentityV1) UpdateAccountSettingsAssignmentWithContext(ctx
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account_settings_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6733,7 +6733,7 @@ func (iamIdentity *IamIdentityV1) GetAccountLimitsWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetAccountLimits"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/accounts/{account_id}/limits/identity`, pathParamsMap)
 	if err != nil {
@@ -6801,7 +6801,7 @@ func (iamIdentity *IamIdentityV1) GetAccountLimitsWithContext(ctx context.Contex
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getAccountLimits", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6842,7 +6842,7 @@ func (iamIdentity *IamIdentityV1) BulkListAccountEntityConsumptionWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "BulkListAccountEntityConsumption"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/accounts/{account_id}/limits/identity`, pathParamsMap)
 	if err != nil {
@@ -6917,7 +6917,7 @@ func (iamIdentity *IamIdentityV1) BulkListAccountEntityConsumptionWithContext(ct
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "bulkListAccountEntityConsumption", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -6953,7 +6953,7 @@ func (iamIdentity *IamIdentityV1) ListIdpsWithContext(ctx context.Context, listI
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListIdps"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/`, nil)
 	if err != nil {
@@ -6986,7 +6986,7 @@ func (iamIdentity *IamIdentityV1) ListIdpsWithContext(ctx context.Context, listI
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "listIdps", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7022,7 +7022,7 @@ func (iamIdentity *IamIdentityV1) CreateIdpWithContext(ctx context.Context, crea
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "CreateIdp"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/`, nil)
 	if err != nil {
@@ -7083,7 +7083,7 @@ func (iamIdentity *IamIdentityV1) CreateIdpWithContext(ctx context.Context, crea
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "createIdp", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7123,7 +7123,7 @@ func (iamIdentity *IamIdentityV1) GetIdpWithContext(ctx context.Context, getIdpO
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetIdp"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/{idp_id}`, pathParamsMap)
 	if err != nil {
@@ -7155,7 +7155,7 @@ func (iamIdentity *IamIdentityV1) GetIdpWithContext(ctx context.Context, getIdpO
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getIdp", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7195,7 +7195,7 @@ func (iamIdentity *IamIdentityV1) UpdateIdpWithContext(ctx context.Context, upda
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateIdp"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/{idp_id}`, pathParamsMap)
 	if err != nil {
@@ -7256,7 +7256,7 @@ func (iamIdentity *IamIdentityV1) UpdateIdpWithContext(ctx context.Context, upda
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "updateIdp", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7296,7 +7296,7 @@ func (iamIdentity *IamIdentityV1) DeleteIdpWithContext(ctx context.Context, dele
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "DeleteIdp"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/{idp_id}`, pathParamsMap)
 	if err != nil {
@@ -7322,7 +7322,7 @@ func (iamIdentity *IamIdentityV1) DeleteIdpWithContext(ctx context.Context, dele
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deleteIdp", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -7354,7 +7354,7 @@ func (iamIdentity *IamIdentityV1) ListConsumerAccountsWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListConsumerAccounts"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/{idp_id}/consumers`, pathParamsMap)
 	if err != nil {
@@ -7382,7 +7382,7 @@ func (iamIdentity *IamIdentityV1) ListConsumerAccountsWithContext(ctx context.Co
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "listConsumerAccounts", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7426,7 +7426,7 @@ func (iamIdentity *IamIdentityV1) ExportSamlMetadataWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ExportSamlMetadata"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/{idp_id}/saml/metadata`, pathParamsMap)
 	if err != nil {
@@ -7453,7 +7453,7 @@ func (iamIdentity *IamIdentityV1) ExportSamlMetadataWithContext(ctx context.Cont
 	response, err = iamIdentity.Service.Request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "exportSamlMetadata", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -7486,7 +7486,7 @@ func (iamIdentity *IamIdentityV1) ImportSamlIdpMetadataWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ImportSamlIdpMetadata"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/{idp_id}/saml/metadata`, pathParamsMap)
 	if err != nil {
@@ -7525,7 +7525,7 @@ func (iamIdentity *IamIdentityV1) ImportSamlIdpMetadataWithContext(ctx context.C
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "importSamlIdpMetadata", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7566,7 +7566,7 @@ func (iamIdentity *IamIdentityV1) GetIdpTestResultWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetIdpTestResult"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/{idp_id}/test`, pathParamsMap)
 	if err != nil {
@@ -7594,7 +7594,7 @@ func (iamIdentity *IamIdentityV1) GetIdpTestResultWithContext(ctx context.Contex
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getIdpTestResult", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7634,7 +7634,7 @@ func (iamIdentity *IamIdentityV1) TestIdpWithContext(ctx context.Context, testId
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "TestIdp"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v1/idps/{idp_id}/test`, pathParamsMap)
 	if err != nil {
@@ -7662,7 +7662,7 @@ func (iamIdentity *IamIdentityV1) TestIdpWithContext(ctx context.Context, testId
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "testIdp", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7702,7 +7702,7 @@ func (iamIdentity *IamIdentityV1) GetLoginSettingsWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetLoginSettings"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v2/loginsettings/{account_id}`, pathParamsMap)
 	if err != nil {
@@ -7730,7 +7730,7 @@ func (iamIdentity *IamIdentityV1) GetLoginSettingsWithContext(ctx context.Contex
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getLoginSettings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7770,7 +7770,7 @@ func (iamIdentity *IamIdentityV1) UpdateLoginSettingsWithContext(ctx context.Con
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateLoginSettings"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v2/loginsettings/{account_id}`, pathParamsMap)
 	if err != nil {
@@ -7809,7 +7809,7 @@ func (iamIdentity *IamIdentityV1) UpdateLoginSettingsWithContext(ctx context.Con
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "updateLoginSettings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7849,7 +7849,7 @@ func (iamIdentity *IamIdentityV1) ListIDPSettingsWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "ListIDPSettings"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v2/loginsettings/{account_id}/idps`, pathParamsMap)
 	if err != nil {
@@ -7882,7 +7882,7 @@ func (iamIdentity *IamIdentityV1) ListIDPSettingsWithContext(ctx context.Context
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "listIdPSettings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7923,7 +7923,7 @@ func (iamIdentity *IamIdentityV1) GetIDPSettingWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "GetIDPSetting"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v2/loginsettings/{account_id}/idps/{idp_id}`, pathParamsMap)
 	if err != nil {
@@ -7951,7 +7951,7 @@ func (iamIdentity *IamIdentityV1) GetIDPSettingWithContext(ctx context.Context,
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getIdPSetting", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -7992,7 +7992,7 @@ func (iamIdentity *IamIdentityV1) AddIDPSettingWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "AddIDPSetting"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v2/loginsettings/{account_id}/idps/{idp_id}`, pathParamsMap)
 	if err != nil {
@@ -8037,7 +8037,7 @@ func (iamIdentity *IamIdentityV1) AddIDPSettingWithContext(ctx context.Context,
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "addIdPSetting", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8078,7 +8078,7 @@ func (iamIdentity *IamIdentityV1) UpdateIDPSettingWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "UpdateIDPSetting"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v2/loginsettings/{account_id}/idps/{idp_id}`, pathParamsMap)
 	if err != nil {
@@ -8123,7 +8123,7 @@ func (iamIdentity *IamIdentityV1) UpdateIDPSettingWithContext(ctx context.Contex
 	response, err = iamIdentity.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "updateIdPSetting", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -8164,7 +8164,7 @@ func (iamIdentity *IamIdentityV1) RemoveIDPSettingWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_identity", "V1", "RemoveIDPSetting"))
 	builder.EnableGzipCompression = iamIdentity.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamIdentity.Service.Options.URL, `/v2/loginsettings/{account_id}/idps/{idp_id}`, pathParamsMap)
 	if err != nil {
@@ -8190,7 +8190,7 @@ func (iamIdentity *IamIdentityV1) RemoveIDPSettingWithContext(ctx context.Contex
 	response, err = iamIdentity.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "removeIdPSetting", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -0,0 +1,444 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package iamidentityv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// IamIdentityV1Intf : The operations of the IamIdentityV1 service client.
+// The interface is satisfied by *IamIdentityV1 and by mocks.IamIdentityV1, allowing consumers to substitute a mock for the client in tests.
+type IamIdentityV1Intf interface {
+	// ListServiceIds : List service IDs
+	ListServiceIds(listServiceIdsOptions *ListServiceIdsOptions) (result *ServiceIDList, response *core.DetailedResponse, err error)
+	// ListServiceIdsWithContext is an alternate form of the ListServiceIds method which supports a Context parameter
+	ListServiceIdsWithContext(ctx context.Context, listServiceIdsOptions *ListServiceIdsOptions) (result *ServiceIDList, response *core.DetailedResponse, err error)
+	// CreateServiceID : Create a service ID
+	CreateServiceID(createServiceIDOptions *CreateServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+	// CreateServiceIDWithContext is an alternate form of the CreateServiceID method which supports a Context parameter
+	CreateServiceIDWithContext(ctx context.Context, createServiceIDOptions *CreateServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+	// GetServiceID : Get details of a service ID
+	GetServiceID(getServiceIDOptions *GetServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+	// GetServiceIDWithContext is an alternate form of the GetServiceID method which supports a Context parameter
+	GetServiceIDWithContext(ctx context.Context, getServiceIDOptions *GetServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+	// UpdateServiceID : Update service ID
+	UpdateServiceID(updateServiceIDOptions *UpdateServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+	// UpdateServiceIDWithContext is an alternate form of the UpdateServiceID method which supports a Context parameter
+	UpdateServiceIDWithContext(ctx context.Context, updateServiceIDOptions *UpdateServiceIDOptions) (result *ServiceID, response *core.DetailedResponse, err error)
+	// DeleteServiceID : Deletes a service ID and associated API keys
+	DeleteServiceID(deleteServiceIDOptions *DeleteServiceIDOptions) (response *core.DetailedResponse, err error)
+	// DeleteServiceIDWithContext is an alternate form of the DeleteServiceID method which supports a Context parameter
+	DeleteServiceIDWithContext(ctx context.Context, deleteServiceIDOptions *DeleteServiceIDOptions) (response *core.DetailedResponse, err error)
+	// LockServiceID : Lock the service ID
+	LockServiceID(lockServiceIDOptions *LockServiceIDOptions) (response *core.DetailedResponse, err error)
+	// LockServiceIDWithContext is an alternate form of the LockServiceID method which supports a Context parameter
+	LockServiceIDWithContext(ctx context.Context, lockServiceIDOptions *LockServiceIDOptions) (response *core.DetailedResponse, err error)
+	// UnlockServiceID : Unlock the service ID
+	UnlockServiceID(unlockServiceIDOptions *UnlockServiceIDOptions) (response *core.DetailedResponse, err error)
+	// UnlockServiceIDWithContext is an alternate form of the UnlockServiceID method which supports a Context parameter
+	UnlockServiceIDWithContext(ctx context.Context, unlockServiceIDOptions *UnlockServiceIDOptions) (response *core.DetailedResponse, err error)
+	// ListServiceIDGroup : List service ID groups
+	ListServiceIDGroup(listServiceIDGroupOptions *ListServiceIDGroupOptions) (result *ServiceIDGroupList, response *core.DetailedResponse, err error)
+	// ListServiceIDGroupWithContext is an alternate form of the ListServiceIDGroup method which supports a Context parameter
+	ListServiceIDGroupWithContext(ctx context.Context, listServiceIDGroupOptions *ListServiceIDGroupOptions) (result *ServiceIDGroupList, response *core.DetailedResponse, err error)
+	// CreateServiceIDGroup : Create a service ID group
+	CreateServiceIDGroup(createServiceIDGroupOptions *CreateServiceIDGroupOptions) (result *ServiceIDGroup, response *core.DetailedResponse, err error)
+	// CreateServiceIDGroupWithContext is an alternate form of the CreateServiceIDGroup method which supports a Context parameter
+	CreateServiceIDGroupWithContext(ctx context.Context, createServiceIDGroupOptions *CreateServiceIDGroupOptions) (result *ServiceIDGroup, response *core.DetailedResponse, err error)
+	// GetServiceIDGroup : Get details of a service ID group
+	GetServiceIDGroup(getServiceIDGroupOptions *GetServiceIDGroupOptions) (result *ServiceIDGroup, response *core.DetailedResponse, err error)
+	// GetServiceIDGroupWithContext is an alternate form of the GetServiceIDGroup method which supports a Context parameter
+	GetServiceIDGroupWithContext(ctx context.Context, getServiceIDGroupOptions *GetServiceIDGroupOptions) (result *ServiceIDGroup, response *core.DetailedResponse, err error)
+	// UpdateServiceIDGroup : Update a service ID group
+	UpdateServiceIDGroup(updateServiceIDGroupOptions *UpdateServiceIDGroupOptions) (result *ServiceIDGroup, response *core.DetailedResponse, err error)
+	// UpdateServiceIDGroupWithContext is an alternate form of the UpdateServiceIDGroup method which supports a Context parameter
+	UpdateServiceIDGroupWithContext(ctx context.Context, updateServiceIDGroupOptions *UpdateServiceIDGroupOptions) (result *ServiceIDGroup, response *core.DetailedResponse, err error)
+	// DeleteServiceIDGroup : Delete a service ID group
+	DeleteServiceIDGroup(deleteServiceIDGroupOptions *DeleteServiceIDGroupOptions) (response *core.DetailedResponse, err error)
+	// DeleteServiceIDGroupWithContext is an alternate form of the DeleteServiceIDGroup method which supports a Context parameter
+	DeleteServiceIDGroupWithContext(ctx context.Context, deleteServiceIDGroupOptions *DeleteServiceIDGroupOptions) (response *core.DetailedResponse, err error)
+	// ListAPIKeys : Get API keys for a given service or user IAM ID and account ID
+	ListAPIKeys(listAPIKeysOptions *ListAPIKeysOptions) (result *APIKeyList, response *core.DetailedResponse, err error)
+	// ListAPIKeysWithContext is an alternate form of the ListAPIKeys method which supports a Context parameter
+	ListAPIKeysWithContext(ctx context.Context, listAPIKeysOptions *ListAPIKeysOptions) (result *APIKeyList, response *core.DetailedResponse, err error)
+	// CreateAPIKey : Create an API key
+	CreateAPIKey(createAPIKeyOptions *CreateAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+	// CreateAPIKeyWithContext is an alternate form of the CreateAPIKey method which supports a Context parameter
+	CreateAPIKeyWithContext(ctx context.Context, createAPIKeyOptions *CreateAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+	// GetAPIKeysDetails : Get details of an API key by its value
+	GetAPIKeysDetails(getAPIKeysDetailsOptions *GetAPIKeysDetailsOptions) (result *APIKey, response *core.DetailedResponse, err error)
+	// GetAPIKeysDetailsWithContext is an alternate form of the GetAPIKeysDetails method which supports a Context parameter
+	GetAPIKeysDetailsWithContext(ctx context.Context, getAPIKeysDetailsOptions *GetAPIKeysDetailsOptions) (result *APIKey, response *core.DetailedResponse, err error)
+	// GetAPIKey : Get details of an API key
+	GetAPIKey(getAPIKeyOptions *GetAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+	// GetAPIKeyWithContext is an alternate form of the GetAPIKey method which supports a Context parameter
+	GetAPIKeyWithContext(ctx context.Context, getAPIKeyOptions *GetAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+	// UpdateAPIKey : Updates an API key
+	UpdateAPIKey(updateAPIKeyOptions *UpdateAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+	// UpdateAPIKeyWithContext is an alternate form of the UpdateAPIKey method which supports a Context parameter
+	UpdateAPIKeyWithContext(ctx context.Context, updateAPIKeyOptions *UpdateAPIKeyOptions) (result *APIKey, response *core.DetailedResponse, err error)
+	// DeleteAPIKey : Deletes an API key
+	DeleteAPIKey(deleteAPIKeyOptions *DeleteAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// DeleteAPIKeyWithContext is an alternate form of the DeleteAPIKey method which supports a Context parameter
+	DeleteAPIKeyWithContext(ctx context.Context, deleteAPIKeyOptions *DeleteAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// LockAPIKey : Lock the API key
+	LockAPIKey(lockAPIKeyOptions *LockAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// LockAPIKeyWithContext is an alternate form of the LockAPIKey method which supports a Context parameter
+	LockAPIKeyWithContext(ctx context.Context, lockAPIKeyOptions *LockAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// UnlockAPIKey : Unlock the API key
+	UnlockAPIKey(unlockAPIKeyOptions *UnlockAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// UnlockAPIKeyWithContext is an alternate form of the UnlockAPIKey method which supports a Context parameter
+	UnlockAPIKeyWithContext(ctx context.Context, unlockAPIKeyOptions *UnlockAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// DisableAPIKey : Disable the API key
+	DisableAPIKey(disableAPIKeyOptions *DisableAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// DisableAPIKeyWithContext is an alternate form of the DisableAPIKey method which supports a Context parameter
+	DisableAPIKeyWithContext(ctx context.Context, disableAPIKeyOptions *DisableAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// EnableAPIKey : Enable the API key
+	EnableAPIKey(enableAPIKeyOptions *EnableAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// EnableAPIKeyWithContext is an alternate form of the EnableAPIKey method which supports a Context parameter
+	EnableAPIKeyWithContext(ctx context.Context, enableAPIKeyOptions *EnableAPIKeyOptions) (response *core.DetailedResponse, err error)
+	// CreateProfile : Create a trusted profile
+	CreateProfile(createProfileOptions *CreateProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+	// CreateProfileWithContext is an alternate form of the CreateProfile method which supports a Context parameter
+	CreateProfileWithContext(ctx context.Context, createProfileOptions *CreateProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+	// ListProfiles : List trusted profiles
+	ListProfiles(listProfilesOptions *ListProfilesOptions) (result *TrustedProfilesList, response *core.DetailedResponse, err error)
+	// ListProfilesWithContext is an alternate form of the ListProfiles method which supports a Context parameter
+	ListProfilesWithContext(ctx context.Context, listProfilesOptions *ListProfilesOptions) (result *TrustedProfilesList, response *core.DetailedResponse, err error)
+	// GetProfile : Get a trusted profile
+	GetProfile(getProfileOptions *GetProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+	// GetProfileWithContext is an alternate form of the GetProfile method which supports a Context parameter
+	GetProfileWithContext(ctx context.Context, getProfileOptions *GetProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+	// UpdateProfile : Update a trusted profile
+	UpdateProfile(updateProfileOptions *UpdateProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+	// UpdateProfileWithContext is an alternate form of the UpdateProfile method which supports a Context parameter
+	UpdateProfileWithContext(ctx context.Context, updateProfileOptions *UpdateProfileOptions) (result *TrustedProfile, response *core.DetailedResponse, err error)
+	// DeleteProfile : Delete a trusted profile
+	DeleteProfile(deleteProfileOptions *DeleteProfileOptions) (response *core.DetailedResponse, err error)
+	// DeleteProfileWithContext is an alternate form of the DeleteProfile method which supports a Context parameter
+	DeleteProfileWithContext(ctx context.Context, deleteProfileOptions *DeleteProfileOptions) (response *core.DetailedResponse, err error)
+	// CreateClaimRule : Create claim rule for a trusted profile
+	CreateClaimRule(createClaimRuleOptions *CreateClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+	// CreateClaimRuleWithContext is an alternate form of the CreateClaimRule method which supports a Context parameter
+	CreateClaimRuleWithContext(ctx context.Context, createClaimRuleOptions *CreateClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+	// ListClaimRules : List claim rules for a trusted profile
+	ListClaimRules(listClaimRulesOptions *ListClaimRulesOptions) (result *ProfileClaimRuleList, response *core.DetailedResponse, err error)
+	// ListClaimRulesWithContext is an alternate form of the ListClaimRules method which supports a Context parameter
+	ListClaimRulesWithContext(ctx context.Context, listClaimRulesOptions *ListClaimRulesOptions) (result *ProfileClaimRuleList, response *core.DetailedResponse, err error)
+	// GetClaimRule : Get a claim rule for a trusted profile
+	GetClaimRule(getClaimRuleOptions *GetClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+	// GetClaimRuleWithContext is an alternate form of the GetClaimRule method which supports a Context parameter
+	GetClaimRuleWithContext(ctx context.Context, getClaimRuleOptions *GetClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+	// UpdateClaimRule : Update claim rule for a trusted profile
+	UpdateClaimRule(updateClaimRuleOptions *UpdateClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+	// UpdateClaimRuleWithContext is an alternate form of the UpdateClaimRule method which supports a Context parameter
+	UpdateClaimRuleWithContext(ctx context.Context, updateClaimRuleOptions *UpdateClaimRuleOptions) (result *ProfileClaimRule, response *core.DetailedResponse, err error)
+	// DeleteClaimRule : Delete a claim rule
+	DeleteClaimRule(deleteClaimRuleOptions *DeleteClaimRuleOptions) (response *core.DetailedResponse, err error)
+	// DeleteClaimRuleWithContext is an alternate form of the DeleteClaimRule method which supports a Context parameter
+	DeleteClaimRuleWithContext(ctx context.Context, deleteClaimRuleOptions *DeleteClaimRuleOptions) (response *core.DetailedResponse, err error)
+	// CreateLink : Create link to a trusted profile
+	CreateLink(createLinkOptions *CreateLinkOptions) (result *ProfileLink, response *core.DetailedResponse, err error)
+	// CreateLinkWithContext is an alternate form of the CreateLink method which supports a Context parameter
+	CreateLinkWithContext(ctx context.Context, createLinkOptions *CreateLinkOptions) (result *ProfileLink, response *core.DetailedResponse, err error)
+	// ListLinks : List links to a trusted profile
+	ListLinks(listLinksOptions *ListLinksOptions) (result *ProfileLinkList, response *core.DetailedResponse, err error)
+	// ListLinksWithContext is an alternate form of the ListLinks method which supports a Context parameter
+	ListLinksWithContext(ctx context.Context, listLinksOptions *ListLinksOptions) (result *ProfileLinkList, response *core.DetailedResponse, err error)
+	// DeleteLinkByParameters : Delete compute resource link to profile by given parameters
+	DeleteLinkByParameters(deleteLinkByParametersOptions *DeleteLinkByParametersOptions) (response *core.DetailedResponse, err error)
+	// DeleteLinkByParametersWithContext is an alternate form of the DeleteLinkByParameters method which supports a Context parameter
+	DeleteLinkByParametersWithContext(ctx context.Context, deleteLinkByParametersOptions *DeleteLinkByParametersOptions) (response *core.DetailedResponse, err error)
+	// GetLink : Get link to a trusted profile
+	GetLink(getLinkOptions *GetLinkOptions) (result *ProfileLink, response *core.DetailedResponse, err error)
+	// GetLinkWithContext is an alternate form of the GetLink method which supports a Context parameter
+	GetLinkWithContext(ctx context.Context, getLinkOptions *GetLinkOptions) (result *ProfileLink, response *core.DetailedResponse, err error)
+	// DeleteLink : Delete link to a trusted profile
+	DeleteLink(deleteLinkOptions *DeleteLinkOptions) (response *core.DetailedResponse, err error)
+	// DeleteLinkWithContext is an alternate form of the DeleteLink method which supports a Context parameter
+	DeleteLinkWithContext(ctx context.Context, deleteLinkOptions *DeleteLinkOptions) (response *core.DetailedResponse, err error)
+	// GetProfileIdentities : Get a list of identities that can assume the trusted profile
+	GetProfileIdentities(getProfileIdentitiesOptions *GetProfileIdentitiesOptions) (result *ProfileIdentitiesResponse, response *core.DetailedResponse, err error)
+	// GetProfileIdentitiesWithContext is an alternate form of the GetProfileIdentities method which supports a Context parameter
+	GetProfileIdentitiesWithContext(ctx context.Context, getProfileIdentitiesOptions *GetProfileIdentitiesOptions) (result *ProfileIdentitiesResponse, response *core.DetailedResponse, err error)
+	// SetProfileIdentities : Update the list of identities that can assume the trusted profile
+	SetProfileIdentities(setProfileIdentitiesOptions *SetProfileIdentitiesOptions) (result *ProfileIdentitiesResponse, response *core.DetailedResponse, err error)
+	// SetProfileIdentitiesWithContext is an alternate form of the SetProfileIdentities method which supports a Context parameter
+	SetProfileIdentitiesWithContext(ctx context.Context, setProfileIdentitiesOptions *SetProfileIdentitiesOptions) (result *ProfileIdentitiesResponse, response *core.DetailedResponse, err error)
+	// SetProfileIdentity : Add a specific identity that can assume the trusted profile
+	SetProfileIdentity(setProfileIdentityOptions *SetProfileIdentityOptions) (result *ProfileIdentityResponse, response *core.DetailedResponse, err error)
+	// SetProfileIdentityWithContext is an alternate form of the SetProfileIdentity method which supports a Context parameter
+	SetProfileIdentityWithContext(ctx context.Context, setProfileIdentityOptions *SetProfileIdentityOptions) (result *ProfileIdentityResponse, response *core.DetailedResponse, err error)
+	// GetProfileIdentity : Get the identity that can assume the trusted profile
+	GetProfileIdentity(getProfileIdentityOptions *GetProfileIdentityOptions) (result *ProfileIdentityResponse, response *core.DetailedResponse, err error)
+	// GetProfileIdentityWithContext is an alternate form of the GetProfileIdentity method which supports a Context parameter
+	GetProfileIdentityWithContext(ctx context.Context, getProfileIdentityOptions *GetProfileIdentityOptions) (result *ProfileIdentityResponse, response *core.DetailedResponse, err error)
+	// DeleteProfileIdentity : Delete the identity that can assume the trusted profile
+	DeleteProfileIdentity(deleteProfileIdentityOptions *DeleteProfileIdentityOptions) (response *core.DetailedResponse, err error)
+	// DeleteProfileIdentityWithContext is an alternate form of the DeleteProfileIdentity method which supports a Context parameter
+	DeleteProfileIdentityWithContext(ctx context.Context, deleteProfileIdentityOptions *DeleteProfileIdentityOptions) (response *core.DetailedResponse, err error)
+	// CreateReport : Trigger activity report for the account
+	CreateReport(createReportOptions *CreateReportOptions) (result *ReportReference, response *core.DetailedResponse, err error)
+	// CreateReportWithContext is an alternate form of the CreateReport method which supports a Context parameter
+	CreateReportWithContext(ctx context.Context, createReportOptions *CreateReportOptions) (result *ReportReference, response *core.DetailedResponse, err error)
+	// GetReport : Get activity report for the account
+	GetReport(getReportOptions *GetReportOptions) (result *Report, response *core.DetailedResponse, err error)
+	// GetReportWithContext is an alternate form of the GetReport method which supports a Context parameter
+	GetReportWithContext(ctx context.Context, getReportOptions *GetReportOptions) (result *Report, response *core.DetailedResponse, err error)
+	// GetAccountSettings : Get account configurations
+	GetAccountSettings(getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+	// GetAccountSettingsWithContext is an alternate form of the GetAccountSettings method which supports a Context parameter
+	GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+	// UpdateAccountSettings : Update account configurations
+	UpdateAccountSettings(updateAccountSettingsOptions *UpdateAccountSettingsOptions) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+	// UpdateAccountSettingsWithContext is an alternate form of the UpdateAccountSettings method which supports a Context parameter
+	UpdateAccountSettingsWithContext(ctx context.Context, updateAccountSettingsOptions *UpdateAccountSettingsOptions) (result *AccountSettingsResponse, response *core.DetailedResponse, err error)
+	// GetEffectiveAccountSettings : Get effective account settings configuration
+	GetEffectiveAccountSettings(getEffectiveAccountSettingsOptions *GetEffectiveAccountSettingsOptions) (result *EffectiveAccountSettingsResponse, response *core.DetailedResponse, err error)
+	// GetEffectiveAccountSettingsWithContext is an alternate form of the GetEffectiveAccountSettings method which supports a Context parameter
+	GetEffectiveAccountSettingsWithContext(ctx context.Context, getEffectiveAccountSettingsOptions *GetEffectiveAccountSettingsOptions) (result *EffectiveAccountSettingsResponse, response *core.DetailedResponse, err error)
+	// GetMfaStatus : Get MFA enrollment status for a single user in the account
+	GetMfaStatus(getMfaStatusOptions *GetMfaStatusOptions) (result *UserMfaEnrollments, response *core.DetailedResponse, err error)
+	// GetMfaStatusWithContext is an alternate form of the GetMfaStatus method which supports a Context parameter
+	GetMfaStatusWithContext(ctx context.Context, getMfaStatusOptions *GetMfaStatusOptions) (result *UserMfaEnrollments, response *core.DetailedResponse, err error)
+	// CreateMfaReport : Trigger MFA enrollment status report for the account
+	CreateMfaReport(createMfaReportOptions *CreateMfaReportOptions) (result *ReportReference, response *core.DetailedResponse, err error)
+	// CreateMfaReportWithContext is an alternate form of the CreateMfaReport method which supports a Context parameter
+	CreateMfaReportWithContext(ctx context.Context, createMfaReportOptions *CreateMfaReportOptions) (result *ReportReference, response *core.DetailedResponse, err error)
+	// GetMfaReport : Get MFA enrollment status report for the account
+	GetMfaReport(getMfaReportOptions *GetMfaReportOptions) (result *ReportMfaEnrollmentStatus, response *core.DetailedResponse, err error)
+	// GetMfaReportWithContext is an alternate form of the GetMfaReport method which supports a Context parameter
+	GetMfaReportWithContext(ctx context.Context, getMfaReportOptions *GetMfaReportOptions) (result *ReportMfaEnrollmentStatus, response *core.DetailedResponse, err error)
+	// UpdatePreferenceOnScopeAccount : Update Identity Preference on scope account
+	UpdatePreferenceOnScopeAccount(updatePreferenceOnScopeAccountOptions *UpdatePreferenceOnScopeAccountOptions) (result *IdentityPreferenceResponse, response *core.DetailedResponse, err error)
+	// UpdatePreferenceOnScopeAccountWithContext is an alternate form of the UpdatePreferenceOnScopeAccount method which supports a Context parameter
+	UpdatePreferenceOnScopeAccountWithContext(ctx context.Context, updatePreferenceOnScopeAccountOptions *UpdatePreferenceOnScopeAccountOptions) (result *IdentityPreferenceResponse, response *core.DetailedResponse, err error)
+	// DeletePreferencesOnScopeAccount : Delete Identity Preference on scope account
+	DeletePreferencesOnScopeAccount(deletePreferencesOnScopeAccountOptions *DeletePreferencesOnScopeAccountOptions) (response *core.DetailedResponse, err error)
+	// DeletePreferencesOnScopeAccountWithContext is an alternate form of the DeletePreferencesOnScopeAccount method which supports a Context parameter
+	DeletePreferencesOnScopeAccountWithContext(ctx context.Context, deletePreferencesOnScopeAccountOptions *DeletePreferencesOnScopeAccountOptions) (response *core.DetailedResponse, err error)
+	// GetPreferencesOnScopeAccount : Get Identity Preference on scope account
+	GetPreferencesOnScopeAccount(getPreferencesOnScopeAccountOptions *GetPreferencesOnScopeAccountOptions) (result *IdentityPreferenceResponse, response *core.DetailedResponse, err error)
+	// GetPreferencesOnScopeAccountWithContext is an alternate form of the GetPreferencesOnScopeAccount method which supports a Context parameter
+	GetPreferencesOnScopeAccountWithContext(ctx context.Context, getPreferencesOnScopeAccountOptions *GetPreferencesOnScopeAccountOptions) (result *IdentityPreferenceResponse, response *core.DetailedResponse, err error)
+	// GetAllPreferencesOnScopeAccount : Get all Identity Preferences on scope account
+	GetAllPreferencesOnScopeAccount(getAllPreferencesOnScopeAccountOptions *GetAllPreferencesOnScopeAccountOptions) (result *IdentityPreferencesResponse, response *core.DetailedResponse, err error)
+	// GetAllPreferencesOnScopeAccountWithContext is an alternate form of the GetAllPreferencesOnScopeAccount method which supports a Context parameter
+	GetAllPreferencesOnScopeAccountWithContext(ctx context.Context, getAllPreferencesOnScopeAccountOptions *GetAllPreferencesOnScopeAccountOptions) (result *IdentityPreferencesResponse, response *core.DetailedResponse, err error)
+	// ListProfileTemplates : List trusted profile templates
+	ListProfileTemplates(listProfileTemplatesOptions *ListProfileTemplatesOptions) (result *TrustedProfileTemplateList, response *core.DetailedResponse, err error)
+	// ListProfileTemplatesWithContext is an alternate form of the ListProfileTemplates method which supports a Context parameter
+	ListProfileTemplatesWithContext(ctx context.Context, listProfileTemplatesOptions *ListProfileTemplatesOptions) (result *TrustedProfileTemplateList, response *core.DetailedResponse, err error)
+	// CreateProfileTemplate : Create a trusted profile template
+	CreateProfileTemplate(createProfileTemplateOptions *CreateProfileTemplateOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// CreateProfileTemplateWithContext is an alternate form of the CreateProfileTemplate method which supports a Context parameter
+	CreateProfileTemplateWithContext(ctx context.Context, createProfileTemplateOptions *CreateProfileTemplateOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// GetLatestProfileTemplateVersion : Get latest version of a trusted profile template
+	GetLatestProfileTemplateVersion(getLatestProfileTemplateVersionOptions *GetLatestProfileTemplateVersionOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// GetLatestProfileTemplateVersionWithContext is an alternate form of the GetLatestProfileTemplateVersion method which supports a Context parameter
+	GetLatestProfileTemplateVersionWithContext(ctx context.Context, getLatestProfileTemplateVersionOptions *GetLatestProfileTemplateVersionOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// DeleteAllVersionsOfProfileTemplate : Delete all versions of a trusted profile template
+	DeleteAllVersionsOfProfileTemplate(deleteAllVersionsOfProfileTemplateOptions *DeleteAllVersionsOfProfileTemplateOptions) (response *core.DetailedResponse, err error)
+	// DeleteAllVersionsOfProfileTemplateWithContext is an alternate form of the DeleteAllVersionsOfProfileTemplate method which supports a Context parameter
+	DeleteAllVersionsOfProfileTemplateWithContext(ctx context.Context, deleteAllVersionsOfProfileTemplateOptions *DeleteAllVersionsOfProfileTemplateOptions) (response *core.DetailedResponse, err error)
+	// ListVersionsOfProfileTemplate : List trusted profile template versions
+	ListVersionsOfProfileTemplate(listVersionsOfProfileTemplateOptions *ListVersionsOfProfileTemplateOptions) (result *TrustedProfileTemplateList, response *core.DetailedResponse, err error)
+	// ListVersionsOfProfileTemplateWithContext is an alternate form of the ListVersionsOfProfileTemplate method which supports a Context parameter
+	ListVersionsOfProfileTemplateWithContext(ctx context.Context, listVersionsOfProfileTemplateOptions *ListVersionsOfProfileTemplateOptions) (result *TrustedProfileTemplateList, response *core.DetailedResponse, err error)
+	// CreateProfileTemplateVersion : Create new version of a trusted profile template
+	CreateProfileTemplateVersion(createProfileTemplateVersionOptions *CreateProfileTemplateVersionOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// CreateProfileTemplateVersionWithContext is an alternate form of the CreateProfileTemplateVersion method which supports a Context parameter
+	CreateProfileTemplateVersionWithContext(ctx context.Context, createProfileTemplateVersionOptions *CreateProfileTemplateVersionOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// GetProfileTemplateVersion : Get version of trusted profile template
+	GetProfileTemplateVersion(getProfileTemplateVersionOptions *GetProfileTemplateVersionOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// GetProfileTemplateVersionWithContext is an alternate form of the GetProfileTemplateVersion method which supports a Context parameter
+	GetProfileTemplateVersionWithContext(ctx context.Context, getProfileTemplateVersionOptions *GetProfileTemplateVersionOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// UpdateProfileTemplateVersion : Update version of trusted profile template
+	UpdateProfileTemplateVersion(updateProfileTemplateVersionOptions *UpdateProfileTemplateVersionOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// UpdateProfileTemplateVersionWithContext is an alternate form of the UpdateProfileTemplateVersion method which supports a Context parameter
+	UpdateProfileTemplateVersionWithContext(ctx context.Context, updateProfileTemplateVersionOptions *UpdateProfileTemplateVersionOptions) (result *TrustedProfileTemplateResponse, response *core.DetailedResponse, err error)
+	// DeleteProfileTemplateVersion : Delete version of trusted profile template
+	DeleteProfileTemplateVersion(deleteProfileTemplateVersionOptions *DeleteProfileTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// DeleteProfileTemplateVersionWithContext is an alternate form of the DeleteProfileTemplateVersion method which supports a Context parameter
+	DeleteProfileTemplateVersionWithContext(ctx context.Context, deleteProfileTemplateVersionOptions *DeleteProfileTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// CommitProfileTemplate : Commit a template version
+	CommitProfileTemplate(commitProfileTemplateOptions *CommitProfileTemplateOptions) (response *core.DetailedResponse, err error)
+	// CommitProfileTemplateWithContext is an alternate form of the CommitProfileTemplate method which supports a Context parameter
+	CommitProfileTemplateWithContext(ctx context.Context, commitProfileTemplateOptions *CommitProfileTemplateOptions) (response *core.DetailedResponse, err error)
+	// ListTrustedProfileAssignments : List assignments
+	ListTrustedProfileAssignments(listTrustedProfileAssignmentsOptions *ListTrustedProfileAssignmentsOptions) (result *TemplateAssignmentListResponse, response *core.DetailedResponse, err error)
+	// ListTrustedProfileAssignmentsWithContext is an alternate form of the ListTrustedProfileAssignments method which supports a Context parameter
+	ListTrustedProfileAssignmentsWithContext(ctx context.Context, listTrustedProfileAssignmentsOptions *ListTrustedProfileAssignmentsOptions) (result *TemplateAssignmentListResponse, response *core.DetailedResponse, err error)
+	// CreateTrustedProfileAssignment : Create assignment
+	CreateTrustedProfileAssignment(createTrustedProfileAssignmentOptions *CreateTrustedProfileAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// CreateTrustedProfileAssignmentWithContext is an alternate form of the CreateTrustedProfileAssignment method which supports a Context parameter
+	CreateTrustedProfileAssignmentWithContext(ctx context.Context, createTrustedProfileAssignmentOptions *CreateTrustedProfileAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// GetTrustedProfileAssignment : Get assignment
+	GetTrustedProfileAssignment(getTrustedProfileAssignmentOptions *GetTrustedProfileAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// GetTrustedProfileAssignmentWithContext is an alternate form of the GetTrustedProfileAssignment method which supports a Context parameter
+	GetTrustedProfileAssignmentWithContext(ctx context.Context, getTrustedProfileAssignmentOptions *GetTrustedProfileAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// DeleteTrustedProfileAssignment : Delete assignment
+	DeleteTrustedProfileAssignment(deleteTrustedProfileAssignmentOptions *DeleteTrustedProfileAssignmentOptions) (result *ExceptionResponse, response *core.DetailedResponse, err error)
+	// DeleteTrustedProfileAssignmentWithContext is an alternate form of the DeleteTrustedProfileAssignment method which supports a Context parameter
+	DeleteTrustedProfileAssignmentWithContext(ctx context.Context, deleteTrustedProfileAssignmentOptions *DeleteTrustedProfileAssignmentOptions) (result *ExceptionResponse, response *core.DetailedResponse, err error)
+	// UpdateTrustedProfileAssignment : Update assignment
+	UpdateTrustedProfileAssignment(updateTrustedProfileAssignmentOptions *UpdateTrustedProfileAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// UpdateTrustedProfileAssignmentWithContext is an alternate form of the UpdateTrustedProfileAssignment method which supports a Context parameter
+	UpdateTrustedProfileAssignmentWithContext(ctx context.Context, updateTrustedProfileAssignmentOptions *UpdateTrustedProfileAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// ListAccountSettingsTemplates : List account settings templates
+	ListAccountSettingsTemplates(listAccountSettingsTemplatesOptions *ListAccountSettingsTemplatesOptions) (result *AccountSettingsTemplateList, response *core.DetailedResponse, err error)
+	// ListAccountSettingsTemplatesWithContext is an alternate form of the ListAccountSettingsTemplates method which supports a Context parameter
+	ListAccountSettingsTemplatesWithContext(ctx context.Context, listAccountSettingsTemplatesOptions *ListAccountSettingsTemplatesOptions) (result *AccountSettingsTemplateList, response *core.DetailedResponse, err error)
+	// CreateAccountSettingsTemplate : Create an account settings template
+	CreateAccountSettingsTemplate(createAccountSettingsTemplateOptions *CreateAccountSettingsTemplateOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// CreateAccountSettingsTemplateWithContext is an alternate form of the CreateAccountSettingsTemplate method which supports a Context parameter
+	CreateAccountSettingsTemplateWithContext(ctx context.Context, createAccountSettingsTemplateOptions *CreateAccountSettingsTemplateOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// GetLatestAccountSettingsTemplateVersion : Get latest version of an account settings template
+	GetLatestAccountSettingsTemplateVersion(getLatestAccountSettingsTemplateVersionOptions *GetLatestAccountSettingsTemplateVersionOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// GetLatestAccountSettingsTemplateVersionWithContext is an alternate form of the GetLatestAccountSettingsTemplateVersion method which supports a Context parameter
+	GetLatestAccountSettingsTemplateVersionWithContext(ctx context.Context, getLatestAccountSettingsTemplateVersionOptions *GetLatestAccountSettingsTemplateVersionOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// DeleteAllVersionsOfAccountSettingsTemplate : Delete all versions of an account settings template
+	DeleteAllVersionsOfAccountSettingsTemplate(deleteAllVersionsOfAccountSettingsTemplateOptions *DeleteAllVersionsOfAccountSettingsTemplateOptions) (response *core.DetailedResponse, err error)
+	// DeleteAllVersionsOfAccountSettingsTemplateWithContext is an alternate form of the DeleteAllVersionsOfAccountSettingsTemplate method which supports a Context parameter
+	DeleteAllVersionsOfAccountSettingsTemplateWithContext(ctx context.Context, deleteAllVersionsOfAccountSettingsTemplateOptions *DeleteAllVersionsOfAccountSettingsTemplateOptions) (response *core.DetailedResponse, err error)
+	// ListVersionsOfAccountSettingsTemplate : List account settings template versions
+	ListVersionsOfAccountSettingsTemplate(listVersionsOfAccountSettingsTemplateOptions *ListVersionsOfAccountSettingsTemplateOptions) (result *AccountSettingsTemplateList, response *core.DetailedResponse, err error)
+	// ListVersionsOfAccountSettingsTemplateWithContext is an alternate form of the ListVersionsOfAccountSettingsTemplate method which supports a Context parameter
+	ListVersionsOfAccountSettingsTemplateWithContext(ctx context.Context, listVersionsOfAccountSettingsTemplateOptions *ListVersionsOfAccountSettingsTemplateOptions) (result *AccountSettingsTemplateList, response *core.DetailedResponse, err error)
+	// CreateAccountSettingsTemplateVersion : Create a new version of an account settings template
+	CreateAccountSettingsTemplateVersion(createAccountSettingsTemplateVersionOptions *CreateAccountSettingsTemplateVersionOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// CreateAccountSettingsTemplateVersionWithContext is an alternate form of the CreateAccountSettingsTemplateVersion method which supports a Context parameter
+	CreateAccountSettingsTemplateVersionWithContext(ctx context.Context, createAccountSettingsTemplateVersionOptions *CreateAccountSettingsTemplateVersionOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// GetAccountSettingsTemplateVersion : Get version of an account settings template
+	GetAccountSettingsTemplateVersion(getAccountSettingsTemplateVersionOptions *GetAccountSettingsTemplateVersionOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// GetAccountSettingsTemplateVersionWithContext is an alternate form of the GetAccountSettingsTemplateVersion method which supports a Context parameter
+	GetAccountSettingsTemplateVersionWithContext(ctx context.Context, getAccountSettingsTemplateVersionOptions *GetAccountSettingsTemplateVersionOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// UpdateAccountSettingsTemplateVersion : Update version of an account settings template
+	UpdateAccountSettingsTemplateVersion(updateAccountSettingsTemplateVersionOptions *UpdateAccountSettingsTemplateVersionOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// UpdateAccountSettingsTemplateVersionWithContext is an alternate form of the UpdateAccountSettingsTemplateVersion method which supports a Context parameter
+	UpdateAccountSettingsTemplateVersionWithContext(ctx context.Context, updateAccountSettingsTemplateVersionOptions *UpdateAccountSettingsTemplateVersionOptions) (result *AccountSettingsTemplateResponse, response *core.DetailedResponse, err error)
+	// DeleteAccountSettingsTemplateVersion : Delete version of an account settings template
+	DeleteAccountSettingsTemplateVersion(deleteAccountSettingsTemplateVersionOptions *DeleteAccountSettingsTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// DeleteAccountSettingsTemplateVersionWithContext is an alternate form of the DeleteAccountSettingsTemplateVersion method which supports a Context parameter
+	DeleteAccountSettingsTemplateVersionWithContext(ctx context.Context, deleteAccountSettingsTemplateVersionOptions *DeleteAccountSettingsTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// CommitAccountSettingsTemplate : Commit a template version
+	CommitAccountSettingsTemplate(commitAccountSettingsTemplateOptions *CommitAccountSettingsTemplateOptions) (response *core.DetailedResponse, err error)
+	// CommitAccountSettingsTemplateWithContext is an alternate form of the CommitAccountSettingsTemplate method which supports a Context parameter
+	CommitAccountSettingsTemplateWithContext(ctx context.Context, commitAccountSettingsTemplateOptions *CommitAccountSettingsTemplateOptions) (response *core.DetailedResponse, err error)
+	// ListAccountSettingsAssignments : List assignments
+	ListAccountSettingsAssignments(listAccountSettingsAssignmentsOptions *ListAccountSettingsAssignmentsOptions) (result *TemplateAssignmentListResponse, response *core.DetailedResponse, err error)
+	// ListAccountSettingsAssignmentsWithContext is an alternate form of the ListAccountSettingsAssignments method which supports a Context parameter
+	ListAccountSettingsAssignmentsWithContext(ctx context.Context, listAccountSettingsAssignmentsOptions *ListAccountSettingsAssignmentsOptions) (result *TemplateAssignmentListResponse, response *core.DetailedResponse, err error)
+	// CreateAccountSettingsAssignment : Create assignment
+	CreateAccountSettingsAssignment(createAccountSettingsAssignmentOptions *CreateAccountSettingsAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// CreateAccountSettingsAssignmentWithContext is an alternate form of the CreateAccountSettingsAssignment method which supports a Context parameter
+	CreateAccountSettingsAssignmentWithContext(ctx context.Context, createAccountSettingsAssignmentOptions *CreateAccountSettingsAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// GetAccountSettingsAssignment : Get assignment
+	GetAccountSettingsAssignment(getAccountSettingsAssignmentOptions *GetAccountSettingsAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// GetAccountSettingsAssignmentWithContext is an alternate form of the GetAccountSettingsAssignment method which supports a Context parameter
+	GetAccountSettingsAssignmentWithContext(ctx context.Context, getAccountSettingsAssignmentOptions *GetAccountSettingsAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// DeleteAccountSettingsAssignment : Delete assignment
+	DeleteAccountSettingsAssignment(deleteAccountSettingsAssignmentOptions *DeleteAccountSettingsAssignmentOptions) (result *ExceptionResponse, response *core.DetailedResponse, err error)
+	// DeleteAccountSettingsAssignmentWithContext is an alternate form of the DeleteAccountSettingsAssignment method which supports a Context parameter
+	DeleteAccountSettingsAssignmentWithContext(ctx context.Context, deleteAccountSettingsAssignmentOptions *DeleteAccountSettingsAssignmentOptions) (result *ExceptionResponse, response *core.DetailedResponse, err error)
+	// UpdateAccountSettingsAssignment : Update assignment
+	UpdateAccountSettingsAssignment(updateAccountSettingsAssignmentOptions *UpdateAccountSettingsAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// UpdateAccountSettingsAssignmentWithContext is an alternate form of the UpdateAccountSettingsAssignment method which supports a Context parameter
+	UpdateAccountSettingsAssignmentWithContext(ctx context.Context, updateAccountSettingsAssignmentOptions *UpdateAccountSettingsAssignmentOptions) (result *TemplateAssignmentResponse, response *core.DetailedResponse, err error)
+	// GetAccountLimits : Get account entity limits
+	GetAccountLimits(getAccountLimitsOptions *GetAccountLimitsOptions) (result *IdentityLimitsUsageResponse, response *core.DetailedResponse, err error)
+	// GetAccountLimitsWithContext is an alternate form of the GetAccountLimits method which supports a Context parameter
+	GetAccountLimitsWithContext(ctx context.Context, getAccountLimitsOptions *GetAccountLimitsOptions) (result *IdentityLimitsUsageResponse, response *core.DetailedResponse, err error)
+	// BulkListAccountEntityConsumption : Get account entity limits via POST request
+	BulkListAccountEntityConsumption(bulkListAccountEntityConsumptionOptions *BulkListAccountEntityConsumptionOptions) (result *IdentityLimitsUsageResponse, response *core.DetailedResponse, err error)
+	// BulkListAccountEntityConsumptionWithContext is an alternate form of the BulkListAccountEntityConsumption method which supports a Context parameter
+	BulkListAccountEntityConsumptionWithContext(ctx context.Context, bulkListAccountEntityConsumptionOptions *BulkListAccountEntityConsumptionOptions) (result *IdentityLimitsUsageResponse, response *core.DetailedResponse, err error)
+	// ListIdps : List IdPs
+	ListIdps(listIdpsOptions *ListIdpsOptions) (result *ListIdpsResponse, response *core.DetailedResponse, err error)
+	// ListIdpsWithContext is an alternate form of the ListIdps method which supports a Context parameter
+	ListIdpsWithContext(ctx context.Context, listIdpsOptions *ListIdpsOptions) (result *ListIdpsResponse, response *core.DetailedResponse, err error)
+	// CreateIdp : Create IdP
+	CreateIdp(createIdpOptions *CreateIdpOptions) (result *Idp, response *core.DetailedResponse, err error)
+	// CreateIdpWithContext is an alternate form of the CreateIdp method which supports a Context parameter
+	CreateIdpWithContext(ctx context.Context, createIdpOptions *CreateIdpOptions) (result *Idp, response *core.DetailedResponse, err error)
+	// GetIdp : Get IdP
+	GetIdp(getIdpOptions *GetIdpOptions) (result *Idp, response *core.DetailedResponse, err error)
+	// GetIdpWithContext is an alternate form of the GetIdp method which supports a Context parameter
+	GetIdpWithContext(ctx context.Context, getIdpOptions *GetIdpOptions) (result *Idp, response *core.DetailedResponse, err error)
+	// UpdateIdp : Update IdP
+	UpdateIdp(updateIdpOptions *UpdateIdpOptions) (result *Idp, response *core.DetailedResponse, err error)
+	// UpdateIdpWithContext is an alternate form of the UpdateIdp method which supports a Context parameter
+	UpdateIdpWithContext(ctx context.Context, updateIdpOptions *UpdateIdpOptions) (result *Idp, response *core.DetailedResponse, err error)
+	// DeleteIdp : Delete IdP
+	DeleteIdp(deleteIdpOptions *DeleteIdpOptions) (response *core.DetailedResponse, err error)
+	// DeleteIdpWithContext is an alternate form of the DeleteIdp method which supports a Context parameter
+	DeleteIdpWithContext(ctx context.Context, deleteIdpOptions *DeleteIdpOptions) (response *core.DetailedResponse, err error)
+	// ListConsumerAccounts : Get consumers of IdP
+	ListConsumerAccounts(listConsumerAccountsOptions *ListConsumerAccountsOptions) (result *ConsumersResponse, response *core.DetailedResponse, err error)
+	// ListConsumerAccountsWithContext is an alternate form of the ListConsumerAccounts method which supports a Context parameter
+	ListConsumerAccountsWithContext(ctx context.Context, listConsumerAccountsOptions *ListConsumerAccountsOptions) (result *ConsumersResponse, response *core.DetailedResponse, err error)
+	// ExportSamlMetadata : Export SAML IdP metadata
+	ExportSamlMetadata(exportSamlMetadataOptions *ExportSamlMetadataOptions) (result *string, response *core.DetailedResponse, err error)
+	// ExportSamlMetadataWithContext is an alternate form of the ExportSamlMetadata method which supports a Context parameter
+	ExportSamlMetadataWithContext(ctx context.Context, exportSamlMetadataOptions *ExportSamlMetadataOptions) (result *string, response *core.DetailedResponse, err error)
+	// ImportSamlIdpMetadata : Import SAML IdP metadata
+	ImportSamlIdpMetadata(importSamlIdpMetadataOptions *ImportSamlIdpMetadataOptions) (result *SamlMetadataImportResponse, response *core.DetailedResponse, err error)
+	// ImportSamlIdpMetadataWithContext is an alternate form of the ImportSamlIdpMetadata method which supports a Context parameter
+	ImportSamlIdpMetadataWithContext(ctx context.Context, importSamlIdpMetadataOptions *ImportSamlIdpMetadataOptions) (result *SamlMetadataImportResponse, response *core.DetailedResponse, err error)
+	// GetIdpTestResult : Get IdP test results
+	GetIdpTestResult(getIdpTestResultOptions *GetIdpTestResultOptions) (result *TestResult, response *core.DetailedResponse, err error)
+	// GetIdpTestResultWithContext is an alternate form of the GetIdpTestResult method which supports a Context parameter
+	GetIdpTestResultWithContext(ctx context.Context, getIdpTestResultOptions *GetIdpTestResultOptions) (result *TestResult, response *core.DetailedResponse, err error)
+	// TestIdp : Trigger IdP configuration test
+	TestIdp(testIdpOptions *TestIdpOptions) (result *TestTriggerResponse, response *core.DetailedResponse, err error)
+	// TestIdpWithContext is an alternate form of the TestIdp method which supports a Context parameter
+	TestIdpWithContext(ctx context.Context, testIdpOptions *TestIdpOptions) (result *TestTriggerResponse, response *core.DetailedResponse, err error)
+	// GetLoginSettings : Get account login settings
+	GetLoginSettings(getLoginSettingsOptions *GetLoginSettingsOptions) (result *AccountLoginSettings, response *core.DetailedResponse, err error)
+	// GetLoginSettingsWithContext is an alternate form of the GetLoginSettings method which supports a Context parameter
+	GetLoginSettingsWithContext(ctx context.Context, getLoginSettingsOptions *GetLoginSettingsOptions) (result *AccountLoginSettings, response *core.DetailedResponse, err error)
+	// UpdateLoginSettings : Update account login settings
+	UpdateLoginSettings(updateLoginSettingsOptions *UpdateLoginSettingsOptions) (result *AccountLoginSettings, response *core.DetailedResponse, err error)
+	// UpdateLoginSettingsWithContext is an alternate form of the UpdateLoginSettings method which supports a Context parameter
+	UpdateLoginSettingsWithContext(ctx context.Context, updateLoginSettingsOptions *UpdateLoginSettingsOptions) (result *AccountLoginSettings, response *core.DetailedResponse, err error)
+	// ListIDPSettings : List IdP Settings
+	ListIDPSettings(listIDPSettingsOptions *ListIDPSettingsOptions) (result *ListIdpSettingsResponse, response *core.DetailedResponse, err error)
+	// ListIDPSettingsWithContext is an alternate form of the ListIDPSettings method which supports a Context parameter
+	ListIDPSettingsWithContext(ctx context.Context, listIDPSettingsOptions *ListIDPSettingsOptions) (result *ListIdpSettingsResponse, response *core.DetailedResponse, err error)
+	// GetIDPSetting : Get IdP setting
+	GetIDPSetting(getIDPSettingOptions *GetIDPSettingOptions) (result *AccountIdpSettings, response *core.DetailedResponse, err error)
+	// GetIDPSettingWithContext is an alternate form of the GetIDPSetting method which supports a Context parameter
+	GetIDPSettingWithContext(ctx context.Context, getIDPSettingOptions *GetIDPSettingOptions) (result *AccountIdpSettings, response *core.DetailedResponse, err error)
+	// AddIDPSetting : Add IdP Setting
+	AddIDPSetting(addIDPSettingOptions *AddIDPSettingOptions) (result *AccountIdpSettings, response *core.DetailedResponse, err error)
+	// AddIDPSettingWithContext is an alternate form of the AddIDPSetting method which supports a Context parameter
+	AddIDPSettingWithContext(ctx context.Context, addIDPSettingOptions *AddIDPSettingOptions) (result *AccountIdpSettings, response *core.DetailedResponse, err error)
+	// UpdateIDPSetting : Update IdP Setting
+	UpdateIDPSetting(updateIDPSettingOptions *UpdateIDPSettingOptions) (result *AccountIdpSettings, response *core.DetailedResponse, err error)
+	// UpdateIDPSettingWithContext is an alternate form of the UpdateIDPSetting method which supports a Context parameter
+	UpdateIDPSettingWithContext(ctx context.Context, updateIDPSettingOptions *UpdateIDPSettingOptions) (result *AccountIdpSettings, response *core.DetailedResponse, err error)
+	// RemoveIDPSetting : Remove IdP Setting
+	RemoveIDPSetting(removeIDPSettingOptions *RemoveIDPSettingOptions) (response *core.DetailedResponse, err error)
+	// RemoveIDPSettingWithContext is an alternate form of the RemoveIDPSetting method which supports a Context parameter
+	RemoveIDPSettingWithContext(ctx context.Context, removeIDPSettingOptions *RemoveIDPSettingOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ IamIdentityV1Intf = (*IamIdentityV1)(nil)
@@ -0,0 +1,2989 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the IamIdentityV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// IamIdentityV1 : A testify mock of the IamIdentityV1 service client.
+type IamIdentityV1 struct {
+	mock.Mock
+}
+
+var _ iamidentityv1.IamIdentityV1Intf = (*IamIdentityV1)(nil)
+
+// ListServiceIds provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListServiceIds(listServiceIdsOptions *iamidentityv1.ListServiceIdsOptions) (*iamidentityv1.ServiceIDList, *core.DetailedResponse, error) {
+	ret := _m.Called(listServiceIdsOptions)
+	var r0 *iamidentityv1.ServiceIDList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListServiceIdsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListServiceIdsWithContext(ctx context.Context, listServiceIdsOptions *iamidentityv1.ListServiceIdsOptions) (*iamidentityv1.ServiceIDList, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, listServiceIdsOptions)
+	var r0 *iamidentityv1.ServiceIDList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDList)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateServiceID provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateServiceID(createServiceIDOptions *iamidentityv1.CreateServiceIDOptions) (*iamidentityv1.ServiceID, *core.DetailedResponse, error) {
+	ret := _m.Called(createServiceIDOptions)
+	var r0 *iamidentityv1.ServiceID
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceID)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateServiceIDWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateServiceIDWithContext(ctx context.Context, createServiceIDOptions *iamidentityv1.CreateServiceIDOptions) (*iamidentityv1.ServiceID, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, createServiceIDOptions)
+	var r0 *iamidentityv1.ServiceID
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceID)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetServiceID provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetServiceID(getServiceIDOptions *iamidentityv1.GetServiceIDOptions) (*iamidentityv1.ServiceID, *core.DetailedResponse, error) {
+	ret := _m.Called(getServiceIDOptions)
+	var r0 *iamidentityv1.ServiceID
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceID)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetServiceIDWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetServiceIDWithContext(ctx context.Context, getServiceIDOptions *iamidentityv1.GetServiceIDOptions) (*iamidentityv1.ServiceID, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getServiceIDOptions)
+	var r0 *iamidentityv1.ServiceID
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceID)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateServiceID provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateServiceID(updateServiceIDOptions *iamidentityv1.UpdateServiceIDOptions) (*iamidentityv1.ServiceID, *core.DetailedResponse, error) {
+	ret := _m.Called(updateServiceIDOptions)
+	var r0 *iamidentityv1.ServiceID
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceID)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateServiceIDWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateServiceIDWithContext(ctx context.Context, updateServiceIDOptions *iamidentityv1.UpdateServiceIDOptions) (*iamidentityv1.ServiceID, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, updateServiceIDOptions)
+	var r0 *iamidentityv1.ServiceID
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceID)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteServiceID provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteServiceID(deleteServiceIDOptions *iamidentityv1.DeleteServiceIDOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteServiceIDOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteServiceIDWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteServiceIDWithContext(ctx context.Context, deleteServiceIDOptions *iamidentityv1.DeleteServiceIDOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteServiceIDOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// LockServiceID provides a mock function with the given fields.
+func (_m *IamIdentityV1) LockServiceID(lockServiceIDOptions *iamidentityv1.LockServiceIDOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(lockServiceIDOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// LockServiceIDWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) LockServiceIDWithContext(ctx context.Context, lockServiceIDOptions *iamidentityv1.LockServiceIDOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, lockServiceIDOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UnlockServiceID provides a mock function with the given fields.
+func (_m *IamIdentityV1) UnlockServiceID(unlockServiceIDOptions *iamidentityv1.UnlockServiceIDOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(unlockServiceIDOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UnlockServiceIDWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UnlockServiceIDWithContext(ctx context.Context, unlockServiceIDOptions *iamidentityv1.UnlockServiceIDOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, unlockServiceIDOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListServiceIDGroup provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListServiceIDGroup(listServiceIDGroupOptions *iamidentityv1.ListServiceIDGroupOptions) (*iamidentityv1.ServiceIDGroupList, *core.DetailedResponse, error) {
+	ret := _m.Called(listServiceIDGroupOptions)
+	var r0 *iamidentityv1.ServiceIDGroupList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDGroupList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListServiceIDGroupWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListServiceIDGroupWithContext(ctx context.Context, listServiceIDGroupOptions *iamidentityv1.ListServiceIDGroupOptions) (*iamidentityv1.ServiceIDGroupList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listServiceIDGroupOptions)
+	var r0 *iamidentityv1.ServiceIDGroupList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDGroupList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateServiceIDGroup provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateServiceIDGroup(createServiceIDGroupOptions *iamidentityv1.CreateServiceIDGroupOptions) (*iamidentityv1.ServiceIDGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(createServiceIDGroupOptions)
+	var r0 *iamidentityv1.ServiceIDGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateServiceIDGroupWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateServiceIDGroupWithContext(ctx context.Context, createServiceIDGroupOptions *iamidentityv1.CreateServiceIDGroupOptions) (*iamidentityv1.ServiceIDGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createServiceIDGroupOptions)
+	var r0 *iamidentityv1.ServiceIDGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetServiceIDGroup provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetServiceIDGroup(getServiceIDGroupOptions *iamidentityv1.GetServiceIDGroupOptions) (*iamidentityv1.ServiceIDGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(getServiceIDGroupOptions)
+	var r0 *iamidentityv1.ServiceIDGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetServiceIDGroupWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetServiceIDGroupWithContext(ctx context.Context, getServiceIDGroupOptions *iamidentityv1.GetServiceIDGroupOptions) (*iamidentityv1.ServiceIDGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getServiceIDGroupOptions)
+	var r0 *iamidentityv1.ServiceIDGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateServiceIDGroup provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateServiceIDGroup(updateServiceIDGroupOptions *iamidentityv1.UpdateServiceIDGroupOptions) (*iamidentityv1.ServiceIDGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(updateServiceIDGroupOptions)
+	var r0 *iamidentityv1.ServiceIDGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateServiceIDGroupWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateServiceIDGroupWithContext(ctx context.Context, updateServiceIDGroupOptions *iamidentityv1.UpdateServiceIDGroupOptions) (*iamidentityv1.ServiceIDGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateServiceIDGroupOptions)
+	var r0 *iamidentityv1.ServiceIDGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ServiceIDGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteServiceIDGroup provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteServiceIDGroup(deleteServiceIDGroupOptions *iamidentityv1.DeleteServiceIDGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteServiceIDGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteServiceIDGroupWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteServiceIDGroupWithContext(ctx context.Context, deleteServiceIDGroupOptions *iamidentityv1.DeleteServiceIDGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteServiceIDGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListAPIKeys provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListAPIKeys(listAPIKeysOptions *iamidentityv1.ListAPIKeysOptions) (*iamidentityv1.APIKeyList, *core.DetailedResponse, error) {
+	ret := _m.Called(listAPIKeysOptions)
+	var r0 *iamidentityv1.APIKeyList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKeyList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAPIKeysWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListAPIKeysWithContext(ctx context.Context, listAPIKeysOptions *iamidentityv1.ListAPIKeysOptions) (*iamidentityv1.APIKeyList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAPIKeysOptions)
+	var r0 *iamidentityv1.APIKeyList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKeyList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAPIKey provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateAPIKey(createAPIKeyOptions *iamidentityv1.CreateAPIKeyOptions) (*iamidentityv1.APIKey, *core.DetailedResponse, error) {
+	ret := _m.Called(createAPIKeyOptions)
+	var r0 *iamidentityv1.APIKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAPIKeyWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateAPIKeyWithContext(ctx context.Context, createAPIKeyOptions *iamidentityv1.CreateAPIKeyOptions) (*iamidentityv1.APIKey, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createAPIKeyOptions)
+	var r0 *iamidentityv1.APIKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAPIKeysDetails provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAPIKeysDetails(getAPIKeysDetailsOptions *iamidentityv1.GetAPIKeysDetailsOptions) (*iamidentityv1.APIKey, *core.DetailedResponse, error) {
+	ret := _m.Called(getAPIKeysDetailsOptions)
+	var r0 *iamidentityv1.APIKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAPIKeysDetailsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAPIKeysDetailsWithContext(ctx context.Context, getAPIKeysDetailsOptions *iamidentityv1.GetAPIKeysDetailsOptions) (*iamidentityv1.APIKey, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getAPIKeysDetailsOptions)
+	var r0 *iamidentityv1.APIKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKey)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAPIKey provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAPIKey(getAPIKeyOptions *iamidentityv1.GetAPIKeyOptions) (*iamidentityv1.APIKey, *core.DetailedResponse, error) {
+	ret := _m.Called(getAPIKeyOptions)
+	var r0 *iamidentityv1.APIKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAPIKeyWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAPIKeyWithContext(ctx context.Context, getAPIKeyOptions *iamidentityv1.GetAPIKeyOptions) (*iamidentityv1.APIKey, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAPIKeyOptions)
+	var r0 *iamidentityv1.APIKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAPIKey provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateAPIKey(updateAPIKeyOptions *iamidentityv1.UpdateAPIKeyOptions) (*iamidentityv1.APIKey, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAPIKeyOptions)
+	var r0 *iamidentityv1.APIKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAPIKeyWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateAPIKeyWithContext(ctx context.Context, updateAPIKeyOptions *iamidentityv1.UpdateAPIKeyOptions) (*iamidentityv1.APIKey, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAPIKeyOptions)
+	var r0 *iamidentityv1.APIKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.APIKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAPIKey provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAPIKey(deleteAPIKeyOptions *iamidentityv1.DeleteAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAPIKeyWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAPIKeyWithContext(ctx context.Context, deleteAPIKeyOptions *iamidentityv1.DeleteAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// LockAPIKey provides a mock function with the given fields.
+func (_m *IamIdentityV1) LockAPIKey(lockAPIKeyOptions *iamidentityv1.LockAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(lockAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// LockAPIKeyWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) LockAPIKeyWithContext(ctx context.Context, lockAPIKeyOptions *iamidentityv1.LockAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, lockAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UnlockAPIKey provides a mock function with the given fields.
+func (_m *IamIdentityV1) UnlockAPIKey(unlockAPIKeyOptions *iamidentityv1.UnlockAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(unlockAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UnlockAPIKeyWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UnlockAPIKeyWithContext(ctx context.Context, unlockAPIKeyOptions *iamidentityv1.UnlockAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, unlockAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DisableAPIKey provides a mock function with the given fields.
+func (_m *IamIdentityV1) DisableAPIKey(disableAPIKeyOptions *iamidentityv1.DisableAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(disableAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DisableAPIKeyWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DisableAPIKeyWithContext(ctx context.Context, disableAPIKeyOptions *iamidentityv1.DisableAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, disableAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// EnableAPIKey provides a mock function with the given fields.
+func (_m *IamIdentityV1) EnableAPIKey(enableAPIKeyOptions *iamidentityv1.EnableAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(enableAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// EnableAPIKeyWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) EnableAPIKeyWithContext(ctx context.Context, enableAPIKeyOptions *iamidentityv1.EnableAPIKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, enableAPIKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateProfile provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateProfile(createProfileOptions *iamidentityv1.CreateProfileOptions) (*iamidentityv1.TrustedProfile, *core.DetailedResponse, error) {
+	ret := _m.Called(createProfileOptions)
+	var r0 *iamidentityv1.TrustedProfile
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfile)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateProfileWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateProfileWithContext(ctx context.Context, createProfileOptions *iamidentityv1.CreateProfileOptions) (*iamidentityv1.TrustedProfile, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, createProfileOptions)
+	var r0 *iamidentityv1.TrustedProfile
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfile)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListProfiles provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListProfiles(listProfilesOptions *iamidentityv1.ListProfilesOptions) (*iamidentityv1.TrustedProfilesList, *core.DetailedResponse, error) {
+	ret := _m.Called(listProfilesOptions)
+	var r0 *iamidentityv1.TrustedProfilesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfilesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListProfilesWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListProfilesWithContext(ctx context.Context, listProfilesOptions *iamidentityv1.ListProfilesOptions) (*iamidentityv1.TrustedProfilesList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listProfilesOptions)
+	var r0 *iamidentityv1.TrustedProfilesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfilesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetProfile provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetProfile(getProfileOptions *iamidentityv1.GetProfileOptions) (*iamidentityv1.TrustedProfile, *core.DetailedResponse, error) {
+	ret := _m.Called(getProfileOptions)
+	var r0 *iamidentityv1.TrustedProfile
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfile)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetProfileWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetProfileWithContext(ctx context.Context, getProfileOptions *iamidentityv1.GetProfileOptions) (*iamidentityv1.TrustedProfile, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getProfileOptions)
+	var r0 *iamidentityv1.TrustedProfile
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfile)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateProfile provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateProfile(updateProfileOptions *iamidentityv1.UpdateProfileOptions) (*iamidentityv1.TrustedProfile, *core.DetailedResponse, error) {
+	ret := _m.Called(updateProfileOptions)
+	var r0 *iamidentityv1.TrustedProfile
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfile)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateProfileWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateProfileWithContext(ctx context.Context, updateProfileOptions *iamidentityv1.UpdateProfileOptions) (*iamidentityv1.TrustedProfile, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, updateProfileOptions)
+	var r0 *iamidentityv1.TrustedProfile
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfile)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteProfile provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteProfile(deleteProfileOptions *iamidentityv1.DeleteProfileOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteProfileOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteProfileWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteProfileWithContext(ctx context.Context, deleteProfileOptions *iamidentityv1.DeleteProfileOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteProfileOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateClaimRule provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateClaimRule(createClaimRuleOptions *iamidentityv1.CreateClaimRuleOptions) (*iamidentityv1.ProfileClaimRule, *core.DetailedResponse, error) {
+	ret := _m.Called(createClaimRuleOptions)
+	var r0 *iamidentityv1.ProfileClaimRule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileClaimRule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateClaimRuleWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateClaimRuleWithContext(ctx context.Context, createClaimRuleOptions *iamidentityv1.CreateClaimRuleOptions) (*iamidentityv1.ProfileClaimRule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createClaimRuleOptions)
+	var r0 *iamidentityv1.ProfileClaimRule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileClaimRule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListClaimRules provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListClaimRules(listClaimRulesOptions *iamidentityv1.ListClaimRulesOptions) (*iamidentityv1.ProfileClaimRuleList, *core.DetailedResponse, error) {
+	ret := _m.Called(listClaimRulesOptions)
+	var r0 *iamidentityv1.ProfileClaimRuleList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileClaimRuleList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListClaimRulesWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListClaimRulesWithContext(ctx context.Context, listClaimRulesOptions *iamidentityv1.ListClaimRulesOptions) (*iamidentityv1.ProfileClaimRuleList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listClaimRulesOptions)
+	var r0 *iamidentityv1.ProfileClaimRuleList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileClaimRuleList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetClaimRule provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetClaimRule(getClaimRuleOptions *iamidentityv1.GetClaimRuleOptions) (*iamidentityv1.ProfileClaimRule, *core.DetailedResponse, error) {
+	ret := _m.Called(getClaimRuleOptions)
+	var r0 *iamidentityv1.ProfileClaimRule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileClaimRule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetClaimRuleWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetClaimRuleWithContext(ctx context.Context, getClaimRuleOptions *iamidentityv1.GetClaimRuleOptions) (*iamidentityv1.ProfileClaimRule, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getClaimRuleOptions)
+	var r0 *iamidentityv1.ProfileClaimRule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileClaimRule)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateClaimRule provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateClaimRule(updateClaimRuleOptions *iamidentityv1.UpdateClaimRuleOptions) (*iamidentityv1.ProfileClaimRule, *core.DetailedResponse, error) {
+	ret := _m.Called(updateClaimRuleOptions)
+	var r0 *iamidentityv1.ProfileClaimRule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileClaimRule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateClaimRuleWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateClaimRuleWithContext(ctx context.Context, updateClaimRuleOptions *iamidentityv1.UpdateClaimRuleOptions) (*iamidentityv1.ProfileClaimRule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateClaimRuleOptions)
+	var r0 *iamidentityv1.ProfileClaimRule
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileClaimRule)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteClaimRule provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteClaimRule(deleteClaimRuleOptions *iamidentityv1.DeleteClaimRuleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteClaimRuleOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteClaimRuleWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteClaimRuleWithContext(ctx context.Context, deleteClaimRuleOptions *iamidentityv1.DeleteClaimRuleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteClaimRuleOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateLink provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateLink(createLinkOptions *iamidentityv1.CreateLinkOptions) (*iamidentityv1.ProfileLink, *core.DetailedResponse, error) {
+	ret := _m.Called(createLinkOptions)
+	var r0 *iamidentityv1.ProfileLink
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileLink)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateLinkWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateLinkWithContext(ctx context.Context, createLinkOptions *iamidentityv1.CreateLinkOptions) (*iamidentityv1.ProfileLink, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createLinkOptions)
+	var r0 *iamidentityv1.ProfileLink
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileLink)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListLinks provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListLinks(listLinksOptions *iamidentityv1.ListLinksOptions) (*iamidentityv1.ProfileLinkList, *core.DetailedResponse, error) {
+	ret := _m.Called(listLinksOptions)
+	var r0 *iamidentityv1.ProfileLinkList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileLinkList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListLinksWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListLinksWithContext(ctx context.Context, listLinksOptions *iamidentityv1.ListLinksOptions) (*iamidentityv1.ProfileLinkList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listLinksOptions)
+	var r0 *iamidentityv1.ProfileLinkList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileLinkList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteLinkByParameters provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteLinkByParameters(deleteLinkByParametersOptions *iamidentityv1.DeleteLinkByParametersOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteLinkByParametersOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteLinkByParametersWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteLinkByParametersWithContext(ctx context.Context, deleteLinkByParametersOptions *iamidentityv1.DeleteLinkByParametersOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteLinkByParametersOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetLink provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetLink(getLinkOptions *iamidentityv1.GetLinkOptions) (*iamidentityv1.ProfileLink, *core.DetailedResponse, error) {
+	ret := _m.Called(getLinkOptions)
+	var r0 *iamidentityv1.ProfileLink
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileLink)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLinkWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetLinkWithContext(ctx context.Context, getLinkOptions *iamidentityv1.GetLinkOptions) (*iamidentityv1.ProfileLink, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getLinkOptions)
+	var r0 *iamidentityv1.ProfileLink
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileLink)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteLink provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteLink(deleteLinkOptions *iamidentityv1.DeleteLinkOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteLinkOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteLinkWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteLinkWithContext(ctx context.Context, deleteLinkOptions *iamidentityv1.DeleteLinkOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteLinkOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetProfileIdentities provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetProfileIdentities(getProfileIdentitiesOptions *iamidentityv1.GetProfileIdentitiesOptions) (*iamidentityv1.ProfileIdentitiesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getProfileIdentitiesOptions)
+	var r0 *iamidentityv1.ProfileIdentitiesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileIdentitiesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetProfileIdentitiesWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetProfileIdentitiesWithContext(ctx context.Context, getProfileIdentitiesOptions *iamidentityv1.GetProfileIdentitiesOptions) (*iamidentityv1.ProfileIdentitiesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getProfileIdentitiesOptions)
+	var r0 *iamidentityv1.ProfileIdentitiesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileIdentitiesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SetProfileIdentities provides a mock function with the given fields.
+func (_m *IamIdentityV1) SetProfileIdentities(setProfileIdentitiesOptions *iamidentityv1.SetProfileIdentitiesOptions) (*iamidentityv1.ProfileIdentitiesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(setProfileIdentitiesOptions)
+	var r0 *iamidentityv1.ProfileIdentitiesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileIdentitiesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SetProfileIdentitiesWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) SetProfileIdentitiesWithContext(ctx context.Context, setProfileIdentitiesOptions *iamidentityv1.SetProfileIdentitiesOptions) (*iamidentityv1.ProfileIdentitiesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, setProfileIdentitiesOptions)
+	var r0 *iamidentityv1.ProfileIdentitiesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileIdentitiesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SetProfileIdentity provides a mock function with the given fields.
+func (_m *IamIdentityV1) SetProfileIdentity(setProfileIdentityOptions *iamidentityv1.SetProfileIdentityOptions) (*iamidentityv1.ProfileIdentityResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(setProfileIdentityOptions)
+	var r0 *iamidentityv1.ProfileIdentityResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileIdentityResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// SetProfileIdentityWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) SetProfileIdentityWithContext(ctx context.Context, setProfileIdentityOptions *iamidentityv1.SetProfileIdentityOptions) (*iamidentityv1.ProfileIdentityResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, setProfileIdentityOptions)
+	var r0 *iamidentityv1.ProfileIdentityResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileIdentityResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetProfileIdentity provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetProfileIdentity(getProfileIdentityOptions *iamidentityv1.GetProfileIdentityOptions) (*iamidentityv1.ProfileIdentityResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getProfileIdentityOptions)
+	var r0 *iamidentityv1.ProfileIdentityResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileIdentityResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetProfileIdentityWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetProfileIdentityWithContext(ctx context.Context, getProfileIdentityOptions *iamidentityv1.GetProfileIdentityOptions) (*iamidentityv1.ProfileIdentityResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getProfileIdentityOptions)
+	var r0 *iamidentityv1.ProfileIdentityResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ProfileIdentityResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteProfileIdentity provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteProfileIdentity(deleteProfileIdentityOptions *iamidentityv1.DeleteProfileIdentityOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteProfileIdentityOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteProfileIdentityWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteProfileIdentityWithContext(ctx context.Context, deleteProfileIdentityOptions *iamidentityv1.DeleteProfileIdentityOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteProfileIdentityOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateReport provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateReport(createReportOptions *iamidentityv1.CreateReportOptions) (*iamidentityv1.ReportReference, *core.DetailedResponse, error) {
+	ret := _m.Called(createReportOptions)
+	var r0 *iamidentityv1.ReportReference
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ReportReference)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateReportWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateReportWithContext(ctx context.Context, createReportOptions *iamidentityv1.CreateReportOptions) (*iamidentityv1.ReportReference, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, createReportOptions)
+	var r0 *iamidentityv1.ReportReference
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ReportReference)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetReport provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetReport(getReportOptions *iamidentityv1.GetReportOptions) (*iamidentityv1.Report, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportOptions)
+	var r0 *iamidentityv1.Report
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.Report)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetReportWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetReportWithContext(ctx context.Context, getReportOptions *iamidentityv1.GetReportOptions) (*iamidentityv1.Report, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportOptions)
+	var r0 *iamidentityv1.Report
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.Report)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSettings provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAccountSettings(getAccountSettingsOptions *iamidentityv1.GetAccountSettingsOptions) (*iamidentityv1.AccountSettingsResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getAccountSettingsOptions)
+	var r0 *iamidentityv1.AccountSettingsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSettingsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *iamidentityv1.GetAccountSettingsOptions) (*iamidentityv1.AccountSettingsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountSettingsOptions)
+	var r0 *iamidentityv1.AccountSettingsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettings provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateAccountSettings(updateAccountSettingsOptions *iamidentityv1.UpdateAccountSettingsOptions) (*iamidentityv1.AccountSettingsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAccountSettingsOptions)
+	var r0 *iamidentityv1.AccountSettingsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettingsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateAccountSettingsWithContext(ctx context.Context, updateAccountSettingsOptions *iamidentityv1.UpdateAccountSettingsOptions) (*iamidentityv1.AccountSettingsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAccountSettingsOptions)
+	var r0 *iamidentityv1.AccountSettingsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetEffectiveAccountSettings provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetEffectiveAccountSettings(getEffectiveAccountSettingsOptions *iamidentityv1.GetEffectiveAccountSettingsOptions) (*iamidentityv1.EffectiveAccountSettingsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getEffectiveAccountSettingsOptions)
+	var r0 *iamidentityv1.EffectiveAccountSettingsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.EffectiveAccountSettingsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetEffectiveAccountSettingsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetEffectiveAccountSettingsWithContext(ctx context.Context, getEffectiveAccountSettingsOptions *iamidentityv1.GetEffectiveAccountSettingsOptions) (*iamidentityv1.EffectiveAccountSettingsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getEffectiveAccountSettingsOptions)
+	var r0 *iamidentityv1.EffectiveAccountSettingsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.EffectiveAccountSettingsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetMfaStatus provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetMfaStatus(getMfaStatusOptions *iamidentityv1.GetMfaStatusOptions) (*iamidentityv1.UserMfaEnrollments, *core.DetailedResponse, error) {
+	ret := _m.Called(getMfaStatusOptions)
+	var r0 *iamidentityv1.UserMfaEnrollments
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.UserMfaEnrollments)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetMfaStatusWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetMfaStatusWithContext(ctx context.Context, getMfaStatusOptions *iamidentityv1.GetMfaStatusOptions) (*iamidentityv1.UserMfaEnrollments, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getMfaStatusOptions)
+	var r0 *iamidentityv1.UserMfaEnrollments
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.UserMfaEnrollments)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateMfaReport provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateMfaReport(createMfaReportOptions *iamidentityv1.CreateMfaReportOptions) (*iamidentityv1.ReportReference, *core.DetailedResponse, error) {
+	ret := _m.Called(createMfaReportOptions)
+	var r0 *iamidentityv1.ReportReference
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ReportReference)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateMfaReportWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateMfaReportWithContext(ctx context.Context, createMfaReportOptions *iamidentityv1.CreateMfaReportOptions) (*iamidentityv1.ReportReference, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createMfaReportOptions)
+	var r0 *iamidentityv1.ReportReference
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ReportReference)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetMfaReport provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetMfaReport(getMfaReportOptions *iamidentityv1.GetMfaReportOptions) (*iamidentityv1.ReportMfaEnrollmentStatus, *core.DetailedResponse, error) {
+	ret := _m.Called(getMfaReportOptions)
+	var r0 *iamidentityv1.ReportMfaEnrollmentStatus
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ReportMfaEnrollmentStatus)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetMfaReportWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetMfaReportWithContext(ctx context.Context, getMfaReportOptions *iamidentityv1.GetMfaReportOptions) (*iamidentityv1.ReportMfaEnrollmentStatus, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getMfaReportOptions)
+	var r0 *iamidentityv1.ReportMfaEnrollmentStatus
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ReportMfaEnrollmentStatus)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdatePreferenceOnScopeAccount provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdatePreferenceOnScopeAccount(updatePreferenceOnScopeAccountOptions *iamidentityv1.UpdatePreferenceOnScopeAccountOptions) (*iamidentityv1.IdentityPreferenceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updatePreferenceOnScopeAccountOptions)
+	var r0 *iamidentityv1.IdentityPreferenceResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityPreferenceResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdatePreferenceOnScopeAccountWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdatePreferenceOnScopeAccountWithContext(ctx context.Context, updatePreferenceOnScopeAccountOptions *iamidentityv1.UpdatePreferenceOnScopeAccountOptions) (*iamidentityv1.IdentityPreferenceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updatePreferenceOnScopeAccountOptions)
+	var r0 *iamidentityv1.IdentityPreferenceResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityPreferenceResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeletePreferencesOnScopeAccount provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeletePreferencesOnScopeAccount(deletePreferencesOnScopeAccountOptions *iamidentityv1.DeletePreferencesOnScopeAccountOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deletePreferencesOnScopeAccountOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeletePreferencesOnScopeAccountWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeletePreferencesOnScopeAccountWithContext(ctx context.Context, deletePreferencesOnScopeAccountOptions *iamidentityv1.DeletePreferencesOnScopeAccountOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deletePreferencesOnScopeAccountOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetPreferencesOnScopeAccount provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetPreferencesOnScopeAccount(getPreferencesOnScopeAccountOptions *iamidentityv1.GetPreferencesOnScopeAccountOptions) (*iamidentityv1.IdentityPreferenceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getPreferencesOnScopeAccountOptions)
+	var r0 *iamidentityv1.IdentityPreferenceResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityPreferenceResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPreferencesOnScopeAccountWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetPreferencesOnScopeAccountWithContext(ctx context.Context, getPreferencesOnScopeAccountOptions *iamidentityv1.GetPreferencesOnScopeAccountOptions) (*iamidentityv1.IdentityPreferenceResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPreferencesOnScopeAccountOptions)
+	var r0 *iamidentityv1.IdentityPreferenceResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityPreferenceResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAllPreferencesOnScopeAccount provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAllPreferencesOnScopeAccount(getAllPreferencesOnScopeAccountOptions *iamidentityv1.GetAllPreferencesOnScopeAccountOptions) (*iamidentityv1.IdentityPreferencesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getAllPreferencesOnScopeAccountOptions)
+	var r0 *iamidentityv1.IdentityPreferencesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityPreferencesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAllPreferencesOnScopeAccountWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAllPreferencesOnScopeAccountWithContext(ctx context.Context, getAllPreferencesOnScopeAccountOptions *iamidentityv1.GetAllPreferencesOnScopeAccountOptions) (*iamidentityv1.IdentityPreferencesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAllPreferencesOnScopeAccountOptions)
+	var r0 *iamidentityv1.IdentityPreferencesResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityPreferencesResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListProfileTemplates provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListProfileTemplates(listProfileTemplatesOptions *iamidentityv1.ListProfileTemplatesOptions) (*iamidentityv1.TrustedProfileTemplateList, *core.DetailedResponse, error) {
+	ret := _m.Called(listProfileTemplatesOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListProfileTemplatesWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListProfileTemplatesWithContext(ctx context.Context, listProfileTemplatesOptions *iamidentityv1.ListProfileTemplatesOptions) (*iamidentityv1.TrustedProfileTemplateList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listProfileTemplatesOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateProfileTemplate provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateProfileTemplate(createProfileTemplateOptions *iamidentityv1.CreateProfileTemplateOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createProfileTemplateOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateProfileTemplateWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateProfileTemplateWithContext(ctx context.Context, createProfileTemplateOptions *iamidentityv1.CreateProfileTemplateOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createProfileTemplateOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLatestProfileTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetLatestProfileTemplateVersion(getLatestProfileTemplateVersionOptions *iamidentityv1.GetLatestProfileTemplateVersionOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getLatestProfileTemplateVersionOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLatestProfileTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetLatestProfileTemplateVersionWithContext(ctx context.Context, getLatestProfileTemplateVersionOptions *iamidentityv1.GetLatestProfileTemplateVersionOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getLatestProfileTemplateVersionOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAllVersionsOfProfileTemplate provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAllVersionsOfProfileTemplate(deleteAllVersionsOfProfileTemplateOptions *iamidentityv1.DeleteAllVersionsOfProfileTemplateOptions) (*core.
+	DetailedResponse, error) {
+	ret := _m.Called(deleteAllVersionsOfProfileTemplateOptions)
+	var r0 *core.
+		DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.
+			DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAllVersionsOfProfileTemplateWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAllVersionsOfProfileTemplateWithContext(ctx context.Context, deleteAllVersionsOfProfileTemplateOptions *iamidentityv1.DeleteAllVersionsOfProfileTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAllVersionsOfProfileTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListVersionsOfProfileTemplate provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListVersionsOfProfileTemplate(listVersionsOfProfileTemplateOptions *iamidentityv1.ListVersionsOfProfileTemplateOptions) (*iamidentityv1.TrustedProfileTemplateList, *core.DetailedResponse, error) {
+	ret := _m.Called(listVersionsOfProfileTemplateOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListVersionsOfProfileTemplateWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListVersionsOfProfileTemplateWithContext(ctx context.Context, listVersionsOfProfileTemplateOptions *iamidentityv1.ListVersionsOfProfileTemplateOptions) (*iamidentityv1.TrustedProfileTemplateList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listVersionsOfProfileTemplateOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateProfileTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateProfileTemplateVersion(createProfileTemplateVersionOptions *iamidentityv1.CreateProfileTemplateVersionOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createProfileTemplateVersionOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateProfileTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateProfileTemplateVersionWithContext(ctx context.Context, createProfileTemplateVersionOptions *iamidentityv1.CreateProfileTemplateVersionOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createProfileTemplateVersionOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetProfileTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetProfileTemplateVersion(getProfileTemplateVersionOptions *iamidentityv1.GetProfileTemplateVersionOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getProfileTemplateVersionOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetProfileTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetProfileTemplateVersionWithContext(ctx context.Context, getProfileTemplateVersionOptions *iamidentityv1.GetProfileTemplateVersionOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getProfileTemplateVersionOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateProfileTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateProfileTemplateVersion(updateProfileTemplateVersionOptions *iamidentityv1.UpdateProfileTemplateVersionOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updateProfileTemplateVersionOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateProfileTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateProfileTemplateVersionWithContext(ctx context.Context, updateProfileTemplateVersionOptions *iamidentityv1.UpdateProfileTemplateVersionOptions) (*iamidentityv1.TrustedProfileTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateProfileTemplateVersionOptions)
+	var r0 *iamidentityv1.TrustedProfileTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TrustedProfileTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteProfileTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteProfileTemplateVersion(deleteProfileTemplateVersionOptions *iamidentityv1.DeleteProfileTemplateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteProfileTemplateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteProfileTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteProfileTemplateVersionWithContext(ctx context.Context, deleteProfileTemplateVersionOptions *iamidentityv1.DeleteProfileTemplateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteProfileTemplateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitProfileTemplate provides a mock function with the given fields.
+func (_m *IamIdentityV1) CommitProfileTemplate(commitProfileTemplateOptions *iamidentityv1.CommitProfileTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(commitProfileTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitProfileTemplateWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CommitProfileTemplateWithContext(ctx context.Context, commitProfileTemplateOptions *iamidentityv1.CommitProfileTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, commitProfileTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListTrustedProfileAssignments provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListTrustedProfileAssignments(listTrustedProfileAssignmentsOptions *iamidentityv1.ListTrustedProfileAssignmentsOptions) (*iamidentityv1.TemplateAssignmentListResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listTrustedProfileAssignmentsOptions)
+	var r0 *iamidentityv1.TemplateAssignmentListResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentListResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTrustedProfileAssignmentsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListTrustedProfileAssignmentsWithContext(ctx context.Context, listTrustedProfileAssignmentsOptions *iamidentityv1.ListTrustedProfileAssignmentsOptions) (*iamidentityv1.TemplateAssignmentListResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listTrustedProfileAssignmentsOptions)
+	var r0 *iamidentityv1.TemplateAssignmentListResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentListResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTrustedProfileAssignment provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateTrustedProfileAssignment(createTrustedProfileAssignmentOptions *iamidentityv1.CreateTrustedProfileAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createTrustedProfileAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTrustedProfileAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateTrustedProfileAssignmentWithContext(ctx context.Context, createTrustedProfileAssignmentOptions *iamidentityv1.CreateTrustedProfileAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createTrustedProfileAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTrustedProfileAssignment provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetTrustedProfileAssignment(getTrustedProfileAssignmentOptions *iamidentityv1.GetTrustedProfileAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getTrustedProfileAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTrustedProfileAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetTrustedProfileAssignmentWithContext(ctx context.Context, getTrustedProfileAssignmentOptions *iamidentityv1.GetTrustedProfileAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getTrustedProfileAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTrustedProfileAssignment provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteTrustedProfileAssignment(deleteTrustedProfileAssignmentOptions *iamidentityv1.DeleteTrustedProfileAssignmentOptions) (*iamidentityv1.ExceptionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteTrustedProfileAssignmentOptions)
+	var r0 *iamidentityv1.ExceptionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ExceptionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTrustedProfileAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteTrustedProfileAssignmentWithContext(ctx context.Context, deleteTrustedProfileAssignmentOptions *iamidentityv1.DeleteTrustedProfileAssignmentOptions) (*iamidentityv1.ExceptionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteTrustedProfileAssignmentOptions)
+	var r0 *iamidentityv1.ExceptionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ExceptionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateTrustedProfileAssignment provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateTrustedProfileAssignment(updateTrustedProfileAssignmentOptions *iamidentityv1.UpdateTrustedProfileAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updateTrustedProfileAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateTrustedProfileAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateTrustedProfileAssignmentWithContext(ctx context.Context, updateTrustedProfileAssignmentOptions *iamidentityv1.UpdateTrustedProfileAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateTrustedProfileAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccountSettingsTemplates provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListAccountSettingsTemplates(listAccountSettingsTemplatesOptions *iamidentityv1.ListAccountSettingsTemplatesOptions) (*iamidentityv1.AccountSettingsTemplateList, *core.DetailedResponse, error) {
+	ret := _m.Called(listAccountSettingsTemplatesOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccountSettingsTemplatesWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListAccountSettingsTemplatesWithContext(ctx context.Context, listAccountSettingsTemplatesOptions *iamidentityv1.ListAccountSettingsTemplatesOptions) (*iamidentityv1.AccountSettingsTemplateList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAccountSettingsTemplatesOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAccountSettingsTemplate provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateAccountSettingsTemplate(createAccountSettingsTemplateOptions *iamidentityv1.CreateAccountSettingsTemplateOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createAccountSettingsTemplateOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAccountSettingsTemplateWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateAccountSettingsTemplateWithContext(ctx context.Context, createAccountSettingsTemplateOptions *iamidentityv1.CreateAccountSettingsTemplateOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createAccountSettingsTemplateOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLatestAccountSettingsTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetLatestAccountSettingsTemplateVersion(getLatestAccountSettingsTemplateVersionOptions *iamidentityv1.GetLatestAccountSettingsTemplateVersionOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getLatestAccountSettingsTemplateVersionOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLatestAccountSettingsTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetLatestAccountSettingsTemplateVersionWithContext(ctx context.Context, getLatestAccountSettingsTemplateVersionOptions *iamidentityv1.GetLatestAccountSettingsTemplateVersionOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getLatestAccountSettingsTemplateVersionOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAllVersionsOfAccountSettingsTemplate provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAllVersionsOfAccountSettingsTemplate(deleteAllVersionsOfAccountSettingsTemplateOptions *iamidentityv1.DeleteAllVersionsOfAccountSettingsTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteAllVersionsOfAccountSettingsTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAllVersionsOfAccountSettingsTemplateWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAllVersionsOfAccountSettingsTemplateWithContext(ctx context.Context, deleteAllVersionsOfAccountSettingsTemplateOptions *iamidentityv1.DeleteAllVersionsOfAccountSettingsTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAllVersionsOfAccountSettingsTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListVersionsOfAccountSettingsTemplate provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListVersionsOfAccountSettingsTemplate(listVersionsOfAccountSettingsTemplateOptions *iamidentityv1.ListVersionsOfAccountSettingsTemplateOptions) (*iamidentityv1.AccountSettingsTemplateList, *core.DetailedResponse, error) {
+	ret := _m.Called(listVersionsOfAccountSettingsTemplateOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListVersionsOfAccountSettingsTemplateWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListVersionsOfAccountSettingsTemplateWithContext(ctx context.Context, listVersionsOfAccountSettingsTemplateOptions *iamidentityv1.ListVersionsOfAccountSettingsTemplateOptions) (*iamidentityv1.AccountSettingsTemplateList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listVersionsOfAccountSettingsTemplateOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAccountSettingsTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateAccountSettingsTemplateVersion(createAccountSettingsTemplateVersionOptions *iamidentityv1.CreateAccountSettingsTemplateVersionOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createAccountSettingsTemplateVersionOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAccountSettingsTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateAccountSettingsTemplateVersionWithContext(ctx context.Context, createAccountSettingsTemplateVersionOptions *iamidentityv1.CreateAccountSettingsTemplateVersionOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createAccountSettingsTemplateVersionOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSettingsTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAccountSettingsTemplateVersion(getAccountSettingsTemplateVersionOptions *iamidentityv1.GetAccountSettingsTemplateVersionOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountSettingsTemplateVersionOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSettingsTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAccountSettingsTemplateVersionWithContext(ctx context.Context, getAccountSettingsTemplateVersionOptions *iamidentityv1.GetAccountSettingsTemplateVersionOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountSettingsTemplateVersionOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettingsTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateAccountSettingsTemplateVersion(updateAccountSettingsTemplateVersionOptions *iamidentityv1.UpdateAccountSettingsTemplateVersionOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAccountSettingsTemplateVersionOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettingsTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateAccountSettingsTemplateVersionWithContext(ctx context.Context, updateAccountSettingsTemplateVersionOptions *iamidentityv1.UpdateAccountSettingsTemplateVersionOptions) (*iamidentityv1.AccountSettingsTemplateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAccountSettingsTemplateVersionOptions)
+	var r0 *iamidentityv1.AccountSettingsTemplateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountSettingsTemplateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAccountSettingsTemplateVersion provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAccountSettingsTemplateVersion(deleteAccountSettingsTemplateVersionOptions *iamidentityv1.DeleteAccountSettingsTemplateVersionOptions) (*core.
+	DetailedResponse, error) {
+	ret := _m.Called(deleteAccountSettingsTemplateVersionOptions)
+	var r0 *core.
+		DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.
+			DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteAccountSettingsTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAccountSettingsTemplateVersionWithContext(ctx context.Context, deleteAccountSettingsTemplateVersionOptions *iamidentityv1.DeleteAccountSettingsTemplateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAccountSettingsTemplateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitAccountSettingsTemplate provides a mock function with the given fields.
+func (_m *IamIdentityV1) CommitAccountSettingsTemplate(commitAccountSettingsTemplateOptions *iamidentityv1.CommitAccountSettingsTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(commitAccountSettingsTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitAccountSettingsTemplateWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CommitAccountSettingsTemplateWithContext(ctx context.Context, commitAccountSettingsTemplateOptions *iamidentityv1.CommitAccountSettingsTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, commitAccountSettingsTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListAccountSettingsAssignments provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListAccountSettingsAssignments(listAccountSettingsAssignmentsOptions *iamidentityv1.ListAccountSettingsAssignmentsOptions) (*iamidentityv1.TemplateAssignmentListResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listAccountSettingsAssignmentsOptions)
+	var r0 *iamidentityv1.TemplateAssignmentListResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentListResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListAccountSettingsAssignmentsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListAccountSettingsAssignmentsWithContext(ctx context.Context, listAccountSettingsAssignmentsOptions *iamidentityv1.ListAccountSettingsAssignmentsOptions) (*iamidentityv1.TemplateAssignmentListResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listAccountSettingsAssignmentsOptions)
+	var r0 *iamidentityv1.TemplateAssignmentListResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentListResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAccountSettingsAssignment provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateAccountSettingsAssignment(createAccountSettingsAssignmentOptions *iamidentityv1.CreateAccountSettingsAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createAccountSettingsAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateAccountSettingsAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateAccountSettingsAssignmentWithContext(ctx context.Context, createAccountSettingsAssignmentOptions *iamidentityv1.CreateAccountSettingsAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createAccountSettingsAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSettingsAssignment provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAccountSettingsAssignment(getAccountSettingsAssignmentOptions *iamidentityv1.GetAccountSettingsAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountSettingsAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSettingsAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAccountSettingsAssignmentWithContext(ctx context.Context, getAccountSettingsAssignmentOptions *iamidentityv1.GetAccountSettingsAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountSettingsAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAccountSettingsAssignment provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAccountSettingsAssignment(deleteAccountSettingsAssignmentOptions *iamidentityv1.DeleteAccountSettingsAssignmentOptions) (*iamidentityv1.ExceptionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteAccountSettingsAssignmentOptions)
+	var r0 *iamidentityv1.ExceptionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ExceptionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteAccountSettingsAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteAccountSettingsAssignmentWithContext(ctx context.Context, deleteAccountSettingsAssignmentOptions *iamidentityv1.DeleteAccountSettingsAssignmentOptions) (*iamidentityv1.ExceptionResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteAccountSettingsAssignmentOptions)
+	var r0 *iamidentityv1.ExceptionResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ExceptionResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettingsAssignment provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateAccountSettingsAssignment(updateAccountSettingsAssignmentOptions *iamidentityv1.UpdateAccountSettingsAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAccountSettingsAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettingsAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateAccountSettingsAssignmentWithContext(ctx context.Context, updateAccountSettingsAssignmentOptions *iamidentityv1.UpdateAccountSettingsAssignmentOptions) (*iamidentityv1.TemplateAssignmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAccountSettingsAssignmentOptions)
+	var r0 *iamidentityv1.TemplateAssignmentResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TemplateAssignmentResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountLimits provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAccountLimits(getAccountLimitsOptions *iamidentityv1.GetAccountLimitsOptions) (*iamidentityv1.IdentityLimitsUsageResponse, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getAccountLimitsOptions)
+	var r0 *iamidentityv1.IdentityLimitsUsageResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityLimitsUsageResponse)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountLimitsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetAccountLimitsWithContext(ctx context.Context, getAccountLimitsOptions *iamidentityv1.GetAccountLimitsOptions) (*iamidentityv1.IdentityLimitsUsageResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountLimitsOptions)
+	var r0 *iamidentityv1.IdentityLimitsUsageResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityLimitsUsageResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// BulkListAccountEntityConsumption provides a mock function with the given fields.
+func (_m *IamIdentityV1) BulkListAccountEntityConsumption(bulkListAccountEntityConsumptionOptions *iamidentityv1.BulkListAccountEntityConsumptionOptions) (*iamidentityv1.IdentityLimitsUsageResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(bulkListAccountEntityConsumptionOptions)
+	var r0 *iamidentityv1.IdentityLimitsUsageResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityLimitsUsageResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// BulkListAccountEntityConsumptionWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) BulkListAccountEntityConsumptionWithContext(ctx context.Context, bulkListAccountEntityConsumptionOptions *iamidentityv1.BulkListAccountEntityConsumptionOptions) (*iamidentityv1.IdentityLimitsUsageResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, bulkListAccountEntityConsumptionOptions)
+	var r0 *iamidentityv1.IdentityLimitsUsageResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.IdentityLimitsUsageResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListIdps provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListIdps(listIdpsOptions *iamidentityv1.ListIdpsOptions) (*iamidentityv1.ListIdpsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listIdpsOptions)
+	var r0 *iamidentityv1.ListIdpsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ListIdpsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListIdpsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListIdpsWithContext(ctx context.Context, listIdpsOptions *iamidentityv1.ListIdpsOptions) (*iamidentityv1.ListIdpsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listIdpsOptions)
+	var r0 *iamidentityv1.ListIdpsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ListIdpsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateIdp provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateIdp(createIdpOptions *iamidentityv1.CreateIdpOptions) (*iamidentityv1.Idp, *core.DetailedResponse, error) {
+	ret := _m.Called(createIdpOptions)
+	var r0 *iamidentityv1.Idp
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.Idp)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateIdpWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) CreateIdpWithContext(ctx context.Context, createIdpOptions *iamidentityv1.CreateIdpOptions) (*iamidentityv1.Idp, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createIdpOptions)
+	var r0 *iamidentityv1.Idp
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.Idp)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetIdp provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetIdp(getIdpOptions *iamidentityv1.GetIdpOptions) (*iamidentityv1.Idp, *core.DetailedResponse, error) {
+	ret := _m.Called(getIdpOptions)
+	var r0 *iamidentityv1.Idp
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.Idp)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetIdpWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetIdpWithContext(ctx context.Context, getIdpOptions *iamidentityv1.GetIdpOptions) (*iamidentityv1.Idp, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getIdpOptions)
+	var r0 *iamidentityv1.Idp
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.Idp)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateIdp provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateIdp(updateIdpOptions *iamidentityv1.UpdateIdpOptions) (*iamidentityv1.Idp, *core.DetailedResponse, error) {
+	ret := _m.Called(updateIdpOptions)
+	var r0 *iamidentityv1.Idp
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.Idp)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateIdpWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateIdpWithContext(ctx context.Context, updateIdpOptions *iamidentityv1.UpdateIdpOptions) (*iamidentityv1.Idp, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateIdpOptions)
+	var r0 *iamidentityv1.Idp
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.Idp)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteIdp provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteIdp(deleteIdpOptions *iamidentityv1.DeleteIdpOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteIdpOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteIdpWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) DeleteIdpWithContext(ctx context.Context, deleteIdpOptions *iamidentityv1.DeleteIdpOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteIdpOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListConsumerAccounts provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListConsumerAccounts(listConsumerAccountsOptions *iamidentityv1.ListConsumerAccountsOptions) (*iamidentityv1.ConsumersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listConsumerAccountsOptions)
+	var r0 *iamidentityv1.ConsumersResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ConsumersResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListConsumerAccountsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListConsumerAccountsWithContext(ctx context.Context, listConsumerAccountsOptions *iamidentityv1.ListConsumerAccountsOptions) (*iamidentityv1.ConsumersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listConsumerAccountsOptions)
+	var r0 *iamidentityv1.ConsumersResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ConsumersResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ExportSamlMetadata provides a mock function with the given fields.
+func (_m *IamIdentityV1) ExportSamlMetadata(exportSamlMetadataOptions *iamidentityv1.ExportSamlMetadataOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(exportSamlMetadataOptions)
+	var r0 *string
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*string)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ExportSamlMetadataWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ExportSamlMetadataWithContext(ctx context.Context, exportSamlMetadataOptions *iamidentityv1.ExportSamlMetadataOptions) (*string, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, exportSamlMetadataOptions)
+	var r0 *string
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*string)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ImportSamlIdpMetadata provides a mock function with the given fields.
+func (_m *IamIdentityV1) ImportSamlIdpMetadata(importSamlIdpMetadataOptions *iamidentityv1.ImportSamlIdpMetadataOptions) (*iamidentityv1.SamlMetadataImportResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(importSamlIdpMetadataOptions)
+	var r0 *iamidentityv1.SamlMetadataImportResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.SamlMetadataImportResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ImportSamlIdpMetadataWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ImportSamlIdpMetadataWithContext(ctx context.Context, importSamlIdpMetadataOptions *iamidentityv1.ImportSamlIdpMetadataOptions) (*iamidentityv1.SamlMetadataImportResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, importSamlIdpMetadataOptions)
+	var r0 *iamidentityv1.SamlMetadataImportResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.SamlMetadataImportResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetIdpTestResult provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetIdpTestResult(getIdpTestResultOptions *iamidentityv1.GetIdpTestResultOptions) (*iamidentityv1.TestResult, *core.DetailedResponse, error) {
+	ret := _m.Called(getIdpTestResultOptions)
+	var r0 *iamidentityv1.TestResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TestResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetIdpTestResultWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetIdpTestResultWithContext(ctx context.Context, getIdpTestResultOptions *iamidentityv1.GetIdpTestResultOptions) (*iamidentityv1.TestResult, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getIdpTestResultOptions)
+	var r0 *iamidentityv1.TestResult
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TestResult)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// TestIdp provides a mock function with the given fields.
+func (_m *IamIdentityV1) TestIdp(testIdpOptions *iamidentityv1.TestIdpOptions) (*iamidentityv1.TestTriggerResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(testIdpOptions)
+	var r0 *iamidentityv1.TestTriggerResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TestTriggerResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// TestIdpWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) TestIdpWithContext(ctx context.Context, testIdpOptions *iamidentityv1.TestIdpOptions) (*iamidentityv1.TestTriggerResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, testIdpOptions)
+	var r0 *iamidentityv1.TestTriggerResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.TestTriggerResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLoginSettings provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetLoginSettings(getLoginSettingsOptions *iamidentityv1.GetLoginSettingsOptions) (*iamidentityv1.AccountLoginSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(getLoginSettingsOptions)
+	var r0 *iamidentityv1.AccountLoginSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountLoginSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLoginSettingsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetLoginSettingsWithContext(ctx context.Context, getLoginSettingsOptions *iamidentityv1.GetLoginSettingsOptions) (*iamidentityv1.AccountLoginSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getLoginSettingsOptions)
+	var r0 *iamidentityv1.AccountLoginSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountLoginSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateLoginSettings provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateLoginSettings(updateLoginSettingsOptions *iamidentityv1.UpdateLoginSettingsOptions) (*iamidentityv1.AccountLoginSettings, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(updateLoginSettingsOptions)
+	var r0 *iamidentityv1.AccountLoginSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountLoginSettings)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateLoginSettingsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateLoginSettingsWithContext(ctx context.Context, updateLoginSettingsOptions *iamidentityv1.UpdateLoginSettingsOptions) (*iamidentityv1.AccountLoginSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateLoginSettingsOptions)
+	var r0 *iamidentityv1.AccountLoginSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountLoginSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListIDPSettings provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListIDPSettings(listIDPSettingsOptions *iamidentityv1.ListIDPSettingsOptions) (*iamidentityv1.ListIdpSettingsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listIDPSettingsOptions)
+	var r0 *iamidentityv1.ListIdpSettingsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ListIdpSettingsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListIDPSettingsWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) ListIDPSettingsWithContext(ctx context.Context, listIDPSettingsOptions *iamidentityv1.ListIDPSettingsOptions) (*iamidentityv1.ListIdpSettingsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listIDPSettingsOptions)
+	var r0 *iamidentityv1.ListIdpSettingsResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.ListIdpSettingsResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetIDPSetting provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetIDPSetting(getIDPSettingOptions *iamidentityv1.GetIDPSettingOptions) (*iamidentityv1.AccountIdpSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(getIDPSettingOptions)
+	var r0 *iamidentityv1.AccountIdpSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountIdpSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetIDPSettingWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) GetIDPSettingWithContext(ctx context.Context, getIDPSettingOptions *iamidentityv1.GetIDPSettingOptions) (*iamidentityv1.AccountIdpSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getIDPSettingOptions)
+	var r0 *iamidentityv1.AccountIdpSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountIdpSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddIDPSetting provides a mock function with the given fields.
+func (_m *IamIdentityV1) AddIDPSetting(addIDPSettingOptions *iamidentityv1.AddIDPSettingOptions) (*iamidentityv1.AccountIdpSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(addIDPSettingOptions)
+	var r0 *iamidentityv1.AccountIdpSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountIdpSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// AddIDPSettingWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) AddIDPSettingWithContext(ctx context.Context, addIDPSettingOptions *iamidentityv1.AddIDPSettingOptions) (*iamidentityv1.AccountIdpSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, addIDPSettingOptions)
+	var r0 *iamidentityv1.AccountIdpSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountIdpSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateIDPSetting provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateIDPSetting(updateIDPSettingOptions *iamidentityv1.UpdateIDPSettingOptions) (*iamidentityv1.AccountIdpSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(updateIDPSettingOptions)
+	var r0 *iamidentityv1.AccountIdpSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountIdpSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateIDPSettingWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) UpdateIDPSettingWithContext(ctx context.Context, updateIDPSettingOptions *iamidentityv1.UpdateIDPSettingOptions) (*iamidentityv1.AccountIdpSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateIDPSettingOptions)
+	var r0 *iamidentityv1.AccountIdpSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iamidentityv1.AccountIdpSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RemoveIDPSetting provides a mock function with the given fields.
+func (_m *IamIdentityV1) RemoveIDPSetting(removeIDPSettingOptions *iamidentityv1.RemoveIDPSettingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(removeIDPSettingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// RemoveIDPSettingWithContext provides a mock function with the given fields.
+func (_m *IamIdentityV1) RemoveIDPSettingWithContext(ctx context.Context, removeIDPSettingOptions *iamidentityv1.RemoveIDPSettingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, removeIDPSettingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// CRNResourceAttributes returns the attributes of a v1 policy resource that grant access to the resource identified
+// by a CRN, or to the resources matching a CRN mask.
+func CRNResourceAttributes(crn *common.CRN) (attributes []ResourceAttribute) {
+	values := crn.Attributes()
+	for _, name := range common.CRNAttributeNames {
+		if value, found := values[name]; found {
+			attributes = append(attributes, ResourceAttribute{
+				Name:     core.StringPtr(name),
+				Value:    core.StringPtr(value),
+				Operator: core.StringPtr(crnAttributeOperator(value)),
+			})
+		}
+	}
+	return
+}
+
+// CRNV2PolicyResourceAttributes returns the attributes of a v2 policy resource that grant access to the resource
+// identified by a CRN, or to the resources matching a CRN mask.
+func CRNV2PolicyResourceAttributes(crn *common.CRN) (attributes []V2PolicyResourceAttribute) {
+	values := crn.Attributes()
+	for _, name := range common.CRNAttributeNames {
+		if value, found := values[name]; found {
+			attributes = append(attributes, V2PolicyResourceAttribute{
+				Key:      core.StringPtr(name),
+				Operator: core.StringPtr(crnAttributeOperator(value)),
+				Value:    value,
+			})
+		}
+	}
+	return
+}
+
+// CRNFromPolicyResource returns the CRN mask selected by the attributes of a v1 policy resource. Attributes with
+// operators other than "stringEquals" and "stringMatch" are ignored.
+func CRNFromPolicyResource(resource *PolicyResource) (*common.CRN, error) {
+	values := map[string]string{}
+	for _, attribute := range resource.Attributes {
+		if isCRNAttributeOperator(attribute.Operator) && attribute.Name != nil && attribute.Value != nil {
+			values[*attribute.Name] = *attribute.Value
+		}
+	}
+	crn, err := common.CRNFromAttributes(values)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "invalid-crn-attributes")
+	}
+	return crn, err
+}
+
+// CRNFromV2PolicyResource returns the CRN mask selected by the attributes of a v2 policy resource. Attributes with
+// operators other than "stringEquals" and "stringMatch", or with values that are not strings, are ignored.
+func CRNFromV2PolicyResource(resource *V2PolicyResource) (*common.CRN, error) {
+	values := map[string]string{}
+	for _, attribute := range resource.Attributes {
+		value, isString := attribute.Value.(string)
+		if isCRNAttributeOperator(attribute.Operator) && attribute.Key != nil && isString {
+			values[*attribute.Key] = value
+		}
+	}
+	crn, err := common.CRNFromAttributes(values)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "invalid-crn-attributes")
+	}
+	return crn, err
+}
+
+func crnAttributeOperator(value string) string {
+	if common.HasWildcards(value) {
+		return V2PolicyResourceAttributeOperatorStringmatchConst
+	}
+	return V2PolicyResourceAttributeOperatorStringequalsConst
+}
+
+// v1 policies omit the operator of "stringEquals" attributes.
+func isCRNAttributeOperator(operator *string) bool {
+	return operator == nil || *operator == V2PolicyResourceAttributeOperatorStringequalsConst ||
+		*operator == V2PolicyResourceAttributeOperatorStringmatchConst
+}
@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CRN policy resources`, func() {
+	mask := common.NewCRN("kms").WithLocation("us-south").WithAccount("abc").WithServiceInstance("key-ring-*")
+
+	It(`Converts CRNs to v1 policy resource attributes`, func() {
+		attributes := iampolicymanagementv1.CRNResourceAttributes(mask)
+		Expect(attributes).To(Equal([]iampolicymanagementv1.ResourceAttribute{
+			{Name: core.StringPtr("accountId"), Value: core.StringPtr("abc"), Operator: core.StringPtr("stringEquals")},
+			{Name: core.StringPtr("serviceName"), Value: core.StringPtr("kms"), Operator: core.StringPtr("stringEquals")},
+			{Name: core.StringPtr("region"), Value: core.StringPtr("us-south"), Operator: core.StringPtr("stringEquals")},
+			{Name: core.StringPtr("serviceInstance"), Value: core.StringPtr("key-ring-*"), Operator: core.StringPtr("stringMatch")},
+		}))
+
+		crn, err := iampolicymanagementv1.CRNFromPolicyResource(&iampolicymanagementv1.PolicyResource{Attributes: attributes})
+		Expect(err).To(BeNil())
+		Expect(crn).To(Equal(mask))
+	})
+	It(`Converts CRNs to v2 policy resource attributes`, func() {
+		attributes := iampolicymanagementv1.CRNV2PolicyResourceAttributes(mask)
+		Expect(attributes).To(HaveLen(4))
+		Expect(*attributes[3].Key).To(Equal("serviceInstance"))
+		Expect(*attributes[3].Operator).To(Equal(iampolicymanagementv1.V2PolicyResourceAttributeOperatorStringmatchConst))
+		Expect(attributes[3].Value).To(Equal("key-ring-*"))
+
+		// Attributes that do not select a single value are ignored.
+		attributes = append(attributes, iampolicymanagementv1.V2PolicyResourceAttribute{
+			Key:      core.StringPtr("resourceType"),
+			Operator: core.StringPtr(iampolicymanagementv1.V2PolicyResourceAttributeOperatorStringexistsConst),
+			Value:    true,
+		})
+		crn, err := iampolicymanagementv1.CRNFromV2PolicyResource(&iampolicymanagementv1.V2PolicyResource{Attributes: attributes})
+		Expect(err).To(BeNil())
+		Expect(crn.String()).To(Equal("crn:v1:bluemix:public:kms:us-south:a/abc:key-ring-*::"))
+	})
+	It(`Rejects invalid attributes`, func() {
+		_, err := iampolicymanagementv1.CRNFromV2PolicyResource(&iampolicymanagementv1.V2PolicyResource{
+			Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+				{Key: core.StringPtr("serviceName"), Operator: core.StringPtr("stringEquals"), Value: "Key Protect"},
+			},
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,58 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+func init() {
+	common.RegisterErrorCodes(DefaultServiceName, map[string]error{
+		ErrorObjectCodeActionControlAssignmentNotFoundConst: common.ErrNotFound,
+		ErrorObjectCodeActionControlTemplateNotFoundConst:   common.ErrNotFound,
+		ErrorObjectCodeInsufficentPermissionsConst:          common.ErrForbidden,
+		ErrorObjectCodeInvalidBodyConst:                     common.ErrBadRequest,
+		ErrorObjectCodeInvalidTokenConst:                    common.ErrUnauthorized,
+		ErrorObjectCodeMissingRequiredQueryParameterConst:   common.ErrBadRequest,
+		ErrorObjectCodeNotFoundConst:                        common.ErrNotFound,
+		ErrorObjectCodePolicyAssignmentConflictErrorConst:   common.ErrConflict,
+		ErrorObjectCodePolicyAssignmentNotFoundConst:        common.ErrNotFound,
+		ErrorObjectCodePolicyConflictErrorConst:             common.ErrConflict,
+		ErrorObjectCodePolicyNotFoundConst:                  common.ErrNotFound,
+		ErrorObjectCodePolicyTemplateConflictErrorConst:     common.ErrConflict,
+		ErrorObjectCodePolicyTemplateNotFoundConst:          common.ErrNotFound,
+		ErrorObjectCodeResourceNotFoundConst:                common.ErrNotFound,
+		ErrorObjectCodeRoleAssignmentNotFoundConst:          common.ErrNotFound,
+		ErrorObjectCodeRoleConflictErrorConst:               common.ErrConflict,
+		ErrorObjectCodeRoleNotFoundConst:                    common.ErrNotFound,
+		ErrorObjectCodeRoleTemplateConflictErrorConst:       common.ErrConflict,
+		ErrorObjectCodeRoleTemplateNotFoundConst:            common.ErrNotFound,
+		ErrorObjectCodeTooManyRequestsConst:                 common.ErrRateLimited,
+		ErrorObjectCodeUnsupportedContentTypeConst:          common.ErrBadRequest,
+	})
+}
+
+// AsErrorObjects returns the errors listed in the body of an error response returned by an operation of the
+// service, with the details of the conflicting resource for conflict errors, or false if "err" is not an error
+// response or its body lists no errors.
+func AsErrorObjects(err error) (errorObjects []ErrorObject, ok bool) {
+	serviceError, ok := common.AsServiceError(err)
+	if !ok || serviceError.UnmarshalBody("errors", &errorObjects, UnmarshalErrorObject) != nil {
+		return nil, false
+	}
+	return errorObjects, len(errorObjects) > 0
+}
@@ -0,0 +1,64 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Error codes`, func() {
+	It(`Classifies the error codes registered by the service`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(400)
+			fmt.Fprint(res, `{"trace": "trace-1", "errors": [{"code": "insufficent_permissions", "message": "You are not allowed to read the policy."}], "status_code": 400}`)
+		}))
+		defer testServer.Close()
+
+		iamPolicyManagementService, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		_, _, err = iamPolicyManagementService.GetV2Policy(iamPolicyManagementService.NewGetV2PolicyOptions("policy-1"))
+		Expect(err).ToNot(BeNil())
+
+		// The code is looked up with the name of the service component of the error, so the registered kind wins
+		// over the status code.
+		Expect(errors.Is(err, common.ErrForbidden)).To(BeTrue())
+		Expect(errors.Is(err, common.ErrBadRequest)).To(BeFalse())
+		serviceError, ok := common.AsServiceError(err)
+		Expect(ok).To(BeTrue())
+		Expect(serviceError.ServiceName).To(Equal(iampolicymanagementv1.DefaultServiceName))
+		Expect(serviceError.Code).To(Equal(iampolicymanagementv1.ErrorObjectCodeInsufficentPermissionsConst))
+		Expect(errors.Is(serviceError, common.ErrForbidden)).To(BeTrue())
+		Expect(errors.Is(serviceError, common.ErrBadRequest)).To(BeFalse())
+
+		errorObjects, ok := iampolicymanagementv1.AsErrorObjects(err)
+		Expect(ok).To(BeTrue())
+		Expect(*errorObjects[0].Message).To(Equal("You are not allowed to read the policy."))
+	})
+})
@@ -196,7 +196,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPoliciesWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListPolicies"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policies`, nil)
 	if err != nil {
@@ -262,7 +262,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPoliciesWithContext(ctx co
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_policies", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -341,7 +341,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreatePolicy"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policies`, nil)
 	if err != nil {
@@ -395,7 +395,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyWithContext(ctx co
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -473,7 +473,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplacePolicyWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ReplacePolicy"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policies/{policy_id}`, pathParamsMap)
 	if err != nil {
@@ -527,7 +527,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplacePolicyWithContext(ctx c
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -568,7 +568,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetPolicy"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policies/{policy_id}`, pathParamsMap)
 	if err != nil {
@@ -596,7 +596,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyWithContext(ctx conte
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -638,7 +638,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeletePolicy"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policies/{policy_id}`, pathParamsMap)
 	if err != nil {
@@ -664,7 +664,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyWithContext(ctx co
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -698,7 +698,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdatePolicyStateWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "UpdatePolicyState"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policies/{policy_id}`, pathParamsMap)
 	if err != nil {
@@ -740,7 +740,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdatePolicyStateWithContext(c
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_policy_state", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -776,7 +776,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListRolesWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListRoles"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/roles`, nil)
 	if err != nil {
@@ -823,7 +823,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListRolesWithContext(ctx conte
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_roles", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -863,7 +863,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreateRole"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/roles`, nil)
 	if err != nil {
@@ -920,7 +920,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleWithContext(ctx cont
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_role", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -962,7 +962,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceRoleWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ReplaceRole"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/roles/{role_id}`, pathParamsMap)
 	if err != nil {
@@ -1010,7 +1010,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceRoleWithContext(ctx con
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_role", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1051,7 +1051,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetRoleWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetRole"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/roles/{role_id}`, pathParamsMap)
 	if err != nil {
@@ -1079,7 +1079,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetRoleWithContext(ctx context
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_role", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1120,7 +1120,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeleteRole"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/roles/{role_id}`, pathParamsMap)
 	if err != nil {
@@ -1146,7 +1146,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleWithContext(ctx cont
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_role", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1179,7 +1179,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListV2PoliciesWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListV2Policies"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/policies`, nil)
 	if err != nil {
@@ -1245,7 +1245,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListV2PoliciesWithContext(ctx
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_v2_policies", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1400,7 +1400,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateV2PolicyWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreateV2Policy"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/policies`, nil)
 	if err != nil {
@@ -1460,7 +1460,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateV2PolicyWithContext(ctx
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_v2_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1615,7 +1615,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceV2PolicyWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ReplaceV2Policy"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/policies/{id}`, pathParamsMap)
 	if err != nil {
@@ -1675,7 +1675,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceV2PolicyWithContext(ctx
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_v2_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1716,7 +1716,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetV2PolicyWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetV2Policy"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/policies/{id}`, pathParamsMap)
 	if err != nil {
@@ -1748,7 +1748,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetV2PolicyWithContext(ctx con
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_v2_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1790,7 +1790,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteV2PolicyWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeleteV2Policy"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v2/policies/{id}`, pathParamsMap)
 	if err != nil {
@@ -1816,7 +1816,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteV2PolicyWithContext(ctx
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_v2_policy", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1850,7 +1850,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyTemplatesWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListPolicyTemplates"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates`, nil)
 	if err != nil {
@@ -1907,7 +1907,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyTemplatesWithContext
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_policy_templates", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1945,7 +1945,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyTemplateWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreatePolicyTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates`, nil)
 	if err != nil {
@@ -1999,7 +1999,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyTemplateWithContex
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_policy_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2040,7 +2040,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyTemplateWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetPolicyTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates/{policy_template_id}`, pathParamsMap)
 	if err != nil {
@@ -2072,7 +2072,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyTemplateWithContext(c
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_policy_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2115,7 +2115,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyTemplateWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeletePolicyTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates/{policy_template_id}`, pathParamsMap)
 	if err != nil {
@@ -2141,7 +2141,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyTemplateWithContex
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_policy_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2175,7 +2175,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyTemplateVersionWit
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreatePolicyTemplateVersion"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates/{policy_template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -2223,7 +2223,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyTemplateVersionWit
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_policy_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2264,7 +2264,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyTemplateVersionsWith
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListPolicyTemplateVersions"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates/{policy_template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -2302,7 +2302,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyTemplateVersionsWith
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_policy_template_versions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2344,7 +2344,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplacePolicyTemplateWithConte
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ReplacePolicyTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates/{policy_template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -2395,7 +2395,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplacePolicyTemplateWithConte
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_policy_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2439,7 +2439,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyTemplateVersionWit
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeletePolicyTemplateVersion"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates/{policy_template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -2465,7 +2465,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyTemplateVersionWit
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_policy_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2499,7 +2499,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyTemplateVersionWithCo
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetPolicyTemplateVersion"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates/{policy_template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -2527,7 +2527,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyTemplateVersionWithCo
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_policy_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2570,7 +2570,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CommitPolicyTemplateWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CommitPolicyTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_templates/{policy_template_id}/versions/{version}/commit`, pathParamsMap)
 	if err != nil {
@@ -2596,7 +2596,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CommitPolicyTemplateWithContex
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_policy_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2629,7 +2629,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyAssignmentsWithConte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListPolicyAssignments"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_assignments`, nil)
 	if err != nil {
@@ -2675,7 +2675,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyAssignmentsWithConte
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_policy_assignments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2713,7 +2713,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyTemplateAssignment
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreatePolicyTemplateAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_assignments`, nil)
 	if err != nil {
@@ -2760,7 +2760,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyTemplateAssignment
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_policy_template_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2801,7 +2801,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyAssignmentWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetPolicyAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -2831,7 +2831,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyAssignmentWithContext
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_policy_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2872,7 +2872,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdatePolicyAssignmentWithCont
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "UpdatePolicyAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -2916,7 +2916,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdatePolicyAssignmentWithCont
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_policy_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2958,7 +2958,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyAssignmentWithCont
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeletePolicyAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/policy_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -2984,7 +2984,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyAssignmentWithCont
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_policy_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3017,7 +3017,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetSettingsWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetSettings"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/accounts/{account_id}/settings/access_management`, pathParamsMap)
 	if err != nil {
@@ -3048,7 +3048,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetSettingsWithContext(ctx con
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3115,7 +3115,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdateSettingsWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "UpdateSettings"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/accounts/{account_id}/settings/access_management`, pathParamsMap)
 	if err != nil {
@@ -3160,7 +3160,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdateSettingsWithContext(ctx
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3202,7 +3202,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListActionControlTemplatesWith
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListActionControlTemplates"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates`, nil)
 	if err != nil {
@@ -3241,7 +3241,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListActionControlTemplatesWith
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_action_control_templates", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3278,7 +3278,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateActionControlTemplateWit
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreateActionControlTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates`, nil)
 	if err != nil {
@@ -3332,7 +3332,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateActionControlTemplateWit
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_action_control_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3373,7 +3373,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetActionControlTemplateWithCo
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetActionControlTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates/{action_control_template_id}`, pathParamsMap)
 	if err != nil {
@@ -3405,7 +3405,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetActionControlTemplateWithCo
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_action_control_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3448,7 +3448,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteActionControlTemplateWit
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeleteActionControlTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates/{action_control_template_id}`, pathParamsMap)
 	if err != nil {
@@ -3474,7 +3474,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteActionControlTemplateWit
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_action_control_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3508,7 +3508,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateActionControlTemplateVer
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreateActionControlTemplateVersion"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates/{action_control_template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -3556,7 +3556,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateActionControlTemplateVer
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_action_control_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3597,7 +3597,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListActionControlTemplateVersi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListActionControlTemplateVersions"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates/{action_control_template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -3635,7 +3635,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListActionControlTemplateVersi
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_action_control_template_versions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3677,7 +3677,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceActionControlTemplateWi
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ReplaceActionControlTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates/{action_control_template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -3728,7 +3728,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceActionControlTemplateWi
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_action_control_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3772,7 +3772,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteActionControlTemplateVer
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeleteActionControlTemplateVersion"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates/{action_control_template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -3798,7 +3798,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteActionControlTemplateVer
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_action_control_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3832,7 +3832,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetActionControlTemplateVersio
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetActionControlTemplateVersion"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates/{action_control_template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -3860,7 +3860,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetActionControlTemplateVersio
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_action_control_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3903,7 +3903,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CommitActionControlTemplateWit
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CommitActionControlTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_templates/{action_control_template_id}/versions/{version}/commit`, pathParamsMap)
 	if err != nil {
@@ -3929,7 +3929,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CommitActionControlTemplateWit
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_action_control_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3962,7 +3962,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListActionControlAssignmentsWi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListActionControlAssignments"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_assignments`, nil)
 	if err != nil {
@@ -4007,7 +4007,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListActionControlAssignmentsWi
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_action_control_assignments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4045,7 +4045,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateActionControlTemplateAss
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreateActionControlTemplateAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_assignments`, nil)
 	if err != nil {
@@ -4090,7 +4090,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateActionControlTemplateAss
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_action_control_template_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4131,7 +4131,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetActionControlAssignmentWith
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetActionControlAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -4159,7 +4159,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetActionControlAssignmentWith
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_action_control_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4200,7 +4200,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdateActionControlAssignmentW
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "UpdateActionControlAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -4242,7 +4242,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdateActionControlAssignmentW
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_action_control_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4284,7 +4284,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteActionControlAssignmentW
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeleteActionControlAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/action_control_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -4310,7 +4310,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteActionControlAssignmentW
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_action_control_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4343,7 +4343,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListRoleTemplatesWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListRoleTemplates"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates`, nil)
 	if err != nil {
@@ -4394,7 +4394,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListRoleTemplatesWithContext(c
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_role_templates", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4431,7 +4431,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleTemplateWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreateRoleTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates`, nil)
 	if err != nil {
@@ -4485,7 +4485,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleTemplateWithContext(
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_role_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4526,7 +4526,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetRoleTemplateWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetRoleTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates/{role_template_id}`, pathParamsMap)
 	if err != nil {
@@ -4558,7 +4558,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetRoleTemplateWithContext(ctx
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_role_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4601,7 +4601,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleTemplateWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeleteRoleTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates/{role_template_id}`, pathParamsMap)
 	if err != nil {
@@ -4627,7 +4627,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleTemplateWithContext(
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_role_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4660,7 +4660,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleTemplateVersionWithC
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreateRoleTemplateVersion"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates/{role_template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -4708,7 +4708,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleTemplateVersionWithC
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_role_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4749,7 +4749,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListRoleTemplateVersionsWithCo
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListRoleTemplateVersions"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates/{role_template_id}/versions`, pathParamsMap)
 	if err != nil {
@@ -4787,7 +4787,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListRoleTemplateVersionsWithCo
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_role_template_versions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4829,7 +4829,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceRoleTemplateWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ReplaceRoleTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates/{role_template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -4880,7 +4880,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceRoleTemplateWithContext
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_role_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4923,7 +4923,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleTemplateVersionWithC
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeleteRoleTemplateVersion"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates/{role_template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -4949,7 +4949,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleTemplateVersionWithC
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_role_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4983,7 +4983,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetRoleTemplateVersionWithCont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetRoleTemplateVersion"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates/{role_template_id}/versions/{version}`, pathParamsMap)
 	if err != nil {
@@ -5011,7 +5011,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetRoleTemplateVersionWithCont
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_role_template_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5054,7 +5054,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CommitRoleTemplateWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CommitRoleTemplate"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_templates/{role_template_id}/versions/{version}/commit`, pathParamsMap)
 	if err != nil {
@@ -5080,7 +5080,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CommitRoleTemplateWithContext(
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_role_template", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5113,7 +5113,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListRoleAssignmentsWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "ListRoleAssignments"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_assignments`, nil)
 	if err != nil {
@@ -5158,7 +5158,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListRoleAssignmentsWithContext
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_role_assignments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5196,7 +5196,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleTemplateAssignmentWi
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "CreateRoleTemplateAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_assignments`, nil)
 	if err != nil {
@@ -5241,7 +5241,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleTemplateAssignmentWi
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_role_template_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5282,7 +5282,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetRoleAssignmentWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "GetRoleAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -5310,7 +5310,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetRoleAssignmentWithContext(c
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_role_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5351,7 +5351,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdateRoleAssignmentWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "UpdateRoleAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -5393,7 +5393,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdateRoleAssignmentWithContex
 	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_role_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5435,7 +5435,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleAssignmentWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "iam_policy_management", "V1", "DeleteRoleAssignment"))
 	builder.EnableGzipCompression = iamPolicyManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(iamPolicyManagement.Service.Options.URL, `/v1/role_assignments/{assignment_id}`, pathParamsMap)
 	if err != nil {
@@ -5461,7 +5461,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleAssignmentWithContex
 	response, err = iamPolicyManagement.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_role_assignment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
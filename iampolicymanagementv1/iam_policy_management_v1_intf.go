@@ -0,0 +1,284 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package iampolicymanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// IamPolicyManagementV1Intf : The operations of the IamPolicyManagementV1 service client.
+// The interface is satisfied by *IamPolicyManagementV1 and by mocks.IamPolicyManagementV1, allowing consumers to substitute a mock for the client in tests.
+type IamPolicyManagementV1Intf interface {
+	// ListPolicies : Get policies by attributes
+	ListPolicies(listPoliciesOptions *ListPoliciesOptions) (result *PolicyCollection, response *core.DetailedResponse, err error)
+	// ListPoliciesWithContext is an alternate form of the ListPolicies method which supports a Context parameter
+	ListPoliciesWithContext(ctx context.Context, listPoliciesOptions *ListPoliciesOptions) (result *PolicyCollection, response *core.DetailedResponse, err error)
+	// CreatePolicy : Create a policy
+	CreatePolicy(createPolicyOptions *CreatePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+	// CreatePolicyWithContext is an alternate form of the CreatePolicy method which supports a Context parameter
+	CreatePolicyWithContext(ctx context.Context, createPolicyOptions *CreatePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+	// ReplacePolicy : Update a policy
+	ReplacePolicy(replacePolicyOptions *ReplacePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+	// ReplacePolicyWithContext is an alternate form of the ReplacePolicy method which supports a Context parameter
+	ReplacePolicyWithContext(ctx context.Context, replacePolicyOptions *ReplacePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+	// GetPolicy : Retrieve a policy by ID
+	GetPolicy(getPolicyOptions *GetPolicyOptions) (result *PolicyTemplateMetaData, response *core.DetailedResponse, err error)
+	// GetPolicyWithContext is an alternate form of the GetPolicy method which supports a Context parameter
+	GetPolicyWithContext(ctx context.Context, getPolicyOptions *GetPolicyOptions) (result *PolicyTemplateMetaData, response *core.DetailedResponse, err error)
+	// DeletePolicy : Delete a policy by ID
+	DeletePolicy(deletePolicyOptions *DeletePolicyOptions) (response *core.DetailedResponse, err error)
+	// DeletePolicyWithContext is an alternate form of the DeletePolicy method which supports a Context parameter
+	DeletePolicyWithContext(ctx context.Context, deletePolicyOptions *DeletePolicyOptions) (response *core.DetailedResponse, err error)
+	// UpdatePolicyState : Restore a deleted policy by ID
+	UpdatePolicyState(updatePolicyStateOptions *UpdatePolicyStateOptions) (result *Policy, response *core.DetailedResponse, err error)
+	// UpdatePolicyStateWithContext is an alternate form of the UpdatePolicyState method which supports a Context parameter
+	UpdatePolicyStateWithContext(ctx context.Context, updatePolicyStateOptions *UpdatePolicyStateOptions) (result *Policy, response *core.DetailedResponse, err error)
+	// ListRoles : Get roles by filters
+	ListRoles(listRolesOptions *ListRolesOptions) (result *RoleCollection, response *core.DetailedResponse, err error)
+	// ListRolesWithContext is an alternate form of the ListRoles method which supports a Context parameter
+	ListRolesWithContext(ctx context.Context, listRolesOptions *ListRolesOptions) (result *RoleCollection, response *core.DetailedResponse, err error)
+	// CreateRole : Create a role
+	CreateRole(createRoleOptions *CreateRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+	// CreateRoleWithContext is an alternate form of the CreateRole method which supports a Context parameter
+	CreateRoleWithContext(ctx context.Context, createRoleOptions *CreateRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+	// ReplaceRole : Update a role
+	ReplaceRole(replaceRoleOptions *ReplaceRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+	// ReplaceRoleWithContext is an alternate form of the ReplaceRole method which supports a Context parameter
+	ReplaceRoleWithContext(ctx context.Context, replaceRoleOptions *ReplaceRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+	// GetRole : Retrieve a role by ID
+	GetRole(getRoleOptions *GetRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+	// GetRoleWithContext is an alternate form of the GetRole method which supports a Context parameter
+	GetRoleWithContext(ctx context.Context, getRoleOptions *GetRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+	// DeleteRole : Delete a role by ID
+	DeleteRole(deleteRoleOptions *DeleteRoleOptions) (response *core.DetailedResponse, err error)
+	// DeleteRoleWithContext is an alternate form of the DeleteRole method which supports a Context parameter
+	DeleteRoleWithContext(ctx context.Context, deleteRoleOptions *DeleteRoleOptions) (response *core.DetailedResponse, err error)
+	// ListV2Policies : Get policies by attributes
+	ListV2Policies(listV2PoliciesOptions *ListV2PoliciesOptions) (result *V2PolicyCollection, response *core.DetailedResponse, err error)
+	// ListV2PoliciesWithContext is an alternate form of the ListV2Policies method which supports a Context parameter
+	ListV2PoliciesWithContext(ctx context.Context, listV2PoliciesOptions *ListV2PoliciesOptions) (result *V2PolicyCollection, response *core.DetailedResponse, err error)
+	// CreateV2Policy : Create a policy
+	CreateV2Policy(createV2PolicyOptions *CreateV2PolicyOptions) (result *V2Policy, response *core.DetailedResponse, err error)
+	// CreateV2PolicyWithContext is an alternate form of the CreateV2Policy method which supports a Context parameter
+	CreateV2PolicyWithContext(ctx context.Context, createV2PolicyOptions *CreateV2PolicyOptions) (result *V2Policy, response *core.DetailedResponse, err error)
+	// ReplaceV2Policy : Update a policy
+	ReplaceV2Policy(replaceV2PolicyOptions *ReplaceV2PolicyOptions) (result *V2Policy, response *core.DetailedResponse, err error)
+	// ReplaceV2PolicyWithContext is an alternate form of the ReplaceV2Policy method which supports a Context parameter
+	ReplaceV2PolicyWithContext(ctx context.Context, replaceV2PolicyOptions *ReplaceV2PolicyOptions) (result *V2Policy, response *core.DetailedResponse, err error)
+	// GetV2Policy : Retrieve a policy by ID
+	GetV2Policy(getV2PolicyOptions *GetV2PolicyOptions) (result *V2PolicyTemplateMetaData, response *core.DetailedResponse, err error)
+	// GetV2PolicyWithContext is an alternate form of the GetV2Policy method which supports a Context parameter
+	GetV2PolicyWithContext(ctx context.Context, getV2PolicyOptions *GetV2PolicyOptions) (result *V2PolicyTemplateMetaData, response *core.DetailedResponse, err error)
+	// DeleteV2Policy : Delete a policy by ID
+	DeleteV2Policy(deleteV2PolicyOptions *DeleteV2PolicyOptions) (response *core.DetailedResponse, err error)
+	// DeleteV2PolicyWithContext is an alternate form of the DeleteV2Policy method which supports a Context parameter
+	DeleteV2PolicyWithContext(ctx context.Context, deleteV2PolicyOptions *DeleteV2PolicyOptions) (response *core.DetailedResponse, err error)
+	// ListPolicyTemplates : List policy templates by attributes
+	ListPolicyTemplates(listPolicyTemplatesOptions *ListPolicyTemplatesOptions) (result *PolicyTemplateCollection, response *core.DetailedResponse, err error)
+	// ListPolicyTemplatesWithContext is an alternate form of the ListPolicyTemplates method which supports a Context parameter
+	ListPolicyTemplatesWithContext(ctx context.Context, listPolicyTemplatesOptions *ListPolicyTemplatesOptions) (result *PolicyTemplateCollection, response *core.DetailedResponse, err error)
+	// CreatePolicyTemplate : Create a policy template
+	CreatePolicyTemplate(createPolicyTemplateOptions *CreatePolicyTemplateOptions) (result *PolicyTemplateLimitData, response *core.DetailedResponse, err error)
+	// CreatePolicyTemplateWithContext is an alternate form of the CreatePolicyTemplate method which supports a Context parameter
+	CreatePolicyTemplateWithContext(ctx context.Context, createPolicyTemplateOptions *CreatePolicyTemplateOptions) (result *PolicyTemplateLimitData, response *core.DetailedResponse, err error)
+	// GetPolicyTemplate : Retrieve latest version of a policy template
+	GetPolicyTemplate(getPolicyTemplateOptions *GetPolicyTemplateOptions) (result *PolicyTemplate, response *core.DetailedResponse, err error)
+	// GetPolicyTemplateWithContext is an alternate form of the GetPolicyTemplate method which supports a Context parameter
+	GetPolicyTemplateWithContext(ctx context.Context, getPolicyTemplateOptions *GetPolicyTemplateOptions) (result *PolicyTemplate, response *core.DetailedResponse, err error)
+	// DeletePolicyTemplate : Delete a policy template
+	DeletePolicyTemplate(deletePolicyTemplateOptions *DeletePolicyTemplateOptions) (response *core.DetailedResponse, err error)
+	// DeletePolicyTemplateWithContext is an alternate form of the DeletePolicyTemplate method which supports a Context parameter
+	DeletePolicyTemplateWithContext(ctx context.Context, deletePolicyTemplateOptions *DeletePolicyTemplateOptions) (response *core.DetailedResponse, err error)
+	// CreatePolicyTemplateVersion : Create a new policy template version
+	CreatePolicyTemplateVersion(createPolicyTemplateVersionOptions *CreatePolicyTemplateVersionOptions) (result *PolicyTemplateLimitData, response *core.DetailedResponse, err error)
+	// CreatePolicyTemplateVersionWithContext is an alternate form of the CreatePolicyTemplateVersion method which supports a Context parameter
+	CreatePolicyTemplateVersionWithContext(ctx context.Context, createPolicyTemplateVersionOptions *CreatePolicyTemplateVersionOptions) (result *PolicyTemplateLimitData, response *core.DetailedResponse, err error)
+	// ListPolicyTemplateVersions : Retrieve policy template versions
+	ListPolicyTemplateVersions(listPolicyTemplateVersionsOptions *ListPolicyTemplateVersionsOptions) (result *PolicyTemplateVersionsCollection, response *core.DetailedResponse, err error)
+	// ListPolicyTemplateVersionsWithContext is an alternate form of the ListPolicyTemplateVersions method which supports a Context parameter
+	ListPolicyTemplateVersionsWithContext(ctx context.Context, listPolicyTemplateVersionsOptions *ListPolicyTemplateVersionsOptions) (result *PolicyTemplateVersionsCollection, response *core.DetailedResponse, err error)
+	// ReplacePolicyTemplate : Update a policy template version
+	ReplacePolicyTemplate(replacePolicyTemplateOptions *ReplacePolicyTemplateOptions) (result *PolicyTemplate, response *core.DetailedResponse, err error)
+	// ReplacePolicyTemplateWithContext is an alternate form of the ReplacePolicyTemplate method which supports a Context parameter
+	ReplacePolicyTemplateWithContext(ctx context.Context, replacePolicyTemplateOptions *ReplacePolicyTemplateOptions) (result *PolicyTemplate, response *core.DetailedResponse, err error)
+	// DeletePolicyTemplateVersion : Delete a policy template version
+	DeletePolicyTemplateVersion(deletePolicyTemplateVersionOptions *DeletePolicyTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// DeletePolicyTemplateVersionWithContext is an alternate form of the DeletePolicyTemplateVersion method which supports a Context parameter
+	DeletePolicyTemplateVersionWithContext(ctx context.Context, deletePolicyTemplateVersionOptions *DeletePolicyTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// GetPolicyTemplateVersion : Retrieve a policy template version
+	GetPolicyTemplateVersion(getPolicyTemplateVersionOptions *GetPolicyTemplateVersionOptions) (result *PolicyTemplate, response *core.DetailedResponse, err error)
+	// GetPolicyTemplateVersionWithContext is an alternate form of the GetPolicyTemplateVersion method which supports a Context parameter
+	GetPolicyTemplateVersionWithContext(ctx context.Context, getPolicyTemplateVersionOptions *GetPolicyTemplateVersionOptions) (result *PolicyTemplate, response *core.DetailedResponse, err error)
+	// CommitPolicyTemplate : Commit a policy template version
+	CommitPolicyTemplate(commitPolicyTemplateOptions *CommitPolicyTemplateOptions) (response *core.DetailedResponse, err error)
+	// CommitPolicyTemplateWithContext is an alternate form of the CommitPolicyTemplate method which supports a Context parameter
+	CommitPolicyTemplateWithContext(ctx context.Context, commitPolicyTemplateOptions *CommitPolicyTemplateOptions) (response *core.DetailedResponse, err error)
+	// ListPolicyAssignments : Get policy template assignments
+	ListPolicyAssignments(listPolicyAssignmentsOptions *ListPolicyAssignmentsOptions) (result *PolicyTemplateAssignmentCollection, response *core.DetailedResponse, err error)
+	// ListPolicyAssignmentsWithContext is an alternate form of the ListPolicyAssignments method which supports a Context parameter
+	ListPolicyAssignmentsWithContext(ctx context.Context, listPolicyAssignmentsOptions *ListPolicyAssignmentsOptions) (result *PolicyTemplateAssignmentCollection, response *core.DetailedResponse, err error)
+	// CreatePolicyTemplateAssignment : Create a policy authorization template assignment
+	CreatePolicyTemplateAssignment(createPolicyTemplateAssignmentOptions *CreatePolicyTemplateAssignmentOptions) (result *PolicyAssignmentV1Collection, response *core.DetailedResponse, err error)
+	// CreatePolicyTemplateAssignmentWithContext is an alternate form of the CreatePolicyTemplateAssignment method which supports a Context parameter
+	CreatePolicyTemplateAssignmentWithContext(ctx context.Context, createPolicyTemplateAssignmentOptions *CreatePolicyTemplateAssignmentOptions) (result *PolicyAssignmentV1Collection, response *core.DetailedResponse, err error)
+	// GetPolicyAssignment : Retrieve a policy assignment
+	GetPolicyAssignment(getPolicyAssignmentOptions *GetPolicyAssignmentOptions) (result PolicyTemplateAssignmentItemsIntf, response *core.DetailedResponse, err error)
+	// GetPolicyAssignmentWithContext is an alternate form of the GetPolicyAssignment method which supports a Context parameter
+	GetPolicyAssignmentWithContext(ctx context.Context, getPolicyAssignmentOptions *GetPolicyAssignmentOptions) (result PolicyTemplateAssignmentItemsIntf, response *core.DetailedResponse, err error)
+	// UpdatePolicyAssignment : Update a policy authorization type assignment
+	UpdatePolicyAssignment(updatePolicyAssignmentOptions *UpdatePolicyAssignmentOptions) (result *PolicyAssignmentV1, response *core.DetailedResponse, err error)
+	// UpdatePolicyAssignmentWithContext is an alternate form of the UpdatePolicyAssignment method which supports a Context parameter
+	UpdatePolicyAssignmentWithContext(ctx context.Context, updatePolicyAssignmentOptions *UpdatePolicyAssignmentOptions) (result *PolicyAssignmentV1, response *core.DetailedResponse, err error)
+	// DeletePolicyAssignment : Remove a policy assignment
+	DeletePolicyAssignment(deletePolicyAssignmentOptions *DeletePolicyAssignmentOptions) (response *core.DetailedResponse, err error)
+	// DeletePolicyAssignmentWithContext is an alternate form of the DeletePolicyAssignment method which supports a Context parameter
+	DeletePolicyAssignmentWithContext(ctx context.Context, deletePolicyAssignmentOptions *DeletePolicyAssignmentOptions) (response *core.DetailedResponse, err error)
+	// GetSettings : Retrieve Access Management account settings by account ID
+	GetSettings(getSettingsOptions *GetSettingsOptions) (result *AccountSettingsAccessManagement, response *core.DetailedResponse, err error)
+	// GetSettingsWithContext is an alternate form of the GetSettings method which supports a Context parameter
+	GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *AccountSettingsAccessManagement, response *core.DetailedResponse, err error)
+	// UpdateSettings : Update Access Management account settings by account ID
+	UpdateSettings(updateSettingsOptions *UpdateSettingsOptions) (result *AccountSettingsAccessManagement, response *core.DetailedResponse, err error)
+	// UpdateSettingsWithContext is an alternate form of the UpdateSettings method which supports a Context parameter
+	UpdateSettingsWithContext(ctx context.Context, updateSettingsOptions *UpdateSettingsOptions) (result *AccountSettingsAccessManagement, response *core.DetailedResponse, err error)
+	// ListActionControlTemplates : List action control templates by attributes
+	ListActionControlTemplates(listActionControlTemplatesOptions *ListActionControlTemplatesOptions) (result *ActionControlTemplateCollection, response *core.DetailedResponse, err error)
+	// ListActionControlTemplatesWithContext is an alternate form of the ListActionControlTemplates method which supports a Context parameter
+	ListActionControlTemplatesWithContext(ctx context.Context, listActionControlTemplatesOptions *ListActionControlTemplatesOptions) (result *ActionControlTemplateCollection, response *core.DetailedResponse, err error)
+	// CreateActionControlTemplate : Create an action control template
+	CreateActionControlTemplate(createActionControlTemplateOptions *CreateActionControlTemplateOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// CreateActionControlTemplateWithContext is an alternate form of the CreateActionControlTemplate method which supports a Context parameter
+	CreateActionControlTemplateWithContext(ctx context.Context, createActionControlTemplateOptions *CreateActionControlTemplateOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// GetActionControlTemplate : Retrieve the latest version of an action control template
+	GetActionControlTemplate(getActionControlTemplateOptions *GetActionControlTemplateOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// GetActionControlTemplateWithContext is an alternate form of the GetActionControlTemplate method which supports a Context parameter
+	GetActionControlTemplateWithContext(ctx context.Context, getActionControlTemplateOptions *GetActionControlTemplateOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// DeleteActionControlTemplate : Delete an action control template
+	DeleteActionControlTemplate(deleteActionControlTemplateOptions *DeleteActionControlTemplateOptions) (response *core.DetailedResponse, err error)
+	// DeleteActionControlTemplateWithContext is an alternate form of the DeleteActionControlTemplate method which supports a Context parameter
+	DeleteActionControlTemplateWithContext(ctx context.Context, deleteActionControlTemplateOptions *DeleteActionControlTemplateOptions) (response *core.DetailedResponse, err error)
+	// CreateActionControlTemplateVersion : Create a new action control template version
+	CreateActionControlTemplateVersion(createActionControlTemplateVersionOptions *CreateActionControlTemplateVersionOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// CreateActionControlTemplateVersionWithContext is an alternate form of the CreateActionControlTemplateVersion method which supports a Context parameter
+	CreateActionControlTemplateVersionWithContext(ctx context.Context, createActionControlTemplateVersionOptions *CreateActionControlTemplateVersionOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// ListActionControlTemplateVersions : Retrieve action control template versions
+	ListActionControlTemplateVersions(listActionControlTemplateVersionsOptions *ListActionControlTemplateVersionsOptions) (result *ActionControlTemplateVersionsCollection, response *core.DetailedResponse, err error)
+	// ListActionControlTemplateVersionsWithContext is an alternate form of the ListActionControlTemplateVersions method which supports a Context parameter
+	ListActionControlTemplateVersionsWithContext(ctx context.Context, listActionControlTemplateVersionsOptions *ListActionControlTemplateVersionsOptions) (result *ActionControlTemplateVersionsCollection, response *core.DetailedResponse, err error)
+	// ReplaceActionControlTemplate : Update an action control template version
+	ReplaceActionControlTemplate(replaceActionControlTemplateOptions *ReplaceActionControlTemplateOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// ReplaceActionControlTemplateWithContext is an alternate form of the ReplaceActionControlTemplate method which supports a Context parameter
+	ReplaceActionControlTemplateWithContext(ctx context.Context, replaceActionControlTemplateOptions *ReplaceActionControlTemplateOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// DeleteActionControlTemplateVersion : Delete an action control template version
+	DeleteActionControlTemplateVersion(deleteActionControlTemplateVersionOptions *DeleteActionControlTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// DeleteActionControlTemplateVersionWithContext is an alternate form of the DeleteActionControlTemplateVersion method which supports a Context parameter
+	DeleteActionControlTemplateVersionWithContext(ctx context.Context, deleteActionControlTemplateVersionOptions *DeleteActionControlTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// GetActionControlTemplateVersion : Retrieve an action control template version
+	GetActionControlTemplateVersion(getActionControlTemplateVersionOptions *GetActionControlTemplateVersionOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// GetActionControlTemplateVersionWithContext is an alternate form of the GetActionControlTemplateVersion method which supports a Context parameter
+	GetActionControlTemplateVersionWithContext(ctx context.Context, getActionControlTemplateVersionOptions *GetActionControlTemplateVersionOptions) (result *ActionControlTemplate, response *core.DetailedResponse, err error)
+	// CommitActionControlTemplate : Commit an action control template version
+	CommitActionControlTemplate(commitActionControlTemplateOptions *CommitActionControlTemplateOptions) (response *core.DetailedResponse, err error)
+	// CommitActionControlTemplateWithContext is an alternate form of the CommitActionControlTemplate method which supports a Context parameter
+	CommitActionControlTemplateWithContext(ctx context.Context, commitActionControlTemplateOptions *CommitActionControlTemplateOptions) (response *core.DetailedResponse, err error)
+	// ListActionControlAssignments : Get action control template assignments
+	ListActionControlAssignments(listActionControlAssignmentsOptions *ListActionControlAssignmentsOptions) (result *ActionControlAssignmentCollection, response *core.DetailedResponse, err error)
+	// ListActionControlAssignmentsWithContext is an alternate form of the ListActionControlAssignments method which supports a Context parameter
+	ListActionControlAssignmentsWithContext(ctx context.Context, listActionControlAssignmentsOptions *ListActionControlAssignmentsOptions) (result *ActionControlAssignmentCollection, response *core.DetailedResponse, err error)
+	// CreateActionControlTemplateAssignment : Create an action control template assignment
+	CreateActionControlTemplateAssignment(createActionControlTemplateAssignmentOptions *CreateActionControlTemplateAssignmentOptions) (result *ActionControlAssignmentCollection, response *core.DetailedResponse, err error)
+	// CreateActionControlTemplateAssignmentWithContext is an alternate form of the CreateActionControlTemplateAssignment method which supports a Context parameter
+	CreateActionControlTemplateAssignmentWithContext(ctx context.Context, createActionControlTemplateAssignmentOptions *CreateActionControlTemplateAssignmentOptions) (result *ActionControlAssignmentCollection, response *core.DetailedResponse, err error)
+	// GetActionControlAssignment : Retrieve an action control assignment
+	GetActionControlAssignment(getActionControlAssignmentOptions *GetActionControlAssignmentOptions) (result *ActionControlAssignment, response *core.DetailedResponse, err error)
+	// GetActionControlAssignmentWithContext is an alternate form of the GetActionControlAssignment method which supports a Context parameter
+	GetActionControlAssignmentWithContext(ctx context.Context, getActionControlAssignmentOptions *GetActionControlAssignmentOptions) (result *ActionControlAssignment, response *core.DetailedResponse, err error)
+	// UpdateActionControlAssignment : Update an action control assignment
+	UpdateActionControlAssignment(updateActionControlAssignmentOptions *UpdateActionControlAssignmentOptions) (result *ActionControlAssignment, response *core.DetailedResponse, err error)
+	// UpdateActionControlAssignmentWithContext is an alternate form of the UpdateActionControlAssignment method which supports a Context parameter
+	UpdateActionControlAssignmentWithContext(ctx context.Context, updateActionControlAssignmentOptions *UpdateActionControlAssignmentOptions) (result *ActionControlAssignment, response *core.DetailedResponse, err error)
+	// DeleteActionControlAssignment : Remove an action control assignment
+	DeleteActionControlAssignment(deleteActionControlAssignmentOptions *DeleteActionControlAssignmentOptions) (response *core.DetailedResponse, err error)
+	// DeleteActionControlAssignmentWithContext is an alternate form of the DeleteActionControlAssignment method which supports a Context parameter
+	DeleteActionControlAssignmentWithContext(ctx context.Context, deleteActionControlAssignmentOptions *DeleteActionControlAssignmentOptions) (response *core.DetailedResponse, err error)
+	// ListRoleTemplates : List role templates by attributes
+	ListRoleTemplates(listRoleTemplatesOptions *ListRoleTemplatesOptions) (result *RoleTemplateCollection, response *core.DetailedResponse, err error)
+	// ListRoleTemplatesWithContext is an alternate form of the ListRoleTemplates method which supports a Context parameter
+	ListRoleTemplatesWithContext(ctx context.Context, listRoleTemplatesOptions *ListRoleTemplatesOptions) (result *RoleTemplateCollection, response *core.DetailedResponse, err error)
+	// CreateRoleTemplate : Create role template
+	CreateRoleTemplate(createRoleTemplateOptions *CreateRoleTemplateOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// CreateRoleTemplateWithContext is an alternate form of the CreateRoleTemplate method which supports a Context parameter
+	CreateRoleTemplateWithContext(ctx context.Context, createRoleTemplateOptions *CreateRoleTemplateOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// GetRoleTemplate : Retrieve the latest version of a role template
+	GetRoleTemplate(getRoleTemplateOptions *GetRoleTemplateOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// GetRoleTemplateWithContext is an alternate form of the GetRoleTemplate method which supports a Context parameter
+	GetRoleTemplateWithContext(ctx context.Context, getRoleTemplateOptions *GetRoleTemplateOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// DeleteRoleTemplate : Delete a Role template
+	DeleteRoleTemplate(deleteRoleTemplateOptions *DeleteRoleTemplateOptions) (response *core.DetailedResponse, err error)
+	// DeleteRoleTemplateWithContext is an alternate form of the DeleteRoleTemplate method which supports a Context parameter
+	DeleteRoleTemplateWithContext(ctx context.Context, deleteRoleTemplateOptions *DeleteRoleTemplateOptions) (response *core.DetailedResponse, err error)
+	// CreateRoleTemplateVersion : Create a new role template version
+	CreateRoleTemplateVersion(createRoleTemplateVersionOptions *CreateRoleTemplateVersionOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// CreateRoleTemplateVersionWithContext is an alternate form of the CreateRoleTemplateVersion method which supports a Context parameter
+	CreateRoleTemplateVersionWithContext(ctx context.Context, createRoleTemplateVersionOptions *CreateRoleTemplateVersionOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// ListRoleTemplateVersions : Retrieve role template versions
+	ListRoleTemplateVersions(listRoleTemplateVersionsOptions *ListRoleTemplateVersionsOptions) (result *RoleTemplateVersionsCollection, response *core.DetailedResponse, err error)
+	// ListRoleTemplateVersionsWithContext is an alternate form of the ListRoleTemplateVersions method which supports a Context parameter
+	ListRoleTemplateVersionsWithContext(ctx context.Context, listRoleTemplateVersionsOptions *ListRoleTemplateVersionsOptions) (result *RoleTemplateVersionsCollection, response *core.DetailedResponse, err error)
+	// ReplaceRoleTemplate : Update a role template version
+	ReplaceRoleTemplate(replaceRoleTemplateOptions *ReplaceRoleTemplateOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// ReplaceRoleTemplateWithContext is an alternate form of the ReplaceRoleTemplate method which supports a Context parameter
+	ReplaceRoleTemplateWithContext(ctx context.Context, replaceRoleTemplateOptions *ReplaceRoleTemplateOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// DeleteRoleTemplateVersion : Delete a role template version
+	DeleteRoleTemplateVersion(deleteRoleTemplateVersionOptions *DeleteRoleTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// DeleteRoleTemplateVersionWithContext is an alternate form of the DeleteRoleTemplateVersion method which supports a Context parameter
+	DeleteRoleTemplateVersionWithContext(ctx context.Context, deleteRoleTemplateVersionOptions *DeleteRoleTemplateVersionOptions) (response *core.DetailedResponse, err error)
+	// GetRoleTemplateVersion : Retrieve a role template version
+	GetRoleTemplateVersion(getRoleTemplateVersionOptions *GetRoleTemplateVersionOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// GetRoleTemplateVersionWithContext is an alternate form of the GetRoleTemplateVersion method which supports a Context parameter
+	GetRoleTemplateVersionWithContext(ctx context.Context, getRoleTemplateVersionOptions *GetRoleTemplateVersionOptions) (result *RoleTemplate, response *core.DetailedResponse, err error)
+	// CommitRoleTemplate : Commit a role template version
+	CommitRoleTemplate(commitRoleTemplateOptions *CommitRoleTemplateOptions) (response *core.DetailedResponse, err error)
+	// CommitRoleTemplateWithContext is an alternate form of the CommitRoleTemplate method which supports a Context parameter
+	CommitRoleTemplateWithContext(ctx context.Context, commitRoleTemplateOptions *CommitRoleTemplateOptions) (response *core.DetailedResponse, err error)
+	// ListRoleAssignments : Get role template assignments
+	ListRoleAssignments(listRoleAssignmentsOptions *ListRoleAssignmentsOptions) (result *RoleAssignmentCollection, response *core.DetailedResponse, err error)
+	// ListRoleAssignmentsWithContext is an alternate form of the ListRoleAssignments method which supports a Context parameter
+	ListRoleAssignmentsWithContext(ctx context.Context, listRoleAssignmentsOptions *ListRoleAssignmentsOptions) (result *RoleAssignmentCollection, response *core.DetailedResponse, err error)
+	// CreateRoleTemplateAssignment : Create a role template assignment
+	CreateRoleTemplateAssignment(createRoleTemplateAssignmentOptions *CreateRoleTemplateAssignmentOptions) (result *RoleAssignmentCollection, response *core.DetailedResponse, err error)
+	// CreateRoleTemplateAssignmentWithContext is an alternate form of the CreateRoleTemplateAssignment method which supports a Context parameter
+	CreateRoleTemplateAssignmentWithContext(ctx context.Context, createRoleTemplateAssignmentOptions *CreateRoleTemplateAssignmentOptions) (result *RoleAssignmentCollection, response *core.DetailedResponse, err error)
+	// GetRoleAssignment : Retrieve a role assignment
+	GetRoleAssignment(getRoleAssignmentOptions *GetRoleAssignmentOptions) (result *RoleAssignment, response *core.DetailedResponse, err error)
+	// GetRoleAssignmentWithContext is an alternate form of the GetRoleAssignment method which supports a Context parameter
+	GetRoleAssignmentWithContext(ctx context.Context, getRoleAssignmentOptions *GetRoleAssignmentOptions) (result *RoleAssignment, response *core.DetailedResponse, err error)
+	// UpdateRoleAssignment : Update a role assignment
+	UpdateRoleAssignment(updateRoleAssignmentOptions *UpdateRoleAssignmentOptions) (result *RoleAssignment, response *core.DetailedResponse, err error)
+	// UpdateRoleAssignmentWithContext is an alternate form of the UpdateRoleAssignment method which supports a Context parameter
+	UpdateRoleAssignmentWithContext(ctx context.Context, updateRoleAssignmentOptions *UpdateRoleAssignmentOptions) (result *RoleAssignment, response *core.DetailedResponse, err error)
+	// DeleteRoleAssignment : Remove a role assignment
+	DeleteRoleAssignment(deleteRoleAssignmentOptions *DeleteRoleAssignmentOptions) (response *core.DetailedResponse, err error)
+	// DeleteRoleAssignmentWithContext is an alternate form of the DeleteRoleAssignment method which supports a Context parameter
+	DeleteRoleAssignmentWithContext(ctx context.Context, deleteRoleAssignmentOptions *DeleteRoleAssignmentOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ IamPolicyManagementV1Intf = (*IamPolicyManagementV1)(nil)
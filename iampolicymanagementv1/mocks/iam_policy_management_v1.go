@@ -0,0 +1,1847 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the IamPolicyManagementV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// IamPolicyManagementV1 : A testify mock of the IamPolicyManagementV1 service client.
+type IamPolicyManagementV1 struct {
+	mock.Mock
+}
+
+var _ iampolicymanagementv1.IamPolicyManagementV1Intf = (*IamPolicyManagementV1)(nil)
+
+// ListPolicies provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListPolicies(listPoliciesOptions *iampolicymanagementv1.ListPoliciesOptions) (*iampolicymanagementv1.PolicyCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listPoliciesOptions)
+	var r0 *iampolicymanagementv1.PolicyCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListPoliciesWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListPoliciesWithContext(ctx context.Context, listPoliciesOptions *iampolicymanagementv1.ListPoliciesOptions) (*iampolicymanagementv1.PolicyCollection, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, listPoliciesOptions)
+	var r0 *iampolicymanagementv1.PolicyCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyCollection)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePolicy provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreatePolicy(createPolicyOptions *iampolicymanagementv1.CreatePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(createPolicyOptions)
+	var r0 *iampolicymanagementv1.Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.Policy)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePolicyWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreatePolicyWithContext(ctx context.Context, createPolicyOptions *iampolicymanagementv1.CreatePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createPolicyOptions)
+	var r0 *iampolicymanagementv1.Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.Policy)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplacePolicy provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplacePolicy(replacePolicyOptions *iampolicymanagementv1.ReplacePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(replacePolicyOptions)
+	var r0 *iampolicymanagementv1.Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.Policy)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplacePolicyWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplacePolicyWithContext(ctx context.Context, replacePolicyOptions *iampolicymanagementv1.ReplacePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replacePolicyOptions)
+	var r0 *iampolicymanagementv1.Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.Policy)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPolicy provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetPolicy(getPolicyOptions *iampolicymanagementv1.GetPolicyOptions) (*iampolicymanagementv1.PolicyTemplateMetaData, *core.DetailedResponse, error) {
+	ret := _m.Called(getPolicyOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateMetaData
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateMetaData)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPolicyWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetPolicyWithContext(ctx context.Context, getPolicyOptions *iampolicymanagementv1.GetPolicyOptions) (*iampolicymanagementv1.PolicyTemplateMetaData, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getPolicyOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateMetaData
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateMetaData)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeletePolicy provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeletePolicy(deletePolicyOptions *iampolicymanagementv1.DeletePolicyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deletePolicyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeletePolicyWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeletePolicyWithContext(ctx context.Context, deletePolicyOptions *iampolicymanagementv1.DeletePolicyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deletePolicyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdatePolicyState provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdatePolicyState(updatePolicyStateOptions *iampolicymanagementv1.UpdatePolicyStateOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(updatePolicyStateOptions)
+	var r0 *iampolicymanagementv1.Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.Policy)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdatePolicyStateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdatePolicyStateWithContext(ctx context.Context, updatePolicyStateOptions *iampolicymanagementv1.UpdatePolicyStateOptions) (*iampolicymanagementv1.Policy, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, updatePolicyStateOptions)
+	var r0 *iampolicymanagementv1.Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.Policy)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoles provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListRoles(listRolesOptions *iampolicymanagementv1.ListRolesOptions) (*iampolicymanagementv1.RoleCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listRolesOptions)
+	var r0 *iampolicymanagementv1.RoleCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRolesWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListRolesWithContext(ctx context.Context, listRolesOptions *iampolicymanagementv1.ListRolesOptions) (*iampolicymanagementv1.RoleCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listRolesOptions)
+	var r0 *iampolicymanagementv1.RoleCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRole provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateRole(createRoleOptions *iampolicymanagementv1.CreateRoleOptions) (*iampolicymanagementv1.CustomRole, *core.DetailedResponse, error) {
+	ret := _m.Called(createRoleOptions)
+	var r0 *iampolicymanagementv1.CustomRole
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.CustomRole)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRoleWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateRoleWithContext(ctx context.Context, createRoleOptions *iampolicymanagementv1.CreateRoleOptions) (*iampolicymanagementv1.CustomRole, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRoleOptions)
+	var r0 *iampolicymanagementv1.CustomRole
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.CustomRole)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceRole provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplaceRole(replaceRoleOptions *iampolicymanagementv1.ReplaceRoleOptions) (*iampolicymanagementv1.CustomRole, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceRoleOptions)
+	var r0 *iampolicymanagementv1.CustomRole
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.CustomRole)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceRoleWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplaceRoleWithContext(ctx context.Context, replaceRoleOptions *iampolicymanagementv1.ReplaceRoleOptions) (*iampolicymanagementv1.CustomRole, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceRoleOptions)
+	var r0 *iampolicymanagementv1.CustomRole
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.CustomRole)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRole provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetRole(getRoleOptions *iampolicymanagementv1.GetRoleOptions) (*iampolicymanagementv1.CustomRole, *core.DetailedResponse, error) {
+	ret := _m.Called(getRoleOptions)
+	var r0 *iampolicymanagementv1.CustomRole
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.CustomRole)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRoleWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetRoleWithContext(ctx context.Context, getRoleOptions *iampolicymanagementv1.GetRoleOptions) (*iampolicymanagementv1.CustomRole, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRoleOptions)
+	var r0 *iampolicymanagementv1.CustomRole
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.CustomRole)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteRole provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteRole(deleteRoleOptions *iampolicymanagementv1.DeleteRoleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRoleOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteRoleWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteRoleWithContext(ctx context.Context, deleteRoleOptions *iampolicymanagementv1.DeleteRoleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRoleOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListV2Policies provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListV2Policies(listV2PoliciesOptions *iampolicymanagementv1.ListV2PoliciesOptions) (*iampolicymanagementv1.V2PolicyCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listV2PoliciesOptions)
+	var r0 *iampolicymanagementv1.V2PolicyCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.V2PolicyCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListV2PoliciesWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListV2PoliciesWithContext(ctx context.Context, listV2PoliciesOptions *iampolicymanagementv1.ListV2PoliciesOptions) (*iampolicymanagementv1.V2PolicyCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listV2PoliciesOptions)
+	var r0 *iampolicymanagementv1.V2PolicyCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.V2PolicyCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateV2Policy provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateV2Policy(createV2PolicyOptions *iampolicymanagementv1.CreateV2PolicyOptions) (*iampolicymanagementv1.V2Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(createV2PolicyOptions)
+	var r0 *iampolicymanagementv1.V2Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.V2Policy)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateV2PolicyWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateV2PolicyWithContext(ctx context.Context, createV2PolicyOptions *iampolicymanagementv1.CreateV2PolicyOptions) (*iampolicymanagementv1.V2Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createV2PolicyOptions)
+	var r0 *iampolicymanagementv1.V2Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.V2Policy)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceV2Policy provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplaceV2Policy(replaceV2PolicyOptions *iampolicymanagementv1.ReplaceV2PolicyOptions) (*iampolicymanagementv1.V2Policy, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceV2PolicyOptions)
+	var r0 *iampolicymanagementv1.V2Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.V2Policy)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceV2PolicyWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplaceV2PolicyWithContext(ctx context.Context, replaceV2PolicyOptions *iampolicymanagementv1.ReplaceV2PolicyOptions) (*iampolicymanagementv1.V2Policy, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceV2PolicyOptions)
+	var r0 *iampolicymanagementv1.V2Policy
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.V2Policy)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetV2Policy provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetV2Policy(getV2PolicyOptions *iampolicymanagementv1.GetV2PolicyOptions) (*iampolicymanagementv1.V2PolicyTemplateMetaData, *core.DetailedResponse, error) {
+	ret := _m.Called(getV2PolicyOptions)
+	var r0 *iampolicymanagementv1.V2PolicyTemplateMetaData
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.V2PolicyTemplateMetaData)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetV2PolicyWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetV2PolicyWithContext(ctx context.Context, getV2PolicyOptions *iampolicymanagementv1.GetV2PolicyOptions) (*iampolicymanagementv1.V2PolicyTemplateMetaData, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getV2PolicyOptions)
+	var r0 *iampolicymanagementv1.V2PolicyTemplateMetaData
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.V2PolicyTemplateMetaData)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteV2Policy provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteV2Policy(deleteV2PolicyOptions *iampolicymanagementv1.DeleteV2PolicyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteV2PolicyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteV2PolicyWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteV2PolicyWithContext(ctx context.Context, deleteV2PolicyOptions *iampolicymanagementv1.DeleteV2PolicyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteV2PolicyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListPolicyTemplates provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListPolicyTemplates(listPolicyTemplatesOptions *iampolicymanagementv1.ListPolicyTemplatesOptions) (*iampolicymanagementv1.PolicyTemplateCollection, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(listPolicyTemplatesOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateCollection)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListPolicyTemplatesWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListPolicyTemplatesWithContext(ctx context.Context, listPolicyTemplatesOptions *iampolicymanagementv1.ListPolicyTemplatesOptions) (*iampolicymanagementv1.PolicyTemplateCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listPolicyTemplatesOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePolicyTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreatePolicyTemplate(createPolicyTemplateOptions *iampolicymanagementv1.CreatePolicyTemplateOptions) (*iampolicymanagementv1.PolicyTemplateLimitData, *core.DetailedResponse, error) {
+	ret := _m.Called(createPolicyTemplateOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateLimitData
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateLimitData)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePolicyTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreatePolicyTemplateWithContext(ctx context.Context, createPolicyTemplateOptions *iampolicymanagementv1.CreatePolicyTemplateOptions) (*iampolicymanagementv1.PolicyTemplateLimitData, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createPolicyTemplateOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateLimitData
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateLimitData)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPolicyTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetPolicyTemplate(getPolicyTemplateOptions *iampolicymanagementv1.GetPolicyTemplateOptions) (*iampolicymanagementv1.PolicyTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(getPolicyTemplateOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPolicyTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetPolicyTemplateWithContext(ctx context.Context, getPolicyTemplateOptions *iampolicymanagementv1.GetPolicyTemplateOptions) (*iampolicymanagementv1.PolicyTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPolicyTemplateOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeletePolicyTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeletePolicyTemplate(deletePolicyTemplateOptions *iampolicymanagementv1.DeletePolicyTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deletePolicyTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeletePolicyTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeletePolicyTemplateWithContext(ctx context.Context, deletePolicyTemplateOptions *iampolicymanagementv1.DeletePolicyTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deletePolicyTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreatePolicyTemplateVersion provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreatePolicyTemplateVersion(createPolicyTemplateVersionOptions *iampolicymanagementv1.CreatePolicyTemplateVersionOptions) (*iampolicymanagementv1.PolicyTemplateLimitData, *core.DetailedResponse, error) {
+	ret := _m.Called(createPolicyTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateLimitData
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateLimitData)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePolicyTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreatePolicyTemplateVersionWithContext(ctx context.Context, createPolicyTemplateVersionOptions *iampolicymanagementv1.CreatePolicyTemplateVersionOptions) (*iampolicymanagementv1.PolicyTemplateLimitData, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createPolicyTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateLimitData
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateLimitData)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListPolicyTemplateVersions provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListPolicyTemplateVersions(listPolicyTemplateVersionsOptions *iampolicymanagementv1.ListPolicyTemplateVersionsOptions) (*iampolicymanagementv1.PolicyTemplateVersionsCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listPolicyTemplateVersionsOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateVersionsCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateVersionsCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListPolicyTemplateVersionsWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListPolicyTemplateVersionsWithContext(ctx context.Context, listPolicyTemplateVersionsOptions *iampolicymanagementv1.ListPolicyTemplateVersionsOptions) (*iampolicymanagementv1.PolicyTemplateVersionsCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listPolicyTemplateVersionsOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateVersionsCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateVersionsCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplacePolicyTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplacePolicyTemplate(replacePolicyTemplateOptions *iampolicymanagementv1.ReplacePolicyTemplateOptions) (*iampolicymanagementv1.PolicyTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(replacePolicyTemplateOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplacePolicyTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplacePolicyTemplateWithContext(ctx context.Context, replacePolicyTemplateOptions *iampolicymanagementv1.ReplacePolicyTemplateOptions) (*iampolicymanagementv1.PolicyTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replacePolicyTemplateOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeletePolicyTemplateVersion provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeletePolicyTemplateVersion(deletePolicyTemplateVersionOptions *iampolicymanagementv1.DeletePolicyTemplateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deletePolicyTemplateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeletePolicyTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeletePolicyTemplateVersionWithContext(ctx context.Context, deletePolicyTemplateVersionOptions *iampolicymanagementv1.DeletePolicyTemplateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deletePolicyTemplateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetPolicyTemplateVersion provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetPolicyTemplateVersion(getPolicyTemplateVersionOptions *iampolicymanagementv1.GetPolicyTemplateVersionOptions) (*iampolicymanagementv1.PolicyTemplate, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getPolicyTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplate)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPolicyTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetPolicyTemplateVersionWithContext(ctx context.Context, getPolicyTemplateVersionOptions *iampolicymanagementv1.GetPolicyTemplateVersionOptions) (*iampolicymanagementv1.PolicyTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPolicyTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CommitPolicyTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CommitPolicyTemplate(commitPolicyTemplateOptions *iampolicymanagementv1.CommitPolicyTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(commitPolicyTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitPolicyTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CommitPolicyTemplateWithContext(ctx context.Context, commitPolicyTemplateOptions *iampolicymanagementv1.CommitPolicyTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, commitPolicyTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListPolicyAssignments provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListPolicyAssignments(listPolicyAssignmentsOptions *iampolicymanagementv1.ListPolicyAssignmentsOptions) (*iampolicymanagementv1.PolicyTemplateAssignmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listPolicyAssignmentsOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateAssignmentCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListPolicyAssignmentsWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListPolicyAssignmentsWithContext(ctx context.Context, listPolicyAssignmentsOptions *iampolicymanagementv1.ListPolicyAssignmentsOptions) (*iampolicymanagementv1.PolicyTemplateAssignmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listPolicyAssignmentsOptions)
+	var r0 *iampolicymanagementv1.PolicyTemplateAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyTemplateAssignmentCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePolicyTemplateAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreatePolicyTemplateAssignment(createPolicyTemplateAssignmentOptions *iampolicymanagementv1.CreatePolicyTemplateAssignmentOptions) (*iampolicymanagementv1.PolicyAssignmentV1Collection, *core.DetailedResponse, error) {
+	ret := _m.Called(createPolicyTemplateAssignmentOptions)
+	var r0 *iampolicymanagementv1.PolicyAssignmentV1Collection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyAssignmentV1Collection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePolicyTemplateAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreatePolicyTemplateAssignmentWithContext(ctx context.Context, createPolicyTemplateAssignmentOptions *iampolicymanagementv1.CreatePolicyTemplateAssignmentOptions) (*iampolicymanagementv1.PolicyAssignmentV1Collection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createPolicyTemplateAssignmentOptions)
+	var r0 *iampolicymanagementv1.PolicyAssignmentV1Collection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyAssignmentV1Collection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPolicyAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetPolicyAssignment(getPolicyAssignmentOptions *iampolicymanagementv1.GetPolicyAssignmentOptions) (iampolicymanagementv1.PolicyTemplateAssignmentItemsIntf, *core.DetailedResponse, error) {
+	ret := _m.Called(getPolicyAssignmentOptions)
+	var r0 iampolicymanagementv1.PolicyTemplateAssignmentItemsIntf
+	if v := ret.Get(0); v != nil {
+		r0 = v.(iampolicymanagementv1.PolicyTemplateAssignmentItemsIntf)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPolicyAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetPolicyAssignmentWithContext(ctx context.Context, getPolicyAssignmentOptions *iampolicymanagementv1.GetPolicyAssignmentOptions) (iampolicymanagementv1.PolicyTemplateAssignmentItemsIntf, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPolicyAssignmentOptions)
+	var r0 iampolicymanagementv1.PolicyTemplateAssignmentItemsIntf
+	if v := ret.Get(0); v != nil {
+		r0 = v.(iampolicymanagementv1.PolicyTemplateAssignmentItemsIntf)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdatePolicyAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdatePolicyAssignment(updatePolicyAssignmentOptions *iampolicymanagementv1.UpdatePolicyAssignmentOptions) (*iampolicymanagementv1.PolicyAssignmentV1, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(updatePolicyAssignmentOptions)
+	var r0 *iampolicymanagementv1.PolicyAssignmentV1
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyAssignmentV1)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdatePolicyAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdatePolicyAssignmentWithContext(ctx context.Context, updatePolicyAssignmentOptions *iampolicymanagementv1.UpdatePolicyAssignmentOptions) (*iampolicymanagementv1.PolicyAssignmentV1, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updatePolicyAssignmentOptions)
+	var r0 *iampolicymanagementv1.PolicyAssignmentV1
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.PolicyAssignmentV1)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeletePolicyAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeletePolicyAssignment(deletePolicyAssignmentOptions *iampolicymanagementv1.DeletePolicyAssignmentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deletePolicyAssignmentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeletePolicyAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeletePolicyAssignmentWithContext(ctx context.Context, deletePolicyAssignmentOptions *iampolicymanagementv1.DeletePolicyAssignmentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deletePolicyAssignmentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetSettings provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetSettings(getSettingsOptions *iampolicymanagementv1.GetSettingsOptions) (*iampolicymanagementv1.AccountSettingsAccessManagement, *core.DetailedResponse, error) {
+	ret := _m.Called(getSettingsOptions)
+	var r0 *iampolicymanagementv1.AccountSettingsAccessManagement
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.AccountSettingsAccessManagement)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetSettingsWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetSettingsWithContext(ctx context.Context, getSettingsOptions *iampolicymanagementv1.GetSettingsOptions) (*iampolicymanagementv1.AccountSettingsAccessManagement, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getSettingsOptions)
+	var r0 *iampolicymanagementv1.AccountSettingsAccessManagement
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.AccountSettingsAccessManagement)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateSettings provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdateSettings(updateSettingsOptions *iampolicymanagementv1.UpdateSettingsOptions) (*iampolicymanagementv1.AccountSettingsAccessManagement, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(updateSettingsOptions)
+	var r0 *iampolicymanagementv1.AccountSettingsAccessManagement
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.AccountSettingsAccessManagement)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateSettingsWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdateSettingsWithContext(ctx context.Context, updateSettingsOptions *iampolicymanagementv1.UpdateSettingsOptions) (*iampolicymanagementv1.AccountSettingsAccessManagement, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateSettingsOptions)
+	var r0 *iampolicymanagementv1.AccountSettingsAccessManagement
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.AccountSettingsAccessManagement)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListActionControlTemplates provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListActionControlTemplates(listActionControlTemplatesOptions *iampolicymanagementv1.ListActionControlTemplatesOptions) (*iampolicymanagementv1.ActionControlTemplateCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listActionControlTemplatesOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplateCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplateCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListActionControlTemplatesWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListActionControlTemplatesWithContext(ctx context.Context, listActionControlTemplatesOptions *iampolicymanagementv1.ListActionControlTemplatesOptions) (*iampolicymanagementv1.ActionControlTemplateCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listActionControlTemplatesOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplateCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplateCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateActionControlTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateActionControlTemplate(createActionControlTemplateOptions *iampolicymanagementv1.CreateActionControlTemplateOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(createActionControlTemplateOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateActionControlTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateActionControlTemplateWithContext(ctx context.Context, createActionControlTemplateOptions *iampolicymanagementv1.CreateActionControlTemplateOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createActionControlTemplateOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetActionControlTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetActionControlTemplate(getActionControlTemplateOptions *iampolicymanagementv1.GetActionControlTemplateOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(getActionControlTemplateOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetActionControlTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetActionControlTemplateWithContext(ctx context.Context, getActionControlTemplateOptions *iampolicymanagementv1.GetActionControlTemplateOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getActionControlTemplateOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteActionControlTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteActionControlTemplate(deleteActionControlTemplateOptions *iampolicymanagementv1.DeleteActionControlTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteActionControlTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteActionControlTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteActionControlTemplateWithContext(ctx context.Context, deleteActionControlTemplateOptions *iampolicymanagementv1.DeleteActionControlTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteActionControlTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateActionControlTemplateVersion provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateActionControlTemplateVersion(createActionControlTemplateVersionOptions *iampolicymanagementv1.CreateActionControlTemplateVersionOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(createActionControlTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateActionControlTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateActionControlTemplateVersionWithContext(ctx context.Context, createActionControlTemplateVersionOptions *iampolicymanagementv1.CreateActionControlTemplateVersionOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createActionControlTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListActionControlTemplateVersions provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListActionControlTemplateVersions(listActionControlTemplateVersionsOptions *iampolicymanagementv1.ListActionControlTemplateVersionsOptions) (*iampolicymanagementv1.ActionControlTemplateVersionsCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listActionControlTemplateVersionsOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplateVersionsCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplateVersionsCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListActionControlTemplateVersionsWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListActionControlTemplateVersionsWithContext(ctx context.Context, listActionControlTemplateVersionsOptions *iampolicymanagementv1.ListActionControlTemplateVersionsOptions) (*iampolicymanagementv1.ActionControlTemplateVersionsCollection, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, listActionControlTemplateVersionsOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplateVersionsCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplateVersionsCollection)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceActionControlTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplaceActionControlTemplate(replaceActionControlTemplateOptions *iampolicymanagementv1.ReplaceActionControlTemplateOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceActionControlTemplateOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceActionControlTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplaceActionControlTemplateWithContext(ctx context.Context, replaceActionControlTemplateOptions *iampolicymanagementv1.ReplaceActionControlTemplateOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceActionControlTemplateOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteActionControlTemplateVersion provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteActionControlTemplateVersion(deleteActionControlTemplateVersionOptions *iampolicymanagementv1.DeleteActionControlTemplateVersionOptions) (*core.
+	DetailedResponse, error) {
+	ret := _m.Called(deleteActionControlTemplateVersionOptions)
+	var r0 *core.
+		DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.
+			DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteActionControlTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteActionControlTemplateVersionWithContext(ctx context.Context, deleteActionControlTemplateVersionOptions *iampolicymanagementv1.DeleteActionControlTemplateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteActionControlTemplateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetActionControlTemplateVersion provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetActionControlTemplateVersion(getActionControlTemplateVersionOptions *iampolicymanagementv1.GetActionControlTemplateVersionOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(getActionControlTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetActionControlTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetActionControlTemplateVersionWithContext(ctx context.Context, getActionControlTemplateVersionOptions *iampolicymanagementv1.GetActionControlTemplateVersionOptions) (*iampolicymanagementv1.ActionControlTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getActionControlTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.ActionControlTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CommitActionControlTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CommitActionControlTemplate(commitActionControlTemplateOptions *iampolicymanagementv1.CommitActionControlTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(commitActionControlTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitActionControlTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CommitActionControlTemplateWithContext(ctx context.Context, commitActionControlTemplateOptions *iampolicymanagementv1.CommitActionControlTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, commitActionControlTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListActionControlAssignments provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListActionControlAssignments(listActionControlAssignmentsOptions *iampolicymanagementv1.ListActionControlAssignmentsOptions) (*iampolicymanagementv1.ActionControlAssignmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listActionControlAssignmentsOptions)
+	var r0 *iampolicymanagementv1.ActionControlAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlAssignmentCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListActionControlAssignmentsWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListActionControlAssignmentsWithContext(ctx context.Context, listActionControlAssignmentsOptions *iampolicymanagementv1.ListActionControlAssignmentsOptions) (*iampolicymanagementv1.ActionControlAssignmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listActionControlAssignmentsOptions)
+	var r0 *iampolicymanagementv1.ActionControlAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlAssignmentCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateActionControlTemplateAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateActionControlTemplateAssignment(createActionControlTemplateAssignmentOptions *iampolicymanagementv1.CreateActionControlTemplateAssignmentOptions) (*iampolicymanagementv1.ActionControlAssignmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(createActionControlTemplateAssignmentOptions)
+	var r0 *iampolicymanagementv1.ActionControlAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlAssignmentCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateActionControlTemplateAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateActionControlTemplateAssignmentWithContext(ctx context.Context, createActionControlTemplateAssignmentOptions *iampolicymanagementv1.CreateActionControlTemplateAssignmentOptions) (*iampolicymanagementv1.ActionControlAssignmentCollection, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, createActionControlTemplateAssignmentOptions)
+	var r0 *iampolicymanagementv1.ActionControlAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlAssignmentCollection)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetActionControlAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetActionControlAssignment(getActionControlAssignmentOptions *iampolicymanagementv1.GetActionControlAssignmentOptions) (*iampolicymanagementv1.ActionControlAssignment, *core.DetailedResponse, error) {
+	ret := _m.Called(getActionControlAssignmentOptions)
+	var r0 *iampolicymanagementv1.ActionControlAssignment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlAssignment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetActionControlAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetActionControlAssignmentWithContext(ctx context.Context, getActionControlAssignmentOptions *iampolicymanagementv1.GetActionControlAssignmentOptions) (*iampolicymanagementv1.ActionControlAssignment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getActionControlAssignmentOptions)
+	var r0 *iampolicymanagementv1.ActionControlAssignment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlAssignment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateActionControlAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdateActionControlAssignment(updateActionControlAssignmentOptions *iampolicymanagementv1.UpdateActionControlAssignmentOptions) (*iampolicymanagementv1.ActionControlAssignment, *core.DetailedResponse, error) {
+	ret := _m.Called(updateActionControlAssignmentOptions)
+	var r0 *iampolicymanagementv1.ActionControlAssignment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlAssignment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateActionControlAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdateActionControlAssignmentWithContext(ctx context.Context, updateActionControlAssignmentOptions *iampolicymanagementv1.UpdateActionControlAssignmentOptions) (*iampolicymanagementv1.ActionControlAssignment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateActionControlAssignmentOptions)
+	var r0 *iampolicymanagementv1.ActionControlAssignment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.ActionControlAssignment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteActionControlAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteActionControlAssignment(deleteActionControlAssignmentOptions *iampolicymanagementv1.DeleteActionControlAssignmentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteActionControlAssignmentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteActionControlAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteActionControlAssignmentWithContext(ctx context.Context, deleteActionControlAssignmentOptions *iampolicymanagementv1.DeleteActionControlAssignmentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteActionControlAssignmentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListRoleTemplates provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListRoleTemplates(listRoleTemplatesOptions *iampolicymanagementv1.ListRoleTemplatesOptions) (*iampolicymanagementv1.RoleTemplateCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listRoleTemplatesOptions)
+	var r0 *iampolicymanagementv1.RoleTemplateCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplateCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoleTemplatesWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListRoleTemplatesWithContext(ctx context.Context, listRoleTemplatesOptions *iampolicymanagementv1.ListRoleTemplatesOptions) (*iampolicymanagementv1.RoleTemplateCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listRoleTemplatesOptions)
+	var r0 *iampolicymanagementv1.RoleTemplateCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplateCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRoleTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateRoleTemplate(createRoleTemplateOptions *iampolicymanagementv1.CreateRoleTemplateOptions) (*iampolicymanagementv1.RoleTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(createRoleTemplateOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRoleTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateRoleTemplateWithContext(ctx context.Context, createRoleTemplateOptions *iampolicymanagementv1.CreateRoleTemplateOptions) (*iampolicymanagementv1.RoleTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRoleTemplateOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRoleTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetRoleTemplate(getRoleTemplateOptions *iampolicymanagementv1.GetRoleTemplateOptions) (*iampolicymanagementv1.RoleTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(getRoleTemplateOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRoleTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetRoleTemplateWithContext(ctx context.Context, getRoleTemplateOptions *iampolicymanagementv1.GetRoleTemplateOptions) (*iampolicymanagementv1.RoleTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRoleTemplateOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteRoleTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteRoleTemplate(deleteRoleTemplateOptions *iampolicymanagementv1.DeleteRoleTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRoleTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteRoleTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteRoleTemplateWithContext(ctx context.Context, deleteRoleTemplateOptions *iampolicymanagementv1.DeleteRoleTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRoleTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateRoleTemplateVersion provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateRoleTemplateVersion(createRoleTemplateVersionOptions *iampolicymanagementv1.CreateRoleTemplateVersionOptions) (*iampolicymanagementv1.RoleTemplate, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(createRoleTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRoleTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateRoleTemplateVersionWithContext(ctx context.Context, createRoleTemplateVersionOptions *iampolicymanagementv1.CreateRoleTemplateVersionOptions) (*iampolicymanagementv1.RoleTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRoleTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoleTemplateVersions provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListRoleTemplateVersions(listRoleTemplateVersionsOptions *iampolicymanagementv1.ListRoleTemplateVersionsOptions) (*iampolicymanagementv1.RoleTemplateVersionsCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listRoleTemplateVersionsOptions)
+	var r0 *iampolicymanagementv1.RoleTemplateVersionsCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplateVersionsCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoleTemplateVersionsWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListRoleTemplateVersionsWithContext(ctx context.Context, listRoleTemplateVersionsOptions *iampolicymanagementv1.ListRoleTemplateVersionsOptions) (*iampolicymanagementv1.RoleTemplateVersionsCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listRoleTemplateVersionsOptions)
+	var r0 *iampolicymanagementv1.RoleTemplateVersionsCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplateVersionsCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceRoleTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplaceRoleTemplate(replaceRoleTemplateOptions *iampolicymanagementv1.ReplaceRoleTemplateOptions) (*iampolicymanagementv1.RoleTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceRoleTemplateOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceRoleTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ReplaceRoleTemplateWithContext(ctx context.Context, replaceRoleTemplateOptions *iampolicymanagementv1.ReplaceRoleTemplateOptions) (*iampolicymanagementv1.RoleTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceRoleTemplateOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteRoleTemplateVersion provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteRoleTemplateVersion(deleteRoleTemplateVersionOptions *iampolicymanagementv1.DeleteRoleTemplateVersionOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRoleTemplateVersionOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteRoleTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteRoleTemplateVersionWithContext(ctx context.Context, deleteRoleTemplateVersionOptions *iampolicymanagementv1.DeleteRoleTemplateVersionOptions) (*core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRoleTemplateVersionOptions)
+	var r0 *core.
+		DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.
+			DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetRoleTemplateVersion provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetRoleTemplateVersion(getRoleTemplateVersionOptions *iampolicymanagementv1.GetRoleTemplateVersionOptions) (*iampolicymanagementv1.RoleTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(getRoleTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRoleTemplateVersionWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetRoleTemplateVersionWithContext(ctx context.Context, getRoleTemplateVersionOptions *iampolicymanagementv1.GetRoleTemplateVersionOptions) (*iampolicymanagementv1.RoleTemplate, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRoleTemplateVersionOptions)
+	var r0 *iampolicymanagementv1.RoleTemplate
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleTemplate)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CommitRoleTemplate provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CommitRoleTemplate(commitRoleTemplateOptions *iampolicymanagementv1.CommitRoleTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(commitRoleTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CommitRoleTemplateWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CommitRoleTemplateWithContext(ctx context.Context, commitRoleTemplateOptions *iampolicymanagementv1.CommitRoleTemplateOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, commitRoleTemplateOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListRoleAssignments provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListRoleAssignments(listRoleAssignmentsOptions *iampolicymanagementv1.ListRoleAssignmentsOptions) (*iampolicymanagementv1.RoleAssignmentCollection, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(listRoleAssignmentsOptions)
+	var r0 *iampolicymanagementv1.RoleAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleAssignmentCollection)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoleAssignmentsWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) ListRoleAssignmentsWithContext(ctx context.Context, listRoleAssignmentsOptions *iampolicymanagementv1.ListRoleAssignmentsOptions) (*iampolicymanagementv1.RoleAssignmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listRoleAssignmentsOptions)
+	var r0 *iampolicymanagementv1.RoleAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleAssignmentCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRoleTemplateAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateRoleTemplateAssignment(createRoleTemplateAssignmentOptions *iampolicymanagementv1.CreateRoleTemplateAssignmentOptions) (*iampolicymanagementv1.RoleAssignmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(createRoleTemplateAssignmentOptions)
+	var r0 *iampolicymanagementv1.RoleAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleAssignmentCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRoleTemplateAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) CreateRoleTemplateAssignmentWithContext(ctx context.Context, createRoleTemplateAssignmentOptions *iampolicymanagementv1.CreateRoleTemplateAssignmentOptions) (*iampolicymanagementv1.RoleAssignmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRoleTemplateAssignmentOptions)
+	var r0 *iampolicymanagementv1.RoleAssignmentCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleAssignmentCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRoleAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetRoleAssignment(getRoleAssignmentOptions *iampolicymanagementv1.GetRoleAssignmentOptions) (*iampolicymanagementv1.RoleAssignment, *core.DetailedResponse, error) {
+	ret := _m.Called(getRoleAssignmentOptions)
+	var r0 *iampolicymanagementv1.RoleAssignment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleAssignment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRoleAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) GetRoleAssignmentWithContext(ctx context.Context, getRoleAssignmentOptions *iampolicymanagementv1.GetRoleAssignmentOptions) (*iampolicymanagementv1.RoleAssignment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRoleAssignmentOptions)
+	var r0 *iampolicymanagementv1.RoleAssignment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleAssignment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateRoleAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdateRoleAssignment(updateRoleAssignmentOptions *iampolicymanagementv1.UpdateRoleAssignmentOptions) (*iampolicymanagementv1.RoleAssignment, *core.DetailedResponse, error) {
+	ret := _m.Called(updateRoleAssignmentOptions)
+	var r0 *iampolicymanagementv1.RoleAssignment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleAssignment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateRoleAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) UpdateRoleAssignmentWithContext(ctx context.Context, updateRoleAssignmentOptions *iampolicymanagementv1.UpdateRoleAssignmentOptions) (*iampolicymanagementv1.RoleAssignment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateRoleAssignmentOptions)
+	var r0 *iampolicymanagementv1.RoleAssignment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*iampolicymanagementv1.RoleAssignment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteRoleAssignment provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteRoleAssignment(deleteRoleAssignmentOptions *iampolicymanagementv1.DeleteRoleAssignmentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRoleAssignmentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteRoleAssignmentWithContext provides a mock function with the given fields.
+func (_m *IamPolicyManagementV1) DeleteRoleAssignmentWithContext(ctx context.Context, deleteRoleAssignmentOptions *iampolicymanagementv1.DeleteRoleAssignmentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRoleAssignmentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
@@ -197,7 +197,7 @@ func (ibmCloudShell *IBMCloudShellV1) GetAccountSettingsWithContext(ctx context.
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "ibm_cloud_shell", "V1", "GetAccountSettings"))
 	builder.EnableGzipCompression = ibmCloudShell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(ibmCloudShell.Service.Options.URL, `/api/v1/user/accounts/{account_id}/settings`, pathParamsMap)
 	if err != nil {
@@ -225,7 +225,7 @@ func (ibmCloudShell *IBMCloudShellV1) GetAccountSettingsWithContext(ctx context.
 	response, err = ibmCloudShell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -269,7 +269,7 @@ func (ibmCloudShell *IBMCloudShellV1) UpdateAccountSettingsWithContext(ctx conte
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "ibm_cloud_shell", "V1", "UpdateAccountSettings"))
 	builder.EnableGzipCompression = ibmCloudShell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(ibmCloudShell.Service.Options.URL, `/api/v1/user/accounts/{account_id}/settings`, pathParamsMap)
 	if err != nil {
@@ -323,7 +323,7 @@ func (ibmCloudShell *IBMCloudShellV1) UpdateAccountSettingsWithContext(ctx conte
 	response, err = ibmCloudShell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
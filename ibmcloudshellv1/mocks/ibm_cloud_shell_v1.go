@@ -0,0 +1,95 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the IBMCloudShellV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/ibmcloudshellv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// IBMCloudShellV1 : A testify mock of the IBMCloudShellV1 service client.
+type IBMCloudShellV1 struct {
+	mock.Mock
+}
+
+var _ ibmcloudshellv1.IBMCloudShellV1Intf = (*IBMCloudShellV1)(nil)
+
+// GetAccountSettings provides a mock function with the given fields.
+func (_m *IBMCloudShellV1) GetAccountSettings(getAccountSettingsOptions *ibmcloudshellv1.GetAccountSettingsOptions) (*ibmcloudshellv1.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountSettingsOptions)
+	var r0 *ibmcloudshellv1.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*ibmcloudshellv1.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSettingsWithContext provides a mock function with the given fields.
+func (_m *IBMCloudShellV1) GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *ibmcloudshellv1.GetAccountSettingsOptions) (*ibmcloudshellv1.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountSettingsOptions)
+	var r0 *ibmcloudshellv1.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*ibmcloudshellv1.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettings provides a mock function with the given fields.
+func (_m *IBMCloudShellV1) UpdateAccountSettings(updateAccountSettingsOptions *ibmcloudshellv1.UpdateAccountSettingsOptions) (*ibmcloudshellv1.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(updateAccountSettingsOptions)
+	var r0 *ibmcloudshellv1.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*ibmcloudshellv1.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateAccountSettingsWithContext provides a mock function with the given fields.
+func (_m *IBMCloudShellV1) UpdateAccountSettingsWithContext(ctx context.Context, updateAccountSettingsOptions *ibmcloudshellv1.UpdateAccountSettingsOptions) (*ibmcloudshellv1.AccountSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateAccountSettingsOptions)
+	var r0 *ibmcloudshellv1.AccountSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*ibmcloudshellv1.AccountSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
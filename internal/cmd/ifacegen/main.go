@@ -0,0 +1,607 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command ifacegen generates, for each service package in the module, an exported interface
+// covering the operations of the service client (<service>_intf.go), a testify mock that
+// implements it (mocks/<service>.go), a test that stubs the mock through the interface (<service>_mock_test.go)
+// and the Use and UseTelemetry methods of the client (<service>_middleware.go).
+//
+// It also instruments the operations of the client in place: each operation sets its service and operation
+// on the context of its request with common.WithOperation, so that middleware can tell which operation sent
+// a request (see common.RequestOperation), and classifies the error responses it returns with
+// common.ClassifyError, so that they match the kinds of errors of the common package with errors.Is.
+// Operations that are already instrumented are left unchanged, so the command must be run again after the
+// service package is regenerated from its API definition.
+//
+// Run it from the root of the module:
+//
+//	go run ./internal/cmd/ifacegen
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	modulePath  = "github.com/IBM/platform-services-go-sdk"
+	mockPath    = "github.com/stretchr/testify/mock"
+	generatedBy = "// Code generated by ifacegen. DO NOT EDIT."
+)
+
+const license = `/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+`
+
+// service describes a service client found in a package.
+type service struct {
+	pkg      string
+	dir      string
+	name     string
+	file     string
+	fset     *token.FileSet
+	imports  map[string]string
+	methods  []*ast.FuncDecl
+	localIDs map[string]bool
+}
+
+func main() {
+	dirs, err := filepath.Glob("*")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, dir := range dirs {
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+		svc, err := loadService(dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if svc == nil {
+			continue
+		}
+		if err = svc.writeInterface(); err != nil {
+			log.Fatal(err)
+		}
+		if err = svc.writeMock(); err != nil {
+			log.Fatal(err)
+		}
+		if err = svc.writeMockTest(); err != nil {
+			log.Fatal(err)
+		}
+		if err = svc.writeMiddleware(); err != nil {
+			log.Fatal(err)
+		}
+		if err = svc.instrumentOperations(); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// loadService parses the non-test sources in "dir" and returns the service client they define, if any.
+func loadService(dir string) (*service, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && !strings.HasSuffix(info.Name(), "_intf.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	for pkgName, pkg := range pkgs {
+		svc := &service{pkg: pkgName, dir: dir, fset: fset, imports: map[string]string{}, localIDs: map[string]bool{}}
+		files := make([]string, 0, len(pkg.Files))
+		for filename := range pkg.Files {
+			files = append(files, filename)
+		}
+		sort.Strings(files)
+
+		// Find the struct holding the core.BaseService; that is the service client.
+		for _, filename := range files {
+			ast.Inspect(pkg.Files[filename], func(n ast.Node) bool {
+				spec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				if st, ok := spec.Type.(*ast.StructType); ok {
+					for _, field := range st.Fields.List {
+						if len(field.Names) == 1 && field.Names[0].Name == "Service" && exprString(fset, field.Type) == "*core.BaseService" {
+							svc.name = spec.Name.Name
+							svc.file = filename
+						}
+					}
+				}
+				return false
+			})
+		}
+		if svc.name == "" {
+			continue
+		}
+
+		// The service's own generated file goes first so that the interface follows the API definition order.
+		sort.SliceStable(files, func(i, j int) bool { return files[i] == svc.file && files[j] != svc.file })
+		for _, filename := range files {
+			file := pkg.Files[filename]
+			for _, decl := range file.Decls {
+				if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+					for _, spec := range gen.Specs {
+						svc.localIDs[spec.(*ast.TypeSpec).Name.Name] = true
+					}
+				}
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv == nil || !fn.Name.IsExported() || !isOperation(fset, fn) {
+					continue
+				}
+				if exprString(fset, fn.Recv.List[0].Type) != "*"+svc.name {
+					continue
+				}
+				for _, spec := range file.Imports {
+					path, _ := strconv.Unquote(spec.Path.Value)
+					name := filepath.Base(path)
+					if spec.Name != nil {
+						name = spec.Name.Name
+					}
+					svc.imports[name] = path
+				}
+				svc.methods = append(svc.methods, fn)
+			}
+		}
+		return svc, nil
+	}
+	return nil, nil
+}
+
+// isOperation returns true if the method returns a *core.DetailedResponse, which is
+// the case for every operation of a service client.
+func isOperation(fset *token.FileSet, fn *ast.FuncDecl) bool {
+	if fn.Type.Results == nil {
+		return false
+	}
+	for _, result := range fn.Type.Results.List {
+		if exprString(fset, result.Type) == "*core.DetailedResponse" {
+			return true
+		}
+	}
+	return false
+}
+
+func (svc *service) interfaceName() string {
+	return svc.name + "Intf"
+}
+
+func (svc *service) writeInterface() error {
+	var body bytes.Buffer
+	used := map[string]bool{}
+	fmt.Fprintf(&body, "// %s : The operations of the %s service client.\n", svc.interfaceName(), svc.name)
+	fmt.Fprintf(&body, "// The interface is satisfied by *%s and by mocks.%s, allowing consumers to substitute a mock for the client in tests.\n", svc.name, svc.name)
+	fmt.Fprintf(&body, "type %s interface {\n", svc.interfaceName())
+	for _, fn := range svc.methods {
+		collectQualifiers(fn.Type, used)
+		if fn.Doc != nil && len(fn.Doc.List) > 0 {
+			fmt.Fprintf(&body, "\t%s\n", fn.Doc.List[0].Text)
+		}
+		fmt.Fprintf(&body, "\t%s%s\n", fn.Name.Name, strings.TrimPrefix(exprString(svc.fset, fn.Type), "func"))
+	}
+	fmt.Fprintf(&body, "}\n\n")
+	fmt.Fprintf(&body, "var _ %s = (*%s)(nil)\n", svc.interfaceName(), svc.name)
+
+	var out bytes.Buffer
+	out.WriteString(license)
+	fmt.Fprintf(&out, "%s\n\npackage %s\n\n", generatedBy, svc.pkg)
+	svc.writeImports(&out, used, nil)
+	out.Write(body.Bytes())
+
+	filename := strings.TrimSuffix(svc.file, ".go") + "_intf.go"
+	return writeSource(filename, out.Bytes())
+}
+
+func (svc *service) writeMock() error {
+	var body bytes.Buffer
+	used := map[string]bool{"mock": true}
+	fmt.Fprintf(&body, "// %s : A testify mock of the %s service client.\n", svc.name, svc.name)
+	fmt.Fprintf(&body, "type %s struct {\n\tmock.Mock\n}\n\n", svc.name)
+	fmt.Fprintf(&body, "var _ %s.%s = (*%s)(nil)\n", svc.pkg, svc.interfaceName(), svc.name)
+
+	for _, fn := range svc.methods {
+		fnType := svc.qualify(fn.Type).(*ast.FuncType)
+		collectQualifiers(fnType, used)
+
+		var params, args []string
+		for i, field := range fnType.Params.List {
+			typ := exprString(svc.fset, field.Type)
+			names := field.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+			}
+			for _, name := range names {
+				params = append(params, name.Name+" "+typ)
+				args = append(args, name.Name)
+			}
+		}
+		var results []string
+		for _, field := range fnType.Results.List {
+			typ := exprString(svc.fset, field.Type)
+			for n := 0; n < max(1, len(field.Names)); n++ {
+				results = append(results, typ)
+			}
+		}
+
+		fmt.Fprintf(&body, "\n// %s provides a mock function with the given fields.\n", fn.Name.Name)
+		fmt.Fprintf(&body, "func (_m *%s) %s(%s) (%s) {\n", svc.name, fn.Name.Name, strings.Join(params, ", "), strings.Join(results, ", "))
+		fmt.Fprintf(&body, "\tret := _m.Called(%s)\n", strings.Join(args, ", "))
+		var returns []string
+		for i, typ := range results {
+			r := fmt.Sprintf("r%d", i)
+			returns = append(returns, r)
+			if typ == "error" {
+				fmt.Fprintf(&body, "\t%s := ret.Error(%d)\n", r, i)
+				continue
+			}
+			fmt.Fprintf(&body, "\tvar %s %s\n", r, typ)
+			fmt.Fprintf(&body, "\tif v := ret.Get(%d); v != nil {\n\t\t%s = v.(%s)\n\t}\n", i, r, typ)
+		}
+		fmt.Fprintf(&body, "\treturn %s\n}\n", strings.Join(returns, ", "))
+	}
+
+	var out bytes.Buffer
+	out.WriteString(license)
+	fmt.Fprintf(&out, "%s\n\n// Package mocks : Testify mocks of the %s service client.\npackage mocks\n\n", generatedBy, svc.name)
+	svc.writeImports(&out, used, map[string]string{svc.pkg: modulePath + "/" + svc.dir, "mock": mockPath})
+	out.Write(body.Bytes())
+
+	filename := filepath.Join(svc.dir, "mocks", strings.TrimSuffix(filepath.Base(svc.file), ".go")+".go")
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil { // #nosec G301
+		return err
+	}
+	return writeSource(filename, out.Bytes())
+}
+
+// writeMockTest writes a test asserting that the mock satisfies the interface and that an operation
+// can be stubbed and invoked through it.
+func (svc *service) writeMockTest() error {
+	fn := svc.methods[0]
+	var args, anything []string
+	for _, field := range fn.Type.Params.List {
+		for n := 0; n < max(1, len(field.Names)); n++ {
+			args = append(args, "nil")
+			anything = append(anything, "mock.Anything")
+		}
+	}
+	var returns, results []string
+	for _, field := range fn.Type.Results.List {
+		for n := 0; n < max(1, len(field.Names)); n++ {
+			returns = append(returns, "nil")
+			results = append(results, "_")
+		}
+	}
+	returns[len(returns)-1] = `errors.New("boom")`
+	results[len(results)-1] = "err"
+
+	var out bytes.Buffer
+	out.WriteString(license)
+	fmt.Fprintf(&out, "%s\n\npackage %s_test\n\n", generatedBy, svc.pkg)
+	fmt.Fprintf(&out, "import (\n\t\"errors\"\n\n")
+	fmt.Fprintf(&out, "\t%q\n\t%q\n", modulePath+"/"+svc.dir, modulePath+"/"+svc.dir+"/mocks")
+	fmt.Fprintf(&out, "\t. \"github.com/onsi/ginkgo\"\n\t. \"github.com/onsi/gomega\"\n\t%q\n)\n\n", mockPath)
+	fmt.Fprintf(&out, "var _ %s.%s = (*mocks.%s)(nil)\n\n", svc.pkg, svc.interfaceName(), svc.name)
+	fmt.Fprintf(&out, "var _ = Describe(`mocks.%s`, func() {\n", svc.name)
+	fmt.Fprintf(&out, "\tIt(`Can be stubbed and invoked through %s`, func() {\n", svc.interfaceName())
+	fmt.Fprintf(&out, "\t\tstub := new(mocks.%s)\n", svc.name)
+	fmt.Fprintf(&out, "\t\tstub.On(%q, %s).Return(%s)\n\n", fn.Name.Name, strings.Join(anything, ", "), strings.Join(returns, ", "))
+	fmt.Fprintf(&out, "\t\tvar client %s.%s = stub\n", svc.pkg, svc.interfaceName())
+	fmt.Fprintf(&out, "\t\t%s := client.%s(%s)\n", strings.Join(results, ", "), fn.Name.Name, strings.Join(args, ", "))
+	fmt.Fprintf(&out, "\t\tExpect(err).To(MatchError(\"boom\"))\n")
+	fmt.Fprintf(&out, "\t\tstub.AssertNumberOfCalls(GinkgoT(), %q, 1)\n\t})\n})\n", fn.Name.Name)
+
+	filename := strings.TrimSuffix(svc.file, ".go") + "_mock_test.go"
+	return writeSource(filename, out.Bytes())
+}
+
+func (svc *service) writeMiddleware() error {
+	receiver := svc.methods[0].Recv.List[0].Names[0].Name
+
+	var out bytes.Buffer
+	out.WriteString(license)
+	fmt.Fprintf(&out, "%s\n\npackage %s\n\n", generatedBy, svc.pkg)
+	svc.writeImports(&out, map[string]bool{"common": true}, map[string]string{"common": modulePath + "/common"})
+	fmt.Fprintf(&out, "// Use installs middleware that wraps every HTTP request sent by the client and the response received for it.\n")
+	fmt.Fprintf(&out, "// See common.Middleware and common.UseMiddleware.\n")
+	fmt.Fprintf(&out, "func (%s *%s) Use(middleware ...common.Middleware) {\n", receiver, svc.name)
+	fmt.Fprintf(&out, "\tcommon.UseMiddleware(%s.Service, middleware...)\n}\n", receiver)
+	fmt.Fprintf(&out, "\n// UseTelemetry installs OpenTelemetry instrumentation that records a span and metrics for every request sent by the client.\n")
+	fmt.Fprintf(&out, "// See common.Telemetry.\n")
+	fmt.Fprintf(&out, "func (%s *%s) UseTelemetry(telemetry *common.Telemetry) {\n", receiver, svc.name)
+	fmt.Fprintf(&out, "\tcommon.UseTelemetry(%s.Service, telemetry)\n}\n", receiver)
+
+	filename := strings.TrimSuffix(svc.file, ".go") + "_middleware.go"
+	return writeSource(filename, out.Bytes())
+}
+
+// edit : The replacement of a range of a source file.
+type edit struct {
+	offset int
+	length int
+	text   string
+}
+
+// apiVersion matches the API version in the documentation of a service client.
+var apiVersion = regexp.MustCompile(`(?m)^// (?:API )?Version: (\S+)$`)
+
+// instrumentOperations rewrites each operation of the client so that:
+//   - "builder.WithContext(ctx)" becomes "builder.WithContext(common.WithOperation(ctx, <service>, <version>, <operation>))",
+//     using the arguments of the operation's call to common.GetSdkHeaders;
+//   - the error returned for an error response is classified with common.ClassifyError, after it has been enriched
+//     with core.EnrichHTTPProblem as the current generator does.
+func (svc *service) instrumentOperations() error {
+	src, err := os.ReadFile(svc.file) // #nosec G304
+	if err != nil {
+		return err
+	}
+	var version string
+	if match := apiVersion.FindSubmatch(src); match != nil {
+		version = string(match[1])
+	}
+	edits := map[string][]edit{}
+	add := func(pos token.Pos, length int, text string) {
+		position := svc.fset.Position(pos)
+		edits[position.Filename] = append(edits[position.Filename], edit{offset: position.Offset, length: length, text: text})
+	}
+	for _, fn := range svc.methods {
+		var operation []string
+		var ctx *ast.Ident
+		var next ast.Stmt
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.CallExpr:
+				switch exprString(svc.fset, n.Fun) {
+				case "common.GetSdkHeaders":
+					for _, arg := range n.Args {
+						if lit, ok := arg.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+							operation = append(operation, lit.Value)
+						}
+					}
+				case "builder.WithContext":
+					if id, ok := n.Args[0].(*ast.Ident); ok && len(n.Args) == 1 {
+						ctx = id
+					}
+				}
+			case *ast.BlockStmt:
+				// The error of the request is handled by the statement that follows it.
+				for i, stmt := range n.List[:max(len(n.List)-1, 0)] {
+					if assign, ok := stmt.(*ast.AssignStmt); ok && len(assign.Rhs) == 1 {
+						if call, ok := assign.Rhs[0].(*ast.CallExpr); ok && strings.HasSuffix(exprString(svc.fset, call.Fun), ".Service.Request") {
+							next = n.List[i+1]
+						}
+					}
+				}
+			}
+			return true
+		})
+		if len(operation) != 3 {
+			continue
+		}
+		if ctx != nil {
+			add(ctx.Pos(), len(ctx.Name), fmt.Sprintf("common.WithOperation(%s, %s)", ctx.Name, strings.Join(operation, ", ")))
+		}
+		if next == nil {
+			continue
+		}
+		operationID, _ := strconv.Unquote(operation[2])
+		classify := func(indent string) (string, error) {
+			if version == "" {
+				return "", fmt.Errorf("%s: no API version in the documentation of %s", svc.dir, svc.name)
+			}
+			return fmt.Sprintf("core.EnrichHTTPProblem(err, %q, core.NewProblemComponent(DefaultServiceName, %q))\n%s", snakeCase(operationID), version, indent) +
+				"err = core.SDKErrorf(common.ClassifyError(err), \"\", \"http-request-err\", common.GetComponentInfo())", nil
+		}
+		failed, ok := next.(*ast.IfStmt)
+		if !ok || exprString(svc.fset, failed.Cond) != "err != nil" || len(failed.Body.List) == 0 {
+			// Clients generated before errors were enriched may return the error of the request as is.
+			indent := strings.Repeat("\t", svc.fset.Position(next.Pos()).Column-1)
+			text, err := classify(indent + "\t")
+			if err != nil {
+				return err
+			}
+			add(next.Pos(), 0, "if err != nil {\n"+indent+"\t"+text+"\n"+indent+"}\n"+indent)
+			continue
+		}
+		first := failed.Body.List[0]
+		if call, ok := first.(*ast.ExprStmt); ok && strings.HasPrefix(exprString(svc.fset, call.X), "core.EnrichHTTPProblem(") {
+			// The error is enriched by the generated code; classify it before it is wrapped.
+			if len(failed.Body.List) > 1 {
+				if assign, ok := failed.Body.List[1].(*ast.AssignStmt); ok && len(assign.Rhs) == 1 {
+					if wrap, ok := assign.Rhs[0].(*ast.CallExpr); ok && exprString(svc.fset, wrap.Fun) == "core.SDKErrorf" {
+						if id, ok := wrap.Args[0].(*ast.Ident); ok && id.Name == "err" {
+							add(id.Pos(), len(id.Name), "common.ClassifyError(err)")
+						}
+					}
+				}
+			}
+			continue
+		}
+		// Clients generated before errors were enriched return the error of the request unchanged.
+		indent := strings.Repeat("\t", svc.fset.Position(first.Pos()).Column-1)
+		text, err := classify(indent)
+		if err != nil {
+			return err
+		}
+		add(first.Pos(), 0, text+"\n"+indent)
+	}
+	return applyEdits(edits)
+}
+
+// snakeCase converts an operation ID such as "GetCase" or "getIAMPolicy" to "get_case" or "get_iam_policy".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+			(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// applyEdits makes the edits of each file in place. The files are not reformatted.
+func applyEdits(edits map[string][]edit) error {
+	for filename, fileEdits := range edits {
+		src, err := os.ReadFile(filename) // #nosec G304
+		if err != nil {
+			return err
+		}
+		sort.Slice(fileEdits, func(i, j int) bool { return fileEdits[i].offset > fileEdits[j].offset })
+		for _, e := range fileEdits {
+			src = append(src[:e.offset:e.offset], append([]byte(e.text), src[e.offset+e.length:]...)...)
+		}
+		if err = os.WriteFile(filename, src, 0644); err != nil { // #nosec G306
+			return err
+		}
+	}
+	return nil
+}
+
+// qualify returns a copy of a type expression in which the types declared by the service package
+// are qualified with the package name, for use outside of the package.
+func (svc *service) qualify(expr ast.Expr) ast.Node {
+	src := exprString(svc.fset, expr)
+	copied, err := parser.ParseExpr(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var rewrite func(n ast.Node) ast.Node
+	rewrite = func(n ast.Node) ast.Node {
+		switch n := n.(type) {
+		case *ast.Ident:
+			if svc.localIDs[n.Name] {
+				return &ast.SelectorExpr{X: ast.NewIdent(svc.pkg), Sel: ast.NewIdent(n.Name)}
+			}
+		case *ast.StarExpr:
+			n.X = rewrite(n.X).(ast.Expr)
+		case *ast.ArrayType:
+			n.Elt = rewrite(n.Elt).(ast.Expr)
+		case *ast.MapType:
+			n.Key = rewrite(n.Key).(ast.Expr)
+			n.Value = rewrite(n.Value).(ast.Expr)
+		case *ast.Ellipsis:
+			n.Elt = rewrite(n.Elt).(ast.Expr)
+		case *ast.FuncType:
+			for _, list := range []*ast.FieldList{n.Params, n.Results} {
+				if list == nil {
+					continue
+				}
+				for _, field := range list.List {
+					field.Type = rewrite(field.Type).(ast.Expr)
+				}
+			}
+		}
+		return n
+	}
+	return rewrite(copied)
+}
+
+func (svc *service) writeImports(out *bytes.Buffer, used map[string]bool, extra map[string]string) {
+	var std, third []string
+	for name := range used {
+		path, ok := extra[name]
+		if !ok {
+			path = svc.imports[name]
+		}
+		if path == "" {
+			log.Fatalf("%s: unknown package qualifier %s", svc.dir, name)
+		}
+		spec := strconv.Quote(path)
+		if filepath.Base(path) != name {
+			spec = name + " " + spec
+		}
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			third = append(third, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(third)
+	out.WriteString("import (\n")
+	for _, spec := range std {
+		fmt.Fprintf(out, "\t%s\n", spec)
+	}
+	if len(std) > 0 && len(third) > 0 {
+		out.WriteString("\n")
+	}
+	for _, spec := range third {
+		fmt.Fprintf(out, "\t%s\n", spec)
+	}
+	out.WriteString(")\n\n")
+}
+
+// collectQualifiers records the package qualifiers referenced by a node.
+func collectQualifiers(n ast.Node, used map[string]bool) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && unicode.IsLower(rune(id.Name[0])) {
+				used[id.Name] = true
+			}
+			return false
+		}
+		return true
+	})
+}
+
+func exprString(fset *token.FileSet, n ast.Node) string {
+	var b bytes.Buffer
+	if err := printer.Fprint(&b, fset, n); err != nil {
+		log.Fatal(err)
+	}
+	return b.String()
+}
+
+func writeSource(filename string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %w\n%s", filename, err, src)
+	}
+	return os.WriteFile(filename, formatted, 0644) // #nosec G306
+}
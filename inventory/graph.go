@@ -0,0 +1,311 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package inventory : An in-process graph of the resources of an account, tying the resource groups of the
+// resource manager (resourcemanagerv2), the instances, keys, aliases and bindings of the resource controller
+// (resourcecontrollerv2), the tags of global tagging (globaltaggingv1) and the results of global search
+// (globalsearchv2).
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Node kinds.
+const (
+	NodeKindResourceGroupConst    = "resource_group"
+	NodeKindResourceInstanceConst = "resource_instance"
+	NodeKindResourceKeyConst      = "resource_key"
+	NodeKindResourceAliasConst    = "resource_alias"
+	NodeKindResourceBindingConst  = "resource_binding"
+
+	// A resource found by global search that is not managed by the resource controller, such as a VPC.
+	NodeKindResourceConst = "resource"
+)
+
+// Edge relations. The target of an edge depends on its source: a resource group contains instances, an instance
+// has keys and aliases, and an alias has keys and bindings.
+const (
+	RelationContainsConst = "contains"
+	RelationKeyConst      = "key"
+	RelationAliasConst    = "alias"
+	RelationBindingConst  = "binding"
+)
+
+// Node : A resource of the inventory, identified by its CRN.
+type Node struct {
+	CRN  string `json:"crn"`
+	ID   string `json:"id,omitempty"`
+	Kind string `json:"kind"`
+
+	// The type of a resource found by global search, such as "vpc".
+	Type string `json:"type,omitempty"`
+
+	Name            string   `json:"name,omitempty"`
+	ResourceGroupID string   `json:"resource_group_id,omitempty"`
+	State           string   `json:"state,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+
+	// The resource as returned by its service, such as a *resourcecontrollerv2.ResourceInstance or a
+	// globalsearchv2.ResultItem. It is not exported to JSON.
+	Resource interface{} `json:"-"`
+}
+
+// HasTag reports whether the node has the specified tag.
+func (node *Node) HasTag(tag string) bool {
+	return common.ContainsString(node.Tags, tag)
+}
+
+// Edge : A dependency between two nodes, identified by their CRNs: the target depends on the source.
+type Edge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+}
+
+// Graph : The resources of an account and their dependencies, as returned by Load. A Graph is not safe for
+// concurrent modification, but may be queried concurrently.
+type Graph struct {
+	nodes    map[string]*Node
+	order    []string
+	edges    []Edge
+	children map[string][]int
+	parents  map[string][]int
+}
+
+// NewGraph returns an empty graph.
+func NewGraph() *Graph {
+	return &Graph{
+		nodes:    map[string]*Node{},
+		children: map[string][]int{},
+		parents:  map[string][]int{},
+	}
+}
+
+// AddNode adds a node to the graph and returns it. If the graph already has a node with the same CRN, it is
+// returned instead and left unchanged.
+func (graph *Graph) AddNode(node *Node) *Node {
+	if existing, found := graph.nodes[node.CRN]; found {
+		return existing
+	}
+	graph.nodes[node.CRN] = node
+	graph.order = append(graph.order, node.CRN)
+	return node
+}
+
+// AddEdge adds an edge between two nodes of the graph. It returns false, and does not add the edge, if either node
+// is not in the graph or the edge already exists.
+func (graph *Graph) AddEdge(from string, to string, relation string) bool {
+	if graph.nodes[from] == nil || graph.nodes[to] == nil || from == to {
+		return false
+	}
+	for _, i := range graph.children[from] {
+		if graph.edges[i].To == to && graph.edges[i].Relation == relation {
+			return false
+		}
+	}
+	graph.edges = append(graph.edges, Edge{From: from, To: to, Relation: relation})
+	graph.children[from] = append(graph.children[from], len(graph.edges)-1)
+	graph.parents[to] = append(graph.parents[to], len(graph.edges)-1)
+	return true
+}
+
+// Node returns the node with the specified CRN, or nil.
+func (graph *Graph) Node(crn string) *Node {
+	return graph.nodes[crn]
+}
+
+// Nodes returns the nodes of the graph in the order in which they were added, or only those of the specified kinds.
+func (graph *Graph) Nodes(kinds ...string) (nodes []*Node) {
+	return graph.Find(func(node *Node) bool {
+		return len(kinds) == 0 || common.ContainsString(kinds, node.Kind)
+	})
+}
+
+// Edges returns the edges of the graph in the order in which they were added.
+func (graph *Graph) Edges() []Edge {
+	return append([]Edge(nil), graph.edges...)
+}
+
+// Find returns the nodes for which "match" returns true.
+func (graph *Graph) Find(match func(*Node) bool) (nodes []*Node) {
+	for _, crn := range graph.order {
+		if node := graph.nodes[crn]; match(node) {
+			nodes = append(nodes, node)
+		}
+	}
+	return
+}
+
+// Tagged returns the nodes that have the specified tag.
+func (graph *Graph) Tagged(tag string) []*Node {
+	return graph.Find(func(node *Node) bool { return node.HasTag(tag) })
+}
+
+// Match returns the nodes whose CRN matches a CRN mask.
+func (graph *Graph) Match(mask *common.CRN) []*Node {
+	return graph.Find(func(node *Node) bool {
+		crn, err := common.ParseCRNMask(node.CRN)
+		return err == nil && mask.Matches(crn)
+	})
+}
+
+// Children returns the nodes that directly depend on a node, optionally only through the specified relations.
+func (graph *Graph) Children(crn string, relations ...string) (nodes []*Node) {
+	for _, i := range graph.children[crn] {
+		if edge := graph.edges[i]; len(relations) == 0 || common.ContainsString(relations, edge.Relation) {
+			nodes = append(nodes, graph.nodes[edge.To])
+		}
+	}
+	return
+}
+
+// Parents returns the nodes on which a node directly depends, optionally only through the specified relations.
+func (graph *Graph) Parents(crn string, relations ...string) (nodes []*Node) {
+	for _, i := range graph.parents[crn] {
+		if edge := graph.edges[i]; len(relations) == 0 || common.ContainsString(relations, edge.Relation) {
+			nodes = append(nodes, graph.nodes[edge.From])
+		}
+	}
+	return
+}
+
+// Dependents returns the nodes that depend on a node, directly or not, nearest first: the keys, aliases and
+// bindings of an instance, or everything a resource group contains. These are the resources to delete, or to
+// detach, before deleting the node.
+func (graph *Graph) Dependents(crn string) (nodes []*Node) {
+	visited := map[string]bool{crn: true}
+	queue := []string{crn}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range graph.Children(current) {
+			if !visited[child.CRN] {
+				visited[child.CRN] = true
+				nodes = append(nodes, child)
+				queue = append(queue, child.CRN)
+			}
+		}
+	}
+	return
+}
+
+// graphDocument : The JSON form of a Graph.
+type graphDocument struct {
+	Nodes []*Node `json:"nodes"`
+	Edges []Edge  `json:"edges"`
+}
+
+// MarshalJSON returns the nodes and edges of the graph as {"nodes": [...], "edges": [...]}.
+func (graph *Graph) MarshalJSON() ([]byte, error) {
+	document := graphDocument{Nodes: graph.Nodes(), Edges: graph.Edges()}
+	if document.Nodes == nil {
+		document.Nodes = []*Node{}
+	}
+	if document.Edges == nil {
+		document.Edges = []Edge{}
+	}
+	return json.Marshal(document)
+}
+
+// UnmarshalJSON reads a graph exported with MarshalJSON. The nodes of the graph have no Resource.
+func (graph *Graph) UnmarshalJSON(data []byte) error {
+	var document graphDocument
+	err := json.Unmarshal(data, &document)
+	if err != nil {
+		return core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+	}
+	*graph = *NewGraph()
+	for _, node := range document.Nodes {
+		graph.AddNode(node)
+	}
+	for _, edge := range document.Edges {
+		graph.AddEdge(edge.From, edge.To, edge.Relation)
+	}
+	return nil
+}
+
+// WriteJSON writes the graph as indented JSON.
+func (graph *Graph) WriteJSON(writer io.Writer) error {
+	data, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+	}
+	_, err = writer.Write(append(data, '\n'))
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// The shapes of the nodes of each kind in DOT exports.
+var dotShapes = map[string]string{
+	NodeKindResourceGroupConst:    "folder",
+	NodeKindResourceInstanceConst: "box",
+	NodeKindResourceKeyConst:      "note",
+	NodeKindResourceAliasConst:    "box3d",
+	NodeKindResourceBindingConst:  "cds",
+	NodeKindResourceConst:         "ellipse",
+}
+
+// WriteDOT writes the graph in the DOT language of Graphviz. Nodes are labelled with their name, kind (or type)
+// and tags, and edges with their relation.
+func (graph *Graph) WriteDOT(writer io.Writer) error {
+	var builder strings.Builder
+	builder.WriteString("digraph inventory {\n  rankdir=LR;\n")
+	for _, node := range graph.Nodes() {
+		lines := []string{node.Name, node.Kind}
+		if node.Name == "" {
+			lines[0] = node.CRN
+		}
+		if node.Type != "" {
+			lines[1] = node.Type
+		}
+		if len(node.Tags) > 0 {
+			tags := append([]string(nil), node.Tags...)
+			sort.Strings(tags)
+			lines = append(lines, strings.Join(tags, ", "))
+		}
+		for i := range lines {
+			lines[i] = dotEscape(lines[i])
+		}
+		shape := dotShapes[node.Kind]
+		if shape == "" {
+			shape = "ellipse"
+		}
+		fmt.Fprintf(&builder, "  \"%s\" [label=\"%s\", shape=%s];\n", dotEscape(node.CRN), strings.Join(lines, `\n`), shape)
+	}
+	for _, edge := range graph.edges {
+		fmt.Fprintf(&builder, "  \"%s\" -> \"%s\" [label=\"%s\"];\n", dotEscape(edge.From), dotEscape(edge.To), dotEscape(edge.Relation))
+	}
+	builder.WriteString("}\n")
+	_, err := io.WriteString(writer, builder.String())
+	if err != nil {
+		return core.SDKErrorf(err, "", "write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
@@ -0,0 +1,240 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/inventory"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	group1CRN    = "crn:v1:bluemix:public:resource-controller::a/acc::resource-group:g1"
+	group2CRN    = "crn:v1:bluemix:public:resource-controller::a/acc::resource-group:g2"
+	instance1CRN = "crn:v1:bluemix:public:kms:us-south:a/acc:i1::"
+	instance2CRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/acc:i2::"
+	aliasCRN     = "crn:v1:bluemix:public:kms:us-south:a/acc:i1:resource-alias:a1"
+	key1CRN      = "crn:v1:bluemix:public:kms:us-south:a/acc:i1:resource-key:k1"
+	key2CRN      = "crn:v1:bluemix:public:kms:us-south:a/acc:i1:resource-key:k2"
+	bindingCRN   = "crn:v1:bluemix:public:kms:us-south:a/acc:i1:resource-binding:b1"
+	vpcCRN       = "crn:v1:bluemix:public:is:us-south:a/acc::vpc:v1"
+)
+
+var _ = Describe(`Inventory`, func() {
+	var testServer *httptest.Server
+	var failing string
+	var searchQuery string
+	var options *inventory.LoadOptions
+
+	BeforeEach(func() {
+		failing = ""
+		searchQuery = ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Path == failing {
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"message": "internal error"}`)
+				return
+			}
+			switch req.URL.Path {
+			case "/v2/resource_groups":
+				fmt.Fprintf(res, `{"resources": [{"id": "g1", "crn": "%s", "name": "default"}, {"id": "g2", "crn": "%s", "name": "network"}]}`,
+					group1CRN, group2CRN)
+			case "/v2/resource_instances":
+				if req.URL.Query().Get("start") == "" {
+					fmt.Fprintf(res, `{"rows_count": 1, "next_url": "/v2/resource_instances?start=p2", "resources": [
+						{"id": "%s", "guid": "i1", "crn": "%s", "name": "keys", "resource_group_id": "g1", "state": "active"}]}`,
+						instance1CRN, instance1CRN)
+				} else {
+					fmt.Fprintf(res, `{"rows_count": 1, "next_url": null, "resources": [
+						{"id": "%s", "guid": "i2", "crn": "%s", "name": "bucket", "resource_group_id": "g1", "state": "active"}]}`,
+						instance2CRN, instance2CRN)
+				}
+			case "/v2/resource_aliases":
+				fmt.Fprintf(res, `{"rows_count": 1, "next_url": null, "resources": [
+					{"id": "%s", "crn": "%s", "name": "alias", "resource_instance_id": "%s"}]}`, aliasCRN, aliasCRN, instance1CRN)
+			case "/v2/resource_keys":
+				fmt.Fprintf(res, `{"rows_count": 2, "next_url": null, "resources": [
+					{"id": "%s", "crn": "%s", "name": "key1", "source_crn": "%s"},
+					{"id": "%s", "crn": "%s", "name": "key2", "source_crn": "%s"}]}`,
+					key1CRN, key1CRN, instance1CRN, key2CRN, key2CRN, aliasCRN)
+			case "/v2/resource_bindings":
+				fmt.Fprintf(res, `{"rows_count": 1, "next_url": null, "resources": [
+					{"id": "%s", "crn": "%s", "name": "binding", "source_crn": "%s", "target_crn": "crn:v1:bluemix:public:cf:us-south:s/space::cf-application:app"}]}`,
+					bindingCRN, bindingCRN, aliasCRN)
+			case "/v3/resources/search":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				searchQuery, _ = body["query"].(string)
+				fmt.Fprintf(res, `{"limit": 1000, "items": [
+					{"crn": "%s", "name": "keys", "type": "resource-instance", "tags": ["env:prod"]},
+					{"crn": "%s", "name": "vpc", "type": "vpc", "resource_group_id": "g2", "tags": ["env:prod", "team:network"]}]}`,
+					instance1CRN, vpcCRN)
+			case "/v3/tags":
+				Expect(req.URL.Query().Get("tag_type")).To(Equal("user"))
+				if req.URL.Query().Get("attached_to") == instance2CRN {
+					fmt.Fprint(res, `{"items": [{"name": "team:storage"}]}`)
+				} else {
+					fmt.Fprint(res, `{"items": []}`)
+				}
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+
+		authenticator := &core.NoAuthAuthenticator{}
+		resourceController, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL: testServer.URL, Authenticator: authenticator})
+		Expect(err).To(BeNil())
+		resourceManager, err := resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+			URL: testServer.URL, Authenticator: authenticator})
+		Expect(err).To(BeNil())
+		globalSearch, err := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL: testServer.URL, Authenticator: authenticator})
+		Expect(err).To(BeNil())
+		globalTagging, err := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL: testServer.URL, Authenticator: authenticator})
+		Expect(err).To(BeNil())
+		options = &inventory.LoadOptions{
+			ResourceController: resourceController,
+			ResourceManager:    resourceManager,
+			GlobalSearch:       globalSearch,
+			GlobalTagging:      globalTagging,
+		}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Loads the graph of the account`, func() {
+		graph, err := inventory.Load(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		crns := func(nodes []*inventory.Node) (crns []string) {
+			for _, node := range nodes {
+				crns = append(crns, node.CRN)
+			}
+			return
+		}
+		Expect(crns(graph.Nodes())).To(Equal([]string{
+			group1CRN, group2CRN, instance1CRN, instance2CRN, aliasCRN, key1CRN, key2CRN, bindingCRN, vpcCRN}))
+		Expect(crns(graph.Nodes(inventory.NodeKindResourceKeyConst))).To(Equal([]string{key1CRN, key2CRN}))
+		Expect(graph.Node(vpcCRN).Type).To(Equal("vpc"))
+
+		Expect(crns(graph.Children(group1CRN))).To(Equal([]string{instance1CRN, instance2CRN}))
+		Expect(crns(graph.Children(group2CRN))).To(Equal([]string{vpcCRN}))
+		Expect(crns(graph.Parents(key2CRN))).To(Equal([]string{aliasCRN}))
+		Expect(crns(graph.Children(instance1CRN, inventory.RelationKeyConst))).To(Equal([]string{key1CRN}))
+		Expect(crns(graph.Dependents(instance1CRN))).To(Equal([]string{aliasCRN, key1CRN, key2CRN, bindingCRN}))
+		Expect(graph.Dependents(instance2CRN)).To(BeEmpty())
+
+		// Tags come from global search, or from global tagging for the resources that search did not return.
+		Expect(crns(graph.Tagged("env:prod"))).To(Equal([]string{instance1CRN, vpcCRN}))
+		Expect(crns(graph.Tagged("team:storage"))).To(Equal([]string{instance2CRN}))
+
+		mask, err := common.ParseCRNMask("crn:v1:bluemix:public:kms:::::")
+		Expect(err).To(BeNil())
+		Expect(crns(graph.Match(mask))).To(Equal([]string{instance1CRN, aliasCRN, key1CRN, key2CRN, bindingCRN}))
+	})
+	It(`Exports the graph as JSON and DOT`, func() {
+		graph, err := inventory.Load(context.Background(), options)
+		Expect(err).To(BeNil())
+
+		var buffer bytes.Buffer
+		Expect(graph.WriteJSON(&buffer)).To(Succeed())
+		imported := inventory.NewGraph()
+		Expect(json.Unmarshal(buffer.Bytes(), imported)).To(Succeed())
+		Expect(imported.Edges()).To(Equal(graph.Edges()))
+		Expect(imported.Node(vpcCRN).Tags).To(Equal([]string{"env:prod", "team:network"}))
+
+		buffer.Reset()
+		Expect(graph.WriteDOT(&buffer)).To(Succeed())
+		Expect(buffer.String()).To(HavePrefix("digraph inventory {\n"))
+		Expect(buffer.String()).To(ContainSubstring(
+			fmt.Sprintf(`"%s" [label="vpc\nvpc\nenv:prod, team:network", shape=ellipse];`, vpcCRN)))
+		Expect(buffer.String()).To(ContainSubstring(fmt.Sprintf(`"%s" -> "%s" [label="binding"];`, aliasCRN, bindingCRN)))
+	})
+	It(`Restricts global search to the resource group`, func() {
+		options.ResourceGroupID = "g2"
+		graph, err := inventory.Load(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(searchQuery).To(Equal("(*) AND resource_group_id:g2"))
+		Expect(graph.Node(group1CRN)).To(BeNil())
+		Expect(graph.Children(group2CRN)).To(ContainElement(graph.Node(vpcCRN)))
+	})
+	It(`Loads only the resource controller when the other services are not set`, func() {
+		graph, err := inventory.Load(context.Background(), &inventory.LoadOptions{ResourceController: options.ResourceController})
+		Expect(err).To(BeNil())
+		Expect(graph.Nodes()).To(HaveLen(6))
+		Expect(graph.Parents(instance1CRN)).To(BeEmpty())
+		Expect(graph.Node(instance2CRN).Tags).To(BeEmpty())
+	})
+	It(`Fails when a listing fails`, func() {
+		failing = "/v2/resource_keys"
+		graph, err := inventory.Load(context.Background(), options)
+		Expect(err).ToNot(BeNil())
+		Expect(graph).To(BeNil())
+
+		_, err = inventory.Load(context.Background(), &inventory.LoadOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Does not validate the fields of the service clients`, func() {
+		_, err := inventory.Load(context.Background(), &inventory.LoadOptions{
+			ResourceController: &taggedResourceController{ResourceControllerV2Intf: options.ResourceController},
+			ResourceManager:    &taggedResourceManager{ResourceManagerV2Intf: options.ResourceManager},
+			GlobalSearch:       &taggedGlobalSearch{GlobalSearchV2Intf: options.GlobalSearch},
+			GlobalTagging:      &taggedGlobalTagging{GlobalTaggingV1Intf: options.GlobalTagging},
+		})
+		Expect(err).To(BeNil())
+	})
+})
+
+// The tagged* clients have a field that does not pass validation, which must not be reached when the options
+// holding the clients are validated.
+type taggedResourceController struct {
+	resourcecontrollerv2.ResourceControllerV2Intf
+	Name *string `validate:"required"`
+}
+
+type taggedResourceManager struct {
+	resourcemanagerv2.ResourceManagerV2Intf
+	Name *string `validate:"required"`
+}
+
+type taggedGlobalSearch struct {
+	globalsearchv2.GlobalSearchV2Intf
+	Name *string `validate:"required"`
+}
+
+type taggedGlobalTagging struct {
+	globaltaggingv1.GlobalTaggingV1Intf
+	Name *string `validate:"required"`
+}
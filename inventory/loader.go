@@ -0,0 +1,396 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+)
+
+// DefaultConcurrency is the number of tag lookups run at the same time by Load when no limit is given.
+const DefaultConcurrency = common.DefaultConcurrency
+
+// The fields requested from global search.
+var searchFields = []string{"crn", "name", "type", "family", "resource_group_id", "tags"}
+
+// LoadOptions : The services and filters used by Load.
+type LoadOptions struct {
+	// The client of the resource controller, which lists instances, keys, aliases and bindings.
+	ResourceController resourcecontrollerv2.ResourceControllerV2Intf `validate:"required,structonly"`
+
+	// The client of the resource manager, which lists resource groups. Resource groups are not loaded when nil.
+	ResourceManager resourcemanagerv2.ResourceManagerV2Intf `validate:"-"`
+
+	// The client of global search. When set, the resources found by SearchQuery are added to the graph, and the tags
+	// of the search results are set on the nodes.
+	GlobalSearch globalsearchv2.GlobalSearchV2Intf `validate:"-"`
+
+	// The client of global tagging. When set, the user tags of the instances and resource groups that global search
+	// did not return are looked up one by one.
+	GlobalTagging globaltaggingv1.GlobalTaggingV1Intf `validate:"-"`
+
+	// The account of the resource groups, search results and tags. Defaults to the account of the credentials.
+	AccountID string
+
+	// Restricts the resource groups, instances, keys, aliases, bindings and search results to those of a resource group.
+	ResourceGroupID string
+
+	// The Lucene query of global search. Defaults to "*".
+	SearchQuery string
+
+	// The number of tag lookups run at the same time. Defaults to DefaultConcurrency.
+	Concurrency int
+}
+
+// Load lists the resources of an account concurrently and returns their graph. Resource groups contain instances
+// and the resources found by global search, instances have keys and aliases, and aliases have keys and bindings.
+func Load(ctx context.Context, options *LoadOptions) (graph *Graph, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	loader := &loader{options: options}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var wg sync.WaitGroup
+	errs := make([]error, 6)
+	for i, load := range []func(context.Context) error{
+		loader.loadResourceGroups,
+		loader.loadInstances,
+		loader.loadKeys,
+		loader.loadAliases,
+		loader.loadBindings,
+		loader.search,
+	} {
+		wg.Add(1)
+		go func(i int, load func(context.Context) error) {
+			defer wg.Done()
+			errs[i] = load(ctx)
+			if errs[i] != nil {
+				cancel()
+			}
+		}(i, load)
+	}
+	wg.Wait()
+	for _, e := range errs {
+		if e != nil && err == nil {
+			err = e
+		}
+	}
+	if err != nil {
+		return
+	}
+
+	graph = loader.build()
+	err = loader.loadTags(ctx, graph)
+	if err != nil {
+		graph = nil
+	}
+	return
+}
+
+// loader : The resources listed by Load, before they are assembled into a graph.
+type loader struct {
+	options   *LoadOptions
+	groups    []resourcemanagerv2.ResourceGroup
+	instances []resourcecontrollerv2.ResourceInstance
+	keys      []resourcecontrollerv2.ResourceKey
+	aliases   []resourcecontrollerv2.ResourceAlias
+	bindings  []resourcecontrollerv2.ResourceBinding
+	results   []globalsearchv2.ResultItem
+
+	// The CRNs of the nodes whose tags were returned by global search.
+	searched map[string]bool
+}
+
+func (loader *loader) loadResourceGroups(ctx context.Context) error {
+	if loader.options.ResourceManager == nil {
+		return nil
+	}
+	options := &resourcemanagerv2.ListResourceGroupsOptions{AccountID: stringPtrOrNil(loader.options.AccountID)}
+	result, _, err := loader.options.ResourceManager.ListResourceGroupsWithContext(ctx, options)
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "list-resource-groups-error")
+	}
+	for _, group := range result.Resources {
+		if loader.options.ResourceGroupID == "" || core.StringNilMapper(group.ID) == loader.options.ResourceGroupID {
+			loader.groups = append(loader.groups, group)
+		}
+	}
+	return nil
+}
+
+func (loader *loader) loadInstances(ctx context.Context) error {
+	options := &resourcecontrollerv2.ListResourceInstancesOptions{ResourceGroupID: stringPtrOrNil(loader.options.ResourceGroupID)}
+	for {
+		result, _, err := loader.options.ResourceController.ListResourceInstancesWithContext(ctx, options)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-resource-instances-error")
+		}
+		loader.instances = append(loader.instances, result.Resources...)
+		options.Start, err = result.GetNextStart()
+		if err != nil || options.Start == nil {
+			return err
+		}
+	}
+}
+
+func (loader *loader) loadKeys(ctx context.Context) error {
+	options := &resourcecontrollerv2.ListResourceKeysOptions{ResourceGroupID: stringPtrOrNil(loader.options.ResourceGroupID)}
+	for {
+		result, _, err := loader.options.ResourceController.ListResourceKeysWithContext(ctx, options)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-resource-keys-error")
+		}
+		loader.keys = append(loader.keys, result.Resources...)
+		options.Start, err = result.GetNextStart()
+		if err != nil || options.Start == nil {
+			return err
+		}
+	}
+}
+
+func (loader *loader) loadAliases(ctx context.Context) error {
+	options := &resourcecontrollerv2.ListResourceAliasesOptions{ResourceGroupID: stringPtrOrNil(loader.options.ResourceGroupID)}
+	for {
+		result, _, err := loader.options.ResourceController.ListResourceAliasesWithContext(ctx, options)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-resource-aliases-error")
+		}
+		loader.aliases = append(loader.aliases, result.Resources...)
+		options.Start, err = result.GetNextStart()
+		if err != nil || options.Start == nil {
+			return err
+		}
+	}
+}
+
+func (loader *loader) loadBindings(ctx context.Context) error {
+	options := &resourcecontrollerv2.ListResourceBindingsOptions{ResourceGroupID: stringPtrOrNil(loader.options.ResourceGroupID)}
+	for {
+		result, _, err := loader.options.ResourceController.ListResourceBindingsWithContext(ctx, options)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-resource-bindings-error")
+		}
+		loader.bindings = append(loader.bindings, result.Resources...)
+		options.Start, err = result.GetNextStart()
+		if err != nil || options.Start == nil {
+			return err
+		}
+	}
+}
+
+func (loader *loader) search(ctx context.Context) error {
+	if loader.options.GlobalSearch == nil {
+		return nil
+	}
+	query := loader.options.SearchQuery
+	if query == "" {
+		query = "*"
+	}
+	if loader.options.ResourceGroupID != "" {
+		query = fmt.Sprintf("(%s) AND resource_group_id:%s", query, loader.options.ResourceGroupID)
+	}
+	options := &globalsearchv2.SearchOptions{
+		Query:     core.StringPtr(query),
+		Fields:    searchFields,
+		AccountID: stringPtrOrNil(loader.options.AccountID),
+		Limit:     core.Int64Ptr(1000),
+	}
+	for {
+		result, _, err := loader.options.GlobalSearch.SearchWithContext(ctx, options)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "search-error")
+		}
+		loader.results = append(loader.results, result.Items...)
+		if result.SearchCursor == nil || len(result.Items) < int(*options.Limit) {
+			return nil
+		}
+		options.SearchCursor = result.SearchCursor
+	}
+}
+
+// build assembles the listed resources into a graph.
+func (loader *loader) build() *Graph {
+	graph := NewGraph()
+	groups := map[string]string{}
+	for i := range loader.groups {
+		group := &loader.groups[i]
+		node := graph.AddNode(&Node{
+			CRN:      crnOrID(group.CRN, group.ID),
+			ID:       core.StringNilMapper(group.ID),
+			Kind:     NodeKindResourceGroupConst,
+			Name:     core.StringNilMapper(group.Name),
+			State:    core.StringNilMapper(group.State),
+			Resource: group,
+		})
+		groups[node.ID] = node.CRN
+	}
+	instances := map[string]string{}
+	for i := range loader.instances {
+		instance := &loader.instances[i]
+		node := graph.AddNode(&Node{
+			CRN:             crnOrID(instance.CRN, instance.ID),
+			ID:              core.StringNilMapper(instance.ID),
+			Kind:            NodeKindResourceInstanceConst,
+			Name:            core.StringNilMapper(instance.Name),
+			ResourceGroupID: core.StringNilMapper(instance.ResourceGroupID),
+			State:           core.StringNilMapper(instance.State),
+			Resource:        instance,
+		})
+		instances[node.ID] = node.CRN
+		if instance.GUID != nil {
+			instances[*instance.GUID] = node.CRN
+		}
+		graph.AddEdge(groups[node.ResourceGroupID], node.CRN, RelationContainsConst)
+	}
+	for i := range loader.aliases {
+		alias := &loader.aliases[i]
+		node := graph.AddNode(&Node{
+			CRN:             crnOrID(alias.CRN, alias.ID),
+			ID:              core.StringNilMapper(alias.ID),
+			Kind:            NodeKindResourceAliasConst,
+			Name:            core.StringNilMapper(alias.Name),
+			ResourceGroupID: core.StringNilMapper(alias.ResourceGroupID),
+			State:           core.StringNilMapper(alias.State),
+			Resource:        alias,
+		})
+		graph.AddEdge(instances[core.StringNilMapper(alias.ResourceInstanceID)], node.CRN, RelationAliasConst)
+	}
+	for i := range loader.keys {
+		key := &loader.keys[i]
+		node := graph.AddNode(&Node{
+			CRN:             crnOrID(key.CRN, key.ID),
+			ID:              core.StringNilMapper(key.ID),
+			Kind:            NodeKindResourceKeyConst,
+			Name:            core.StringNilMapper(key.Name),
+			ResourceGroupID: core.StringNilMapper(key.ResourceGroupID),
+			State:           core.StringNilMapper(key.State),
+			Resource:        key,
+		})
+		graph.AddEdge(core.StringNilMapper(key.SourceCRN), node.CRN, RelationKeyConst)
+	}
+	for i := range loader.bindings {
+		binding := &loader.bindings[i]
+		node := graph.AddNode(&Node{
+			CRN:             crnOrID(binding.CRN, binding.ID),
+			ID:              core.StringNilMapper(binding.ID),
+			Kind:            NodeKindResourceBindingConst,
+			Name:            core.StringNilMapper(binding.Name),
+			ResourceGroupID: core.StringNilMapper(binding.ResourceGroupID),
+			State:           core.StringNilMapper(binding.State),
+			Resource:        binding,
+		})
+		graph.AddEdge(core.StringNilMapper(binding.SourceCRN), node.CRN, RelationBindingConst)
+	}
+
+	loader.searched = map[string]bool{}
+	for i := range loader.results {
+		result := &loader.results[i]
+		node := graph.AddNode(&Node{
+			CRN:      core.StringNilMapper(result.CRN),
+			Kind:     NodeKindResourceConst,
+			Resource: result,
+		})
+		if node.Kind == NodeKindResourceConst {
+			node.Type = stringProperty(result, "type")
+			node.Name = stringProperty(result, "name")
+			node.ResourceGroupID = stringProperty(result, "resource_group_id")
+			graph.AddEdge(groups[node.ResourceGroupID], node.CRN, RelationContainsConst)
+		}
+		if tags, ok := result.GetProperty("tags").([]interface{}); ok {
+			node.Tags = nil
+			for _, tag := range tags {
+				if tag, ok := tag.(string); ok {
+					node.Tags = append(node.Tags, tag)
+				}
+			}
+			loader.searched[node.CRN] = true
+		}
+	}
+	return graph
+}
+
+// loadTags looks up the tags of the instances and resource groups whose tags were not returned by global search.
+func (loader *loader) loadTags(ctx context.Context, graph *Graph) error {
+	if loader.options.GlobalTagging == nil {
+		return nil
+	}
+	var nodes []*Node
+	for _, node := range graph.Nodes(NodeKindResourceGroupConst, NodeKindResourceInstanceConst) {
+		if !loader.searched[node.CRN] {
+			nodes = append(nodes, node)
+		}
+	}
+	errs := common.ForEach(ctx, len(nodes), loader.options.Concurrency, func(ctx context.Context, i int) error {
+		options := &globaltaggingv1.ListTagsOptions{
+			AccountID:  stringPtrOrNil(loader.options.AccountID),
+			TagType:    core.StringPtr(globaltaggingv1.ListTagsOptionsTagTypeUserConst),
+			AttachedTo: core.StringPtr(nodes[i].CRN),
+			Limit:      core.Int64Ptr(1000),
+		}
+		result, _, err := loader.options.GlobalTagging.ListTagsWithContext(ctx, options)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-tags-error")
+		}
+		nodes[i].Tags = nil
+		for _, tag := range result.Items {
+			nodes[i].Tags = append(nodes[i].Tags, core.StringNilMapper(tag.Name))
+		}
+		return nil
+	})
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func crnOrID(crn *string, id *string) string {
+	if crn != nil && *crn != "" {
+		return *crn
+	}
+	return core.StringNilMapper(id)
+}
+
+func stringProperty(item *globalsearchv2.ResultItem, key string) string {
+	value, _ := item.GetProperty(key).(string)
+	return value
+}
+
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return core.StringPtr(s)
+}
@@ -226,7 +226,7 @@ func (logsRouter *LogsRouterV3) CreateTargetWithContext(ctx context.Context, cre
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "CreateTarget"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/targets`, nil)
 	if err != nil {
@@ -274,7 +274,7 @@ func (logsRouter *LogsRouterV3) CreateTargetWithContext(ctx context.Context, cre
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -309,7 +309,7 @@ func (logsRouter *LogsRouterV3) ListTargetsWithContext(ctx context.Context, list
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "ListTargets"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/targets`, nil)
 	if err != nil {
@@ -337,7 +337,7 @@ func (logsRouter *LogsRouterV3) ListTargetsWithContext(ctx context.Context, list
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_targets", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -381,7 +381,7 @@ func (logsRouter *LogsRouterV3) GetTargetWithContext(ctx context.Context, getTar
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "GetTarget"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/targets/{id}`, pathParamsMap)
 	if err != nil {
@@ -409,7 +409,7 @@ func (logsRouter *LogsRouterV3) GetTargetWithContext(ctx context.Context, getTar
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -453,7 +453,7 @@ func (logsRouter *LogsRouterV3) UpdateTargetWithContext(ctx context.Context, upd
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "UpdateTarget"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/targets/{id}`, pathParamsMap)
 	if err != nil {
@@ -495,7 +495,7 @@ func (logsRouter *LogsRouterV3) UpdateTargetWithContext(ctx context.Context, upd
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -536,7 +536,7 @@ func (logsRouter *LogsRouterV3) DeleteTargetWithContext(ctx context.Context, del
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "DeleteTarget"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/targets/{id}`, pathParamsMap)
 	if err != nil {
@@ -562,7 +562,7 @@ func (logsRouter *LogsRouterV3) DeleteTargetWithContext(ctx context.Context, del
 	response, err = logsRouter.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -594,7 +594,7 @@ func (logsRouter *LogsRouterV3) CreateRouteWithContext(ctx context.Context, crea
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "CreateRoute"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/routes`, nil)
 	if err != nil {
@@ -639,7 +639,7 @@ func (logsRouter *LogsRouterV3) CreateRouteWithContext(ctx context.Context, crea
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -671,7 +671,7 @@ func (logsRouter *LogsRouterV3) ListRoutesWithContext(ctx context.Context, listR
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "ListRoutes"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/routes`, nil)
 	if err != nil {
@@ -699,7 +699,7 @@ func (logsRouter *LogsRouterV3) ListRoutesWithContext(ctx context.Context, listR
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_routes", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -742,7 +742,7 @@ func (logsRouter *LogsRouterV3) GetRouteWithContext(ctx context.Context, getRout
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "GetRoute"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/routes/{id}`, pathParamsMap)
 	if err != nil {
@@ -770,7 +770,7 @@ func (logsRouter *LogsRouterV3) GetRouteWithContext(ctx context.Context, getRout
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -814,7 +814,7 @@ func (logsRouter *LogsRouterV3) UpdateRouteWithContext(ctx context.Context, upda
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "UpdateRoute"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/routes/{id}`, pathParamsMap)
 	if err != nil {
@@ -856,7 +856,7 @@ func (logsRouter *LogsRouterV3) UpdateRouteWithContext(ctx context.Context, upda
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -897,7 +897,7 @@ func (logsRouter *LogsRouterV3) DeleteRouteWithContext(ctx context.Context, dele
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "DeleteRoute"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/routes/{id}`, pathParamsMap)
 	if err != nil {
@@ -923,7 +923,7 @@ func (logsRouter *LogsRouterV3) DeleteRouteWithContext(ctx context.Context, dele
 	response, err = logsRouter.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -947,7 +947,7 @@ func (logsRouter *LogsRouterV3) GetSettingsWithContext(ctx context.Context, getS
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "GetSettings"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/settings`, nil)
 	if err != nil {
@@ -975,7 +975,7 @@ func (logsRouter *LogsRouterV3) GetSettingsWithContext(ctx context.Context, getS
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1020,7 +1020,7 @@ func (logsRouter *LogsRouterV3) UpdateSettingsWithContext(ctx context.Context, u
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "UpdateSettings"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/settings`, nil)
 	if err != nil {
@@ -1074,7 +1074,7 @@ func (logsRouter *LogsRouterV3) UpdateSettingsWithContext(ctx context.Context, u
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1111,7 +1111,7 @@ func (logsRouter *LogsRouterV3) QueryDestinationsWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "QueryDestinations"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/destinations/query`, nil)
 	if err != nil {
@@ -1150,7 +1150,7 @@ func (logsRouter *LogsRouterV3) QueryDestinationsWithContext(ctx context.Context
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "query_destinations", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1189,7 +1189,7 @@ func (logsRouter *LogsRouterV3) MigrateActionsWithContext(ctx context.Context, m
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "MigrateActions"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/migrate`, nil)
 	if err != nil {
@@ -1219,7 +1219,7 @@ func (logsRouter *LogsRouterV3) MigrateActionsWithContext(ctx context.Context, m
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "migrate_actions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1252,7 +1252,7 @@ func (logsRouter *LogsRouterV3) GetMigrationStatusWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "GetMigrationStatus"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/migrate`, nil)
 	if err != nil {
@@ -1280,7 +1280,7 @@ func (logsRouter *LogsRouterV3) GetMigrationStatusWithContext(ctx context.Contex
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_migration_status", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1313,7 +1313,7 @@ func (logsRouter *LogsRouterV3) ResetMigrationWithContext(ctx context.Context, r
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "logs_router", "V3", "ResetMigration"))
 	builder.EnableGzipCompression = logsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(logsRouter.Service.Options.URL, `/migrate`, nil)
 	if err != nil {
@@ -1341,7 +1341,7 @@ func (logsRouter *LogsRouterV3) ResetMigrationWithContext(ctx context.Context, r
 	response, err = logsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "reset_migration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,96 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package logsrouterv3
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// LogsRouterV3Intf : The operations of the LogsRouterV3 service client.
+// The interface is satisfied by *LogsRouterV3 and by mocks.LogsRouterV3, allowing consumers to substitute a mock for the client in tests.
+type LogsRouterV3Intf interface {
+	// CreateTarget : Create a target
+	CreateTarget(createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// CreateTargetWithContext is an alternate form of the CreateTarget method which supports a Context parameter
+	CreateTargetWithContext(ctx context.Context, createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// ListTargets : List targets
+	ListTargets(listTargetsOptions *ListTargetsOptions) (result *TargetCollection, response *core.DetailedResponse, err error)
+	// ListTargetsWithContext is an alternate form of the ListTargets method which supports a Context parameter
+	ListTargetsWithContext(ctx context.Context, listTargetsOptions *ListTargetsOptions) (result *TargetCollection, response *core.DetailedResponse, err error)
+	// GetTarget : Get details of a target
+	GetTarget(getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// GetTargetWithContext is an alternate form of the GetTarget method which supports a Context parameter
+	GetTargetWithContext(ctx context.Context, getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// UpdateTarget : Update a target
+	UpdateTarget(updateTargetOptions *UpdateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// UpdateTargetWithContext is an alternate form of the UpdateTarget method which supports a Context parameter
+	UpdateTargetWithContext(ctx context.Context, updateTargetOptions *UpdateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+	// DeleteTarget : Delete a target
+	DeleteTarget(deleteTargetOptions *DeleteTargetOptions) (response *core.DetailedResponse, err error)
+	// DeleteTargetWithContext is an alternate form of the DeleteTarget method which supports a Context parameter
+	DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *DeleteTargetOptions) (response *core.DetailedResponse, err error)
+	// CreateRoute : Create a route
+	CreateRoute(createRouteOptions *CreateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// CreateRouteWithContext is an alternate form of the CreateRoute method which supports a Context parameter
+	CreateRouteWithContext(ctx context.Context, createRouteOptions *CreateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// ListRoutes : List routes
+	ListRoutes(listRoutesOptions *ListRoutesOptions) (result *RouteCollection, response *core.DetailedResponse, err error)
+	// ListRoutesWithContext is an alternate form of the ListRoutes method which supports a Context parameter
+	ListRoutesWithContext(ctx context.Context, listRoutesOptions *ListRoutesOptions) (result *RouteCollection, response *core.DetailedResponse, err error)
+	// GetRoute : Get details of a route
+	GetRoute(getRouteOptions *GetRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// GetRouteWithContext is an alternate form of the GetRoute method which supports a Context parameter
+	GetRouteWithContext(ctx context.Context, getRouteOptions *GetRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// UpdateRoute : Update a route
+	UpdateRoute(updateRouteOptions *UpdateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// UpdateRouteWithContext is an alternate form of the UpdateRoute method which supports a Context parameter
+	UpdateRouteWithContext(ctx context.Context, updateRouteOptions *UpdateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+	// DeleteRoute : Delete a route
+	DeleteRoute(deleteRouteOptions *DeleteRouteOptions) (response *core.DetailedResponse, err error)
+	// DeleteRouteWithContext is an alternate form of the DeleteRoute method which supports a Context parameter
+	DeleteRouteWithContext(ctx context.Context, deleteRouteOptions *DeleteRouteOptions) (response *core.DetailedResponse, err error)
+	// GetSettings : Get settings
+	GetSettings(getSettingsOptions *GetSettingsOptions) (result *Setting, response *core.DetailedResponse, err error)
+	// GetSettingsWithContext is an alternate form of the GetSettings method which supports a Context parameter
+	GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *Setting, response *core.DetailedResponse, err error)
+	// UpdateSettings : Modify settings
+	UpdateSettings(updateSettingsOptions *UpdateSettingsOptions) (result *Setting, response *core.DetailedResponse, err error)
+	// UpdateSettingsWithContext is an alternate form of the UpdateSettings method which supports a Context parameter
+	UpdateSettingsWithContext(ctx context.Context, updateSettingsOptions *UpdateSettingsOptions) (result *Setting, response *core.DetailedResponse, err error)
+	// QueryDestinations : Query Destinations
+	QueryDestinations(queryDestinationsOptions *QueryDestinationsOptions) (result *DestinationsQuery, response *core.DetailedResponse, err error)
+	// QueryDestinationsWithContext is an alternate form of the QueryDestinations method which supports a Context parameter
+	QueryDestinationsWithContext(ctx context.Context, queryDestinationsOptions *QueryDestinationsOptions) (result *DestinationsQuery, response *core.DetailedResponse, err error)
+	// MigrateActions : Migrate from API version 1 to version 3
+	MigrateActions(migrateActionsOptions *MigrateActionsOptions) (result *MigrationState, response *core.DetailedResponse, err error)
+	// MigrateActionsWithContext is an alternate form of the MigrateActions method which supports a Context parameter
+	MigrateActionsWithContext(ctx context.Context, migrateActionsOptions *MigrateActionsOptions) (result *MigrationState, response *core.DetailedResponse, err error)
+	// GetMigrationStatus : Get migration status
+	GetMigrationStatus(getMigrationStatusOptions *GetMigrationStatusOptions) (result *MigrationState, response *core.DetailedResponse, err error)
+	// GetMigrationStatusWithContext is an alternate form of the GetMigrationStatus method which supports a Context parameter
+	GetMigrationStatusWithContext(ctx context.Context, getMigrationStatusOptions *GetMigrationStatusOptions) (result *MigrationState, response *core.DetailedResponse, err error)
+	// ResetMigration : Reset migration state
+	ResetMigration(resetMigrationOptions *ResetMigrationOptions) (result *MigrationState, response *core.DetailedResponse, err error)
+	// ResetMigrationWithContext is an alternate form of the ResetMigration method which supports a Context parameter
+	ResetMigrationWithContext(ctx context.Context, resetMigrationOptions *ResetMigrationOptions) (result *MigrationState, response *core.DetailedResponse, err error)
+}
+
+var _ LogsRouterV3Intf = (*LogsRouterV3)(nil)
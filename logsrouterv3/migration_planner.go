@@ -0,0 +1,492 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logsrouterv3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+const (
+	defaultMigrationPollInterval = 10 * time.Second
+	defaultMigrationTimeout      = 30 * time.Minute
+
+	// Limits of the logs router API.
+	maxRulesPerRoute       = 10
+	maxInclusionFilterSize = 20
+)
+
+// Constants associated with TargetMigration.Status.
+const (
+	TargetMigrationStatusExistingConst    = "existing"
+	TargetMigrationStatusCreateConst      = "create"
+	TargetMigrationStatusUnsupportedConst = "unsupported"
+)
+
+// TargetMigration : How an Activity Tracker target carries over to the logs router.
+type TargetMigration struct {
+	// The Activity Tracker target.
+	Source *atrackerv2.Target
+
+	// One of existing (a logs router target already sends to the same destination), create (a new logs router target
+	// is needed) or unsupported (the logs router has no equivalent target type).
+	Status string
+
+	// The logs router target with the same destination, when Status is existing.
+	Destination *Target
+
+	// The logs router target to create, when Status is create.
+	Prototype *CreateTargetOptions
+}
+
+// DestinationID returns the ID of the logs router target, or "" if the target does not exist yet.
+func (migration *TargetMigration) DestinationID() string {
+	if migration.Destination != nil {
+		return core.StringNilMapper(migration.Destination.ID)
+	}
+	return ""
+}
+
+// RuleMigration : The translation of an Activity Tracker rule into a logs router rule.
+type RuleMigration struct {
+	// The Activity Tracker rule.
+	Source *atrackerv2.Rule
+
+	// The equivalent logs router rule, or nil if none of the rule's targets can be migrated.
+	// Targets that do not exist yet in the logs router have no ID.
+	Rule *RulePrototype
+
+	// The migrations of the rule's targets that are carried over, in the order of Rule.Targets.
+	Targets []*TargetMigration
+}
+
+// RouteMigration : The translation of an Activity Tracker route into a logs router route.
+type RouteMigration struct {
+	// The Activity Tracker route.
+	Source *atrackerv2.Route
+
+	// The logs router route with the same name, if one exists.
+	Destination *Route
+
+	// The translated rules, in the order of the source route.
+	Rules []RuleMigration
+}
+
+// SettingsMigration : The logs router settings equivalent to the Activity Tracker settings.
+type SettingsMigration struct {
+	// The migrations of the default targets that are carried over.
+	DefaultTargets []*TargetMigration
+
+	PermittedTargetRegions []string
+	PrimaryMetadataRegion  string
+	BackupMetadataRegion   string
+	PrivateAPIEndpointOnly bool
+}
+
+// MigrationWarning : A feature or setting that does not carry over to the logs router as is.
+type MigrationWarning struct {
+	// The Activity Tracker resource concerned, such as "target t-1" or "route r-1 rule 2".
+	Subject string
+
+	// Description of the problem.
+	Message string
+}
+
+func (warning MigrationWarning) String() string {
+	return warning.Subject + ": " + warning.Message
+}
+
+// MigrationInventory : The configuration of both services, as input for a migration plan.
+type MigrationInventory struct {
+	ActivityTrackerTargets  []atrackerv2.Target
+	ActivityTrackerRoutes   []atrackerv2.Route
+	ActivityTrackerSettings *atrackerv2.Settings
+
+	Targets  []Target
+	Routes   []Route
+	Settings *Setting
+}
+
+// MigrationPlan : A side-by-side mapping of the Activity Tracker configuration to the logs router.
+type MigrationPlan struct {
+	Targets  []*TargetMigration
+	Routes   []RouteMigration
+	Settings *SettingsMigration
+	Warnings []MigrationWarning
+
+	// The migration state of the account when the plan was made, if known.
+	State *MigrationState
+}
+
+// PlanMigration maps an Activity Tracker configuration onto the logs router. It does not contact either service.
+func PlanMigration(inventory *MigrationInventory) *MigrationPlan {
+	plan := &MigrationPlan{
+		Targets:  []*TargetMigration{},
+		Routes:   []RouteMigration{},
+		Warnings: []MigrationWarning{},
+	}
+	warn := func(subject string, format string, args ...interface{}) {
+		plan.Warnings = append(plan.Warnings, MigrationWarning{Subject: subject, Message: fmt.Sprintf(format, args...)})
+	}
+
+	byID := map[string]*TargetMigration{}
+	for i := range inventory.ActivityTrackerTargets {
+		source := &inventory.ActivityTrackerTargets[i]
+		migration := planTargetMigration(source, inventory.Targets)
+		subject := "target " + core.StringNilMapper(source.Name)
+		if migration.Status == TargetMigrationStatusUnsupportedConst {
+			warn(subject, "target type '%s' is not supported by the logs router; events routed to it will not be migrated",
+				core.StringNilMapper(source.TargetType))
+		}
+		if source.WriteStatus != nil && core.StringNilMapper(source.WriteStatus.Status) == "failed" {
+			warn(subject, "the target is failing to receive events: %s", core.StringNilMapper(source.WriteStatus.ReasonForLastFailure))
+		}
+		byID[core.StringNilMapper(source.ID)] = migration
+		plan.Targets = append(plan.Targets, migration)
+	}
+
+	mapTargets := func(subject string, ids []string) (identities []TargetIdentity, migrations []*TargetMigration) {
+		identities = []TargetIdentity{}
+		migrations = []*TargetMigration{}
+		for _, id := range ids {
+			migration, ok := byID[id]
+			if !ok {
+				warn(subject, "target '%s' does not exist", id)
+				continue
+			}
+			if migration.Status == TargetMigrationStatusUnsupportedConst {
+				continue
+			}
+			identity := TargetIdentity{}
+			if destinationID := migration.DestinationID(); destinationID != "" {
+				identity.ID = core.StringPtr(destinationID)
+			}
+			identities = append(identities, identity)
+			migrations = append(migrations, migration)
+		}
+		return
+	}
+
+	for i := range inventory.ActivityTrackerRoutes {
+		source := &inventory.ActivityTrackerRoutes[i]
+		routeMigration := RouteMigration{Source: source, Rules: []RuleMigration{}}
+		for j := range inventory.Routes {
+			if core.StringNilMapper(inventory.Routes[j].Name) == core.StringNilMapper(source.Name) {
+				routeMigration.Destination = &inventory.Routes[j]
+				break
+			}
+		}
+		if len(source.Rules) > maxRulesPerRoute {
+			warn("route "+core.StringNilMapper(source.Name), "the logs router allows at most %d rules per route; the route has %d",
+				maxRulesPerRoute, len(source.Rules))
+		}
+		for j := range source.Rules {
+			rule := &source.Rules[j]
+			subject := fmt.Sprintf("route %s rule %d", core.StringNilMapper(source.Name), j)
+			ruleMigration := RuleMigration{Source: rule}
+			identities, migrations := mapTargets(subject, rule.TargetIds)
+			if len(identities) == 0 {
+				warn(subject, "none of the rule's targets can be migrated; the rule will be omitted")
+			} else {
+				ruleMigration.Rule = &RulePrototype{
+					Action:           core.StringPtr(RulePrototypeActionSendConst),
+					Targets:          identities,
+					InclusionFilters: translateLocations(rule.Locations),
+				}
+				ruleMigration.Targets = migrations
+				if len(rule.Locations) > maxInclusionFilterSize && ruleMigration.Rule.InclusionFilters != nil {
+					warn(subject, "the logs router allows at most %d values per inclusion filter; the rule has %d locations",
+						maxInclusionFilterSize, len(rule.Locations))
+				}
+			}
+			routeMigration.Rules = append(routeMigration.Rules, ruleMigration)
+		}
+		plan.Routes = append(plan.Routes, routeMigration)
+	}
+
+	if settings := inventory.ActivityTrackerSettings; settings != nil {
+		_, defaults := mapTargets("settings", settings.DefaultTargets)
+		if len(settings.DefaultTargets) > 0 && len(defaults) == 0 {
+			warn("settings", "none of the default targets can be migrated; unrouted events will be discarded")
+		}
+		plan.Settings = &SettingsMigration{
+			DefaultTargets:         defaults,
+			PermittedTargetRegions: settings.PermittedTargetRegions,
+			PrimaryMetadataRegion:  core.StringNilMapper(settings.MetadataRegionPrimary),
+			BackupMetadataRegion:   core.StringNilMapper(settings.MetadataRegionBackup),
+			PrivateAPIEndpointOnly: settings.PrivateAPIEndpointOnly != nil && *settings.PrivateAPIEndpointOnly,
+		}
+		if current := inventory.Settings; current != nil {
+			if primary := core.StringNilMapper(current.PrimaryMetadataRegion); primary != "" && primary != plan.Settings.PrimaryMetadataRegion {
+				warn("settings", "the logs router primary metadata region '%s' differs from the Activity Tracker one '%s'",
+					primary, plan.Settings.PrimaryMetadataRegion)
+			}
+		}
+	}
+	return plan
+}
+
+// planTargetMigration finds or proposes the logs router target equivalent to an Activity Tracker target.
+func planTargetMigration(source *atrackerv2.Target, targets []Target) *TargetMigration {
+	migration := &TargetMigration{Source: source}
+	if core.StringNilMapper(source.TargetType) != atrackerv2.TargetTargetTypeCloudLogsConst || source.CloudlogsEndpoint == nil {
+		migration.Status = TargetMigrationStatusUnsupportedConst
+		return migration
+	}
+
+	destinationCRN := core.StringNilMapper(source.CloudlogsEndpoint.TargetCRN)
+	for i := range targets {
+		if core.StringNilMapper(targets[i].DestinationCRN) == destinationCRN {
+			migration.Status = TargetMigrationStatusExistingConst
+			migration.Destination = &targets[i]
+			return migration
+		}
+	}
+	migration.Status = TargetMigrationStatusCreateConst
+	migration.Prototype = &CreateTargetOptions{
+		Name:           source.Name,
+		DestinationCRN: core.StringPtr(destinationCRN),
+		Region:         source.Region,
+		ManagedBy:      source.ManagedBy,
+	}
+	return migration
+}
+
+// translateLocations converts the locations of an Activity Tracker rule into logs router inclusion filters.
+// The "*" location matches every event, which is expressed by a rule without inclusion filters.
+func translateLocations(locations []string) []InclusionFilterPrototype {
+	for _, location := range locations {
+		if location == atrackerv2.RouteLocationAnyConst {
+			return nil
+		}
+	}
+	operator := InclusionFilterPrototypeOperatorInConst
+	if len(locations) == 1 {
+		operator = InclusionFilterPrototypeOperatorIsConst
+	}
+	return []InclusionFilterPrototype{{
+		Operand:  core.StringPtr(InclusionFilterPrototypeOperandLocationConst),
+		Operator: core.StringPtr(operator),
+		Values:   locations,
+	}}
+}
+
+// String renders the plan as side-by-side tables of targets and rules, followed by the warnings.
+func (plan *MigrationPlan) String() string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ACTIVITY TRACKER TARGET\tTYPE\tLOGS ROUTER TARGET\tSTATUS")
+	for _, target := range plan.Targets {
+		destination := "-"
+		switch target.Status {
+		case TargetMigrationStatusExistingConst:
+			destination = core.StringNilMapper(target.Destination.Name)
+		case TargetMigrationStatusCreateConst:
+			destination = core.StringNilMapper(target.Prototype.Name)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", core.StringNilMapper(target.Source.Name), core.StringNilMapper(target.Source.TargetType),
+			destination, target.Status)
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "ROUTE\tRULE\tLOCATIONS\tLOGS ROUTER RULE")
+	for _, route := range plan.Routes {
+		for i, rule := range route.Rules {
+			translated := "omitted"
+			if rule.Rule != nil {
+				names := make([]string, 0, len(rule.Targets))
+				for _, target := range rule.Targets {
+					names = append(names, core.StringNilMapper(target.Source.Name))
+				}
+				filter := "all locations"
+				if len(rule.Rule.InclusionFilters) > 0 {
+					f := rule.Rule.InclusionFilters[0]
+					filter = fmt.Sprintf("location %s [%s]", core.StringNilMapper(f.Operator), strings.Join(f.Values, ", "))
+				}
+				translated = fmt.Sprintf("send to %s where %s", strings.Join(names, ", "), filter)
+			}
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", core.StringNilMapper(route.Source.Name), i, strings.Join(rule.Source.Locations, ", "), translated)
+		}
+	}
+	_ = w.Flush()
+
+	if len(plan.Warnings) > 0 {
+		b.WriteString("\nWarnings:\n")
+		for _, warning := range plan.Warnings {
+			fmt.Fprintf(&b, "- %s\n", warning)
+		}
+	}
+	return b.String()
+}
+
+// MigrationPlannerOptions : The options for a MigrationPlanner.
+type MigrationPlannerOptions struct {
+	// The Activity Tracker client of the account to migrate.
+	ActivityTracker atrackerv2.AtrackerV2Intf `validate:"required,structonly"`
+
+	// How often to poll the migration state while it is in progress. Defaults to 10 seconds.
+	PollInterval time.Duration
+
+	// How long to wait for the migration to finish. Defaults to 30 minutes.
+	Timeout time.Duration
+
+	// If set, Migrate stops once the migration is pending completion, so that the result can be reviewed
+	// before it is completed with a later call to Migrate.
+	SkipCompletion bool
+}
+
+// MigrationPlanner : Plans and drives the migration of an account from Activity Tracker to the logs router.
+type MigrationPlanner struct {
+	logsRouter *LogsRouterV3
+	options    *MigrationPlannerOptions
+}
+
+// NewMigrationPlanner : Instantiate MigrationPlanner
+func (logsRouter *LogsRouterV3) NewMigrationPlanner(options *MigrationPlannerOptions) (planner *MigrationPlanner, err error) {
+	if core.IsNil(options) {
+		err = core.SDKErrorf(fmt.Errorf("options cannot be nil"), "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	planner = &MigrationPlanner{logsRouter: logsRouter, options: options}
+	return
+}
+
+// Plan reads the configuration of both services and the current migration state, and returns the migration plan.
+func (planner *MigrationPlanner) Plan(ctx context.Context) (plan *MigrationPlan, err error) {
+	inventory, err := planner.inventory(ctx)
+	if err != nil {
+		return
+	}
+	plan = PlanMigration(inventory)
+	plan.State, _, err = planner.logsRouter.GetMigrationStatusWithContext(ctx, planner.logsRouter.NewGetMigrationStatusOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-migration-status-error")
+	}
+	return
+}
+
+func (planner *MigrationPlanner) inventory(ctx context.Context) (inventory *MigrationInventory, err error) {
+	atracker := planner.options.ActivityTracker
+	inventory = &MigrationInventory{}
+
+	atTargets, _, err := atracker.ListTargetsWithContext(ctx, &atrackerv2.ListTargetsOptions{})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-atracker-targets-error")
+		return
+	}
+	inventory.ActivityTrackerTargets = atTargets.Targets
+	atRoutes, _, err := atracker.ListRoutesWithContext(ctx, &atrackerv2.ListRoutesOptions{})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-atracker-routes-error")
+		return
+	}
+	inventory.ActivityTrackerRoutes = atRoutes.Routes
+	inventory.ActivityTrackerSettings, _, err = atracker.GetSettingsWithContext(ctx, &atrackerv2.GetSettingsOptions{})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-atracker-settings-error")
+		return
+	}
+
+	targets, _, err := planner.logsRouter.ListTargetsWithContext(ctx, planner.logsRouter.NewListTargetsOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-targets-error")
+		return
+	}
+	inventory.Targets = targets.Targets
+	routes, _, err := planner.logsRouter.ListRoutesWithContext(ctx, planner.logsRouter.NewListRoutesOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-routes-error")
+		return
+	}
+	inventory.Routes = routes.Routes
+	inventory.Settings, _, err = planner.logsRouter.GetSettingsWithContext(ctx, planner.logsRouter.NewGetSettingsOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-settings-error")
+	}
+	return
+}
+
+// Migrate drives the migration to completion: it starts the migration if it has not started, polls the migration
+// state while it is in progress and completes it once it is pending completion (unless SkipCompletion is set).
+// It returns the last migration state observed.
+func (planner *MigrationPlanner) Migrate(ctx context.Context) (state *MigrationState, err error) {
+	pollInterval := planner.options.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultMigrationPollInterval
+	}
+	timeout := planner.options.Timeout
+	if timeout <= 0 {
+		timeout = defaultMigrationTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	logsRouter := planner.logsRouter
+	state, _, err = logsRouter.GetMigrationStatusWithContext(ctx, logsRouter.NewGetMigrationStatusOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-migration-status-error")
+		return
+	}
+	for {
+		switch core.StringNilMapper(state.State) {
+		case MigrationStateStateCompleteConst:
+			return
+		case MigrationStateStateBeforeConst:
+			_, _, err = logsRouter.MigrateActionsWithContext(ctx, logsRouter.NewMigrateActionsOptions(MigrateActionsOptionsActionGenerateConst))
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "migrate-generate-error")
+				return
+			}
+		case MigrationStateStatePendingCompletionConst:
+			if planner.options.SkipCompletion {
+				return
+			}
+			_, _, err = logsRouter.MigrateActionsWithContext(ctx, logsRouter.NewMigrateActionsOptions(MigrateActionsOptionsActionCompleteConst))
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "migrate-complete-error")
+				return
+			}
+		}
+
+		// The actions are asynchronous; the migration state is polled until it reaches the next step.
+		select {
+		case <-ctx.Done():
+			err = core.SDKErrorf(ctx.Err(), fmt.Sprintf("timed out waiting for the migration; last state '%s'", core.StringNilMapper(state.State)),
+				"migration-timeout", common.GetComponentInfo())
+			return
+		case <-time.After(pollInterval):
+		}
+		state, _, err = logsRouter.GetMigrationStatusWithContext(ctx, logsRouter.NewGetMigrationStatusOptions())
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-migration-status-error")
+			return
+		}
+	}
+}
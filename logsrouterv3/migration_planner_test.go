@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logsrouterv3_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/logsrouterv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`MigrationPlanner`, func() {
+	inventory := &logsrouterv3.MigrationInventory{
+		ActivityTrackerTargets: []atrackerv2.Target{
+			{
+				ID: core.StringPtr("at-logs"), Name: core.StringPtr("logs"), TargetType: core.StringPtr(atrackerv2.TargetTargetTypeCloudLogsConst),
+				CloudlogsEndpoint: &atrackerv2.CloudLogsEndpoint{TargetCRN: core.StringPtr("crn:v1:bluemix:public:logs:us-south:a/1:inst1::")},
+			},
+			{
+				ID: core.StringPtr("at-logs2"), Name: core.StringPtr("logs2"), TargetType: core.StringPtr(atrackerv2.TargetTargetTypeCloudLogsConst),
+				Region:            core.StringPtr("eu-de"),
+				CloudlogsEndpoint: &atrackerv2.CloudLogsEndpoint{TargetCRN: core.StringPtr("crn:v1:bluemix:public:logs:eu-de:a/1:inst2::")},
+			},
+			{
+				ID: core.StringPtr("at-cos"), Name: core.StringPtr("cos"), TargetType: core.StringPtr(atrackerv2.TargetTargetTypeCloudObjectStorageConst),
+				WriteStatus: &atrackerv2.WriteStatus{Status: core.StringPtr("failed"), ReasonForLastFailure: core.StringPtr("forbidden")},
+			},
+		},
+		ActivityTrackerRoutes: []atrackerv2.Route{{
+			ID:   core.StringPtr("r1"),
+			Name: core.StringPtr("main"),
+			Rules: []atrackerv2.Rule{
+				{TargetIds: []string{"at-logs", "at-cos"}, Locations: []string{"us-south", "us-east"}},
+				{TargetIds: []string{"at-cos"}, Locations: []string{"eu-de"}},
+				{TargetIds: []string{"at-logs2"}, Locations: []string{"*"}},
+			},
+		}},
+		ActivityTrackerSettings: &atrackerv2.Settings{
+			DefaultTargets:         []string{"at-cos"},
+			PermittedTargetRegions: []string{"us-south"},
+			MetadataRegionPrimary:  core.StringPtr("us-south"),
+		},
+		Targets: []logsrouterv3.Target{
+			{ID: core.StringPtr("lr-1"), Name: core.StringPtr("existing"), DestinationCRN: core.StringPtr("crn:v1:bluemix:public:logs:us-south:a/1:inst1::")},
+		},
+	}
+
+	It(`Maps targets, rules and settings and warns on unsupported features`, func() {
+		plan := logsrouterv3.PlanMigration(inventory)
+
+		Expect(plan.Targets).To(HaveLen(3))
+		Expect(plan.Targets[0].Status).To(Equal(logsrouterv3.TargetMigrationStatusExistingConst))
+		Expect(plan.Targets[0].DestinationID()).To(Equal("lr-1"))
+		Expect(plan.Targets[1].Status).To(Equal(logsrouterv3.TargetMigrationStatusCreateConst))
+		Expect(*plan.Targets[1].Prototype.DestinationCRN).To(Equal("crn:v1:bluemix:public:logs:eu-de:a/1:inst2::"))
+		Expect(*plan.Targets[1].Prototype.Region).To(Equal("eu-de"))
+		Expect(plan.Targets[2].Status).To(Equal(logsrouterv3.TargetMigrationStatusUnsupportedConst))
+
+		rules := plan.Routes[0].Rules
+		Expect(rules).To(HaveLen(3))
+		Expect(rules[0].Rule.Targets).To(HaveLen(1))
+		Expect(*rules[0].Rule.Targets[0].ID).To(Equal("lr-1"))
+		Expect(*rules[0].Rule.InclusionFilters[0].Operator).To(Equal(logsrouterv3.InclusionFilterPrototypeOperatorInConst))
+		Expect(rules[0].Rule.InclusionFilters[0].Values).To(Equal([]string{"us-south", "us-east"}))
+		Expect(rules[1].Rule).To(BeNil())
+		Expect(rules[2].Rule.InclusionFilters).To(BeEmpty())
+		Expect(rules[2].Rule.Targets[0].ID).To(BeNil())
+
+		Expect(plan.Settings.DefaultTargets).To(BeEmpty())
+		Expect(plan.Settings.PrimaryMetadataRegion).To(Equal("us-south"))
+
+		warnings := []string{}
+		for _, warning := range plan.Warnings {
+			warnings = append(warnings, warning.String())
+		}
+		Expect(warnings).To(ConsistOf(
+			ContainSubstring("target cos: target type 'cloud_object_storage' is not supported"),
+			ContainSubstring("target cos: the target is failing"),
+			ContainSubstring("route main rule 1: none of the rule's targets"),
+			ContainSubstring("settings: none of the default targets"),
+		))
+
+		table := plan.String()
+		Expect(table).To(ContainSubstring("logs2"))
+		Expect(table).To(ContainSubstring("send to logs where location in [us-south, us-east]"))
+		Expect(table).To(ContainSubstring("Warnings:"))
+	})
+
+	It(`Plans from both services and drives the migration to completion`, func() {
+		state := logsrouterv3.MigrationStateStateBeforeConst
+		polls := 0
+		actions := []string{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.Path {
+			case "GET /api/v2/targets", "GET /targets":
+				fmt.Fprint(res, `{"targets": []}`)
+			case "GET /api/v2/routes", "GET /routes":
+				fmt.Fprint(res, `{"routes": []}`)
+			case "GET /api/v2/settings":
+				fmt.Fprint(res, `{"default_targets": [], "metadata_region_primary": "us-south"}`)
+			case "GET /settings":
+				fmt.Fprint(res, `{"default_targets": []}`)
+			case "GET /migrate":
+				polls++
+				if state == logsrouterv3.MigrationStateStateInProgressConst && polls > 3 {
+					state = logsrouterv3.MigrationStateStatePendingCompletionConst
+				}
+				fmt.Fprintf(res, `{"api_version": 1, "state": "%s", "message": ""}`, state)
+			case "POST /migrate":
+				action := req.URL.Query().Get("action")
+				actions = append(actions, action)
+				if action == "generate" {
+					state = logsrouterv3.MigrationStateStateInProgressConst
+					res.WriteHeader(202)
+				} else {
+					state = logsrouterv3.MigrationStateStateCompleteConst
+				}
+				fmt.Fprintf(res, `{"api_version": 1, "state": "%s", "message": ""}`, state)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.Path)
+			}
+		}))
+		defer testServer.Close()
+
+		atracker, err := atrackerv2.NewAtrackerV2(&atrackerv2.AtrackerV2Options{URL: testServer.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		Expect(err).To(BeNil())
+		service, err := logsrouterv3.NewLogsRouterV3(&logsrouterv3.LogsRouterV3Options{URL: testServer.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		Expect(err).To(BeNil())
+
+		_, err = service.NewMigrationPlanner(&logsrouterv3.MigrationPlannerOptions{})
+		Expect(err).ToNot(BeNil())
+
+		planner, err := service.NewMigrationPlanner(&logsrouterv3.MigrationPlannerOptions{ActivityTracker: atracker, PollInterval: time.Millisecond})
+		Expect(err).To(BeNil())
+
+		plan, err := planner.Plan(context.Background())
+		Expect(err).To(BeNil())
+		Expect(*plan.State.State).To(Equal(logsrouterv3.MigrationStateStateBeforeConst))
+		Expect(plan.Settings.PrimaryMetadataRegion).To(Equal("us-south"))
+
+		final, err := planner.Migrate(context.Background())
+		Expect(err).To(BeNil())
+		Expect(*final.State).To(Equal(logsrouterv3.MigrationStateStateCompleteConst))
+		Expect(actions).To(Equal([]string{"generate", "complete"}))
+	})
+	It(`Does not validate the fields of the Activity Tracker client`, func() {
+		service, err := logsrouterv3.NewLogsRouterV3(&logsrouterv3.LogsRouterV3Options{URL: "https://logs.example.com", Authenticator: &core.NoAuthAuthenticator{}})
+		Expect(err).To(BeNil())
+		_, err = service.NewMigrationPlanner(&logsrouterv3.MigrationPlannerOptions{ActivityTracker: &taggedActivityTracker{}})
+		Expect(err).To(BeNil())
+	})
+})
+
+// taggedActivityTracker : An Activity Tracker client with a field that does not pass validation, which must not be
+// reached when the options holding the client are validated.
+type taggedActivityTracker struct {
+	atrackerv2.AtrackerV2Intf
+	Name *string `validate:"required"`
+}
@@ -0,0 +1,502 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the LogsRouterV3 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/logsrouterv3"
+	"github.com/stretchr/testify/mock"
+)
+
+// LogsRouterV3 : A testify mock of the LogsRouterV3 service client.
+type LogsRouterV3 struct {
+	mock.Mock
+}
+
+var _ logsrouterv3.LogsRouterV3Intf = (*LogsRouterV3)(nil)
+
+// CreateTarget provides a mock function with the given fields.
+func (_m *LogsRouterV3) CreateTarget(createTargetOptions *logsrouterv3.CreateTargetOptions) (*logsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(createTargetOptions)
+	var r0 *logsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTargetWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) CreateTargetWithContext(ctx context.Context, createTargetOptions *logsrouterv3.CreateTargetOptions) (*logsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createTargetOptions)
+	var r0 *logsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTargets provides a mock function with the given fields.
+func (_m *LogsRouterV3) ListTargets(listTargetsOptions *logsrouterv3.ListTargetsOptions) (*logsrouterv3.TargetCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listTargetsOptions)
+	var r0 *logsrouterv3.TargetCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.TargetCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTargetsWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) ListTargetsWithContext(ctx context.Context, listTargetsOptions *logsrouterv3.ListTargetsOptions) (*logsrouterv3.TargetCollection, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, listTargetsOptions)
+	var r0 *logsrouterv3.TargetCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.TargetCollection)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTarget provides a mock function with the given fields.
+func (_m *LogsRouterV3) GetTarget(getTargetOptions *logsrouterv3.GetTargetOptions) (*logsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(getTargetOptions)
+	var r0 *logsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTargetWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) GetTargetWithContext(ctx context.Context, getTargetOptions *logsrouterv3.GetTargetOptions) (*logsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getTargetOptions)
+	var r0 *logsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateTarget provides a mock function with the given fields.
+func (_m *LogsRouterV3) UpdateTarget(updateTargetOptions *logsrouterv3.UpdateTargetOptions) (*logsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(updateTargetOptions)
+	var r0 *logsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateTargetWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) UpdateTargetWithContext(ctx context.Context, updateTargetOptions *logsrouterv3.UpdateTargetOptions) (*logsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateTargetOptions)
+	var r0 *logsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTarget provides a mock function with the given fields.
+func (_m *LogsRouterV3) DeleteTarget(deleteTargetOptions *logsrouterv3.DeleteTargetOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteTargetOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteTargetWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *logsrouterv3.DeleteTargetOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteTargetOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateRoute provides a mock function with the given fields.
+func (_m *LogsRouterV3) CreateRoute(createRouteOptions *logsrouterv3.CreateRouteOptions) (*logsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(createRouteOptions)
+	var r0 *logsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRouteWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) CreateRouteWithContext(ctx context.Context, createRouteOptions *logsrouterv3.CreateRouteOptions) (*logsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRouteOptions)
+	var r0 *logsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoutes provides a mock function with the given fields.
+func (_m *LogsRouterV3) ListRoutes(listRoutesOptions *logsrouterv3.ListRoutesOptions) (*logsrouterv3.RouteCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listRoutesOptions)
+	var r0 *logsrouterv3.RouteCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.RouteCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoutesWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) ListRoutesWithContext(ctx context.Context, listRoutesOptions *logsrouterv3.ListRoutesOptions) (*logsrouterv3.RouteCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listRoutesOptions)
+	var r0 *logsrouterv3.RouteCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.RouteCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRoute provides a mock function with the given fields.
+func (_m *LogsRouterV3) GetRoute(getRouteOptions *logsrouterv3.GetRouteOptions) (*logsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(getRouteOptions)
+	var r0 *logsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRouteWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) GetRouteWithContext(ctx context.Context, getRouteOptions *logsrouterv3.GetRouteOptions) (*logsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRouteOptions)
+	var r0 *logsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateRoute provides a mock function with the given fields.
+func (_m *LogsRouterV3) UpdateRoute(updateRouteOptions *logsrouterv3.UpdateRouteOptions) (*logsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(updateRouteOptions)
+	var r0 *logsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateRouteWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) UpdateRouteWithContext(ctx context.Context, updateRouteOptions *logsrouterv3.UpdateRouteOptions) (*logsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateRouteOptions)
+	var r0 *logsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteRoute provides a mock function with the given fields.
+func (_m *LogsRouterV3) DeleteRoute(deleteRouteOptions *logsrouterv3.DeleteRouteOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRouteOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteRouteWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) DeleteRouteWithContext(ctx context.Context, deleteRouteOptions *logsrouterv3.DeleteRouteOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRouteOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetSettings provides a mock function with the given fields.
+func (_m *LogsRouterV3) GetSettings(getSettingsOptions *logsrouterv3.GetSettingsOptions) (*logsrouterv3.Setting, *core.DetailedResponse, error) {
+	ret := _m.Called(getSettingsOptions)
+	var r0 *logsrouterv3.Setting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Setting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetSettingsWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) GetSettingsWithContext(ctx context.Context, getSettingsOptions *logsrouterv3.GetSettingsOptions) (*logsrouterv3.Setting, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getSettingsOptions)
+	var r0 *logsrouterv3.Setting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Setting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateSettings provides a mock function with the given fields.
+func (_m *LogsRouterV3) UpdateSettings(updateSettingsOptions *logsrouterv3.UpdateSettingsOptions) (*logsrouterv3.Setting, *core.DetailedResponse, error) {
+	ret := _m.Called(updateSettingsOptions)
+	var r0 *logsrouterv3.Setting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Setting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateSettingsWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) UpdateSettingsWithContext(ctx context.Context, updateSettingsOptions *logsrouterv3.UpdateSettingsOptions) (*logsrouterv3.Setting, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateSettingsOptions)
+	var r0 *logsrouterv3.Setting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.Setting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// QueryDestinations provides a mock function with the given fields.
+func (_m *LogsRouterV3) QueryDestinations(queryDestinationsOptions *logsrouterv3.QueryDestinationsOptions) (*logsrouterv3.DestinationsQuery, *core.DetailedResponse, error) {
+	ret := _m.Called(queryDestinationsOptions)
+	var r0 *logsrouterv3.DestinationsQuery
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.DestinationsQuery)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// QueryDestinationsWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) QueryDestinationsWithContext(ctx context.Context, queryDestinationsOptions *logsrouterv3.QueryDestinationsOptions) (*logsrouterv3.DestinationsQuery, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, queryDestinationsOptions)
+	var r0 *logsrouterv3.DestinationsQuery
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.DestinationsQuery)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// MigrateActions provides a mock function with the given fields.
+func (_m *LogsRouterV3) MigrateActions(migrateActionsOptions *logsrouterv3.MigrateActionsOptions) (*logsrouterv3.MigrationState, *core.DetailedResponse, error) {
+	ret := _m.Called(migrateActionsOptions)
+	var r0 *logsrouterv3.MigrationState
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.MigrationState)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// MigrateActionsWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) MigrateActionsWithContext(ctx context.Context, migrateActionsOptions *logsrouterv3.MigrateActionsOptions) (*logsrouterv3.MigrationState, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, migrateActionsOptions)
+	var r0 *logsrouterv3.MigrationState
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.MigrationState)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetMigrationStatus provides a mock function with the given fields.
+func (_m *LogsRouterV3) GetMigrationStatus(getMigrationStatusOptions *logsrouterv3.GetMigrationStatusOptions) (*logsrouterv3.MigrationState, *core.DetailedResponse, error) {
+	ret := _m.Called(getMigrationStatusOptions)
+	var r0 *logsrouterv3.MigrationState
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.MigrationState)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetMigrationStatusWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) GetMigrationStatusWithContext(ctx context.Context, getMigrationStatusOptions *logsrouterv3.GetMigrationStatusOptions) (*logsrouterv3.MigrationState, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getMigrationStatusOptions)
+	var r0 *logsrouterv3.MigrationState
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.MigrationState)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ResetMigration provides a mock function with the given fields.
+func (_m *LogsRouterV3) ResetMigration(resetMigrationOptions *logsrouterv3.ResetMigrationOptions) (*logsrouterv3.MigrationState, *core.DetailedResponse, error) {
+	ret := _m.Called(resetMigrationOptions)
+	var r0 *logsrouterv3.MigrationState
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.MigrationState)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ResetMigrationWithContext provides a mock function with the given fields.
+func (_m *LogsRouterV3) ResetMigrationWithContext(ctx context.Context, resetMigrationOptions *logsrouterv3.ResetMigrationOptions) (*logsrouterv3.MigrationState, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, resetMigrationOptions)
+	var r0 *logsrouterv3.MigrationState
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*logsrouterv3.MigrationState)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
@@ -0,0 +1,82 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logsrouterv3_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/logsrouterv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`MultiRegionLogsRouterV3`, func() {
+	It(`Creates a client for every supported region`, func() {
+		Expect(logsrouterv3.SupportedRegions).To(ContainElement("eu-de"))
+		service, err := logsrouterv3.NewMultiRegionLogsRouterV3(&logsrouterv3.MultiRegionLogsRouterV3Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			Private:       true,
+		})
+		Expect(err).To(BeNil())
+		Expect(service.Clients).To(HaveLen(len(logsrouterv3.SupportedRegions)))
+		Expect(service.Client("eu-de").GetServiceURL()).To(Equal("https://api.private.eu-de.logs-router.cloud.ibm.com/v3"))
+
+		_, err = logsrouterv3.NewMultiRegionLogsRouterV3(&logsrouterv3.MultiRegionLogsRouterV3Options{
+			Authenticator: &core.NoAuthAuthenticator{},
+			Regions:       []string{"mars-north"},
+		})
+		Expect(err).ToNot(BeNil())
+	})
+
+	// The merging of regional results is tested with common.MultiRegionClient; this only checks that
+	// each operation is sent to the regional client.
+	It(`Sends each operation to the regional client`, func() {
+		var paths []string
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			paths = append(paths, req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"id": "x"}`)
+		}))
+		defer server.Close()
+		client, err := logsrouterv3.NewLogsRouterV3(&logsrouterv3.LogsRouterV3Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		Expect(err).To(BeNil())
+		service := &logsrouterv3.MultiRegionLogsRouterV3{MultiRegionClient: common.MultiRegionClient[*logsrouterv3.LogsRouterV3]{
+			Regions: []string{"us-south"},
+			Clients: map[string]*logsrouterv3.LogsRouterV3{"us-south": client},
+		}}
+
+		_, err = service.ListTargets(context.Background())
+		Expect(err).To(BeNil())
+		target, err := service.GetTarget(context.Background(), "x")
+		Expect(err).To(BeNil())
+		Expect(target.Region).To(Equal("us-south"))
+		_, err = service.ListRoutes(context.Background())
+		Expect(err).To(BeNil())
+		route, err := service.GetRoute(context.Background(), "x")
+		Expect(err).To(BeNil())
+		Expect(*route.Value.ID).To(Equal("x"))
+		settings, err := service.GetSettings(context.Background())
+		Expect(err).To(BeNil())
+		Expect(settings).To(HaveLen(1))
+		Expect(paths).To(Equal([]string{"/targets", "/targets/x", "/routes", "/routes/x", "/settings"}))
+	})
+})
@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logsrouterv3
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// RouteEventLocationGlobal is the location of platform logs that are not generated in a region.
+const RouteEventLocationGlobal = "global"
+
+// RouteEvent : A synthetic platform log event, used to evaluate routes locally.
+type RouteEvent struct {
+	// The location in which the event is generated, such as "us-south". When empty, the location is taken from SourceCRN.
+	Region string
+
+	// The CRN of the resource that generated the event.
+	SourceCRN string
+
+	// Set for global platform events, which are routed with the location "global".
+	Global bool
+}
+
+// Location returns the location used to match the event against inclusion filters.
+func (event *RouteEvent) Location() string {
+	if event.Global {
+		return RouteEventLocationGlobal
+	}
+	if event.Region != "" {
+		return event.Region
+	}
+	return event.sourceCRN().Location
+}
+
+// sourceCRN returns the parsed SourceCRN of the event, or an empty CRN if it is not a valid CRN.
+func (event *RouteEvent) sourceCRN() *common.CRN {
+	crn, err := common.ParseCRN(event.SourceCRN)
+	if err != nil {
+		return &common.CRN{}
+	}
+	return crn
+}
+
+// operandValue returns the value of the event compared with the values of an inclusion filter.
+func (event *RouteEvent) operandValue(operand string) string {
+	switch operand {
+	case InclusionFilterOperandLocationConst:
+		return event.Location()
+	}
+	return ""
+}
+
+// RouteMatch : The outcome of evaluating one route for an event.
+type RouteMatch struct {
+	// The evaluated route.
+	Route *Route
+
+	// The index of the first rule of the route that matched the event, or -1 if none did.
+	RuleIndex int
+
+	// The rule that matched the event, if any.
+	Rule *Rule
+
+	// The action of the matched rule: send or drop.
+	Action string
+}
+
+// Matched returns true if one of the route's rules matched the event.
+func (match *RouteMatch) Matched() bool {
+	return match.Rule != nil
+}
+
+// RouteEvaluation : Where an event is routed.
+type RouteEvaluation struct {
+	// The evaluated event.
+	Event *RouteEvent
+
+	// The outcome for each route, in the order in which the routes were provided.
+	Matches []RouteMatch
+
+	// The targets that receive the event.
+	Targets []TargetReference
+
+	// Set if no rule matched the event and it was sent to the account's default targets.
+	DefaultTargetsUsed bool
+}
+
+// Dropped returns true if the event is not sent to any target.
+func (evaluation *RouteEvaluation) Dropped() bool {
+	return len(evaluation.Targets) == 0
+}
+
+// ShadowedRule : A rule that can never match because an earlier rule of the same route matches every event it would.
+type ShadowedRule struct {
+	// The ID of the route that contains the rule.
+	RouteID string
+
+	// The name of the route that contains the rule.
+	RouteName string
+
+	// The index of the unreachable rule.
+	RuleIndex int
+
+	// The index of the earlier rule that shadows it.
+	ShadowedBy int
+}
+
+// RouteEvaluator : Evaluates the routes of an account locally, to audit where platform logs are sent.
+type RouteEvaluator struct {
+	// The routes of the account.
+	Routes []Route
+
+	// The targets that receive events that match no rule.
+	DefaultTargets []TargetReference
+}
+
+// NewRouteEvaluator : Instantiate RouteEvaluator
+func NewRouteEvaluator(routes []Route, defaultTargets []TargetReference) *RouteEvaluator {
+	return &RouteEvaluator{
+		Routes:         routes,
+		DefaultTargets: defaultTargets,
+	}
+}
+
+// LoadRouteEvaluator fetches the routes and default targets of the account and returns an evaluator for them.
+func (logsRouter *LogsRouterV3) LoadRouteEvaluator(ctx context.Context) (evaluator *RouteEvaluator, err error) {
+	routes, _, err := logsRouter.ListRoutesWithContext(ctx, logsRouter.NewListRoutesOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-routes-error")
+		return
+	}
+	settings, _, err := logsRouter.GetSettingsWithContext(ctx, logsRouter.NewGetSettingsOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-settings-error")
+		return
+	}
+	evaluator = NewRouteEvaluator(routes.Routes, settings.DefaultTargets)
+	return
+}
+
+// Evaluate determines where the specified event is sent. Every route is evaluated; within a route, the first
+// matching rule decides the action. The event is sent to the targets of every matched "send" rule, or to the
+// default targets if no rule of any route matched it.
+func (evaluator *RouteEvaluator) Evaluate(event *RouteEvent) *RouteEvaluation {
+	evaluation := &RouteEvaluation{
+		Event:   event,
+		Matches: make([]RouteMatch, 0, len(evaluator.Routes)),
+		Targets: []TargetReference{},
+	}
+
+	seen := map[string]bool{}
+	addTargets := func(targets []TargetReference) {
+		for _, target := range targets {
+			id := core.StringNilMapper(target.ID)
+			if !seen[id] {
+				seen[id] = true
+				evaluation.Targets = append(evaluation.Targets, target)
+			}
+		}
+	}
+
+	matched := false
+	for i := range evaluator.Routes {
+		route := &evaluator.Routes[i]
+		match := RouteMatch{Route: route, RuleIndex: -1}
+		for j := range route.Rules {
+			rule := &route.Rules[j]
+			if ruleMatches(rule, event) {
+				match.RuleIndex = j
+				match.Rule = rule
+				match.Action = ruleAction(rule)
+				break
+			}
+		}
+		if match.Matched() {
+			matched = true
+			if match.Action == RuleActionSendConst {
+				addTargets(match.Rule.Targets)
+			}
+		}
+		evaluation.Matches = append(evaluation.Matches, match)
+	}
+
+	if !matched {
+		evaluation.DefaultTargetsUsed = true
+		addTargets(evaluator.DefaultTargets)
+	}
+	return evaluation
+}
+
+// ShadowedRules returns the rules that can never match an event because an earlier rule
+// of the same route matches a superset of the events that they match.
+func (evaluator *RouteEvaluator) ShadowedRules() []ShadowedRule {
+	shadowed := []ShadowedRule{}
+	for _, route := range evaluator.Routes {
+		for j := 1; j < len(route.Rules); j++ {
+			for i := 0; i < j; i++ {
+				if filtersCover(route.Rules[i].InclusionFilters, route.Rules[j].InclusionFilters) {
+					shadowed = append(shadowed, ShadowedRule{
+						RouteID:    core.StringNilMapper(route.ID),
+						RouteName:  core.StringNilMapper(route.Name),
+						RuleIndex:  j,
+						ShadowedBy: i,
+					})
+					break
+				}
+			}
+		}
+	}
+	return shadowed
+}
+
+// ruleMatches returns true if the event satisfies every inclusion filter of the rule.
+// A rule without inclusion filters matches every event.
+func ruleMatches(rule *Rule, event *RouteEvent) bool {
+	for _, filter := range rule.InclusionFilters {
+		value := event.operandValue(core.StringNilMapper(filter.Operand))
+		if !common.ContainsString(filter.Values, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func ruleAction(rule *Rule) string {
+	if action := core.StringNilMapper(rule.Action); action != "" {
+		return action
+	}
+	return RuleActionSendConst
+}
+
+// filtersCover returns true if every event that satisfies "later" also satisfies "earlier":
+// each filter of "earlier" must be implied by a filter of "later" on the same operand.
+func filtersCover(earlier []InclusionFilter, later []InclusionFilter) bool {
+	for _, e := range earlier {
+		implied := false
+		for _, l := range later {
+			if core.StringNilMapper(l.Operand) == core.StringNilMapper(e.Operand) && isSubset(l.Values, e.Values) {
+				implied = true
+				break
+			}
+		}
+		if !implied {
+			return false
+		}
+	}
+	return true
+}
+
+func isSubset(values []string, of []string) bool {
+	for _, value := range values {
+		if !common.ContainsString(of, value) {
+			return false
+		}
+	}
+	return true
+}
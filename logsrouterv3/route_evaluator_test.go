@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logsrouterv3_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/logsrouterv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RouteEvaluator`, func() {
+	target := func(id string) logsrouterv3.TargetReference {
+		return logsrouterv3.TargetReference{ID: core.StringPtr(id), Name: core.StringPtr(id)}
+	}
+	filter := func(operator string, values ...string) logsrouterv3.InclusionFilter {
+		return logsrouterv3.InclusionFilter{
+			Operand:  core.StringPtr(logsrouterv3.InclusionFilterOperandLocationConst),
+			Operator: core.StringPtr(operator),
+			Values:   values,
+		}
+	}
+	routes := []logsrouterv3.Route{
+		{
+			ID:   core.StringPtr("route-1"),
+			Name: core.StringPtr("regional"),
+			Rules: []logsrouterv3.Rule{
+				{Action: core.StringPtr(logsrouterv3.RuleActionDropConst), Targets: []logsrouterv3.TargetReference{}, InclusionFilters: []logsrouterv3.InclusionFilter{filter("is", "eu-de")}},
+				{Targets: []logsrouterv3.TargetReference{target("t-us")}, InclusionFilters: []logsrouterv3.InclusionFilter{filter("in", "us-south", "us-east")}},
+				{Targets: []logsrouterv3.TargetReference{target("t-south")}, InclusionFilters: []logsrouterv3.InclusionFilter{filter("is", "us-south")}},
+			},
+		},
+		{
+			ID:   core.StringPtr("route-2"),
+			Name: core.StringPtr("global"),
+			Rules: []logsrouterv3.Rule{
+				{Targets: []logsrouterv3.TargetReference{target("t-global"), target("t-us")}, InclusionFilters: []logsrouterv3.InclusionFilter{filter("is", "global")}},
+			},
+		},
+	}
+	evaluator := logsrouterv3.NewRouteEvaluator(routes, []logsrouterv3.TargetReference{target("t-default")})
+
+	It(`Resolves the matched rule, action and targets`, func() {
+		evaluation := evaluator.Evaluate(&logsrouterv3.RouteEvent{SourceCRN: "crn:v1:bluemix:public:is:us-south:a/123::instance:abc"})
+		Expect(evaluation.Matches).To(HaveLen(2))
+		Expect(evaluation.Matches[0].RuleIndex).To(Equal(1))
+		Expect(evaluation.Matches[0].Action).To(Equal(logsrouterv3.RuleActionSendConst))
+		Expect(evaluation.Matches[1].Matched()).To(BeFalse())
+		Expect(evaluation.Targets).To(ConsistOf(target("t-us")))
+		Expect(evaluation.DefaultTargetsUsed).To(BeFalse())
+
+		evaluation = evaluator.Evaluate(&logsrouterv3.RouteEvent{Global: true})
+		Expect(evaluation.Targets).To(Equal([]logsrouterv3.TargetReference{target("t-global"), target("t-us")}))
+
+		evaluation = evaluator.Evaluate(&logsrouterv3.RouteEvent{Region: "eu-de"})
+		Expect(evaluation.Matches[0].Action).To(Equal(logsrouterv3.RuleActionDropConst))
+		Expect(evaluation.Dropped()).To(BeTrue())
+		Expect(evaluation.DefaultTargetsUsed).To(BeFalse())
+
+		evaluation = evaluator.Evaluate(&logsrouterv3.RouteEvent{Region: "jp-tok"})
+		Expect(evaluation.DefaultTargetsUsed).To(BeTrue())
+		Expect(evaluation.Targets).To(ConsistOf(target("t-default")))
+	})
+
+	It(`Flags rules shadowed by earlier rules`, func() {
+		shadowed := evaluator.ShadowedRules()
+		Expect(shadowed).To(Equal([]logsrouterv3.ShadowedRule{
+			{RouteID: "route-1", RouteName: "regional", RuleIndex: 2, ShadowedBy: 1},
+		}))
+
+		catchAll := logsrouterv3.NewRouteEvaluator([]logsrouterv3.Route{{
+			ID:    core.StringPtr("route-3"),
+			Rules: []logsrouterv3.Rule{{}, {InclusionFilters: []logsrouterv3.InclusionFilter{filter("is", "us-south")}}},
+		}}, nil)
+		Expect(catchAll.ShadowedRules()).To(HaveLen(1))
+	})
+
+	It(`Loads routes and default targets from the service`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path {
+			case "/routes":
+				fmt.Fprint(res, `{"routes": [{"id": "route-1", "name": "r", "rules": [{"targets": [{"id": "t-1"}], "inclusion_filters": [{"operand": "location", "operator": "is", "values": ["us-south"]}]}]}]}`)
+			case "/settings":
+				fmt.Fprint(res, `{"default_targets": [{"id": "t-default"}]}`)
+			default:
+				Fail("unexpected request " + req.URL.Path)
+			}
+		}))
+		defer testServer.Close()
+
+		service, serviceErr := logsrouterv3.NewLogsRouterV3(&logsrouterv3.LogsRouterV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		loaded, err := service.LoadRouteEvaluator(context.Background())
+		Expect(err).To(BeNil())
+		Expect(loaded.Routes).To(HaveLen(1))
+		Expect(*loaded.Evaluate(&logsrouterv3.RouteEvent{Region: "us-south"}).Targets[0].ID).To(Equal("t-1"))
+		Expect(*loaded.Evaluate(&logsrouterv3.RouteEvent{Region: "eu-gb"}).Targets[0].ID).To(Equal("t-default"))
+	})
+})
@@ -232,7 +232,7 @@ func (metricsRouter *MetricsRouterV3) CreateTargetWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "CreateTarget"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/targets`, nil)
 	if err != nil {
@@ -280,7 +280,7 @@ func (metricsRouter *MetricsRouterV3) CreateTargetWithContext(ctx context.Contex
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -315,7 +315,7 @@ func (metricsRouter *MetricsRouterV3) ListTargetsWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "ListTargets"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/targets`, nil)
 	if err != nil {
@@ -343,7 +343,7 @@ func (metricsRouter *MetricsRouterV3) ListTargetsWithContext(ctx context.Context
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_targets", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -387,7 +387,7 @@ func (metricsRouter *MetricsRouterV3) GetTargetWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "GetTarget"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/targets/{id}`, pathParamsMap)
 	if err != nil {
@@ -415,7 +415,7 @@ func (metricsRouter *MetricsRouterV3) GetTargetWithContext(ctx context.Context,
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -459,7 +459,7 @@ func (metricsRouter *MetricsRouterV3) UpdateTargetWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "UpdateTarget"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/targets/{id}`, pathParamsMap)
 	if err != nil {
@@ -501,7 +501,7 @@ func (metricsRouter *MetricsRouterV3) UpdateTargetWithContext(ctx context.Contex
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -542,7 +542,7 @@ func (metricsRouter *MetricsRouterV3) DeleteTargetWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "DeleteTarget"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/targets/{id}`, pathParamsMap)
 	if err != nil {
@@ -568,7 +568,7 @@ func (metricsRouter *MetricsRouterV3) DeleteTargetWithContext(ctx context.Contex
 	response, err = metricsRouter.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -600,7 +600,7 @@ func (metricsRouter *MetricsRouterV3) CreateRouteWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "CreateRoute"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/routes`, nil)
 	if err != nil {
@@ -645,7 +645,7 @@ func (metricsRouter *MetricsRouterV3) CreateRouteWithContext(ctx context.Context
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -677,7 +677,7 @@ func (metricsRouter *MetricsRouterV3) ListRoutesWithContext(ctx context.Context,
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "ListRoutes"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/routes`, nil)
 	if err != nil {
@@ -705,7 +705,7 @@ func (metricsRouter *MetricsRouterV3) ListRoutesWithContext(ctx context.Context,
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_routes", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -748,7 +748,7 @@ func (metricsRouter *MetricsRouterV3) GetRouteWithContext(ctx context.Context, g
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "GetRoute"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/routes/{id}`, pathParamsMap)
 	if err != nil {
@@ -776,7 +776,7 @@ func (metricsRouter *MetricsRouterV3) GetRouteWithContext(ctx context.Context, g
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -820,7 +820,7 @@ func (metricsRouter *MetricsRouterV3) UpdateRouteWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "UpdateRoute"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/routes/{id}`, pathParamsMap)
 	if err != nil {
@@ -862,7 +862,7 @@ func (metricsRouter *MetricsRouterV3) UpdateRouteWithContext(ctx context.Context
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -903,7 +903,7 @@ func (metricsRouter *MetricsRouterV3) DeleteRouteWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "DeleteRoute"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/routes/{id}`, pathParamsMap)
 	if err != nil {
@@ -929,7 +929,7 @@ func (metricsRouter *MetricsRouterV3) DeleteRouteWithContext(ctx context.Context
 	response, err = metricsRouter.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_route", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -953,7 +953,7 @@ func (metricsRouter *MetricsRouterV3) GetSettingsWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "GetSettings"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/settings`, nil)
 	if err != nil {
@@ -981,7 +981,7 @@ func (metricsRouter *MetricsRouterV3) GetSettingsWithContext(ctx context.Context
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1022,7 +1022,7 @@ func (metricsRouter *MetricsRouterV3) UpdateSettingsWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "metrics_router", "V3", "UpdateSettings"))
 	builder.EnableGzipCompression = metricsRouter.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(metricsRouter.Service.Options.URL, `/settings`, nil)
 	if err != nil {
@@ -1073,7 +1073,7 @@ func (metricsRouter *MetricsRouterV3) UpdateSettingsWithContext(ctx context.Cont
 	response, err = metricsRouter.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,382 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the MetricsRouterV3 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
+	"github.com/stretchr/testify/mock"
+)
+
+// MetricsRouterV3 : A testify mock of the MetricsRouterV3 service client.
+type MetricsRouterV3 struct {
+	mock.Mock
+}
+
+var _ metricsrouterv3.MetricsRouterV3Intf = (*MetricsRouterV3)(nil)
+
+// CreateTarget provides a mock function with the given fields.
+func (_m *MetricsRouterV3) CreateTarget(createTargetOptions *metricsrouterv3.CreateTargetOptions) (*metricsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(createTargetOptions)
+	var r0 *metricsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateTargetWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) CreateTargetWithContext(ctx context.Context, createTargetOptions *metricsrouterv3.CreateTargetOptions) (*metricsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createTargetOptions)
+	var r0 *metricsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTargets provides a mock function with the given fields.
+func (_m *MetricsRouterV3) ListTargets(listTargetsOptions *metricsrouterv3.ListTargetsOptions) (*metricsrouterv3.TargetCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listTargetsOptions)
+	var r0 *metricsrouterv3.TargetCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.TargetCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListTargetsWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) ListTargetsWithContext(ctx context.Context, listTargetsOptions *metricsrouterv3.ListTargetsOptions) (*metricsrouterv3.TargetCollection, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, listTargetsOptions)
+	var r0 *metricsrouterv3.TargetCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.TargetCollection)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTarget provides a mock function with the given fields.
+func (_m *MetricsRouterV3) GetTarget(getTargetOptions *metricsrouterv3.GetTargetOptions) (*metricsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(getTargetOptions)
+	var r0 *metricsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetTargetWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) GetTargetWithContext(ctx context.Context, getTargetOptions *metricsrouterv3.GetTargetOptions) (*metricsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getTargetOptions)
+	var r0 *metricsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateTarget provides a mock function with the given fields.
+func (_m *MetricsRouterV3) UpdateTarget(updateTargetOptions *metricsrouterv3.UpdateTargetOptions) (*metricsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(updateTargetOptions)
+	var r0 *metricsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateTargetWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) UpdateTargetWithContext(ctx context.Context, updateTargetOptions *metricsrouterv3.UpdateTargetOptions) (*metricsrouterv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateTargetOptions)
+	var r0 *metricsrouterv3.Target
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Target)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteTarget provides a mock function with the given fields.
+func (_m *MetricsRouterV3) DeleteTarget(deleteTargetOptions *metricsrouterv3.DeleteTargetOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteTargetOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteTargetWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *metricsrouterv3.DeleteTargetOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteTargetOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateRoute provides a mock function with the given fields.
+func (_m *MetricsRouterV3) CreateRoute(createRouteOptions *metricsrouterv3.CreateRouteOptions) (*metricsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(createRouteOptions)
+	var r0 *metricsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRouteWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) CreateRouteWithContext(ctx context.Context, createRouteOptions *metricsrouterv3.CreateRouteOptions) (*metricsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRouteOptions)
+	var r0 *metricsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoutes provides a mock function with the given fields.
+func (_m *MetricsRouterV3) ListRoutes(listRoutesOptions *metricsrouterv3.ListRoutesOptions) (*metricsrouterv3.RouteCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listRoutesOptions)
+	var r0 *metricsrouterv3.RouteCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.RouteCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListRoutesWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) ListRoutesWithContext(ctx context.Context, listRoutesOptions *metricsrouterv3.ListRoutesOptions) (*metricsrouterv3.RouteCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listRoutesOptions)
+	var r0 *metricsrouterv3.RouteCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.RouteCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRoute provides a mock function with the given fields.
+func (_m *MetricsRouterV3) GetRoute(getRouteOptions *metricsrouterv3.GetRouteOptions) (*metricsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(getRouteOptions)
+	var r0 *metricsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRouteWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) GetRouteWithContext(ctx context.Context, getRouteOptions *metricsrouterv3.GetRouteOptions) (*metricsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRouteOptions)
+	var r0 *metricsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateRoute provides a mock function with the given fields.
+func (_m *MetricsRouterV3) UpdateRoute(updateRouteOptions *metricsrouterv3.UpdateRouteOptions) (*metricsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(updateRouteOptions)
+	var r0 *metricsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateRouteWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) UpdateRouteWithContext(ctx context.Context, updateRouteOptions *metricsrouterv3.UpdateRouteOptions) (*metricsrouterv3.Route, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateRouteOptions)
+	var r0 *metricsrouterv3.Route
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Route)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteRoute provides a mock function with the given fields.
+func (_m *MetricsRouterV3) DeleteRoute(deleteRouteOptions *metricsrouterv3.DeleteRouteOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRouteOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteRouteWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) DeleteRouteWithContext(ctx context.Context, deleteRouteOptions *metricsrouterv3.DeleteRouteOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRouteOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetSettings provides a mock function with the given fields.
+func (_m *MetricsRouterV3) GetSettings(getSettingsOptions *metricsrouterv3.GetSettingsOptions) (*metricsrouterv3.Setting, *core.DetailedResponse, error) {
+	ret := _m.Called(getSettingsOptions)
+	var r0 *metricsrouterv3.Setting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Setting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetSettingsWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) GetSettingsWithContext(ctx context.Context, getSettingsOptions *metricsrouterv3.GetSettingsOptions) (*metricsrouterv3.Setting, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getSettingsOptions)
+	var r0 *metricsrouterv3.Setting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Setting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateSettings provides a mock function with the given fields.
+func (_m *MetricsRouterV3) UpdateSettings(updateSettingsOptions *metricsrouterv3.UpdateSettingsOptions) (*metricsrouterv3.Setting, *core.DetailedResponse, error) {
+	ret := _m.Called(updateSettingsOptions)
+	var r0 *metricsrouterv3.Setting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Setting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateSettingsWithContext provides a mock function with the given fields.
+func (_m *MetricsRouterV3) UpdateSettingsWithContext(ctx context.Context, updateSettingsOptions *metricsrouterv3.UpdateSettingsOptions) (*metricsrouterv3.Setting, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateSettingsOptions)
+	var r0 *metricsrouterv3.Setting
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*metricsrouterv3.Setting)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
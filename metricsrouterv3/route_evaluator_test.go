@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metricsrouterv3_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RouteEvaluator`, func() {
+	target := func(id string) metricsrouterv3.TargetReference {
+		return metricsrouterv3.TargetReference{ID: core.StringPtr(id)}
+	}
+	filter := func(operand string, values ...string) metricsrouterv3.InclusionFilter {
+		return metricsrouterv3.InclusionFilter{Operand: core.StringPtr(operand), Operator: core.StringPtr("in"), Values: values}
+	}
+	routes := []metricsrouterv3.Route{{
+		ID:   core.StringPtr("route-1"),
+		Name: core.StringPtr("metrics"),
+		Rules: []metricsrouterv3.Rule{
+			{
+				Action:  core.StringPtr(metricsrouterv3.RuleActionDropConst),
+				Targets: []metricsrouterv3.TargetReference{},
+				InclusionFilters: []metricsrouterv3.InclusionFilter{
+					filter(metricsrouterv3.InclusionFilterOperandServiceNameConst, "cloud-object-storage"),
+				},
+			},
+			{
+				Targets: []metricsrouterv3.TargetReference{target("t-is")},
+				InclusionFilters: []metricsrouterv3.InclusionFilter{
+					filter(metricsrouterv3.InclusionFilterOperandServiceNameConst, "is"),
+					filter(metricsrouterv3.InclusionFilterOperandLocationConst, "us-south", "us-east"),
+				},
+			},
+			{
+				Targets: []metricsrouterv3.TargetReference{target("t-vsi")},
+				InclusionFilters: []metricsrouterv3.InclusionFilter{
+					filter(metricsrouterv3.InclusionFilterOperandLocationConst, "us-south"),
+					filter(metricsrouterv3.InclusionFilterOperandServiceNameConst, "is"),
+					filter(metricsrouterv3.InclusionFilterOperandResourceTypeConst, "instance"),
+				},
+			},
+			{
+				Targets: []metricsrouterv3.TargetReference{target("t-other")},
+				InclusionFilters: []metricsrouterv3.InclusionFilter{
+					filter(metricsrouterv3.InclusionFilterOperandLocationConst, "us-south", "eu-de"),
+				},
+			},
+		},
+	}}
+	evaluator := metricsrouterv3.NewRouteEvaluator(routes, []metricsrouterv3.TargetReference{target("t-default")})
+
+	It(`Matches inclusion filters against the parts of the source CRN`, func() {
+		evaluation := evaluator.Evaluate(&metricsrouterv3.RouteEvent{SourceCRN: "crn:v1:bluemix:public:is:us-south:a/123::instance:abc"})
+		Expect(evaluation.Matches[0].RuleIndex).To(Equal(1))
+		Expect(evaluation.Targets).To(ConsistOf(target("t-is")))
+
+		evaluation = evaluator.Evaluate(&metricsrouterv3.RouteEvent{SourceCRN: "crn:v1:bluemix:public:cloud-object-storage:global:a/123:guid::"})
+		Expect(evaluation.Matches[0].Action).To(Equal(metricsrouterv3.RuleActionDropConst))
+		Expect(evaluation.Dropped()).To(BeTrue())
+
+		evaluation = evaluator.Evaluate(&metricsrouterv3.RouteEvent{Region: "eu-de", SourceCRN: "crn:v1:bluemix:public:databases-for-redis:us-south:a/123:guid::"})
+		Expect(evaluation.Matches[0].RuleIndex).To(Equal(3))
+
+		evaluation = evaluator.Evaluate(&metricsrouterv3.RouteEvent{Region: "jp-tok"})
+		Expect(evaluation.DefaultTargetsUsed).To(BeTrue())
+		Expect(evaluation.Targets).To(ConsistOf(target("t-default")))
+	})
+
+	It(`Flags rules shadowed by earlier rules`, func() {
+		Expect(evaluator.ShadowedRules()).To(Equal([]metricsrouterv3.ShadowedRule{
+			{RouteID: "route-1", RouteName: "metrics", RuleIndex: 2, ShadowedBy: 1},
+		}))
+	})
+})
@@ -0,0 +1,306 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the OpenServiceBrokerV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/openservicebrokerv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// OpenServiceBrokerV1 : A testify mock of the OpenServiceBrokerV1 service client.
+type OpenServiceBrokerV1 struct {
+	mock.Mock
+}
+
+var _ openservicebrokerv1.OpenServiceBrokerV1Intf = (*OpenServiceBrokerV1)(nil)
+
+// GetServiceInstanceState provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) GetServiceInstanceState(getServiceInstanceStateOptions *openservicebrokerv1.GetServiceInstanceStateOptions) (*openservicebrokerv1.Resp1874644Root, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getServiceInstanceStateOptions)
+	var r0 *openservicebrokerv1.Resp1874644Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp1874644Root)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetServiceInstanceStateWithContext provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) GetServiceInstanceStateWithContext(ctx context.Context, getServiceInstanceStateOptions *openservicebrokerv1.GetServiceInstanceStateOptions) (*openservicebrokerv1.Resp1874644Root, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getServiceInstanceStateOptions)
+	var r0 *openservicebrokerv1.Resp1874644Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp1874644Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceServiceInstanceState provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) ReplaceServiceInstanceState(replaceServiceInstanceStateOptions *openservicebrokerv1.ReplaceServiceInstanceStateOptions) (*openservicebrokerv1.Resp2448145Root, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceServiceInstanceStateOptions)
+	var r0 *openservicebrokerv1.Resp2448145Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2448145Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceServiceInstanceStateWithContext provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) ReplaceServiceInstanceStateWithContext(ctx context.Context, replaceServiceInstanceStateOptions *openservicebrokerv1.ReplaceServiceInstanceStateOptions) (*openservicebrokerv1.Resp2448145Root, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceServiceInstanceStateOptions)
+	var r0 *openservicebrokerv1.Resp2448145Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2448145Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceServiceInstance provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) ReplaceServiceInstance(replaceServiceInstanceOptions *openservicebrokerv1.ReplaceServiceInstanceOptions) (*openservicebrokerv1.Resp2079872Root, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(replaceServiceInstanceOptions)
+	var r0 *openservicebrokerv1.Resp2079872Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079872Root)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceServiceInstanceWithContext provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) ReplaceServiceInstanceWithContext(ctx context.Context, replaceServiceInstanceOptions *openservicebrokerv1.ReplaceServiceInstanceOptions) (*openservicebrokerv1.Resp2079872Root, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceServiceInstanceOptions)
+	var r0 *openservicebrokerv1.Resp2079872Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079872Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateServiceInstance provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) UpdateServiceInstance(updateServiceInstanceOptions *openservicebrokerv1.UpdateServiceInstanceOptions) (*openservicebrokerv1.Resp2079874Root, *core.DetailedResponse, error) {
+	ret := _m.Called(updateServiceInstanceOptions)
+	var r0 *openservicebrokerv1.Resp2079874Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079874Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateServiceInstanceWithContext provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) UpdateServiceInstanceWithContext(ctx context.Context, updateServiceInstanceOptions *openservicebrokerv1.UpdateServiceInstanceOptions) (*openservicebrokerv1.Resp2079874Root, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateServiceInstanceOptions)
+	var r0 *openservicebrokerv1.Resp2079874Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079874Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteServiceInstance provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) DeleteServiceInstance(deleteServiceInstanceOptions *openservicebrokerv1.DeleteServiceInstanceOptions) (*openservicebrokerv1.Resp2079874Root, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteServiceInstanceOptions)
+	var r0 *openservicebrokerv1.Resp2079874Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079874Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteServiceInstanceWithContext provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) DeleteServiceInstanceWithContext(ctx context.Context, deleteServiceInstanceOptions *openservicebrokerv1.DeleteServiceInstanceOptions) (*openservicebrokerv1.Resp2079874Root, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteServiceInstanceOptions)
+	var r0 *openservicebrokerv1.Resp2079874Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079874Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListCatalog provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) ListCatalog(listCatalogOptions *openservicebrokerv1.ListCatalogOptions) (*openservicebrokerv1.Resp1874650Root, *core.DetailedResponse, error) {
+	ret := _m.Called(listCatalogOptions)
+	var r0 *openservicebrokerv1.Resp1874650Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp1874650Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListCatalogWithContext provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) ListCatalogWithContext(ctx context.Context, listCatalogOptions *openservicebrokerv1.ListCatalogOptions) (*openservicebrokerv1.Resp1874650Root, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, listCatalogOptions)
+	var r0 *openservicebrokerv1.Resp1874650Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp1874650Root)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLastOperation provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) GetLastOperation(getLastOperationOptions *openservicebrokerv1.GetLastOperationOptions) (*openservicebrokerv1.Resp2079894Root, *core.DetailedResponse, error) {
+	ret := _m.Called(getLastOperationOptions)
+	var r0 *openservicebrokerv1.Resp2079894Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079894Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetLastOperationWithContext provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) GetLastOperationWithContext(ctx context.Context, getLastOperationOptions *openservicebrokerv1.GetLastOperationOptions) (*openservicebrokerv1.Resp2079894Root, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getLastOperationOptions)
+	var r0 *openservicebrokerv1.Resp2079894Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079894Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceServiceBinding provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) ReplaceServiceBinding(replaceServiceBindingOptions *openservicebrokerv1.ReplaceServiceBindingOptions) (*openservicebrokerv1.Resp2079876Root, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceServiceBindingOptions)
+	var r0 *openservicebrokerv1.Resp2079876Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079876Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceServiceBindingWithContext provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) ReplaceServiceBindingWithContext(ctx context.Context, replaceServiceBindingOptions *openservicebrokerv1.ReplaceServiceBindingOptions) (*openservicebrokerv1.Resp2079876Root, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceServiceBindingOptions)
+	var r0 *openservicebrokerv1.Resp2079876Root
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*openservicebrokerv1.Resp2079876Root)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteServiceBinding provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) DeleteServiceBinding(deleteServiceBindingOptions *openservicebrokerv1.DeleteServiceBindingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteServiceBindingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteServiceBindingWithContext provides a mock function with the given fields.
+func (_m *OpenServiceBrokerV1) DeleteServiceBindingWithContext(ctx context.Context, deleteServiceBindingOptions *openservicebrokerv1.DeleteServiceBindingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteServiceBindingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
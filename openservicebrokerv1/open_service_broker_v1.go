@@ -187,7 +187,7 @@ func (openServiceBroker *OpenServiceBrokerV1) GetServiceInstanceStateWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "open_service_broker", "V1", "GetServiceInstanceState"))
 	builder.EnableGzipCompression = openServiceBroker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(openServiceBroker.Service.Options.URL, `/bluemix_v1/service_instances/{instance_id}`, pathParamsMap)
 	if err != nil {
@@ -212,6 +212,8 @@ func (openServiceBroker *OpenServiceBrokerV1) GetServiceInstanceStateWithContext
 	var rawResponse map[string]json.RawMessage
 	response, err = openServiceBroker.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_service_instance_state", core.NewProblemComponent(DefaultServiceName, "1.4"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResp1874644Root)
@@ -253,7 +255,7 @@ func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceInstanceStateWithCon
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "open_service_broker", "V1", "ReplaceServiceInstanceState"))
 	builder.EnableGzipCompression = openServiceBroker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(openServiceBroker.Service.Options.URL, `/bluemix_v1/service_instances/{instance_id}`, pathParamsMap)
 	if err != nil {
@@ -294,6 +296,8 @@ func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceInstanceStateWithCon
 	var rawResponse map[string]json.RawMessage
 	response, err = openServiceBroker.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "replace_service_instance_state", core.NewProblemComponent(DefaultServiceName, "1.4"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResp2448145Root)
@@ -337,7 +341,7 @@ func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceInstanceWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "open_service_broker", "V1", "ReplaceServiceInstance"))
 	builder.EnableGzipCompression = openServiceBroker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(openServiceBroker.Service.Options.URL, `/v2/service_instances/{instance_id}`, pathParamsMap)
 	if err != nil {
@@ -391,6 +395,8 @@ func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceInstanceWithContext(
 	var rawResponse map[string]json.RawMessage
 	response, err = openServiceBroker.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "replace_service_instance", core.NewProblemComponent(DefaultServiceName, "1.4"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResp2079872Root)
@@ -429,7 +435,7 @@ func (openServiceBroker *OpenServiceBrokerV1) UpdateServiceInstanceWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "open_service_broker", "V1", "UpdateServiceInstance"))
 	builder.EnableGzipCompression = openServiceBroker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(openServiceBroker.Service.Options.URL, `/v2/service_instances/{instance_id}`, pathParamsMap)
 	if err != nil {
@@ -480,6 +486,8 @@ func (openServiceBroker *OpenServiceBrokerV1) UpdateServiceInstanceWithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = openServiceBroker.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "update_service_instance", core.NewProblemComponent(DefaultServiceName, "1.4"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResp2079874Root)
@@ -515,7 +523,7 @@ func (openServiceBroker *OpenServiceBrokerV1) DeleteServiceInstanceWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "open_service_broker", "V1", "DeleteServiceInstance"))
 	builder.EnableGzipCompression = openServiceBroker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(openServiceBroker.Service.Options.URL, `/v2/service_instances/{instance_id}`, pathParamsMap)
 	if err != nil {
@@ -546,6 +554,8 @@ func (openServiceBroker *OpenServiceBrokerV1) DeleteServiceInstanceWithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = openServiceBroker.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "delete_service_instance", core.NewProblemComponent(DefaultServiceName, "1.4"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResp2079874Root)
@@ -578,7 +588,7 @@ func (openServiceBroker *OpenServiceBrokerV1) ListCatalogWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "open_service_broker", "V1", "ListCatalog"))
 	builder.EnableGzipCompression = openServiceBroker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(openServiceBroker.Service.Options.URL, `/v2/catalog`, nil)
 	if err != nil {
@@ -603,6 +613,8 @@ func (openServiceBroker *OpenServiceBrokerV1) ListCatalogWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = openServiceBroker.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "list_catalog", core.NewProblemComponent(DefaultServiceName, "1.4"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResp1874650Root)
@@ -644,7 +656,7 @@ func (openServiceBroker *OpenServiceBrokerV1) GetLastOperationWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "open_service_broker", "V1", "GetLastOperation"))
 	builder.EnableGzipCompression = openServiceBroker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(openServiceBroker.Service.Options.URL, `/v2/service_instances/{instance_id}/last_operation`, pathParamsMap)
 	if err != nil {
@@ -679,6 +691,8 @@ func (openServiceBroker *OpenServiceBrokerV1) GetLastOperationWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = openServiceBroker.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_last_operation", core.NewProblemComponent(DefaultServiceName, "1.4"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResp2079894Root)
@@ -721,7 +735,7 @@ func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceBindingWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "open_service_broker", "V1", "ReplaceServiceBinding"))
 	builder.EnableGzipCompression = openServiceBroker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(openServiceBroker.Service.Options.URL, `/v2/service_instances/{instance_id}/service_bindings/{binding_id}`, pathParamsMap)
 	if err != nil {
@@ -765,6 +779,8 @@ func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceBindingWithContext(c
 	var rawResponse map[string]json.RawMessage
 	response, err = openServiceBroker.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "replace_service_binding", core.NewProblemComponent(DefaultServiceName, "1.4"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResp2079876Root)
@@ -805,7 +821,7 @@ func (openServiceBroker *OpenServiceBrokerV1) DeleteServiceBindingWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "open_service_broker", "V1", "DeleteServiceBinding"))
 	builder.EnableGzipCompression = openServiceBroker.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(openServiceBroker.Service.Options.URL, `/v2/service_instances/{instance_id}/service_bindings/{binding_id}`, pathParamsMap)
 	if err != nil {
@@ -832,6 +848,10 @@ func (openServiceBroker *OpenServiceBrokerV1) DeleteServiceBindingWithContext(ct
 
 	response, err = openServiceBroker.Service.Request(request, nil)
 
+	if err != nil {
+		core.EnrichHTTPProblem(err, "delete_service_binding", core.NewProblemComponent(DefaultServiceName, "1.4"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
+	}
 	return
 }
 
@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package openservicebrokerv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// OpenServiceBrokerV1Intf : The operations of the OpenServiceBrokerV1 service client.
+// The interface is satisfied by *OpenServiceBrokerV1 and by mocks.OpenServiceBrokerV1, allowing consumers to substitute a mock for the client in tests.
+type OpenServiceBrokerV1Intf interface {
+	// GetServiceInstanceState : Get the current state of the service instance
+	GetServiceInstanceState(getServiceInstanceStateOptions *GetServiceInstanceStateOptions) (result *Resp1874644Root, response *core.DetailedResponse, err error)
+	// GetServiceInstanceStateWithContext is an alternate form of the GetServiceInstanceState method which supports a Context parameter
+	GetServiceInstanceStateWithContext(ctx context.Context, getServiceInstanceStateOptions *GetServiceInstanceStateOptions) (result *Resp1874644Root, response *core.DetailedResponse, err error)
+	// ReplaceServiceInstanceState : Update the state of a provisioned service instance
+	ReplaceServiceInstanceState(replaceServiceInstanceStateOptions *ReplaceServiceInstanceStateOptions) (result *Resp2448145Root, response *core.DetailedResponse, err error)
+	// ReplaceServiceInstanceStateWithContext is an alternate form of the ReplaceServiceInstanceState method which supports a Context parameter
+	ReplaceServiceInstanceStateWithContext(ctx context.Context, replaceServiceInstanceStateOptions *ReplaceServiceInstanceStateOptions) (result *Resp2448145Root, response *core.DetailedResponse, err error)
+	// ReplaceServiceInstance : Create (provision) a service instance
+	ReplaceServiceInstance(replaceServiceInstanceOptions *ReplaceServiceInstanceOptions) (result *Resp2079872Root, response *core.DetailedResponse, err error)
+	// ReplaceServiceInstanceWithContext is an alternate form of the ReplaceServiceInstance method which supports a Context parameter
+	ReplaceServiceInstanceWithContext(ctx context.Context, replaceServiceInstanceOptions *ReplaceServiceInstanceOptions) (result *Resp2079872Root, response *core.DetailedResponse, err error)
+	// UpdateServiceInstance : Update a service instance
+	UpdateServiceInstance(updateServiceInstanceOptions *UpdateServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+	// UpdateServiceInstanceWithContext is an alternate form of the UpdateServiceInstance method which supports a Context parameter
+	UpdateServiceInstanceWithContext(ctx context.Context, updateServiceInstanceOptions *UpdateServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+	// DeleteServiceInstance : Delete (deprovision) a service instance
+	DeleteServiceInstance(deleteServiceInstanceOptions *DeleteServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+	// DeleteServiceInstanceWithContext is an alternate form of the DeleteServiceInstance method which supports a Context parameter
+	DeleteServiceInstanceWithContext(ctx context.Context, deleteServiceInstanceOptions *DeleteServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+	// ListCatalog : Get the catalog metadata stored within the broker
+	ListCatalog(listCatalogOptions *ListCatalogOptions) (result *Resp1874650Root, response *core.DetailedResponse, err error)
+	// ListCatalogWithContext is an alternate form of the ListCatalog method which supports a Context parameter
+	ListCatalogWithContext(ctx context.Context, listCatalogOptions *ListCatalogOptions) (result *Resp1874650Root, response *core.DetailedResponse, err error)
+	// GetLastOperation : Get the current status of a provision in-progress for a service instance
+	GetLastOperation(getLastOperationOptions *GetLastOperationOptions) (result *Resp2079894Root, response *core.DetailedResponse, err error)
+	// GetLastOperationWithContext is an alternate form of the GetLastOperation method which supports a Context parameter
+	GetLastOperationWithContext(ctx context.Context, getLastOperationOptions *GetLastOperationOptions) (result *Resp2079894Root, response *core.DetailedResponse, err error)
+	// ReplaceServiceBinding : Bind a service instance to another resource
+	ReplaceServiceBinding(replaceServiceBindingOptions *ReplaceServiceBindingOptions) (result *Resp2079876Root, response *core.DetailedResponse, err error)
+	// ReplaceServiceBindingWithContext is an alternate form of the ReplaceServiceBinding method which supports a Context parameter
+	ReplaceServiceBindingWithContext(ctx context.Context, replaceServiceBindingOptions *ReplaceServiceBindingOptions) (result *Resp2079876Root, response *core.DetailedResponse, err error)
+	// DeleteServiceBinding : Delete (unbind) the credentials bound to a resource
+	DeleteServiceBinding(deleteServiceBindingOptions *DeleteServiceBindingOptions) (response *core.DetailedResponse, err error)
+	// DeleteServiceBindingWithContext is an alternate form of the DeleteServiceBinding method which supports a Context parameter
+	DeleteServiceBindingWithContext(ctx context.Context, deleteServiceBindingOptions *DeleteServiceBindingOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ OpenServiceBrokerV1Intf = (*OpenServiceBrokerV1)(nil)
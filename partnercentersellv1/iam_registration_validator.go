@@ -0,0 +1,376 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package partnercentersellv1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the IamRegistrationProblem.Severity property.
+const (
+	IamRegistrationProblemSeverityErrorConst   = "error"
+	IamRegistrationProblemSeverityWarningConst = "warning"
+)
+
+// The network types that can be used in the "networkType" environment attribute of context-based restrictions.
+var iamNetworkTypes = []string{"public", "private", "direct"}
+
+var (
+	// Programmatic names of services.
+	iamServiceNamePattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+	// Actions are named "<service name>.<resource>.<operation>", with one or more segments after the service name.
+	iamActionIDPattern = regexp.MustCompile(`^([a-z][a-z0-9-]*)(\.[A-Za-z0-9_-]+)+$`)
+
+	// Roles are identified by CRNs, such as "crn:v1:bluemix:public:iam::::serviceRole:Reader" for the predefined
+	// service roles and "crn:v1:bluemix:public:<service name>::::serviceRole:<role>" for custom roles.
+	iamRoleIDPattern = regexp.MustCompile(`^crn:v1:bluemix:public:([a-z0-9-]+)::::(role|serviceRole):([A-Za-z][A-Za-z0-9]*)$`)
+)
+
+// IamRegistrationProblem : A problem of an IAM registration found by ValidateIamRegistration.
+type IamRegistrationProblem struct {
+	// The JSON path of the property with the problem, such as "actions[2].roles[0]".
+	Path string
+
+	// The rule that is broken, such as "unknown-role".
+	Rule string
+
+	// The severity of the problem, one of the IamRegistrationProblemSeverity* constants. Registrations with errors
+	// are rejected by the service; warnings point out properties that are most likely mistakes.
+	Severity string
+
+	// The explanation of the problem.
+	Message string
+}
+
+// String returns the path, message and rule of the problem.
+func (problem IamRegistrationProblem) String() string {
+	return fmt.Sprintf("%s: %s (%s)", problem.Path, problem.Message, problem.Rule)
+}
+
+// IamRegistrationProblems : The problems of an IAM registration.
+type IamRegistrationProblems []IamRegistrationProblem
+
+// Error lists the problems, one per line.
+func (problems IamRegistrationProblems) Error() string {
+	lines := []string{fmt.Sprintf("the IAM registration has %d problem(s):", len(problems))}
+	for _, problem := range problems {
+		lines = append(lines, fmt.Sprintf("  %s: %s", problem.Severity, problem.String()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Errors returns the problems of severity "error".
+func (problems IamRegistrationProblems) Errors() (errors IamRegistrationProblems) {
+	for _, problem := range problems {
+		if problem.Severity == IamRegistrationProblemSeverityErrorConst {
+			errors = append(errors, problem)
+		}
+	}
+	return
+}
+
+// Err returns the problems of severity "error" as an error, or nil if there are none:
+//
+//	if err := partnercentersellv1.ValidateIamRegistration(registration).Err(); err != nil {
+//		t.Fatal(err)
+//	}
+func (problems IamRegistrationProblems) Err() error {
+	if errors := problems.Errors(); len(errors) > 0 {
+		return errors
+	}
+	return nil
+}
+
+// ValidateIamRegistration checks an IAM registration locally for the problems that the service would otherwise
+// report, or silently accept:
+//
+//   - the naming conventions of the service name, action IDs and role IDs;
+//   - duplicate roles, actions, attributes and API types;
+//   - references to roles that are not supported roles, from actions, anonymous accesses and authorization
+//     subjects;
+//   - references to attributes that are not supported attributes, from the resource hierarchy attribute and
+//     anonymous accesses;
+//   - references to actions that are not declared, from the supported action control;
+//   - the consistency of the context-based restrictions settings: the API types of actions must be operations of
+//     the supported network, whose environment attributes must be valid network types.
+func ValidateIamRegistration(registration *IamServiceRegistration) IamRegistrationProblems {
+	validator := &iamRegistrationValidator{registration: registration}
+	validator.validate()
+	return validator.problems
+}
+
+// ValidateIamRegistrationPatch checks the properties of an IAM registration given as a patch, such as those of an
+// IamRegistrationSpec, with ValidateIamRegistration.
+func ValidateIamRegistrationPatch(name string, patch *IamServiceRegistrationPatch) IamRegistrationProblems {
+	return ValidateIamRegistration(&IamServiceRegistration{
+		Name:                           &name,
+		Enabled:                        patch.Enabled,
+		ServiceType:                    patch.ServiceType,
+		Actions:                        patch.Actions,
+		AdditionalPolicyScopes:         patch.AdditionalPolicyScopes,
+		DisplayName:                    patch.DisplayName,
+		ParentIds:                      patch.ParentIds,
+		ResourceHierarchyAttribute:     patch.ResourceHierarchyAttribute,
+		SupportedAnonymousAccesses:     patch.SupportedAnonymousAccesses,
+		SupportedAttributes:            patch.SupportedAttributes,
+		SupportedAuthorizationSubjects: patch.SupportedAuthorizationSubjects,
+		SupportedRoles:                 patch.SupportedRoles,
+		SupportedNetwork:               patch.SupportedNetwork,
+		SupportedActionControl:         patch.SupportedActionControl,
+	})
+}
+
+type iamRegistrationValidator struct {
+	registration *IamServiceRegistration
+	problems     IamRegistrationProblems
+	name         string
+
+	// The declared roles, actions, attributes and API types, and whether they are used.
+	roles      map[string]bool
+	actions    map[string]bool
+	attributes map[string]bool
+	apiTypes   map[string]bool
+}
+
+func (validator *iamRegistrationValidator) errorf(path string, rule string, format string, args ...interface{}) {
+	validator.problems = append(validator.problems, IamRegistrationProblem{
+		Path:     path,
+		Rule:     rule,
+		Severity: IamRegistrationProblemSeverityErrorConst,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (validator *iamRegistrationValidator) warnf(path string, rule string, format string, args ...interface{}) {
+	validator.errorf(path, rule, format, args...)
+	validator.problems[len(validator.problems)-1].Severity = IamRegistrationProblemSeverityWarningConst
+}
+
+func (validator *iamRegistrationValidator) validate() {
+	registration := validator.registration
+	if registration.Name == nil || *registration.Name == "" {
+		validator.errorf("name", "required", "the name is required")
+	} else {
+		validator.name = *registration.Name
+		if !iamServiceNamePattern.MatchString(validator.name) {
+			validator.errorf("name", "service-name", "'%s' is not a valid programmatic name: use lowercase letters, digits and hyphens, "+
+				"starting with a letter", validator.name)
+		}
+	}
+
+	// Declarations are collected first, so that references can be checked in any order.
+	validator.validateRoles()
+	validator.validateAttributes()
+	validator.validateNetwork()
+	validator.validateActions()
+
+	for i, action := range registration.SupportedActionControl {
+		if _, found := validator.actions[action]; !found {
+			validator.errorf(fmt.Sprintf("supported_action_control[%d]", i), "unknown-action",
+				"action '%s' is not declared in actions", action)
+		}
+	}
+	for i, access := range registration.SupportedAnonymousAccesses {
+		path := fmt.Sprintf("supported_anonymous_accesses[%d]", i)
+		validator.checkRoles(path, access.Roles)
+		if access.Attributes != nil {
+			for key := range access.Attributes.AdditionalProperties {
+				validator.checkAttribute(path+".attributes.additional_properties."+key, key)
+			}
+		}
+	}
+	for i, subject := range registration.SupportedAuthorizationSubjects {
+		validator.checkRoles(fmt.Sprintf("supported_authorization_subjects[%d]", i), subject.Roles)
+	}
+	if attribute := registration.ResourceHierarchyAttribute; attribute != nil && attribute.Key != nil {
+		validator.checkAttribute("resource_hierarchy_attribute.key", *attribute.Key)
+	}
+
+	for i, role := range registration.SupportedRoles {
+		if role.ID != nil && !validator.roles[*role.ID] {
+			validator.warnf(fmt.Sprintf("supported_roles[%d]", i), "unused-role",
+				"role '%s' is not granted any action, anonymous access or authorization subject", *role.ID)
+		}
+	}
+	if network := registration.SupportedNetwork; network != nil && network.Operations != nil {
+		for i, apiType := range network.Operations.ApiTypes {
+			if apiType.Name != nil && !validator.apiTypes[*apiType.Name] {
+				validator.warnf(fmt.Sprintf("supported_network.operations.api_types[%d]", i), "unused-api-type",
+					"API type '%s' is not used by any action, so context-based restrictions on it have no effect", *apiType.Name)
+			}
+		}
+	}
+}
+
+func (validator *iamRegistrationValidator) validateRoles() {
+	validator.roles = map[string]bool{}
+	for i, role := range validator.registration.SupportedRoles {
+		path := fmt.Sprintf("supported_roles[%d]", i)
+		if role.ID == nil || *role.ID == "" {
+			validator.errorf(path+".id", "required", "the role ID is required")
+			continue
+		}
+		id := *role.ID
+		if _, found := validator.roles[id]; found {
+			validator.errorf(path+".id", "duplicate-role", "role '%s' is declared more than once", id)
+		}
+		validator.roles[id] = false
+
+		if match := iamRoleIDPattern.FindStringSubmatch(id); match == nil {
+			validator.errorf(path+".id", "role-id", "'%s' is not a role CRN such as 'crn:v1:bluemix:public:iam::::serviceRole:Reader' "+
+				"or 'crn:v1:bluemix:public:%s::::serviceRole:<name>'", id, validator.name)
+		} else if match[1] != "iam" && match[1] != validator.name {
+			validator.errorf(path+".id", "role-id", "custom role '%s' must be scoped to the service '%s'", id, validator.name)
+		} else if match[1] != "iam" && match[2] != "serviceRole" {
+			validator.errorf(path+".id", "role-id", "custom role '%s' must be a serviceRole; platform roles are predefined", id)
+		}
+		if role.DisplayName == nil || (role.DisplayName.Default == nil && role.DisplayName.En == nil) {
+			validator.errorf(path+".display_name", "display-name", "role '%s' needs a default or English display name", id)
+		}
+	}
+}
+
+func (validator *iamRegistrationValidator) validateAttributes() {
+	validator.attributes = map[string]bool{}
+	for i, attribute := range validator.registration.SupportedAttributes {
+		path := fmt.Sprintf("supported_attributes[%d]", i)
+		if attribute.Key == nil || *attribute.Key == "" {
+			validator.errorf(path+".key", "required", "the attribute key is required")
+			continue
+		}
+		if validator.attributes[*attribute.Key] {
+			validator.errorf(path+".key", "duplicate-attribute", "attribute '%s' is declared more than once", *attribute.Key)
+		}
+		validator.attributes[*attribute.Key] = true
+		if options := attribute.Options; options != nil && options.Key != nil && *options.Key != *attribute.Key {
+			validator.errorf(path+".options.key", "attribute-key", "the key of the options, '%s', differs from the attribute key '%s'",
+				*options.Key, *attribute.Key)
+		}
+	}
+
+	// The keys of the resource hierarchies of composite services refer to other attributes.
+	for i, attribute := range validator.registration.SupportedAttributes {
+		if options := attribute.Options; options != nil && options.ResourceHierarchy != nil && options.ResourceHierarchy.Key != nil &&
+			options.ResourceHierarchy.Key.Key != nil {
+			validator.checkAttribute(fmt.Sprintf("supported_attributes[%d].options.resource_hierarchy.key.key", i),
+				*options.ResourceHierarchy.Key.Key)
+		}
+	}
+}
+
+func (validator *iamRegistrationValidator) validateNetwork() {
+	validator.apiTypes = map[string]bool{}
+	network := validator.registration.SupportedNetwork
+	if network == nil {
+		return
+	}
+
+	networkType := false
+	for i, attribute := range network.EnvironmentAttributes {
+		path := fmt.Sprintf("supported_network.environment_attributes[%d]", i)
+		if attribute.Key == nil || *attribute.Key != "networkType" {
+			validator.errorf(path+".key", "network-attribute", "the only supported environment attribute is 'networkType'")
+			continue
+		}
+		networkType = true
+		if len(attribute.Values) == 0 {
+			validator.errorf(path+".values", "network-type", "at least one network type is required")
+		}
+		for j, value := range attribute.Values {
+			if !common.ContainsString(iamNetworkTypes, value) {
+				validator.errorf(fmt.Sprintf("%s.values[%d]", path, j), "network-type", "'%s' is not a network type; use one of %s",
+					value, strings.Join(iamNetworkTypes, ", "))
+			}
+		}
+	}
+	if !networkType {
+		validator.errorf("supported_network.environment_attributes", "network-attribute",
+			"the supported network must declare the 'networkType' environment attribute")
+	}
+
+	if network.Operations == nil {
+		return
+	}
+	for i, apiType := range network.Operations.ApiTypes {
+		path := fmt.Sprintf("supported_network.operations.api_types[%d]", i)
+		if apiType.Name == nil || *apiType.Name == "" {
+			validator.errorf(path+".name", "required", "the API type name is required")
+			continue
+		}
+		if _, found := validator.apiTypes[*apiType.Name]; found {
+			validator.errorf(path+".name", "duplicate-api-type", "API type '%s' is declared more than once", *apiType.Name)
+		}
+		validator.apiTypes[*apiType.Name] = false
+	}
+}
+
+func (validator *iamRegistrationValidator) validateActions() {
+	validator.actions = map[string]bool{}
+	for i, action := range validator.registration.Actions {
+		path := fmt.Sprintf("actions[%d]", i)
+		if action.ID == nil || *action.ID == "" {
+			validator.errorf(path+".id", "required", "the action ID is required")
+			continue
+		}
+		id := *action.ID
+		if validator.actions[id] {
+			validator.errorf(path+".id", "duplicate-action", "action '%s' is declared more than once", id)
+		}
+		validator.actions[id] = true
+
+		if match := iamActionIDPattern.FindStringSubmatch(id); match == nil {
+			validator.errorf(path+".id", "action-id", "'%s' is not an action ID such as '%s.<resource>.<operation>'", id, validator.name)
+		} else if match[1] != validator.name {
+			validator.errorf(path+".id", "action-id", "action '%s' must start with the service name '%s.'", id, validator.name)
+		}
+		if len(action.Roles) == 0 {
+			validator.warnf(path+".roles", "no-roles", "action '%s' is not granted by any role", id)
+		}
+		validator.checkRoles(path, action.Roles)
+
+		for j, apiType := range action.ApiTypes {
+			if _, found := validator.apiTypes[apiType]; !found {
+				validator.errorf(fmt.Sprintf("%s.api_types[%d]", path, j), "unknown-api-type",
+					"API type '%s' is not an operation of supported_network.operations.api_types", apiType)
+				continue
+			}
+			validator.apiTypes[apiType] = true
+		}
+	}
+}
+
+// checkRoles checks the roles referenced at a path, and marks them as used.
+func (validator *iamRegistrationValidator) checkRoles(path string, roles []string) {
+	for i, role := range roles {
+		if _, found := validator.roles[role]; !found {
+			validator.errorf(fmt.Sprintf("%s.roles[%d]", path, i), "unknown-role", "role '%s' is not declared in supported_roles", role)
+			continue
+		}
+		validator.roles[role] = true
+	}
+}
+
+func (validator *iamRegistrationValidator) checkAttribute(path string, key string) {
+	if !validator.attributes[key] {
+		validator.errorf(path, "unknown-attribute", "attribute '%s' is not declared in supported_attributes", key)
+	}
+}
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package partnercentersellv1_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/partnercentersellv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ValidateIamRegistration`, func() {
+	const (
+		reader = "crn:v1:bluemix:public:iam::::serviceRole:Reader"
+		custom = "crn:v1:bluemix:public:example-service::::serviceRole:Auditor"
+	)
+	var registration *partnercentersellv1.IamServiceRegistration
+
+	BeforeEach(func() {
+		displayName := func(name string) *partnercentersellv1.IamServiceRegistrationDisplayNameObject {
+			return &partnercentersellv1.IamServiceRegistrationDisplayNameObject{Default: core.StringPtr(name)}
+		}
+		registration = &partnercentersellv1.IamServiceRegistration{
+			Name: core.StringPtr("example-service"),
+			SupportedRoles: []partnercentersellv1.IamServiceRegistrationSupportedRole{
+				{ID: core.StringPtr(reader), DisplayName: displayName("Reader")},
+				{ID: core.StringPtr(custom), DisplayName: displayName("Auditor")},
+			},
+			Actions: []partnercentersellv1.IamServiceRegistrationAction{
+				{ID: core.StringPtr("example-service.dashboard.view"), Roles: []string{reader, custom}, ApiTypes: []string{"api-read"}},
+				{ID: core.StringPtr("example-service.logs.read"), Roles: []string{custom}},
+			},
+			SupportedAttributes: []partnercentersellv1.IamServiceRegistrationSupportedAttribute{
+				{Key: core.StringPtr("instance")},
+				{Key: core.StringPtr("bucket"), Options: &partnercentersellv1.SupportedAttributesOptions{
+					ResourceHierarchy: &partnercentersellv1.SupportedAttributesOptionsResourceHierarchy{
+						Key: &partnercentersellv1.SupportedAttributesOptionsResourceHierarchyKey{Key: core.StringPtr("instance")},
+					},
+				}},
+			},
+			ResourceHierarchyAttribute: &partnercentersellv1.IamServiceRegistrationResourceHierarchyAttribute{
+				Key: core.StringPtr("instance"), Value: core.StringPtr("example"),
+			},
+			SupportedAnonymousAccesses: []partnercentersellv1.IamServiceRegistrationSupportedAnonymousAccess{
+				{Roles: []string{reader}, Attributes: &partnercentersellv1.IamServiceRegistrationSupportedAnonymousAccessAttributes{
+					AccountID:            core.StringPtr("account"),
+					ServiceName:          core.StringPtr("example-service"),
+					AdditionalProperties: map[string]string{"bucket": "public"},
+				}},
+			},
+			SupportedActionControl: []string{"example-service.dashboard.view"},
+			SupportedNetwork: &partnercentersellv1.IamServiceRegistrationSupportedNetwork{
+				EnvironmentAttributes: []partnercentersellv1.EnvironmentAttribute{
+					{Key: core.StringPtr("networkType"), Values: []string{"public", "private"}},
+				},
+				Operations: &partnercentersellv1.IamServiceRegistrationSupportedNetworkOperations{
+					ApiTypes: []partnercentersellv1.IamServiceRegistrationSupportedNetworkOperationsApiTypeItems{
+						{Name: core.StringPtr("api-read")},
+					},
+				},
+			},
+		}
+	})
+	rules := func(problems partnercentersellv1.IamRegistrationProblems) (rules []string) {
+		for _, problem := range problems {
+			rules = append(rules, problem.Path+" "+problem.Rule)
+		}
+		return
+	}
+
+	It(`Accepts a consistent registration`, func() {
+		problems := partnercentersellv1.ValidateIamRegistration(registration)
+		Expect(problems).To(BeEmpty())
+		Expect(problems.Err()).To(BeNil())
+	})
+	It(`Reports broken references`, func() {
+		registration.Actions[1].Roles = []string{"crn:v1:bluemix:public:iam::::serviceRole:Writer"}
+		registration.Actions[0].ApiTypes = []string{"api-write"}
+		registration.SupportedActionControl = []string{"example-service.dashboard.edit"}
+		registration.ResourceHierarchyAttribute.Key = core.StringPtr("region")
+		registration.SupportedAnonymousAccesses[0].Attributes.AdditionalProperties = map[string]string{"object": "public"}
+
+		problems := partnercentersellv1.ValidateIamRegistration(registration)
+		Expect(rules(problems)).To(Equal([]string{
+			"actions[0].api_types[0] unknown-api-type",
+			"actions[1].roles[0] unknown-role",
+			"supported_action_control[0] unknown-action",
+			"supported_anonymous_accesses[0].attributes.additional_properties.object unknown-attribute",
+			"resource_hierarchy_attribute.key unknown-attribute",
+			"supported_network.operations.api_types[0] unused-api-type",
+		}))
+		Expect(problems[1].Message).To(Equal("role 'crn:v1:bluemix:public:iam::::serviceRole:Writer' is not declared in supported_roles"))
+		Expect(problems[5].Severity).To(Equal(partnercentersellv1.IamRegistrationProblemSeverityWarningConst))
+		Expect(problems.Errors()).To(HaveLen(5))
+		Expect(problems.Err().Error()).To(ContainSubstring("actions[1].roles[0]: role "))
+	})
+	It(`Reports naming problems and duplicates`, func() {
+		registration.Name = core.StringPtr("Example_Service")
+		registration.SupportedRoles[1].ID = core.StringPtr("crn:v1:bluemix:public:other-service::::serviceRole:Auditor")
+		registration.SupportedRoles = append(registration.SupportedRoles, registration.SupportedRoles[0])
+		registration.SupportedAttributes[1].Key = core.StringPtr("instance")
+
+		problems := partnercentersellv1.ValidateIamRegistration(registration)
+		Expect(rules(problems)).To(ContainElements(
+			"name service-name",
+			"supported_roles[1].id role-id",
+			"supported_roles[2].id duplicate-role",
+			"supported_attributes[1].key duplicate-attribute",
+			"actions[0].id action-id",
+		))
+	})
+	It(`Checks the network settings`, func() {
+		registration.SupportedNetwork.EnvironmentAttributes[0].Values = []string{"public", "internal"}
+		Expect(rules(partnercentersellv1.ValidateIamRegistration(registration))).To(Equal([]string{
+			"supported_network.environment_attributes[0].values[1] network-type",
+		}))
+		registration.SupportedNetwork.EnvironmentAttributes = nil
+		Expect(rules(partnercentersellv1.ValidateIamRegistration(registration))).To(Equal([]string{
+			"supported_network.environment_attributes network-attribute",
+		}))
+	})
+})
@@ -0,0 +1,891 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the PartnerCenterSellV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/partnercentersellv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// PartnerCenterSellV1 : A testify mock of the PartnerCenterSellV1 service client.
+type PartnerCenterSellV1 struct {
+	mock.Mock
+}
+
+var _ partnercentersellv1.PartnerCenterSellV1Intf = (*PartnerCenterSellV1)(nil)
+
+// CreateRegistration provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateRegistration(createRegistrationOptions *partnercentersellv1.CreateRegistrationOptions) (*partnercentersellv1.Registration, *core.DetailedResponse, error) {
+	ret := _m.Called(createRegistrationOptions)
+	var r0 *partnercentersellv1.Registration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Registration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateRegistrationWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateRegistrationWithContext(ctx context.Context, createRegistrationOptions *partnercentersellv1.CreateRegistrationOptions) (*partnercentersellv1.Registration, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRegistrationOptions)
+	var r0 *partnercentersellv1.Registration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Registration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRegistration provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetRegistration(getRegistrationOptions *partnercentersellv1.GetRegistrationOptions) (*partnercentersellv1.Registration, *core.DetailedResponse, error) {
+	ret := _m.Called(getRegistrationOptions)
+	var r0 *partnercentersellv1.Registration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Registration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetRegistrationWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetRegistrationWithContext(ctx context.Context, getRegistrationOptions *partnercentersellv1.GetRegistrationOptions) (*partnercentersellv1.Registration, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRegistrationOptions)
+	var r0 *partnercentersellv1.Registration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Registration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateRegistration provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateRegistration(updateRegistrationOptions *partnercentersellv1.UpdateRegistrationOptions) (*partnercentersellv1.Registration, *core.DetailedResponse, error) {
+	ret := _m.Called(updateRegistrationOptions)
+	var r0 *partnercentersellv1.Registration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Registration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateRegistrationWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateRegistrationWithContext(ctx context.Context, updateRegistrationOptions *partnercentersellv1.UpdateRegistrationOptions) (*partnercentersellv1.Registration, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateRegistrationOptions)
+	var r0 *partnercentersellv1.Registration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Registration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteRegistration provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteRegistration(deleteRegistrationOptions *partnercentersellv1.DeleteRegistrationOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRegistrationOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteRegistrationWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteRegistrationWithContext(ctx context.Context, deleteRegistrationOptions *partnercentersellv1.DeleteRegistrationOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRegistrationOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateOnboardingProduct provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateOnboardingProduct(createOnboardingProductOptions *partnercentersellv1.CreateOnboardingProductOptions) (*partnercentersellv1.OnboardingProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(createOnboardingProductOptions)
+	var r0 *partnercentersellv1.OnboardingProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.OnboardingProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateOnboardingProductWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateOnboardingProductWithContext(ctx context.Context, createOnboardingProductOptions *partnercentersellv1.CreateOnboardingProductOptions) (*partnercentersellv1.OnboardingProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createOnboardingProductOptions)
+	var r0 *partnercentersellv1.OnboardingProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.OnboardingProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOnboardingProduct provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetOnboardingProduct(getOnboardingProductOptions *partnercentersellv1.GetOnboardingProductOptions) (*partnercentersellv1.OnboardingProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(getOnboardingProductOptions)
+	var r0 *partnercentersellv1.OnboardingProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.OnboardingProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOnboardingProductWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetOnboardingProductWithContext(ctx context.Context, getOnboardingProductOptions *partnercentersellv1.GetOnboardingProductOptions) (*partnercentersellv1.OnboardingProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOnboardingProductOptions)
+	var r0 *partnercentersellv1.OnboardingProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.OnboardingProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateOnboardingProduct provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateOnboardingProduct(updateOnboardingProductOptions *partnercentersellv1.UpdateOnboardingProductOptions) (*partnercentersellv1.OnboardingProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(updateOnboardingProductOptions)
+	var r0 *partnercentersellv1.OnboardingProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.OnboardingProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateOnboardingProductWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateOnboardingProductWithContext(ctx context.Context, updateOnboardingProductOptions *partnercentersellv1.UpdateOnboardingProductOptions) (*partnercentersellv1.OnboardingProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateOnboardingProductOptions)
+	var r0 *partnercentersellv1.OnboardingProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.OnboardingProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteOnboardingProduct provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteOnboardingProduct(deleteOnboardingProductOptions *partnercentersellv1.DeleteOnboardingProductOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteOnboardingProductOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteOnboardingProductWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteOnboardingProductWithContext(ctx context.Context, deleteOnboardingProductOptions *partnercentersellv1.DeleteOnboardingProductOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteOnboardingProductOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateCatalogProduct provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateCatalogProduct(createCatalogProductOptions *partnercentersellv1.CreateCatalogProductOptions) (*partnercentersellv1.GlobalCatalogProduct, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(createCatalogProductOptions)
+	var r0 *partnercentersellv1.GlobalCatalogProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogProduct)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateCatalogProductWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateCatalogProductWithContext(ctx context.Context, createCatalogProductOptions *partnercentersellv1.CreateCatalogProductOptions) (*partnercentersellv1.GlobalCatalogProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createCatalogProductOptions)
+	var r0 *partnercentersellv1.GlobalCatalogProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogProduct provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetCatalogProduct(getCatalogProductOptions *partnercentersellv1.GetCatalogProductOptions) (*partnercentersellv1.GlobalCatalogProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(getCatalogProductOptions)
+	var r0 *partnercentersellv1.GlobalCatalogProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogProductWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetCatalogProductWithContext(ctx context.Context, getCatalogProductOptions *partnercentersellv1.GetCatalogProductOptions) (*partnercentersellv1.GlobalCatalogProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCatalogProductOptions)
+	var r0 *partnercentersellv1.GlobalCatalogProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogProduct provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateCatalogProduct(updateCatalogProductOptions *partnercentersellv1.UpdateCatalogProductOptions) (*partnercentersellv1.GlobalCatalogProduct, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(updateCatalogProductOptions)
+	var r0 *partnercentersellv1.GlobalCatalogProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogProduct)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogProductWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateCatalogProductWithContext(ctx context.Context, updateCatalogProductOptions *partnercentersellv1.UpdateCatalogProductOptions) (*partnercentersellv1.GlobalCatalogProduct, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateCatalogProductOptions)
+	var r0 *partnercentersellv1.GlobalCatalogProduct
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogProduct)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteCatalogProduct provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteCatalogProduct(deleteCatalogProductOptions *partnercentersellv1.DeleteCatalogProductOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteCatalogProductOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteCatalogProductWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteCatalogProductWithContext(ctx context.Context, deleteCatalogProductOptions *partnercentersellv1.DeleteCatalogProductOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteCatalogProductOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateCatalogPlan provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateCatalogPlan(createCatalogPlanOptions *partnercentersellv1.CreateCatalogPlanOptions) (*partnercentersellv1.GlobalCatalogPlan, *core.DetailedResponse, error) {
+	ret := _m.Called(createCatalogPlanOptions)
+	var r0 *partnercentersellv1.GlobalCatalogPlan
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogPlan)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateCatalogPlanWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateCatalogPlanWithContext(ctx context.Context, createCatalogPlanOptions *partnercentersellv1.CreateCatalogPlanOptions) (*partnercentersellv1.GlobalCatalogPlan, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createCatalogPlanOptions)
+	var r0 *partnercentersellv1.GlobalCatalogPlan
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogPlan)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogPlan provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetCatalogPlan(getCatalogPlanOptions *partnercentersellv1.GetCatalogPlanOptions) (*partnercentersellv1.GlobalCatalogPlan, *core.DetailedResponse, error) {
+	ret := _m.Called(getCatalogPlanOptions)
+	var r0 *partnercentersellv1.GlobalCatalogPlan
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogPlan)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogPlanWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetCatalogPlanWithContext(ctx context.Context, getCatalogPlanOptions *partnercentersellv1.GetCatalogPlanOptions) (*partnercentersellv1.GlobalCatalogPlan, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCatalogPlanOptions)
+	var r0 *partnercentersellv1.GlobalCatalogPlan
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogPlan)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogPlan provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateCatalogPlan(updateCatalogPlanOptions *partnercentersellv1.UpdateCatalogPlanOptions) (*partnercentersellv1.GlobalCatalogPlan, *core.DetailedResponse, error) {
+	ret := _m.Called(updateCatalogPlanOptions)
+	var r0 *partnercentersellv1.GlobalCatalogPlan
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogPlan)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogPlanWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateCatalogPlanWithContext(ctx context.Context, updateCatalogPlanOptions *partnercentersellv1.UpdateCatalogPlanOptions) (*partnercentersellv1.GlobalCatalogPlan, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateCatalogPlanOptions)
+	var r0 *partnercentersellv1.GlobalCatalogPlan
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogPlan)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteCatalogPlan provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteCatalogPlan(deleteCatalogPlanOptions *partnercentersellv1.DeleteCatalogPlanOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteCatalogPlanOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteCatalogPlanWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteCatalogPlanWithContext(ctx context.Context, deleteCatalogPlanOptions *partnercentersellv1.DeleteCatalogPlanOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteCatalogPlanOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateCatalogDeployment provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateCatalogDeployment(createCatalogDeploymentOptions *partnercentersellv1.CreateCatalogDeploymentOptions) (*partnercentersellv1.GlobalCatalogDeployment, *core.DetailedResponse, error) {
+	ret := _m.Called(createCatalogDeploymentOptions)
+	var r0 *partnercentersellv1.GlobalCatalogDeployment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogDeployment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateCatalogDeploymentWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateCatalogDeploymentWithContext(ctx context.Context, createCatalogDeploymentOptions *partnercentersellv1.CreateCatalogDeploymentOptions) (*partnercentersellv1.GlobalCatalogDeployment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createCatalogDeploymentOptions)
+	var r0 *partnercentersellv1.GlobalCatalogDeployment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogDeployment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogDeployment provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetCatalogDeployment(getCatalogDeploymentOptions *partnercentersellv1.GetCatalogDeploymentOptions) (*partnercentersellv1.GlobalCatalogDeployment, *core.DetailedResponse, error) {
+	ret := _m.Called(getCatalogDeploymentOptions)
+	var r0 *partnercentersellv1.GlobalCatalogDeployment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogDeployment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCatalogDeploymentWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetCatalogDeploymentWithContext(ctx context.Context, getCatalogDeploymentOptions *partnercentersellv1.GetCatalogDeploymentOptions) (*partnercentersellv1.GlobalCatalogDeployment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCatalogDeploymentOptions)
+	var r0 *partnercentersellv1.GlobalCatalogDeployment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogDeployment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogDeployment provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateCatalogDeployment(updateCatalogDeploymentOptions *partnercentersellv1.UpdateCatalogDeploymentOptions) (*partnercentersellv1.GlobalCatalogDeployment, *core.DetailedResponse, error) {
+	ret := _m.Called(updateCatalogDeploymentOptions)
+	var r0 *partnercentersellv1.GlobalCatalogDeployment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogDeployment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateCatalogDeploymentWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateCatalogDeploymentWithContext(ctx context.Context, updateCatalogDeploymentOptions *partnercentersellv1.UpdateCatalogDeploymentOptions) (*partnercentersellv1.GlobalCatalogDeployment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateCatalogDeploymentOptions)
+	var r0 *partnercentersellv1.GlobalCatalogDeployment
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.GlobalCatalogDeployment)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteCatalogDeployment provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteCatalogDeployment(deleteCatalogDeploymentOptions *partnercentersellv1.DeleteCatalogDeploymentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteCatalogDeploymentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteCatalogDeploymentWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteCatalogDeploymentWithContext(ctx context.Context, deleteCatalogDeploymentOptions *partnercentersellv1.DeleteCatalogDeploymentOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteCatalogDeploymentOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// CreateIamRegistration provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateIamRegistration(createIamRegistrationOptions *partnercentersellv1.CreateIamRegistrationOptions) (*partnercentersellv1.IamServiceRegistration, *core.DetailedResponse, error) {
+	ret := _m.Called(createIamRegistrationOptions)
+	var r0 *partnercentersellv1.IamServiceRegistration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.IamServiceRegistration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateIamRegistrationWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateIamRegistrationWithContext(ctx context.Context, createIamRegistrationOptions *partnercentersellv1.CreateIamRegistrationOptions) (*partnercentersellv1.IamServiceRegistration, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createIamRegistrationOptions)
+	var r0 *partnercentersellv1.IamServiceRegistration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.IamServiceRegistration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateIamRegistration provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateIamRegistration(updateIamRegistrationOptions *partnercentersellv1.UpdateIamRegistrationOptions) (*partnercentersellv1.IamServiceRegistration, *core.DetailedResponse, error) {
+	ret := _m.Called(updateIamRegistrationOptions)
+	var r0 *partnercentersellv1.IamServiceRegistration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.IamServiceRegistration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateIamRegistrationWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateIamRegistrationWithContext(ctx context.Context, updateIamRegistrationOptions *partnercentersellv1.UpdateIamRegistrationOptions) (*partnercentersellv1.IamServiceRegistration, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateIamRegistrationOptions)
+	var r0 *partnercentersellv1.IamServiceRegistration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.IamServiceRegistration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteIamRegistration provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteIamRegistration(deleteIamRegistrationOptions *partnercentersellv1.DeleteIamRegistrationOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteIamRegistrationOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteIamRegistrationWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteIamRegistrationWithContext(ctx context.Context, deleteIamRegistrationOptions *partnercentersellv1.DeleteIamRegistrationOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteIamRegistrationOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetIamRegistration provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetIamRegistration(getIamRegistrationOptions *partnercentersellv1.GetIamRegistrationOptions) (*partnercentersellv1.IamServiceRegistration, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getIamRegistrationOptions)
+	var r0 *partnercentersellv1.IamServiceRegistration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.IamServiceRegistration)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetIamRegistrationWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetIamRegistrationWithContext(ctx context.Context, getIamRegistrationOptions *partnercentersellv1.GetIamRegistrationOptions) (*partnercentersellv1.IamServiceRegistration, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getIamRegistrationOptions)
+	var r0 *partnercentersellv1.IamServiceRegistration
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.IamServiceRegistration)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceBroker provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateResourceBroker(createResourceBrokerOptions *partnercentersellv1.CreateResourceBrokerOptions) (*partnercentersellv1.Broker, *core.DetailedResponse, error) {
+	ret := _m.Called(createResourceBrokerOptions)
+	var r0 *partnercentersellv1.Broker
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Broker)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceBrokerWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) CreateResourceBrokerWithContext(ctx context.Context, createResourceBrokerOptions *partnercentersellv1.CreateResourceBrokerOptions) (*partnercentersellv1.Broker, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createResourceBrokerOptions)
+	var r0 *partnercentersellv1.Broker
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Broker)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateResourceBroker provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateResourceBroker(updateResourceBrokerOptions *partnercentersellv1.UpdateResourceBrokerOptions) (*partnercentersellv1.Broker, *core.DetailedResponse, error) {
+	ret := _m.Called(updateResourceBrokerOptions)
+	var r0 *partnercentersellv1.Broker
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Broker)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateResourceBrokerWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) UpdateResourceBrokerWithContext(ctx context.Context, updateResourceBrokerOptions *partnercentersellv1.UpdateResourceBrokerOptions) (*partnercentersellv1.Broker, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateResourceBrokerOptions)
+	var r0 *partnercentersellv1.Broker
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Broker)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceBroker provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetResourceBroker(getResourceBrokerOptions *partnercentersellv1.GetResourceBrokerOptions) (*partnercentersellv1.Broker, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceBrokerOptions)
+	var r0 *partnercentersellv1.Broker
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Broker)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceBrokerWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetResourceBrokerWithContext(ctx context.Context, getResourceBrokerOptions *partnercentersellv1.GetResourceBrokerOptions) (*partnercentersellv1.Broker, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceBrokerOptions)
+	var r0 *partnercentersellv1.Broker
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.Broker)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteResourceBroker provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteResourceBroker(deleteResourceBrokerOptions *partnercentersellv1.DeleteResourceBrokerOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteResourceBrokerOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteResourceBrokerWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) DeleteResourceBrokerWithContext(ctx context.Context, deleteResourceBrokerOptions *partnercentersellv1.DeleteResourceBrokerOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteResourceBrokerOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListProductBadges provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) ListProductBadges(listProductBadgesOptions *partnercentersellv1.ListProductBadgesOptions) (*partnercentersellv1.ProductBadgeCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listProductBadgesOptions)
+	var r0 *partnercentersellv1.ProductBadgeCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.ProductBadgeCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListProductBadgesWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) ListProductBadgesWithContext(ctx context.Context, listProductBadgesOptions *partnercentersellv1.ListProductBadgesOptions) (*partnercentersellv1.ProductBadgeCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listProductBadgesOptions)
+	var r0 *partnercentersellv1.ProductBadgeCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.ProductBadgeCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetProductBadge provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetProductBadge(getProductBadgeOptions *partnercentersellv1.GetProductBadgeOptions) (*partnercentersellv1.ProductBadge, *core.DetailedResponse, error) {
+	ret := _m.Called(getProductBadgeOptions)
+	var r0 *partnercentersellv1.ProductBadge
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.ProductBadge)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetProductBadgeWithContext provides a mock function with the given fields.
+func (_m *PartnerCenterSellV1) GetProductBadgeWithContext(ctx context.Context, getProductBadgeOptions *partnercentersellv1.GetProductBadgeOptions) (*partnercentersellv1.ProductBadge, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getProductBadgeOptions)
+	var r0 *partnercentersellv1.ProductBadge
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnercentersellv1.ProductBadge)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
@@ -192,7 +192,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateRegistrationWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "CreateRegistration"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/registration`, nil)
 	if err != nil {
@@ -243,7 +243,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateRegistrationWithContext(ctx
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_registration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -284,7 +284,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetRegistrationWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "GetRegistration"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/registration/{registration_id}`, pathParamsMap)
 	if err != nil {
@@ -312,7 +312,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetRegistrationWithContext(ctx con
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_registration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -353,7 +353,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateRegistrationWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "UpdateRegistration"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/registration/{registration_id}`, pathParamsMap)
 	if err != nil {
@@ -388,7 +388,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateRegistrationWithContext(ctx
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_registration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -429,7 +429,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteRegistrationWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "DeleteRegistration"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/registration/{registration_id}`, pathParamsMap)
 	if err != nil {
@@ -455,7 +455,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteRegistrationWithContext(ctx
 	response, err = partnerCenterSell.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_registration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -485,7 +485,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateOnboardingProductWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "CreateOnboardingProduct"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products`, nil)
 	if err != nil {
@@ -542,7 +542,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateOnboardingProductWithContext
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_onboarding_product", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -583,7 +583,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetOnboardingProductWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "GetOnboardingProduct"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}`, pathParamsMap)
 	if err != nil {
@@ -611,7 +611,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetOnboardingProductWithContext(ct
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_onboarding_product", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -652,7 +652,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateOnboardingProductWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "UpdateOnboardingProduct"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}`, pathParamsMap)
 	if err != nil {
@@ -687,7 +687,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateOnboardingProductWithContext
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_onboarding_product", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -728,7 +728,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteOnboardingProductWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "DeleteOnboardingProduct"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}`, pathParamsMap)
 	if err != nil {
@@ -754,7 +754,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteOnboardingProductWithContext
 	response, err = partnerCenterSell.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_onboarding_product", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -788,7 +788,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateCatalogProductWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "CreateCatalogProduct"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products`, pathParamsMap)
 	if err != nil {
@@ -861,7 +861,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateCatalogProductWithContext(ct
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_catalog_product", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -903,7 +903,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetCatalogProductWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "GetCatalogProduct"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}`, pathParamsMap)
 	if err != nil {
@@ -935,7 +935,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetCatalogProductWithContext(ctx c
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_product", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -977,7 +977,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateCatalogProductWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "UpdateCatalogProduct"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}`, pathParamsMap)
 	if err != nil {
@@ -1016,7 +1016,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateCatalogProductWithContext(ct
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_catalog_product", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1058,7 +1058,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteCatalogProductWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "DeleteCatalogProduct"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}`, pathParamsMap)
 	if err != nil {
@@ -1088,7 +1088,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteCatalogProductWithContext(ct
 	response, err = partnerCenterSell.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_catalog_product", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1122,7 +1122,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateCatalogPlanWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "CreateCatalogPlan"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}/catalog_plans`, pathParamsMap)
 	if err != nil {
@@ -1195,7 +1195,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateCatalogPlanWithContext(ctx c
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_catalog_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1238,7 +1238,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetCatalogPlanWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "GetCatalogPlan"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}/catalog_plans/{catalog_plan_id}`, pathParamsMap)
 	if err != nil {
@@ -1270,7 +1270,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetCatalogPlanWithContext(ctx cont
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1313,7 +1313,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateCatalogPlanWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "UpdateCatalogPlan"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}/catalog_plans/{catalog_plan_id}`, pathParamsMap)
 	if err != nil {
@@ -1352,7 +1352,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateCatalogPlanWithContext(ctx c
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_catalog_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1395,7 +1395,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteCatalogPlanWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "DeleteCatalogPlan"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}/catalog_plans/{catalog_plan_id}`, pathParamsMap)
 	if err != nil {
@@ -1425,7 +1425,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteCatalogPlanWithContext(ctx c
 	response, err = partnerCenterSell.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_catalog_plan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1461,7 +1461,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateCatalogDeploymentWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "CreateCatalogDeployment"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}/catalog_plans/{catalog_plan_id}/catalog_deployments`, pathParamsMap)
 	if err != nil {
@@ -1531,7 +1531,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateCatalogDeploymentWithContext
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_catalog_deployment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1575,7 +1575,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetCatalogDeploymentWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "GetCatalogDeployment"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}/catalog_plans/{catalog_plan_id}/catalog_deployments/{catalog_deployment_id}`, pathParamsMap)
 	if err != nil {
@@ -1607,7 +1607,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetCatalogDeploymentWithContext(ct
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_deployment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1651,7 +1651,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateCatalogDeploymentWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "UpdateCatalogDeployment"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}/catalog_plans/{catalog_plan_id}/catalog_deployments/{catalog_deployment_id}`, pathParamsMap)
 	if err != nil {
@@ -1690,7 +1690,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateCatalogDeploymentWithContext
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_catalog_deployment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1734,7 +1734,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteCatalogDeploymentWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "DeleteCatalogDeployment"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/catalog_products/{catalog_product_id}/catalog_plans/{catalog_plan_id}/catalog_deployments/{catalog_deployment_id}`, pathParamsMap)
 	if err != nil {
@@ -1764,7 +1764,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteCatalogDeploymentWithContext
 	response, err = partnerCenterSell.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_catalog_deployment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1797,7 +1797,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateIamRegistrationWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "CreateIamRegistration"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/iam_registration`, pathParamsMap)
 	if err != nil {
@@ -1879,7 +1879,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateIamRegistrationWithContext(c
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_iam_registration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1921,7 +1921,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateIamRegistrationWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "UpdateIamRegistration"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/iam_registration/{programmatic_name}`, pathParamsMap)
 	if err != nil {
@@ -1960,7 +1960,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateIamRegistrationWithContext(c
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_iam_registration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2002,7 +2002,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteIamRegistrationWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "DeleteIamRegistration"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/iam_registration/{programmatic_name}`, pathParamsMap)
 	if err != nil {
@@ -2032,7 +2032,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteIamRegistrationWithContext(c
 	response, err = partnerCenterSell.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_iam_registration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2066,7 +2066,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetIamRegistrationWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "GetIamRegistration"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/products/{product_id}/iam_registration/{programmatic_name}`, pathParamsMap)
 	if err != nil {
@@ -2098,7 +2098,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetIamRegistrationWithContext(ctx
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_iam_registration", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2135,7 +2135,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateResourceBrokerWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "CreateResourceBroker"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/brokers`, nil)
 	if err != nil {
@@ -2208,7 +2208,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateResourceBrokerWithContext(ct
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_broker", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2249,7 +2249,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateResourceBrokerWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "UpdateResourceBroker"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/brokers/{broker_id}`, pathParamsMap)
 	if err != nil {
@@ -2288,7 +2288,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateResourceBrokerWithContext(ct
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_broker", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2329,7 +2329,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetResourceBrokerWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "GetResourceBroker"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/brokers/{broker_id}`, pathParamsMap)
 	if err != nil {
@@ -2361,7 +2361,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetResourceBrokerWithContext(ctx c
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_broker", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2402,7 +2402,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteResourceBrokerWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "DeleteResourceBroker"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/brokers/{broker_id}`, pathParamsMap)
 	if err != nil {
@@ -2435,7 +2435,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteResourceBrokerWithContext(ct
 	response, err = partnerCenterSell.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_broker", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2459,7 +2459,7 @@ func (partnerCenterSell *PartnerCenterSellV1) ListProductBadgesWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "ListProductBadges"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/product_badges`, nil)
 	if err != nil {
@@ -2494,7 +2494,7 @@ func (partnerCenterSell *PartnerCenterSellV1) ListProductBadgesWithContext(ctx c
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_product_badges", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2535,7 +2535,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetProductBadgeWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_center_sell", "V1", "GetProductBadge"))
 	builder.EnableGzipCompression = partnerCenterSell.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerCenterSell.Service.Options.URL, `/product_badges/{badge_id}`, pathParamsMap)
 	if err != nil {
@@ -2563,7 +2563,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetProductBadgeWithContext(ctx con
 	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_product_badge", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
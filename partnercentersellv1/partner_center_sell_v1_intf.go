@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package partnercentersellv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PartnerCenterSellV1Intf : The operations of the PartnerCenterSellV1 service client.
+// The interface is satisfied by *PartnerCenterSellV1 and by mocks.PartnerCenterSellV1, allowing consumers to substitute a mock for the client in tests.
+type PartnerCenterSellV1Intf interface {
+	// CreateRegistration : Register your account in Partner Center - Sell
+	CreateRegistration(createRegistrationOptions *CreateRegistrationOptions) (result *Registration, response *core.DetailedResponse, err error)
+	// CreateRegistrationWithContext is an alternate form of the CreateRegistration method which supports a Context parameter
+	CreateRegistrationWithContext(ctx context.Context, createRegistrationOptions *CreateRegistrationOptions) (result *Registration, response *core.DetailedResponse, err error)
+	// GetRegistration : Retrieve a Partner Center - Sell registration
+	GetRegistration(getRegistrationOptions *GetRegistrationOptions) (result *Registration, response *core.DetailedResponse, err error)
+	// GetRegistrationWithContext is an alternate form of the GetRegistration method which supports a Context parameter
+	GetRegistrationWithContext(ctx context.Context, getRegistrationOptions *GetRegistrationOptions) (result *Registration, response *core.DetailedResponse, err error)
+	// UpdateRegistration : Update a Partner Center - Sell registration
+	UpdateRegistration(updateRegistrationOptions *UpdateRegistrationOptions) (result *Registration, response *core.DetailedResponse, err error)
+	// UpdateRegistrationWithContext is an alternate form of the UpdateRegistration method which supports a Context parameter
+	UpdateRegistrationWithContext(ctx context.Context, updateRegistrationOptions *UpdateRegistrationOptions) (result *Registration, response *core.DetailedResponse, err error)
+	// DeleteRegistration : Delete your registration in Partner - Center Sell
+	DeleteRegistration(deleteRegistrationOptions *DeleteRegistrationOptions) (response *core.DetailedResponse, err error)
+	// DeleteRegistrationWithContext is an alternate form of the DeleteRegistration method which supports a Context parameter
+	DeleteRegistrationWithContext(ctx context.Context, deleteRegistrationOptions *DeleteRegistrationOptions) (response *core.DetailedResponse, err error)
+	// CreateOnboardingProduct : Create a product to onboard
+	CreateOnboardingProduct(createOnboardingProductOptions *CreateOnboardingProductOptions) (result *OnboardingProduct, response *core.DetailedResponse, err error)
+	// CreateOnboardingProductWithContext is an alternate form of the CreateOnboardingProduct method which supports a Context parameter
+	CreateOnboardingProductWithContext(ctx context.Context, createOnboardingProductOptions *CreateOnboardingProductOptions) (result *OnboardingProduct, response *core.DetailedResponse, err error)
+	// GetOnboardingProduct : Get an onboarding product
+	GetOnboardingProduct(getOnboardingProductOptions *GetOnboardingProductOptions) (result *OnboardingProduct, response *core.DetailedResponse, err error)
+	// GetOnboardingProductWithContext is an alternate form of the GetOnboardingProduct method which supports a Context parameter
+	GetOnboardingProductWithContext(ctx context.Context, getOnboardingProductOptions *GetOnboardingProductOptions) (result *OnboardingProduct, response *core.DetailedResponse, err error)
+	// UpdateOnboardingProduct : Update an onboarding product
+	UpdateOnboardingProduct(updateOnboardingProductOptions *UpdateOnboardingProductOptions) (result *OnboardingProduct, response *core.DetailedResponse, err error)
+	// UpdateOnboardingProductWithContext is an alternate form of the UpdateOnboardingProduct method which supports a Context parameter
+	UpdateOnboardingProductWithContext(ctx context.Context, updateOnboardingProductOptions *UpdateOnboardingProductOptions) (result *OnboardingProduct, response *core.DetailedResponse, err error)
+	// DeleteOnboardingProduct : Delete an onboarding product
+	DeleteOnboardingProduct(deleteOnboardingProductOptions *DeleteOnboardingProductOptions) (response *core.DetailedResponse, err error)
+	// DeleteOnboardingProductWithContext is an alternate form of the DeleteOnboardingProduct method which supports a Context parameter
+	DeleteOnboardingProductWithContext(ctx context.Context, deleteOnboardingProductOptions *DeleteOnboardingProductOptions) (response *core.DetailedResponse, err error)
+	// CreateCatalogProduct : Create a global catalog product
+	CreateCatalogProduct(createCatalogProductOptions *CreateCatalogProductOptions) (result *GlobalCatalogProduct, response *core.DetailedResponse, err error)
+	// CreateCatalogProductWithContext is an alternate form of the CreateCatalogProduct method which supports a Context parameter
+	CreateCatalogProductWithContext(ctx context.Context, createCatalogProductOptions *CreateCatalogProductOptions) (result *GlobalCatalogProduct, response *core.DetailedResponse, err error)
+	// GetCatalogProduct : Get a global catalog product
+	GetCatalogProduct(getCatalogProductOptions *GetCatalogProductOptions) (result *GlobalCatalogProduct, response *core.DetailedResponse, err error)
+	// GetCatalogProductWithContext is an alternate form of the GetCatalogProduct method which supports a Context parameter
+	GetCatalogProductWithContext(ctx context.Context, getCatalogProductOptions *GetCatalogProductOptions) (result *GlobalCatalogProduct, response *core.DetailedResponse, err error)
+	// UpdateCatalogProduct : Update a global catalog product
+	UpdateCatalogProduct(updateCatalogProductOptions *UpdateCatalogProductOptions) (result *GlobalCatalogProduct, response *core.DetailedResponse, err error)
+	// UpdateCatalogProductWithContext is an alternate form of the UpdateCatalogProduct method which supports a Context parameter
+	UpdateCatalogProductWithContext(ctx context.Context, updateCatalogProductOptions *UpdateCatalogProductOptions) (result *GlobalCatalogProduct, response *core.DetailedResponse, err error)
+	// DeleteCatalogProduct : Delete a global catalog product
+	DeleteCatalogProduct(deleteCatalogProductOptions *DeleteCatalogProductOptions) (response *core.DetailedResponse, err error)
+	// DeleteCatalogProductWithContext is an alternate form of the DeleteCatalogProduct method which supports a Context parameter
+	DeleteCatalogProductWithContext(ctx context.Context, deleteCatalogProductOptions *DeleteCatalogProductOptions) (response *core.DetailedResponse, err error)
+	// CreateCatalogPlan : Create a pricing plan in global catalog
+	CreateCatalogPlan(createCatalogPlanOptions *CreateCatalogPlanOptions) (result *GlobalCatalogPlan, response *core.DetailedResponse, err error)
+	// CreateCatalogPlanWithContext is an alternate form of the CreateCatalogPlan method which supports a Context parameter
+	CreateCatalogPlanWithContext(ctx context.Context, createCatalogPlanOptions *CreateCatalogPlanOptions) (result *GlobalCatalogPlan, response *core.DetailedResponse, err error)
+	// GetCatalogPlan : Get a global catalog pricing plan
+	GetCatalogPlan(getCatalogPlanOptions *GetCatalogPlanOptions) (result *GlobalCatalogPlan, response *core.DetailedResponse, err error)
+	// GetCatalogPlanWithContext is an alternate form of the GetCatalogPlan method which supports a Context parameter
+	GetCatalogPlanWithContext(ctx context.Context, getCatalogPlanOptions *GetCatalogPlanOptions) (result *GlobalCatalogPlan, response *core.DetailedResponse, err error)
+	// UpdateCatalogPlan : Update a global catalog plan
+	UpdateCatalogPlan(updateCatalogPlanOptions *UpdateCatalogPlanOptions) (result *GlobalCatalogPlan, response *core.DetailedResponse, err error)
+	// UpdateCatalogPlanWithContext is an alternate form of the UpdateCatalogPlan method which supports a Context parameter
+	UpdateCatalogPlanWithContext(ctx context.Context, updateCatalogPlanOptions *UpdateCatalogPlanOptions) (result *GlobalCatalogPlan, response *core.DetailedResponse, err error)
+	// DeleteCatalogPlan : Delete a global catalog pricing plan
+	DeleteCatalogPlan(deleteCatalogPlanOptions *DeleteCatalogPlanOptions) (response *core.DetailedResponse, err error)
+	// DeleteCatalogPlanWithContext is an alternate form of the DeleteCatalogPlan method which supports a Context parameter
+	DeleteCatalogPlanWithContext(ctx context.Context, deleteCatalogPlanOptions *DeleteCatalogPlanOptions) (response *core.DetailedResponse, err error)
+	// CreateCatalogDeployment : Create a global catalog deployment
+	CreateCatalogDeployment(createCatalogDeploymentOptions *CreateCatalogDeploymentOptions) (result *GlobalCatalogDeployment, response *core.DetailedResponse, err error)
+	// CreateCatalogDeploymentWithContext is an alternate form of the CreateCatalogDeployment method which supports a Context parameter
+	CreateCatalogDeploymentWithContext(ctx context.Context, createCatalogDeploymentOptions *CreateCatalogDeploymentOptions) (result *GlobalCatalogDeployment, response *core.DetailedResponse, err error)
+	// GetCatalogDeployment : Get a global catalog deployment
+	GetCatalogDeployment(getCatalogDeploymentOptions *GetCatalogDeploymentOptions) (result *GlobalCatalogDeployment, response *core.DetailedResponse, err error)
+	// GetCatalogDeploymentWithContext is an alternate form of the GetCatalogDeployment method which supports a Context parameter
+	GetCatalogDeploymentWithContext(ctx context.Context, getCatalogDeploymentOptions *GetCatalogDeploymentOptions) (result *GlobalCatalogDeployment, response *core.DetailedResponse, err error)
+	// UpdateCatalogDeployment : Update a global catalog deployment
+	UpdateCatalogDeployment(updateCatalogDeploymentOptions *UpdateCatalogDeploymentOptions) (result *GlobalCatalogDeployment, response *core.DetailedResponse, err error)
+	// UpdateCatalogDeploymentWithContext is an alternate form of the UpdateCatalogDeployment method which supports a Context parameter
+	UpdateCatalogDeploymentWithContext(ctx context.Context, updateCatalogDeploymentOptions *UpdateCatalogDeploymentOptions) (result *GlobalCatalogDeployment, response *core.DetailedResponse, err error)
+	// DeleteCatalogDeployment : Delete a global catalog deployment
+	DeleteCatalogDeployment(deleteCatalogDeploymentOptions *DeleteCatalogDeploymentOptions) (response *core.DetailedResponse, err error)
+	// DeleteCatalogDeploymentWithContext is an alternate form of the DeleteCatalogDeployment method which supports a Context parameter
+	DeleteCatalogDeploymentWithContext(ctx context.Context, deleteCatalogDeploymentOptions *DeleteCatalogDeploymentOptions) (response *core.DetailedResponse, err error)
+	// CreateIamRegistration : Create IAM registration for your service
+	CreateIamRegistration(createIamRegistrationOptions *CreateIamRegistrationOptions) (result *IamServiceRegistration, response *core.DetailedResponse, err error)
+	// CreateIamRegistrationWithContext is an alternate form of the CreateIamRegistration method which supports a Context parameter
+	CreateIamRegistrationWithContext(ctx context.Context, createIamRegistrationOptions *CreateIamRegistrationOptions) (result *IamServiceRegistration, response *core.DetailedResponse, err error)
+	// UpdateIamRegistration : Update IAM registration for your service
+	UpdateIamRegistration(updateIamRegistrationOptions *UpdateIamRegistrationOptions) (result *IamServiceRegistration, response *core.DetailedResponse, err error)
+	// UpdateIamRegistrationWithContext is an alternate form of the UpdateIamRegistration method which supports a Context parameter
+	UpdateIamRegistrationWithContext(ctx context.Context, updateIamRegistrationOptions *UpdateIamRegistrationOptions) (result *IamServiceRegistration, response *core.DetailedResponse, err error)
+	// DeleteIamRegistration : Delete IAM registration for your service
+	DeleteIamRegistration(deleteIamRegistrationOptions *DeleteIamRegistrationOptions) (response *core.DetailedResponse, err error)
+	// DeleteIamRegistrationWithContext is an alternate form of the DeleteIamRegistration method which supports a Context parameter
+	DeleteIamRegistrationWithContext(ctx context.Context, deleteIamRegistrationOptions *DeleteIamRegistrationOptions) (response *core.DetailedResponse, err error)
+	// GetIamRegistration : Get IAM registration for your service
+	GetIamRegistration(getIamRegistrationOptions *GetIamRegistrationOptions) (result *IamServiceRegistration, response *core.DetailedResponse, err error)
+	// GetIamRegistrationWithContext is an alternate form of the GetIamRegistration method which supports a Context parameter
+	GetIamRegistrationWithContext(ctx context.Context, getIamRegistrationOptions *GetIamRegistrationOptions) (result *IamServiceRegistration, response *core.DetailedResponse, err error)
+	// CreateResourceBroker : Create a broker
+	CreateResourceBroker(createResourceBrokerOptions *CreateResourceBrokerOptions) (result *Broker, response *core.DetailedResponse, err error)
+	// CreateResourceBrokerWithContext is an alternate form of the CreateResourceBroker method which supports a Context parameter
+	CreateResourceBrokerWithContext(ctx context.Context, createResourceBrokerOptions *CreateResourceBrokerOptions) (result *Broker, response *core.DetailedResponse, err error)
+	// UpdateResourceBroker : Update broker details
+	UpdateResourceBroker(updateResourceBrokerOptions *UpdateResourceBrokerOptions) (result *Broker, response *core.DetailedResponse, err error)
+	// UpdateResourceBrokerWithContext is an alternate form of the UpdateResourceBroker method which supports a Context parameter
+	UpdateResourceBrokerWithContext(ctx context.Context, updateResourceBrokerOptions *UpdateResourceBrokerOptions) (result *Broker, response *core.DetailedResponse, err error)
+	// GetResourceBroker : Get a broker
+	GetResourceBroker(getResourceBrokerOptions *GetResourceBrokerOptions) (result *Broker, response *core.DetailedResponse, err error)
+	// GetResourceBrokerWithContext is an alternate form of the GetResourceBroker method which supports a Context parameter
+	GetResourceBrokerWithContext(ctx context.Context, getResourceBrokerOptions *GetResourceBrokerOptions) (result *Broker, response *core.DetailedResponse, err error)
+	// DeleteResourceBroker : Remove a broker
+	DeleteResourceBroker(deleteResourceBrokerOptions *DeleteResourceBrokerOptions) (response *core.DetailedResponse, err error)
+	// DeleteResourceBrokerWithContext is an alternate form of the DeleteResourceBroker method which supports a Context parameter
+	DeleteResourceBrokerWithContext(ctx context.Context, deleteResourceBrokerOptions *DeleteResourceBrokerOptions) (response *core.DetailedResponse, err error)
+	// ListProductBadges : List badges
+	ListProductBadges(listProductBadgesOptions *ListProductBadgesOptions) (result *ProductBadgeCollection, response *core.DetailedResponse, err error)
+	// ListProductBadgesWithContext is an alternate form of the ListProductBadges method which supports a Context parameter
+	ListProductBadgesWithContext(ctx context.Context, listProductBadgesOptions *ListProductBadgesOptions) (result *ProductBadgeCollection, response *core.DetailedResponse, err error)
+	// GetProductBadge : Get badge
+	GetProductBadge(getProductBadgeOptions *GetProductBadgeOptions) (result *ProductBadge, response *core.DetailedResponse, err error)
+	// GetProductBadgeWithContext is an alternate form of the GetProductBadge method which supports a Context parameter
+	GetProductBadgeWithContext(ctx context.Context, getProductBadgeOptions *GetProductBadgeOptions) (result *ProductBadge, response *core.DetailedResponse, err error)
+}
+
+var _ PartnerCenterSellV1Intf = (*PartnerCenterSellV1)(nil)
@@ -0,0 +1,598 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package partnercentersellv1
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"reflect"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the ProductChange.Resource property.
+const (
+	ProductChangeResourceRegistrationConst      = "registration"
+	ProductChangeResourceProductConst           = "product"
+	ProductChangeResourceBrokerConst            = "broker"
+	ProductChangeResourceIamRegistrationConst   = "iam_registration"
+	ProductChangeResourceCatalogProductConst    = "catalog_product"
+	ProductChangeResourceCatalogPlanConst       = "catalog_plan"
+	ProductChangeResourceCatalogDeploymentConst = "catalog_deployment"
+)
+
+// Constants associated with the ProductChange.Action property.
+const (
+	ProductChangeActionCreateConst = "create"
+	ProductChangeActionUpdateConst = "update"
+	ProductChangeActionNoneConst   = "none"
+)
+
+// ProductApplierOptions : The options of a ProductApplier.
+type ProductApplierOptions struct {
+	// The client used to apply the spec.
+	Service PartnerCenterSellV1Intf `validate:"required,structonly"`
+
+	// The lock file in which the IDs of the created resources are stored. It is created if it does not exist.
+	LockPath string `validate:"required"`
+
+	// Compute the changes without making them.
+	DryRun bool
+}
+
+// ProductChange : A change made, or to be made in a dry run, to a resource of a product.
+type ProductChange struct {
+	// The kind of resource, one of the ProductChangeResource* constants.
+	Resource string `json:"resource"`
+
+	// The name of the resource in the spec, if any.
+	Name string `json:"name,omitempty"`
+
+	// The ID of the resource. It is empty for resources to be created in a dry run.
+	ID string `json:"id,omitempty"`
+
+	// The action, one of the ProductChangeAction* constants.
+	Action string `json:"action"`
+
+	// The properties sent to update the resource.
+	Patch map[string]interface{} `json:"patch,omitempty"`
+}
+
+// ProductApplyResult : The result of applying a ProductSpec.
+type ProductApplyResult struct {
+	// The changes, in the order in which they were made.
+	Changes []ProductChange `json:"changes"`
+
+	// The IDs of the resources. In a dry run, the IDs of the resources to be created are missing.
+	Lock *ProductLock `json:"lock"`
+}
+
+// ProductApplier : Applies ProductSpecs, creating or updating the resources of the product in dependency order:
+// the registration, the onboarding product, the brokers, the IAM registration, and the Global Catalog product
+// with its plans and deployments.
+//
+// The IDs of the created resources are stored in the lock file after each creation, so that applying the spec
+// again, even after a failure, updates the existing resources. An update sends only the properties of the spec
+// that differ from the current resource; write-only properties, such as the password of a broker, are sent every
+// time. Resources removed from the spec are not deleted.
+type ProductApplier struct {
+	options *ProductApplierOptions
+}
+
+// NewProductApplier : constructs an instance of ProductApplier with the specified options.
+func NewProductApplier(options *ProductApplierOptions) (applier *ProductApplier, err error) {
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	applier = &ProductApplier{options: options}
+	return
+}
+
+// Apply validates the spec and applies it. When it fails, the result lists the changes made before the failure.
+func (applier *ProductApplier) Apply(ctx context.Context, spec *ProductSpec) (result *ProductApplyResult, err error) {
+	err = spec.Validate()
+	if err != nil {
+		return
+	}
+	lock := &ProductLock{}
+	if _, statErr := os.Stat(applier.options.LockPath); statErr == nil {
+		lock, err = ReadProductLock(applier.options.LockPath)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "read-lock-error")
+			return
+		}
+	}
+	apply := &productApply{
+		ProductApplier: applier,
+		service:        applier.options.Service,
+		spec:           spec,
+		env:            stringPtrOrNil(spec.Env),
+		result:         &ProductApplyResult{Changes: []ProductChange{}, Lock: lock},
+	}
+	err = apply.run(ctx)
+	result = apply.result
+	return
+}
+
+// productApply : The state of one call to Apply.
+type productApply struct {
+	*ProductApplier
+	service PartnerCenterSellV1Intf
+	spec    *ProductSpec
+	env     *string
+	result  *ProductApplyResult
+}
+
+// productStep : A resource to create or update.
+type productStep struct {
+	resource string
+	name     string
+
+	// The ID of the resource in the lock file, if any.
+	id string
+
+	// The desired properties of the resource.
+	desired interface {
+		AsPatch() (map[string]interface{}, error)
+	}
+
+	get    func(ctx context.Context, id string) (current interface{}, err error)
+	create func(ctx context.Context) (id string, err error)
+	update func(ctx context.Context, id string, patch map[string]interface{}) error
+
+	// Stores the ID of the resource in the lock.
+	lock func(lock *ProductLock, id string)
+}
+
+func (apply *productApply) run(ctx context.Context) (err error) {
+	spec, lock := apply.spec, apply.result.Lock
+
+	if spec.Registration != nil {
+		err = apply.reconcile(ctx, apply.registrationStep(lock.RegistrationID))
+		if err != nil {
+			return
+		}
+	}
+	err = apply.reconcile(ctx, apply.productStep(lock.ProductID))
+	if err != nil {
+		return
+	}
+	for i := range spec.Brokers {
+		err = apply.reconcile(ctx, apply.brokerStep(&spec.Brokers[i], lock.Brokers[spec.Brokers[i].Name]))
+		if err != nil {
+			return
+		}
+	}
+
+	// In a dry run, the children of resources to be created are to be created too.
+	productID := lock.ProductID
+	if spec.IamRegistration != nil {
+		err = apply.reconcile(ctx, apply.iamRegistrationStep(productID, lockedChildID(productID, lock.IamRegistration)))
+		if err != nil {
+			return
+		}
+	}
+	catalogProduct := spec.CatalogProduct
+	if catalogProduct == nil {
+		return
+	}
+	err = apply.reconcile(ctx, apply.catalogProductStep(productID, lockedChildID(productID, lock.CatalogProductID)))
+	if err != nil {
+		return
+	}
+	catalogProductID := lock.CatalogProductID
+	for i := range catalogProduct.Plans {
+		plan := &catalogProduct.Plans[i]
+		err = apply.reconcile(ctx, apply.catalogPlanStep(plan, productID, catalogProductID,
+			lockedChildID(catalogProductID, lock.CatalogPlans[plan.Name])))
+		if err != nil {
+			return
+		}
+		planID := lock.CatalogPlans[plan.Name]
+		for j := range plan.Deployments {
+			deployment := &plan.Deployments[j]
+			err = apply.reconcile(ctx, apply.catalogDeploymentStep(plan.Name, deployment, productID, catalogProductID, planID,
+				lockedChildID(planID, lock.CatalogDeployments[plan.Name+"/"+deployment.Name])))
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// reconcile creates the resource of a step if it has no ID or no longer exists, and otherwise updates the
+// properties that changed, and stores the ID of the resource in the lock.
+func (apply *productApply) reconcile(ctx context.Context, step *productStep) (err error) {
+	change := ProductChange{Resource: step.resource, Name: step.name, ID: step.id}
+
+	var current interface{}
+	if change.ID != "" {
+		current, err = step.get(ctx, change.ID)
+		if _, notFound := common.AsNotFound(err); notFound {
+			// The resource in the lock file was deleted, so it is created again.
+			change.ID, err = "", nil
+		} else if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-"+step.resource+"-error")
+			return
+		}
+	}
+
+	if change.ID == "" {
+		change.Action = ProductChangeActionCreateConst
+		if !apply.options.DryRun {
+			change.ID, err = step.create(ctx)
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "create-"+step.resource+"-error")
+				return
+			}
+		}
+	} else {
+		var desired map[string]interface{}
+		desired, err = step.desired.AsPatch()
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "patch-"+step.resource+"-error")
+			return
+		}
+		change.Patch, err = diffPatch(desired, current)
+		if err != nil {
+			return
+		}
+		change.Action = ProductChangeActionNoneConst
+		if len(change.Patch) > 0 {
+			change.Action = ProductChangeActionUpdateConst
+			if !apply.options.DryRun {
+				err = step.update(ctx, change.ID, change.Patch)
+				if err != nil {
+					err = core.RepurposeSDKProblem(err, "update-"+step.resource+"-error")
+					return
+				}
+			}
+		}
+	}
+	apply.result.Changes = append(apply.result.Changes, change)
+
+	step.lock(apply.result.Lock, change.ID)
+	if change.Action == ProductChangeActionCreateConst && !apply.options.DryRun {
+		// The lock file is written after each creation so that a failure later on does not lose the ID.
+		err = WriteProductLock(apply.options.LockPath, apply.result.Lock)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "write-lock-error")
+		}
+	}
+	return
+}
+
+func (apply *productApply) registrationStep(id string) *productStep {
+	registration := apply.spec.Registration
+	return &productStep{
+		resource: ProductChangeResourceRegistrationConst,
+		id:       id,
+		desired:  &registration.RegistrationPatch,
+		get: func(ctx context.Context, id string) (interface{}, error) {
+			current, _, err := apply.service.GetRegistrationWithContext(ctx, &GetRegistrationOptions{RegistrationID: &id})
+			return current, err
+		},
+		create: func(ctx context.Context) (string, error) {
+			created, _, err := apply.service.CreateRegistrationWithContext(ctx, registration.createOptions())
+			return createdID(created, err, func() *string { return created.ID })
+		},
+		update: func(ctx context.Context, id string, patch map[string]interface{}) error {
+			_, _, err := apply.service.UpdateRegistrationWithContext(ctx, &UpdateRegistrationOptions{
+				RegistrationID:    &id,
+				RegistrationPatch: patch,
+			})
+			return err
+		},
+		lock: func(lock *ProductLock, id string) {
+			lock.RegistrationID = id
+		},
+	}
+}
+
+func (apply *productApply) productStep(id string) *productStep {
+	product := apply.spec.Product
+	return &productStep{
+		resource: ProductChangeResourceProductConst,
+		id:       id,
+		desired:  &product.OnboardingProductPatch,
+		get: func(ctx context.Context, id string) (interface{}, error) {
+			current, _, err := apply.service.GetOnboardingProductWithContext(ctx, &GetOnboardingProductOptions{ProductID: &id})
+			return current, err
+		},
+		create: func(ctx context.Context) (string, error) {
+			created, _, err := apply.service.CreateOnboardingProductWithContext(ctx, product.createOptions())
+			return createdID(created, err, func() *string { return created.ID })
+		},
+		update: func(ctx context.Context, id string, patch map[string]interface{}) error {
+			_, _, err := apply.service.UpdateOnboardingProductWithContext(ctx, &UpdateOnboardingProductOptions{
+				ProductID:              &id,
+				OnboardingProductPatch: patch,
+			})
+			return err
+		},
+		lock: func(lock *ProductLock, id string) {
+			lock.ProductID = id
+		},
+	}
+}
+
+func (apply *productApply) brokerStep(broker *BrokerSpec, id string) *productStep {
+	return &productStep{
+		resource: ProductChangeResourceBrokerConst,
+		name:     broker.Name,
+		id:       id,
+		desired:  &broker.BrokerPatch,
+		get: func(ctx context.Context, id string) (interface{}, error) {
+			current, _, err := apply.service.GetResourceBrokerWithContext(ctx, &GetResourceBrokerOptions{BrokerID: &id, Env: apply.env})
+			return current, err
+		},
+		create: func(ctx context.Context) (string, error) {
+			created, _, err := apply.service.CreateResourceBrokerWithContext(ctx, broker.createOptions(apply.env))
+			return createdID(created, err, func() *string { return created.ID })
+		},
+		update: func(ctx context.Context, id string, patch map[string]interface{}) error {
+			_, _, err := apply.service.UpdateResourceBrokerWithContext(ctx, &UpdateResourceBrokerOptions{
+				BrokerID:    &id,
+				BrokerPatch: patch,
+				Env:         apply.env,
+			})
+			return err
+		},
+		lock: func(lock *ProductLock, id string) {
+			lock.Brokers = setLockedID(lock.Brokers, broker.Name, id)
+		},
+	}
+}
+
+func (apply *productApply) iamRegistrationStep(productID string, name string) *productStep {
+	registration := apply.spec.IamRegistration
+	return &productStep{
+		resource: ProductChangeResourceIamRegistrationConst,
+		name:     registration.Name,
+		id:       name,
+		desired:  &registration.IamServiceRegistrationPatch,
+		get: func(ctx context.Context, name string) (interface{}, error) {
+			current, _, err := apply.service.GetIamRegistrationWithContext(ctx, &GetIamRegistrationOptions{
+				ProductID:        &productID,
+				ProgrammaticName: &name,
+				Env:              apply.env,
+			})
+			return current, err
+		},
+		create: func(ctx context.Context) (string, error) {
+			created, _, err := apply.service.CreateIamRegistrationWithContext(ctx, registration.createOptions(productID, apply.env))
+			return createdID(created, err, func() *string { return created.Name })
+		},
+		update: func(ctx context.Context, name string, patch map[string]interface{}) error {
+			_, _, err := apply.service.UpdateIamRegistrationWithContext(ctx, &UpdateIamRegistrationOptions{
+				ProductID:            &productID,
+				ProgrammaticName:     &name,
+				IamRegistrationPatch: patch,
+				Env:                  apply.env,
+			})
+			return err
+		},
+		lock: func(lock *ProductLock, id string) {
+			lock.IamRegistration = id
+		},
+	}
+}
+
+func (apply *productApply) catalogProductStep(productID string, id string) *productStep {
+	catalogProduct := apply.spec.CatalogProduct
+	return &productStep{
+		resource: ProductChangeResourceCatalogProductConst,
+		name:     catalogProduct.Name,
+		id:       id,
+		desired:  &catalogProduct.GlobalCatalogProductPatch,
+		get: func(ctx context.Context, id string) (interface{}, error) {
+			current, _, err := apply.service.GetCatalogProductWithContext(ctx, &GetCatalogProductOptions{
+				ProductID:        &productID,
+				CatalogProductID: &id,
+				Env:              apply.env,
+			})
+			return current, err
+		},
+		create: func(ctx context.Context) (string, error) {
+			created, _, err := apply.service.CreateCatalogProductWithContext(ctx, catalogProduct.createOptions(productID, apply.env))
+			return createdID(created, err, func() *string { return created.ID })
+		},
+		update: func(ctx context.Context, id string, patch map[string]interface{}) error {
+			_, _, err := apply.service.UpdateCatalogProductWithContext(ctx, &UpdateCatalogProductOptions{
+				ProductID:                 &productID,
+				CatalogProductID:          &id,
+				GlobalCatalogProductPatch: patch,
+				Env:                       apply.env,
+			})
+			return err
+		},
+		lock: func(lock *ProductLock, id string) {
+			lock.CatalogProductID = id
+		},
+	}
+}
+
+func (apply *productApply) catalogPlanStep(plan *CatalogPlanSpec, productID string, catalogProductID string, id string) *productStep {
+	return &productStep{
+		resource: ProductChangeResourceCatalogPlanConst,
+		name:     plan.Name,
+		id:       id,
+		desired:  &plan.GlobalCatalogPlanPatch,
+		get: func(ctx context.Context, id string) (interface{}, error) {
+			current, _, err := apply.service.GetCatalogPlanWithContext(ctx, &GetCatalogPlanOptions{
+				ProductID:        &productID,
+				CatalogProductID: &catalogProductID,
+				CatalogPlanID:    &id,
+				Env:              apply.env,
+			})
+			return current, err
+		},
+		create: func(ctx context.Context) (string, error) {
+			created, _, err := apply.service.CreateCatalogPlanWithContext(ctx, plan.createOptions(productID, catalogProductID, apply.env))
+			return createdID(created, err, func() *string { return created.ID })
+		},
+		update: func(ctx context.Context, id string, patch map[string]interface{}) error {
+			_, _, err := apply.service.UpdateCatalogPlanWithContext(ctx, &UpdateCatalogPlanOptions{
+				ProductID:              &productID,
+				CatalogProductID:       &catalogProductID,
+				CatalogPlanID:          &id,
+				GlobalCatalogPlanPatch: patch,
+				Env:                    apply.env,
+			})
+			return err
+		},
+		lock: func(lock *ProductLock, id string) {
+			lock.CatalogPlans = setLockedID(lock.CatalogPlans, plan.Name, id)
+		},
+	}
+}
+
+func (apply *productApply) catalogDeploymentStep(planName string, deployment *CatalogDeploymentSpec, productID string, catalogProductID string,
+	catalogPlanID string, id string) *productStep {
+	return &productStep{
+		resource: ProductChangeResourceCatalogDeploymentConst,
+		name:     deployment.Name,
+		id:       id,
+		desired:  &deployment.GlobalCatalogDeploymentPatch,
+		get: func(ctx context.Context, id string) (interface{}, error) {
+			current, _, err := apply.service.GetCatalogDeploymentWithContext(ctx, &GetCatalogDeploymentOptions{
+				ProductID:           &productID,
+				CatalogProductID:    &catalogProductID,
+				CatalogPlanID:       &catalogPlanID,
+				CatalogDeploymentID: &id,
+				Env:                 apply.env,
+			})
+			return current, err
+		},
+		create: func(ctx context.Context) (string, error) {
+			created, _, err := apply.service.CreateCatalogDeploymentWithContext(ctx,
+				deployment.createOptions(productID, catalogProductID, catalogPlanID, apply.env))
+			return createdID(created, err, func() *string { return created.ID })
+		},
+		update: func(ctx context.Context, id string, patch map[string]interface{}) error {
+			_, _, err := apply.service.UpdateCatalogDeploymentWithContext(ctx, &UpdateCatalogDeploymentOptions{
+				ProductID:                    &productID,
+				CatalogProductID:             &catalogProductID,
+				CatalogPlanID:                &catalogPlanID,
+				CatalogDeploymentID:          &id,
+				GlobalCatalogDeploymentPatch: patch,
+				Env:                          apply.env,
+			})
+			return err
+		},
+		lock: func(lock *ProductLock, id string) {
+			lock.CatalogDeployments = setLockedID(lock.CatalogDeployments, planName+"/"+deployment.Name, id)
+		},
+	}
+}
+
+// createdID returns the ID of a created resource, or an error if the response has none.
+func createdID(created interface{}, err error, id func() *string) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	if reflect.ValueOf(created).IsNil() || id() == nil {
+		return "", core.SDKErrorf(nil, "the response has no ID", "missing-id", common.GetComponentInfo())
+	}
+	return *id(), nil
+}
+
+// lockedChildID returns the locked ID of a child resource, or an empty ID if its parent is to be created.
+func lockedChildID(parentID string, id string) string {
+	if parentID == "" {
+		return ""
+	}
+	return id
+}
+
+func setLockedID(ids map[string]string, key string, id string) map[string]string {
+	if id == "" {
+		delete(ids, key)
+		return ids
+	}
+	if ids == nil {
+		ids = map[string]string{}
+	}
+	ids[key] = id
+	return ids
+}
+
+// diffPatch returns the properties of the desired patch whose values are not already those of the current
+// resource, as JSON values. Objects of the patch match when the current objects have the same values for the
+// properties of the patch, so that properties set by the service do not cause updates.
+func diffPatch(desired map[string]interface{}, current interface{}) (patch map[string]interface{}, err error) {
+	desiredJSON, err := toJSONMap(desired)
+	var currentJSON map[string]interface{}
+	if err == nil {
+		currentJSON, err = toJSONMap(current)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "diff-error", common.GetComponentInfo())
+		return
+	}
+	for name, value := range desiredJSON {
+		if !containsJSON(currentJSON[name], value) {
+			if patch == nil {
+				patch = map[string]interface{}{}
+			}
+			patch[name] = value
+		}
+	}
+	return
+}
+
+func toJSONMap(value interface{}) (jsonMap map[string]interface{}, err error) {
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(data, &jsonMap)
+	}
+	return
+}
+
+// containsJSON reports whether a current JSON value contains the desired one.
+func containsJSON(current interface{}, desired interface{}) bool {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		currentMap, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for name, value := range desired {
+			if !containsJSON(currentMap[name], value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		currentSlice, ok := current.([]interface{})
+		if !ok || len(currentSlice) != len(desired) {
+			return false
+		}
+		for i := range desired {
+			if !containsJSON(currentSlice[i], desired[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(current, desired)
+}
@@ -0,0 +1,247 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package partnercentersellv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/partnercentersellv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const productSpecYAML = `
+env: current
+product:
+  type: service
+  primary_contact:
+    name: Jane Doe
+    email: jane@example.com
+brokers:
+- name: main
+  auth_scheme: bearer
+  broker_url: https://broker.example.com
+  type: provision_through
+iam_registration:
+  name: example-service
+  enabled: true
+catalog_product:
+  name: example-service
+  kind: service
+  active: true
+  disabled: false
+  tags: [example]
+  object_provider:
+    name: Example
+    email: support@example.com
+  plans:
+  - name: lite
+    kind: plan
+    active: true
+    disabled: false
+    tags: [free]
+    object_provider:
+      name: Example
+      email: support@example.com
+    deployments:
+    - name: us-south
+      kind: deployment
+      active: true
+      disabled: false
+      object_provider:
+        name: Example
+        email: support@example.com
+`
+
+var _ = Describe(`ProductApplier`, func() {
+	var (
+		requests   []string
+		resources  map[string]map[string]interface{}
+		testServer *httptest.Server
+		service    *partnercentersellv1.PartnerCenterSellV1
+		lockPath   string
+	)
+
+	// The server stores the resources by path.
+	BeforeEach(func() {
+		requests = nil
+		resources = map[string]map[string]interface{}{}
+		nextID := 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			Expect(req.URL.Query().Get("env")).To(Or(Equal(""), Equal("current")))
+			body := map[string]interface{}{}
+			if req.Method == http.MethodPost || req.Method == http.MethodPatch {
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(BeNil())
+			}
+			res.Header().Set("Content-type", "application/json")
+			resource := resources[req.URL.Path]
+			switch req.Method {
+			case http.MethodPost:
+				id, _ := body["name"].(string)
+				if !strings.HasSuffix(req.URL.Path, "/iam_registration") {
+					nextID++
+					id = fmt.Sprintf("id-%d", nextID)
+				}
+				body["id"] = id
+				body["created_at"] = "2026-01-01T00:00:00Z"
+				resource = body
+				resources[req.URL.Path+"/"+id] = resource
+				res.WriteHeader(http.StatusCreated)
+			case http.MethodPatch:
+				if resource != nil {
+					for name, value := range body {
+						resource[name] = value
+					}
+				}
+			}
+			if resource == nil {
+				res.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Not found"}]}`)
+				return
+			}
+			Expect(json.NewEncoder(res).Encode(resource)).To(BeNil())
+		}))
+
+		var err error
+		service, err = partnercentersellv1.NewPartnerCenterSellV1(&partnercentersellv1.PartnerCenterSellV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		dir, err := os.MkdirTemp("", "product-applier")
+		Expect(err).To(BeNil())
+		lockPath = filepath.Join(dir, "product.lock.json")
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(filepath.Dir(lockPath))
+	})
+
+	apply := func(spec *partnercentersellv1.ProductSpec, dryRun bool) *partnercentersellv1.ProductApplyResult {
+		applier, err := partnercentersellv1.NewProductApplier(&partnercentersellv1.ProductApplierOptions{
+			Service:  service,
+			LockPath: lockPath,
+			DryRun:   dryRun,
+		})
+		Expect(err).To(BeNil())
+		requests = nil
+		result, err := applier.Apply(context.Background(), spec)
+		Expect(err).To(BeNil())
+		return result
+	}
+	actions := func(result *partnercentersellv1.ProductApplyResult) (actions []string) {
+		for _, change := range result.Changes {
+			actions = append(actions, change.Resource+":"+change.Action)
+		}
+		return
+	}
+
+	It(`Creates the resources, then updates only what changed`, func() {
+		spec, err := partnercentersellv1.ParseProductSpec([]byte(productSpecYAML))
+		Expect(err).To(BeNil())
+
+		result := apply(spec, true)
+		Expect(requests).To(BeEmpty())
+		Expect(actions(result)).To(Equal([]string{
+			"product:create", "broker:create", "iam_registration:create", "catalog_product:create", "catalog_plan:create",
+			"catalog_deployment:create",
+		}))
+		_, err = os.Stat(lockPath)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		result = apply(spec, false)
+		Expect(requests).To(Equal([]string{
+			"POST /products", "POST /brokers", "POST /products/id-1/iam_registration", "POST /products/id-1/catalog_products",
+			"POST /products/id-1/catalog_products/id-3/catalog_plans",
+			"POST /products/id-1/catalog_products/id-3/catalog_plans/id-4/catalog_deployments",
+		}))
+		lock, err := partnercentersellv1.ReadProductLock(lockPath)
+		Expect(err).To(BeNil())
+		Expect(lock).To(Equal(&partnercentersellv1.ProductLock{
+			ProductID:          "id-1",
+			CatalogProductID:   "id-3",
+			IamRegistration:    "example-service",
+			Brokers:            map[string]string{"main": "id-2"},
+			CatalogPlans:       map[string]string{"lite": "id-4"},
+			CatalogDeployments: map[string]string{"lite/us-south": "id-5"},
+		}))
+		Expect(result.Lock).To(Equal(lock))
+
+		// Applying the same spec again changes nothing.
+		result = apply(spec, false)
+		Expect(requests).To(HaveLen(6))
+		for _, change := range result.Changes {
+			Expect(change.Action).To(Equal(partnercentersellv1.ProductChangeActionNoneConst))
+		}
+
+		// A dry run shows the changed properties, which are the only ones sent.
+		spec.CatalogProduct.Plans[0].Tags = []string{"free", "lite"}
+		result = apply(spec, true)
+		Expect(requests).To(HaveLen(6))
+		Expect(result.Changes[4].Action).To(Equal(partnercentersellv1.ProductChangeActionUpdateConst))
+		Expect(result.Changes[4].Patch).To(Equal(map[string]interface{}{"tags": []interface{}{"free", "lite"}}))
+
+		result = apply(spec, false)
+		Expect(requests).To(ContainElement("PATCH /products/id-1/catalog_products/id-3/catalog_plans/id-4"))
+		Expect(resources["/products/id-1/catalog_products/id-3/catalog_plans/id-4"]["tags"]).To(Equal([]interface{}{"free", "lite"}))
+
+		// Resources deleted from the service are created again.
+		delete(resources, "/products/id-1/catalog_products/id-3/catalog_plans/id-4/catalog_deployments/id-5")
+		result = apply(spec, false)
+		Expect(result.Changes[5].Action).To(Equal(partnercentersellv1.ProductChangeActionCreateConst))
+		Expect(result.Lock.CatalogDeployments["lite/us-south"]).To(Equal("id-6"))
+	})
+	It(`Validates the spec`, func() {
+		_, err := partnercentersellv1.ParseProductSpec([]byte(strings.Replace(productSpecYAML, "  type: service\n", "", 1)))
+		Expect(err).ToNot(BeNil())
+		_, err = partnercentersellv1.ParseProductSpec([]byte(strings.Replace(productSpecYAML, "    email: jane@example.com\n", "", 1)))
+		Expect(err).ToNot(BeNil())
+		_, err = partnercentersellv1.ParseProductSpec([]byte(strings.Replace(productSpecYAML, "  tags: [example]\n", "", 1)))
+		Expect(err).ToNot(BeNil())
+		_, err = partnercentersellv1.ParseProductSpec([]byte(strings.Replace(productSpecYAML, "iam_registration:",
+			"- name: main\n  auth_scheme: bearer\n  broker_url: https://broker.example.com\n  type: provision_through\niam_registration:", 1)))
+		Expect(err.Error()).To(ContainSubstring("broker 'main' is declared more than once"))
+		_, err = partnercentersellv1.ParseProductSpec([]byte(productSpecYAML + "unknown: true\n"))
+		Expect(err).ToNot(BeNil())
+
+		_, err = partnercentersellv1.NewProductApplier(&partnercentersellv1.ProductApplierOptions{Service: service})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Does not validate the fields of the service client`, func() {
+		_, err := partnercentersellv1.NewProductApplier(&partnercentersellv1.ProductApplierOptions{
+			Service:  &taggedPartnerCenterSell{PartnerCenterSellV1Intf: service},
+			LockPath: lockPath,
+		})
+		Expect(err).To(BeNil())
+	})
+})
+
+// taggedPartnerCenterSell : A service client with a field that does not pass validation, which must not be
+// reached when the options holding the client are validated.
+type taggedPartnerCenterSell struct {
+	partnercentersellv1.PartnerCenterSellV1Intf
+	Name *string `validate:"required"`
+}
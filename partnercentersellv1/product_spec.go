@@ -0,0 +1,361 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package partnercentersellv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"sigs.k8s.io/yaml"
+)
+
+// ProductSpec : The declarative description of a product onboarded to Partner Center, applied with a
+// ProductApplier. Every part but the onboarding product is optional. The properties of each part are those of its
+// Patch type, plus the properties that are set only when the resource is created.
+type ProductSpec struct {
+	// The environment in which the catalog, IAM and broker resources are managed, such as "current".
+	Env string `json:"env,omitempty"`
+
+	// The registration of the account in Partner Center.
+	Registration *RegistrationSpec `json:"registration,omitempty"`
+
+	// The onboarding product.
+	Product *OnboardingProductSpec `json:"product" validate:"required"`
+
+	// The resource brokers of the product.
+	Brokers []BrokerSpec `json:"brokers,omitempty"`
+
+	// The IAM registration of the product.
+	IamRegistration *IamRegistrationSpec `json:"iam_registration,omitempty"`
+
+	// The Global Catalog product, with its plans and deployments.
+	CatalogProduct *CatalogProductSpec `json:"catalog_product,omitempty"`
+}
+
+// RegistrationSpec : The registration of a ProductSpec.
+type RegistrationSpec struct {
+	AccountID string `json:"account_id" validate:"required"`
+	RegistrationPatch
+}
+
+// OnboardingProductSpec : The onboarding product of a ProductSpec.
+type OnboardingProductSpec struct {
+	Type string `json:"type" validate:"required"`
+	OnboardingProductPatch
+}
+
+// BrokerSpec : A resource broker of a ProductSpec. The name identifies the broker in the lock file.
+type BrokerSpec struct {
+	Name string `json:"name" validate:"required"`
+	BrokerPatch
+}
+
+// IamRegistrationSpec : The IAM registration of a ProductSpec. The name is the programmatic name of the product.
+type IamRegistrationSpec struct {
+	Name string `json:"name" validate:"required"`
+	IamServiceRegistrationPatch
+}
+
+// CatalogProductSpec : The Global Catalog product of a ProductSpec.
+type CatalogProductSpec struct {
+	Name     string `json:"name" validate:"required"`
+	Kind     string `json:"kind" validate:"required"`
+	ID       string `json:"id,omitempty"`
+	ObjectID string `json:"object_id,omitempty"`
+	GlobalCatalogProductPatch
+
+	// The plans of the product. Their names must be unique.
+	Plans []CatalogPlanSpec `json:"plans,omitempty"`
+}
+
+// CatalogPlanSpec : A Global Catalog plan of a ProductSpec.
+type CatalogPlanSpec struct {
+	Name     string `json:"name" validate:"required"`
+	Kind     string `json:"kind" validate:"required"`
+	ID       string `json:"id,omitempty"`
+	ObjectID string `json:"object_id,omitempty"`
+	GlobalCatalogPlanPatch
+
+	// The deployments of the plan. Their names must be unique within the plan.
+	Deployments []CatalogDeploymentSpec `json:"deployments,omitempty"`
+}
+
+// CatalogDeploymentSpec : A Global Catalog deployment of a ProductSpec.
+type CatalogDeploymentSpec struct {
+	Name     string `json:"name" validate:"required"`
+	Kind     string `json:"kind" validate:"required"`
+	ID       string `json:"id,omitempty"`
+	ObjectID string `json:"object_id,omitempty"`
+	GlobalCatalogDeploymentPatch
+}
+
+// ProductLock : The IDs of the resources created for a ProductSpec, which a ProductApplier stores in a lock file
+// so that the resources are updated rather than created again when the spec is applied again.
+type ProductLock struct {
+	RegistrationID   string `json:"registration_id,omitempty"`
+	ProductID        string `json:"product_id,omitempty"`
+	CatalogProductID string `json:"catalog_product_id,omitempty"`
+
+	// The programmatic name of the IAM registration.
+	IamRegistration string `json:"iam_registration,omitempty"`
+
+	// The IDs of the brokers and plans by name, and of the deployments by "<plan name>/<deployment name>".
+	Brokers            map[string]string `json:"brokers,omitempty"`
+	CatalogPlans       map[string]string `json:"catalog_plans,omitempty"`
+	CatalogDeployments map[string]string `json:"catalog_deployments,omitempty"`
+}
+
+// ParseProductSpec parses a spec in YAML or JSON format and validates it.
+func ParseProductSpec(data []byte) (spec *ProductSpec, err error) {
+	spec = new(ProductSpec)
+	err = yaml.UnmarshalStrict(data, spec)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+		spec = nil
+		return
+	}
+	err = spec.Validate()
+	if err != nil {
+		spec = nil
+	}
+	return
+}
+
+// LoadProductSpec reads a spec in YAML or JSON format from the specified file.
+func LoadProductSpec(path string) (spec *ProductSpec, err error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-error", common.GetComponentInfo())
+		return
+	}
+	return ParseProductSpec(data)
+}
+
+// Validate checks that the spec has the properties required to create each of its resources, that the names of
+// brokers, plans and deployments are unique, and that the IAM registration has no errors (see
+// ValidateIamRegistration).
+func (spec *ProductSpec) Validate() (err error) {
+	err = core.ValidateStruct(spec, "spec")
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+
+	// The create options are validated with placeholders for the IDs of the parent resources.
+	const parentID = "placeholder"
+	options := []interface{}{spec.Product.createOptions()}
+	if spec.Registration != nil {
+		options = append(options, spec.Registration.createOptions())
+	}
+	brokers := map[string]bool{}
+	for i := range spec.Brokers {
+		if brokers[spec.Brokers[i].Name] {
+			return invalidProductSpec("broker '%s' is declared more than once", spec.Brokers[i].Name)
+		}
+		brokers[spec.Brokers[i].Name] = true
+		options = append(options, spec.Brokers[i].createOptions(nil))
+	}
+	if spec.IamRegistration != nil {
+		options = append(options, spec.IamRegistration.createOptions(parentID, nil))
+		err = ValidateIamRegistrationPatch(spec.IamRegistration.Name, &spec.IamRegistration.IamServiceRegistrationPatch).Err()
+		if err != nil {
+			return core.SDKErrorf(err, "", "invalid-iam-registration", common.GetComponentInfo())
+		}
+	}
+	if product := spec.CatalogProduct; product != nil {
+		options = append(options, product.createOptions(parentID, nil))
+		plans := map[string]bool{}
+		for i := range product.Plans {
+			plan := &product.Plans[i]
+			if plans[plan.Name] {
+				return invalidProductSpec("plan '%s' is declared more than once", plan.Name)
+			}
+			plans[plan.Name] = true
+			options = append(options, plan.createOptions(parentID, parentID, nil))
+			deployments := map[string]bool{}
+			for j := range plan.Deployments {
+				if deployments[plan.Deployments[j].Name] {
+					return invalidProductSpec("deployment '%s' of plan '%s' is declared more than once", plan.Deployments[j].Name, plan.Name)
+				}
+				deployments[plan.Deployments[j].Name] = true
+				options = append(options, plan.Deployments[j].createOptions(parentID, parentID, parentID, nil))
+			}
+		}
+	}
+	for _, o := range options {
+		err = core.ValidateStruct(o, "options")
+		if err != nil {
+			return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		}
+	}
+	return nil
+}
+
+func invalidProductSpec(format string, args ...interface{}) error {
+	return core.SDKErrorf(nil, fmt.Sprintf(format, args...), "invalid-product-spec", common.GetComponentInfo())
+}
+
+func (spec *RegistrationSpec) createOptions() *CreateRegistrationOptions {
+	return &CreateRegistrationOptions{
+		AccountID:               core.StringPtr(spec.AccountID),
+		CompanyName:             spec.CompanyName,
+		PrimaryContact:          spec.PrimaryContact,
+		DefaultPrivateCatalogID: spec.DefaultPrivateCatalogID,
+		ProviderAccessGroup:     spec.ProviderAccessGroup,
+	}
+}
+
+func (spec *OnboardingProductSpec) createOptions() *CreateOnboardingProductOptions {
+	return &CreateOnboardingProductOptions{
+		Type:           core.StringPtr(spec.Type),
+		PrimaryContact: spec.PrimaryContact,
+		EccnNumber:     spec.EccnNumber,
+		EroClass:       spec.EroClass,
+		Unspsc:         spec.Unspsc,
+		TaxAssessment:  spec.TaxAssessment,
+		Support:        spec.Support,
+	}
+}
+
+func (spec *BrokerSpec) createOptions(env *string) *CreateResourceBrokerOptions {
+	return &CreateResourceBrokerOptions{
+		AuthScheme:          spec.AuthScheme,
+		Name:                core.StringPtr(spec.Name),
+		BrokerURL:           spec.BrokerURL,
+		Type:                spec.Type,
+		AuthUsername:        spec.AuthUsername,
+		AuthPassword:        spec.AuthPassword,
+		ResourceGroupCrn:    spec.ResourceGroupCrn,
+		State:               spec.State,
+		AllowContextUpdates: spec.AllowContextUpdates,
+		CatalogType:         spec.CatalogType,
+		Region:              spec.Region,
+		Env:                 env,
+	}
+}
+
+func (spec *IamRegistrationSpec) createOptions(productID string, env *string) *CreateIamRegistrationOptions {
+	return &CreateIamRegistrationOptions{
+		ProductID:                      core.StringPtr(productID),
+		Name:                           core.StringPtr(spec.Name),
+		Enabled:                        spec.Enabled,
+		ServiceType:                    spec.ServiceType,
+		Actions:                        spec.Actions,
+		AdditionalPolicyScopes:         spec.AdditionalPolicyScopes,
+		DisplayName:                    spec.DisplayName,
+		ParentIds:                      spec.ParentIds,
+		ResourceHierarchyAttribute:     spec.ResourceHierarchyAttribute,
+		SupportedAnonymousAccesses:     spec.SupportedAnonymousAccesses,
+		SupportedAttributes:            spec.SupportedAttributes,
+		SupportedAuthorizationSubjects: spec.SupportedAuthorizationSubjects,
+		SupportedRoles:                 spec.SupportedRoles,
+		SupportedNetwork:               spec.SupportedNetwork,
+		SupportedActionControl:         spec.SupportedActionControl,
+		Env:                            env,
+	}
+}
+
+func (spec *CatalogProductSpec) createOptions(productID string, env *string) *CreateCatalogProductOptions {
+	return &CreateCatalogProductOptions{
+		ProductID:      core.StringPtr(productID),
+		Name:           core.StringPtr(spec.Name),
+		Active:         spec.Active,
+		Disabled:       spec.Disabled,
+		Kind:           core.StringPtr(spec.Kind),
+		Tags:           spec.Tags,
+		ObjectProvider: spec.ObjectProvider,
+		ID:             stringPtrOrNil(spec.ID),
+		ObjectID:       stringPtrOrNil(spec.ObjectID),
+		OverviewUi:     spec.OverviewUi,
+		Images:         spec.Images,
+		Metadata:       spec.Metadata,
+		Env:            env,
+	}
+}
+
+func (spec *CatalogPlanSpec) createOptions(productID string, catalogProductID string, env *string) *CreateCatalogPlanOptions {
+	return &CreateCatalogPlanOptions{
+		ProductID:        core.StringPtr(productID),
+		CatalogProductID: core.StringPtr(catalogProductID),
+		Name:             core.StringPtr(spec.Name),
+		Active:           spec.Active,
+		Disabled:         spec.Disabled,
+		Kind:             core.StringPtr(spec.Kind),
+		ObjectProvider:   spec.ObjectProvider,
+		ID:               stringPtrOrNil(spec.ID),
+		ObjectID:         stringPtrOrNil(spec.ObjectID),
+		OverviewUi:       spec.OverviewUi,
+		Tags:             spec.Tags,
+		PricingTags:      spec.PricingTags,
+		Metadata:         spec.Metadata,
+		Env:              env,
+	}
+}
+
+func (spec *CatalogDeploymentSpec) createOptions(productID string, catalogProductID string, catalogPlanID string,
+	env *string) *CreateCatalogDeploymentOptions {
+	return &CreateCatalogDeploymentOptions{
+		ProductID:        core.StringPtr(productID),
+		CatalogProductID: core.StringPtr(catalogProductID),
+		CatalogPlanID:    core.StringPtr(catalogPlanID),
+		Name:             core.StringPtr(spec.Name),
+		Active:           spec.Active,
+		Disabled:         spec.Disabled,
+		Kind:             core.StringPtr(spec.Kind),
+		ObjectProvider:   spec.ObjectProvider,
+		ID:               stringPtrOrNil(spec.ID),
+		ObjectID:         stringPtrOrNil(spec.ObjectID),
+		OverviewUi:       spec.OverviewUi,
+		Tags:             spec.Tags,
+		Metadata:         spec.Metadata,
+		Env:              env,
+	}
+}
+
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return core.StringPtr(s)
+}
+
+// ReadProductLock reads a lock file written by a ProductApplier.
+func ReadProductLock(path string) (lock *ProductLock, err error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err == nil {
+		err = json.Unmarshal(data, &lock)
+	}
+	if err != nil {
+		lock = nil
+		err = core.SDKErrorf(err, "", "read-lock-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// WriteProductLock writes a lock file. The file is replaced atomically.
+func WriteProductLock(path string, lock *ProductLock) (err error) {
+	data, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "marshal-lock-error", common.GetComponentInfo())
+	}
+	if err = common.WriteFileAtomic(path, append(data, '\n')); err != nil {
+		return core.RepurposeSDKProblem(err, "write-lock-error")
+	}
+	return nil
+}
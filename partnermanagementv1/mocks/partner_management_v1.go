@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the PartnerManagementV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/partnermanagementv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// PartnerManagementV1 : A testify mock of the PartnerManagementV1 service client.
+type PartnerManagementV1 struct {
+	mock.Mock
+}
+
+var _ partnermanagementv1.PartnerManagementV1Intf = (*PartnerManagementV1)(nil)
+
+// GetResourceUsageReport provides a mock function with the given fields.
+func (_m *PartnerManagementV1) GetResourceUsageReport(getResourceUsageReportOptions *partnermanagementv1.GetResourceUsageReportOptions) (*partnermanagementv1.PartnerUsageReportSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceUsageReportOptions)
+	var r0 *partnermanagementv1.PartnerUsageReportSummary
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnermanagementv1.PartnerUsageReportSummary)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceUsageReportWithContext provides a mock function with the given fields.
+func (_m *PartnerManagementV1) GetResourceUsageReportWithContext(ctx context.Context, getResourceUsageReportOptions *partnermanagementv1.GetResourceUsageReportOptions) (*partnermanagementv1.PartnerUsageReportSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceUsageReportOptions)
+	var r0 *partnermanagementv1.PartnerUsageReportSummary
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnermanagementv1.PartnerUsageReportSummary)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetBillingOptions provides a mock function with the given fields.
+func (_m *PartnerManagementV1) GetBillingOptions(getBillingOptionsOptions *partnermanagementv1.GetBillingOptionsOptions) (*partnermanagementv1.BillingOptionsSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(getBillingOptionsOptions)
+	var r0 *partnermanagementv1.BillingOptionsSummary
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnermanagementv1.BillingOptionsSummary)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetBillingOptionsWithContext provides a mock function with the given fields.
+func (_m *PartnerManagementV1) GetBillingOptionsWithContext(ctx context.Context, getBillingOptionsOptions *partnermanagementv1.GetBillingOptionsOptions) (*partnermanagementv1.BillingOptionsSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getBillingOptionsOptions)
+	var r0 *partnermanagementv1.BillingOptionsSummary
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnermanagementv1.BillingOptionsSummary)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCreditPoolsReport provides a mock function with the given fields.
+func (_m *PartnerManagementV1) GetCreditPoolsReport(getCreditPoolsReportOptions *partnermanagementv1.GetCreditPoolsReportOptions) (*partnermanagementv1.CreditPoolsReportSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(getCreditPoolsReportOptions)
+	var r0 *partnermanagementv1.CreditPoolsReportSummary
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnermanagementv1.CreditPoolsReportSummary)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetCreditPoolsReportWithContext provides a mock function with the given fields.
+func (_m *PartnerManagementV1) GetCreditPoolsReportWithContext(ctx context.Context, getCreditPoolsReportOptions *partnermanagementv1.GetCreditPoolsReportOptions) (*partnermanagementv1.CreditPoolsReportSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCreditPoolsReportOptions)
+	var r0 *partnermanagementv1.CreditPoolsReportSummary
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*partnermanagementv1.CreditPoolsReportSummary)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
@@ -193,7 +193,7 @@ func (partnerManagement *PartnerManagementV1) GetResourceUsageReportWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_management", "V1", "GetResourceUsageReport"))
 	builder.EnableGzipCompression = partnerManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerManagement.Service.Options.URL, `/v1/resource-usage-reports`, nil)
 	if err != nil {
@@ -247,7 +247,7 @@ func (partnerManagement *PartnerManagementV1) GetResourceUsageReportWithContext(
 	response, err = partnerManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_usage_report", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -284,7 +284,7 @@ func (partnerManagement *PartnerManagementV1) GetBillingOptionsWithContext(ctx c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_management", "V1", "GetBillingOptions"))
 	builder.EnableGzipCompression = partnerManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerManagement.Service.Options.URL, `/v1/billing-options`, nil)
 	if err != nil {
@@ -326,7 +326,7 @@ func (partnerManagement *PartnerManagementV1) GetBillingOptionsWithContext(ctx c
 	response, err = partnerManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_billing_options", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -363,7 +363,7 @@ func (partnerManagement *PartnerManagementV1) GetCreditPoolsReportWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "partner_management", "V1", "GetCreditPoolsReport"))
 	builder.EnableGzipCompression = partnerManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(partnerManagement.Service.Options.URL, `/v1/credit-pools`, nil)
 	if err != nil {
@@ -405,7 +405,7 @@ func (partnerManagement *PartnerManagementV1) GetCreditPoolsReportWithContext(ct
 	response, err = partnerManagement.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_credit_pools_report", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,379 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the PlatformNotificationsV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/platformnotificationsv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// PlatformNotificationsV1 : A testify mock of the PlatformNotificationsV1 service client.
+type PlatformNotificationsV1 struct {
+	mock.Mock
+}
+
+var _ platformnotificationsv1.PlatformNotificationsV1Intf = (*PlatformNotificationsV1)(nil)
+
+// ListNotifications provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) ListNotifications(listNotificationsOptions *platformnotificationsv1.ListNotificationsOptions) (*platformnotificationsv1.NotificationCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listNotificationsOptions)
+	var r0 *platformnotificationsv1.NotificationCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.NotificationCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListNotificationsWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) ListNotificationsWithContext(ctx context.Context, listNotificationsOptions *platformnotificationsv1.ListNotificationsOptions) (*platformnotificationsv1.NotificationCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listNotificationsOptions)
+	var r0 *platformnotificationsv1.NotificationCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.NotificationCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAcknowledgement provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) GetAcknowledgement(getAcknowledgementOptions *platformnotificationsv1.GetAcknowledgementOptions) (*platformnotificationsv1.Acknowledgement, *core.DetailedResponse, error) {
+	ret := _m.Called(getAcknowledgementOptions)
+	var r0 *platformnotificationsv1.Acknowledgement
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.Acknowledgement)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAcknowledgementWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) GetAcknowledgementWithContext(ctx context.Context, getAcknowledgementOptions *platformnotificationsv1.GetAcknowledgementOptions) (*platformnotificationsv1.Acknowledgement, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAcknowledgementOptions)
+	var r0 *platformnotificationsv1.Acknowledgement
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.Acknowledgement)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceNotificationAcknowledgement provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) ReplaceNotificationAcknowledgement(replaceNotificationAcknowledgementOptions *platformnotificationsv1.ReplaceNotificationAcknowledgementOptions) (*platformnotificationsv1.Acknowledgement, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceNotificationAcknowledgementOptions)
+	var r0 *platformnotificationsv1.Acknowledgement
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.Acknowledgement)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceNotificationAcknowledgementWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) ReplaceNotificationAcknowledgementWithContext(ctx context.Context, replaceNotificationAcknowledgementOptions *platformnotificationsv1.ReplaceNotificationAcknowledgementOptions) (*platformnotificationsv1.Acknowledgement, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceNotificationAcknowledgementOptions)
+	var r0 *platformnotificationsv1.Acknowledgement
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.Acknowledgement)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListDistributionListDestinations provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) ListDistributionListDestinations(listDistributionListDestinationsOptions *platformnotificationsv1.ListDistributionListDestinationsOptions) (*platformnotificationsv1.AddDestinationCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listDistributionListDestinationsOptions)
+	var r0 *platformnotificationsv1.AddDestinationCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.AddDestinationCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListDistributionListDestinationsWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) ListDistributionListDestinationsWithContext(ctx context.Context, listDistributionListDestinationsOptions *platformnotificationsv1.ListDistributionListDestinationsOptions) (*platformnotificationsv1.AddDestinationCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listDistributionListDestinationsOptions)
+	var r0 *platformnotificationsv1.AddDestinationCollection
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.AddDestinationCollection)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateDistributionListDestination provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) CreateDistributionListDestination(createDistributionListDestinationOptions *platformnotificationsv1.CreateDistributionListDestinationOptions) (platformnotificationsv1.AddDestinationIntf, *core.DetailedResponse, error) {
+	ret := _m.Called(createDistributionListDestinationOptions)
+	var r0 platformnotificationsv1.AddDestinationIntf
+	if v := ret.Get(0); v != nil {
+		r0 = v.(platformnotificationsv1.AddDestinationIntf)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateDistributionListDestinationWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) CreateDistributionListDestinationWithContext(ctx context.Context, createDistributionListDestinationOptions *platformnotificationsv1.CreateDistributionListDestinationOptions) (platformnotificationsv1.AddDestinationIntf, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createDistributionListDestinationOptions)
+	var r0 platformnotificationsv1.AddDestinationIntf
+	if v := ret.Get(0); v != nil {
+		r0 = v.(platformnotificationsv1.AddDestinationIntf)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetDistributionListDestination provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) GetDistributionListDestination(getDistributionListDestinationOptions *platformnotificationsv1.GetDistributionListDestinationOptions) (platformnotificationsv1.AddDestinationIntf, *core.DetailedResponse, error) {
+	ret := _m.Called(getDistributionListDestinationOptions)
+	var r0 platformnotificationsv1.AddDestinationIntf
+	if v := ret.Get(0); v != nil {
+		r0 = v.(platformnotificationsv1.AddDestinationIntf)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetDistributionListDestinationWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) GetDistributionListDestinationWithContext(ctx context.Context, getDistributionListDestinationOptions *platformnotificationsv1.GetDistributionListDestinationOptions) (platformnotificationsv1.AddDestinationIntf, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getDistributionListDestinationOptions)
+	var r0 platformnotificationsv1.AddDestinationIntf
+	if v := ret.Get(0); v != nil {
+		r0 = v.(platformnotificationsv1.AddDestinationIntf)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteDistributionListDestination provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) DeleteDistributionListDestination(deleteDistributionListDestinationOptions *platformnotificationsv1.DeleteDistributionListDestinationOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteDistributionListDestinationOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteDistributionListDestinationWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) DeleteDistributionListDestinationWithContext(ctx context.Context, deleteDistributionListDestinationOptions *platformnotificationsv1.DeleteDistributionListDestinationOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteDistributionListDestinationOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// TestDistributionListDestination provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) TestDistributionListDestination(testDistributionListDestinationOptions *platformnotificationsv1.TestDistributionListDestinationOptions) (*platformnotificationsv1.TestDestinationResponseBody, *core.DetailedResponse, error) {
+	ret := _m.Called(testDistributionListDestinationOptions)
+	var r0 *platformnotificationsv1.TestDestinationResponseBody
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.TestDestinationResponseBody)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// TestDistributionListDestinationWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) TestDistributionListDestinationWithContext(ctx context.Context, testDistributionListDestinationOptions *platformnotificationsv1.TestDistributionListDestinationOptions) (*platformnotificationsv1.TestDestinationResponseBody, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, testDistributionListDestinationOptions)
+	var r0 *platformnotificationsv1.TestDestinationResponseBody
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.TestDestinationResponseBody)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPreferences provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) GetPreferences(getPreferencesOptions *platformnotificationsv1.GetPreferencesOptions) (*platformnotificationsv1.PreferencesObject, *core.DetailedResponse, error) {
+	ret := _m.Called(getPreferencesOptions)
+	var r0 *platformnotificationsv1.PreferencesObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.PreferencesObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetPreferencesWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) GetPreferencesWithContext(ctx context.Context, getPreferencesOptions *platformnotificationsv1.GetPreferencesOptions) (*platformnotificationsv1.PreferencesObject, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getPreferencesOptions)
+	var r0 *platformnotificationsv1.PreferencesObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.PreferencesObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePreferences provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) CreatePreferences(createPreferencesOptions *platformnotificationsv1.CreatePreferencesOptions) (*platformnotificationsv1.PreferencesObject, *core.DetailedResponse, error) {
+	ret := _m.Called(createPreferencesOptions)
+	var r0 *platformnotificationsv1.PreferencesObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.PreferencesObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreatePreferencesWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) CreatePreferencesWithContext(ctx context.Context, createPreferencesOptions *platformnotificationsv1.CreatePreferencesOptions) (*platformnotificationsv1.PreferencesObject, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createPreferencesOptions)
+	var r0 *platformnotificationsv1.PreferencesObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.PreferencesObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceNotificationPreferences provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) ReplaceNotificationPreferences(replaceNotificationPreferencesOptions *platformnotificationsv1.ReplaceNotificationPreferencesOptions) (*platformnotificationsv1.PreferencesObject, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceNotificationPreferencesOptions)
+	var r0 *platformnotificationsv1.PreferencesObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.PreferencesObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ReplaceNotificationPreferencesWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) ReplaceNotificationPreferencesWithContext(ctx context.Context, replaceNotificationPreferencesOptions *platformnotificationsv1.ReplaceNotificationPreferencesOptions) (*platformnotificationsv1.PreferencesObject, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceNotificationPreferencesOptions)
+	var r0 *platformnotificationsv1.PreferencesObject
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*platformnotificationsv1.PreferencesObject)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteNotificationPreferences provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) DeleteNotificationPreferences(deleteNotificationPreferencesOptions *platformnotificationsv1.DeleteNotificationPreferencesOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteNotificationPreferencesOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteNotificationPreferencesWithContext provides a mock function with the given fields.
+func (_m *PlatformNotificationsV1) DeleteNotificationPreferencesWithContext(ctx context.Context, deleteNotificationPreferencesOptions *platformnotificationsv1.DeleteNotificationPreferencesOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteNotificationPreferencesOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
@@ -0,0 +1,322 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformnotificationsv1
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// NotificationFeedOptions : The options of a NotificationFeed.
+type NotificationFeedOptions struct {
+	// The client used to list and acknowledge notifications.
+	Service PlatformNotificationsV1Intf `validate:"required,structonly"`
+
+	// The file in which the feed stores the IDs of the notifications already seen. It is created if it does not exist.
+	StatePath string `validate:"required"`
+
+	// The sinks to which new notifications are delivered.
+	Sinks []NotificationSink `validate:"required,min=1"`
+
+	// The account whose notifications are consumed. Defaults to the account of the credentials.
+	AccountID string
+
+	// Selects the notifications delivered to the sinks. All notifications are delivered by default.
+	Filter *NotificationFilter
+
+	// Acknowledge the notifications once they are delivered, so that they are no longer shown as unread.
+	Acknowledge bool
+
+	// The time between polls of Run. Defaults to 5 minutes.
+	PollInterval time.Duration
+
+	// The number of notifications requested per page. Defaults to 50.
+	PageSize int64
+
+	// The number of notification IDs remembered in the state. Defaults to 1000; it must be larger than the number
+	// of notifications listed by the service.
+	MaxSeen int
+
+	// Called with the errors of the polls of Run, which then keeps polling. When nil, Run returns the first error.
+	OnError func(err error)
+}
+
+// NotificationFilter : Selects notifications. A notification matches when it matches every criterion that is set,
+// and it matches a criterion when it matches any of its values.
+type NotificationFilter struct {
+	// The categories of notifications, such as "incident" or "maintenance".
+	Categories []string
+
+	// The regions of the resources. Global notifications match every region.
+	Regions []string
+
+	// The names of the affected components (services).
+	ComponentNames []string
+
+	// The CRNs of the resources, matched against the CRN masks of notifications. Notifications without CRN masks are
+	// not scoped to resources and match every CRN.
+	ResourceCRNs []string
+
+	// The lowest severity of the notifications, from 1, the highest, to 3. Notifications of severity 0, which is the
+	// lowest of every category, only match when MaxSeverity is not set.
+	MaxSeverity int64
+}
+
+// Matches reports whether a notification matches the filter.
+func (filter *NotificationFilter) Matches(notification *Notification) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.Categories) > 0 && (notification.Category == nil || !common.ContainsString(filter.Categories, *notification.Category)) {
+		return false
+	}
+	if len(filter.Regions) > 0 && !(notification.IsGlobal != nil && *notification.IsGlobal) &&
+		!containsAny(filter.Regions, notification.Regions) {
+		return false
+	}
+	if len(filter.ComponentNames) > 0 && !containsAny(filter.ComponentNames, notification.ComponentNames) {
+		return false
+	}
+	if filter.MaxSeverity > 0 && (notification.Severity == nil || *notification.Severity == 0 || *notification.Severity > filter.MaxSeverity) {
+		return false
+	}
+	if len(filter.ResourceCRNs) > 0 && len(notification.CrnMasks) > 0 {
+		for _, mask := range notification.CrnMasks {
+			for _, crn := range filter.ResourceCRNs {
+				if common.CRNMatches(mask, crn) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// notificationFeedState : The content of the state file of a NotificationFeed.
+type notificationFeedState struct {
+	// The IDs of the notifications already seen, oldest first.
+	Seen []string `json:"seen"`
+
+	// The creation timestamp, in milliseconds, of the last notification acknowledged.
+	LastAcknowledged int64 `json:"last_acknowledged,omitempty"`
+
+	// The time of the last successful poll.
+	PolledAt time.Time `json:"polled_at"`
+}
+
+// NotificationFeed : Consumes the notifications of an account incrementally and delivers the new ones to sinks.
+//
+// Each poll lists the notifications, newest first, until a page contains only notifications already seen. The new
+// notifications that match the filter are delivered to every sink, in the order in which they were created, and
+// the IDs of all new notifications are then stored in the state file. A notification is delivered at least once:
+// when a sink fails, the notifications of the poll are delivered again by the next poll, to every sink.
+type NotificationFeed struct {
+	options *NotificationFeedOptions
+
+	// Serializes polls.
+	mutex sync.Mutex
+	state *notificationFeedState
+	seen  map[string]bool
+}
+
+// NewNotificationFeed : constructs an instance of NotificationFeed with the specified options, and loads its state
+// if it exists.
+func NewNotificationFeed(options *NotificationFeedOptions) (feed *NotificationFeed, err error) {
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	state := &notificationFeedState{Seen: []string{}}
+	if data, readErr := os.ReadFile(options.StatePath); readErr == nil {
+		err = json.Unmarshal(data, state)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "read-state-error", common.GetComponentInfo())
+			return
+		}
+	} else if !os.IsNotExist(readErr) {
+		err = core.SDKErrorf(readErr, "", "read-state-error", common.GetComponentInfo())
+		return
+	}
+	feed = &NotificationFeed{options: options}
+	feed.setState(state)
+	return
+}
+
+func (feed *NotificationFeed) setState(state *notificationFeedState) {
+	feed.state = state
+	feed.seen = map[string]bool{}
+	for _, id := range state.Seen {
+		feed.seen[id] = true
+	}
+}
+
+// Poll fetches the new notifications and delivers those that match the filter. It returns the notifications
+// delivered.
+func (feed *NotificationFeed) Poll(ctx context.Context) (delivered []Notification, err error) {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	fresh, err := feed.listNew(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-notifications-error")
+		return
+	}
+
+	// The pages are newest first; the sinks receive the notifications oldest first.
+	for i := len(fresh) - 1; i >= 0; i-- {
+		if feed.options.Filter.Matches(&fresh[i]) {
+			delivered = append(delivered, fresh[i])
+		}
+	}
+	if len(delivered) > 0 {
+		for _, sink := range feed.options.Sinks {
+			err = sink.Deliver(ctx, delivered)
+			if err != nil {
+				delivered = nil
+				err = core.SDKErrorf(err, "", "deliver-error", common.GetComponentInfo())
+				return
+			}
+		}
+	}
+
+	state := &notificationFeedState{
+		Seen:             append([]string{}, feed.state.Seen...),
+		LastAcknowledged: feed.state.LastAcknowledged,
+		PolledAt:         time.Now().UTC(),
+	}
+	var newest int64
+	for i := len(fresh) - 1; i >= 0; i-- {
+		state.Seen = append(state.Seen, *fresh[i].ID)
+		if fresh[i].CreationTimestamp != nil && *fresh[i].CreationTimestamp > newest {
+			newest = *fresh[i].CreationTimestamp
+		}
+	}
+	if maxSeen := feed.maxSeen(); len(state.Seen) > maxSeen {
+		state.Seen = state.Seen[len(state.Seen)-maxSeen:]
+	}
+
+	if feed.options.Acknowledge && newest > state.LastAcknowledged {
+		options := &ReplaceNotificationAcknowledgementOptions{LastAcknowledged: core.Int64Ptr(newest)}
+		if feed.options.AccountID != "" {
+			options.AccountID = core.StringPtr(feed.options.AccountID)
+		}
+		_, _, err = feed.options.Service.ReplaceNotificationAcknowledgementWithContext(ctx, options)
+		if err != nil {
+			// The notifications were delivered, so they are recorded as seen even though they are not acknowledged.
+			err = core.RepurposeSDKProblem(err, "acknowledge-error")
+		} else {
+			state.LastAcknowledged = newest
+		}
+	}
+
+	if writeErr := writeNotificationFeedState(feed.options.StatePath, state); writeErr != nil && err == nil {
+		err = writeErr
+	}
+	feed.setState(state)
+	return
+}
+
+// Run polls until the context is canceled, and then returns nil. See NotificationFeedOptions.OnError for the
+// handling of errors.
+func (feed *NotificationFeed) Run(ctx context.Context) error {
+	interval := feed.options.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		_, err := feed.Poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			if feed.options.OnError == nil {
+				return err
+			}
+			feed.options.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// listNew returns the notifications not yet seen, newest first.
+func (feed *NotificationFeed) listNew(ctx context.Context) (fresh []Notification, err error) {
+	options := &ListNotificationsOptions{Limit: core.Int64Ptr(50)}
+	if feed.options.PageSize > 0 {
+		options.Limit = core.Int64Ptr(feed.options.PageSize)
+	}
+	if feed.options.AccountID != "" {
+		options.AccountID = core.StringPtr(feed.options.AccountID)
+	}
+	for {
+		var page *NotificationCollection
+		page, _, err = feed.options.Service.ListNotificationsWithContext(ctx, options)
+		if err != nil {
+			return
+		}
+		newInPage := 0
+		for _, notification := range page.Notifications {
+			if notification.ID != nil && !feed.seen[*notification.ID] {
+				fresh = append(fresh, notification)
+				newInPage++
+			}
+		}
+		next, _ := page.GetNextStart()
+		if newInPage == 0 || next == nil {
+			return
+		}
+		options.Start = next
+	}
+}
+
+func (feed *NotificationFeed) maxSeen() int {
+	if feed.options.MaxSeen > 0 {
+		return feed.options.MaxSeen
+	}
+	return 1000
+}
+
+// writeNotificationFeedState writes the state file atomically.
+func writeNotificationFeedState(path string, state *notificationFeedState) (err error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return core.SDKErrorf(err, "", "marshal-state-error", common.GetComponentInfo())
+	}
+	if err = common.WriteFileAtomic(path, data); err != nil {
+		return core.RepurposeSDKProblem(err, "write-state-error")
+	}
+	return nil
+}
+
+func containsAny(values []string, candidates []string) bool {
+	for _, candidate := range candidates {
+		if common.ContainsString(values, candidate) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformnotificationsv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/platformnotificationsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`NotificationFeed`, func() {
+	const myCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/abc:instance-1::"
+	var (
+		notifications []string
+		requests      []string
+		acknowledged  []int64
+		testServer    *httptest.Server
+		service       *platformnotificationsv1.PlatformNotificationsV1
+		statePath     string
+	)
+	notification := func(id string, category string, created int64, crnMask string) string {
+		return fmt.Sprintf(`{"id": "%s", "title": "%s", "body": "body", "category": "%s", "component_names": ["cloud-object-storage"],
+			"is_global": false, "regions": ["us-south"], "crn_masks": ["%s"], "severity": 1, "creation_timestamp": %d}`,
+			id, id, category, crnMask, created)
+	}
+
+	BeforeEach(func() {
+		requests = nil
+		acknowledged = nil
+		notifications = []string{
+			notification("n3", "incident", 3000, "crn:v1:bluemix:public:cloud-object-storage:::::"),
+			notification("n2", "maintenance", 2000, "crn:v1:bluemix:public:cloud-object-storage:::::"),
+			notification("n1", "incident", 1000, "crn:v1:bluemix:public:kms:::::"),
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req.Method+" "+req.URL.Path+"?"+req.URL.Query().Get("start"))
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Path == "/v1/notifications/acknowledgement" {
+				var body struct {
+					LastAcknowledged int64 `json:"last_acknowledged"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(BeNil())
+				acknowledged = append(acknowledged, body.LastAcknowledged)
+				fmt.Fprintf(res, `{"has_unread": false, "last_acknowledged": %d}`, body.LastAcknowledged)
+				return
+			}
+			// Pages of two notifications.
+			start := 0
+			fmt.Sscan(req.URL.Query().Get("start"), &start)
+			end := start + 2
+			next := ""
+			if end < len(notifications) {
+				next = fmt.Sprintf(`, "next": {"href": "next", "start": "%d"}`, end)
+			} else {
+				end = len(notifications)
+			}
+			fmt.Fprintf(res, `{"limit": 2, "total_count": %d, "first": {"href": "first"}%s, "notifications": [%s]}`,
+				len(notifications), next, strings.Join(notifications[start:end], ","))
+		}))
+
+		var err error
+		service, err = platformnotificationsv1.NewPlatformNotificationsV1(&platformnotificationsv1.PlatformNotificationsV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		dir, err := os.MkdirTemp("", "notification-feed")
+		Expect(err).To(BeNil())
+		statePath = filepath.Join(dir, "state.json")
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(filepath.Dir(statePath))
+	})
+
+	newFeed := func(sinks ...platformnotificationsv1.NotificationSink) *platformnotificationsv1.NotificationFeed {
+		feed, err := platformnotificationsv1.NewNotificationFeed(&platformnotificationsv1.NotificationFeedOptions{
+			Service:     service,
+			StatePath:   statePath,
+			Sinks:       sinks,
+			Filter:      &platformnotificationsv1.NotificationFilter{Categories: []string{"incident"}, ResourceCRNs: []string{myCRN}},
+			Acknowledge: true,
+			PageSize:    2,
+		})
+		Expect(err).To(BeNil())
+		return feed
+	}
+	ids := func(notifications []platformnotificationsv1.Notification) (ids []string) {
+		for _, notification := range notifications {
+			ids = append(ids, *notification.ID)
+		}
+		return
+	}
+
+	It(`Delivers new matching notifications once`, func() {
+		var output bytes.Buffer
+		channel := make(chan platformnotificationsv1.Notification, 10)
+		feed := newFeed(platformnotificationsv1.NewWriterSink(&output), platformnotificationsv1.NewChannelSink(channel))
+
+		delivered, err := feed.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(ids(delivered)).To(Equal([]string{"n3"}))
+		Expect(requests).To(Equal([]string{"GET /v1/notifications?", "GET /v1/notifications?2", "PUT /v1/notifications/acknowledgement?"}))
+		Expect(acknowledged).To(Equal([]int64{3000}))
+		Expect(output.String()).To(HavePrefix(`{"title":"n3",`))
+		Expect(*(<-channel).ID).To(Equal("n3"))
+
+		// A new feed with the same state sees only the new notifications, and stops at the first page without any.
+		notifications = append([]string{
+			notification("n5", "incident", 5000, "crn:v1:bluemix:public:cloud-object-storage:global::::"),
+			notification("n4", "incident", 4000, "crn:v1:bluemix:public:cloud-object-storage:eu-de::::"),
+		}, notifications...)
+		requests = nil
+		feed = newFeed(platformnotificationsv1.NewWriterSink(io.Discard))
+		delivered, err = feed.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(ids(delivered)).To(Equal([]string{"n5"}))
+		Expect(requests).To(Equal([]string{"GET /v1/notifications?", "GET /v1/notifications?2", "PUT /v1/notifications/acknowledgement?"}))
+		Expect(acknowledged).To(Equal([]int64{3000, 5000}))
+
+		requests = nil
+		delivered, err = feed.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(delivered).To(BeEmpty())
+		Expect(requests).To(Equal([]string{"GET /v1/notifications?"}))
+	})
+	It(`Delivers the notifications again when a sink fails`, func() {
+		failures := 1
+		var received []string
+		feed := newFeed(platformnotificationsv1.NotificationSinkFunc(func(ctx context.Context, notifications []platformnotificationsv1.Notification) error {
+			if failures > 0 {
+				failures--
+				return errors.New("sink unavailable")
+			}
+			received = append(received, ids(notifications)...)
+			return nil
+		}))
+		_, err := feed.Poll(context.Background())
+		Expect(err).ToNot(BeNil())
+		Expect(acknowledged).To(BeEmpty())
+		_, err = feed.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(received).To(Equal([]string{"n3"}))
+	})
+	It(`Posts notifications to a webhook`, func() {
+		var posted []map[string]interface{}
+		status := http.StatusNoContent
+		webhook := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			Expect(req.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(json.NewDecoder(req.Body).Decode(&posted)).To(BeNil())
+			res.WriteHeader(status)
+		}))
+		defer webhook.Close()
+
+		sink := platformnotificationsv1.NewWebhookSink(webhook.URL, nil)
+		feed := newFeed(sink)
+		_, err := feed.Poll(context.Background())
+		Expect(err).To(BeNil())
+		Expect(posted).To(HaveLen(1))
+		Expect(posted[0]["id"]).To(Equal("n3"))
+
+		status = http.StatusInternalServerError
+		err = sink.Deliver(context.Background(), []platformnotificationsv1.Notification{{ID: core.StringPtr("n1")}})
+		Expect(err.Error()).To(ContainSubstring("returned status 500"))
+	})
+	It(`Filters notifications`, func() {
+		incident := platformnotificationsv1.Notification{
+			Category:       core.StringPtr("incident"),
+			ComponentNames: []string{"kms"},
+			Regions:        []string{"eu-de"},
+			IsGlobal:       core.BoolPtr(false),
+			CrnMasks:       []string{"crn:v1:bluemix:public:kms:eu-de::::"},
+			Severity:       core.Int64Ptr(2),
+		}
+		matches := func(filter platformnotificationsv1.NotificationFilter) bool {
+			return filter.Matches(&incident)
+		}
+		Expect(matches(platformnotificationsv1.NotificationFilter{})).To(BeTrue())
+		Expect(matches(platformnotificationsv1.NotificationFilter{Regions: []string{"us-south"}})).To(BeFalse())
+		Expect(matches(platformnotificationsv1.NotificationFilter{ComponentNames: []string{"kms"}, MaxSeverity: 2})).To(BeTrue())
+		Expect(matches(platformnotificationsv1.NotificationFilter{MaxSeverity: 1})).To(BeFalse())
+		Expect(matches(platformnotificationsv1.NotificationFilter{ResourceCRNs: []string{"crn:v1:bluemix:public:kms:eu-de:a/abc:key-ring-1::"}})).To(BeTrue())
+		Expect(matches(platformnotificationsv1.NotificationFilter{ResourceCRNs: []string{"crn:v1:bluemix:public:kms:us-south:a/abc:key-ring-1::"}})).To(BeFalse())
+
+		// Masks that are not valid CRN masks are still matched.
+		incident.CrnMasks = []string{"crn:v1:bluemix:public:kms:eu-de"}
+		Expect(matches(platformnotificationsv1.NotificationFilter{ResourceCRNs: []string{"crn:v1:bluemix:public:kms:eu-de:a/abc:key-ring-1::"}})).To(BeTrue())
+		Expect(matches(platformnotificationsv1.NotificationFilter{ResourceCRNs: []string{"crn:v1:bluemix:public:kms:us-south:a/abc:key-ring-1::"}})).To(BeFalse())
+		incident.IsGlobal = core.BoolPtr(true)
+		Expect(matches(platformnotificationsv1.NotificationFilter{Regions: []string{"us-south"}})).To(BeTrue())
+	})
+	It(`Does not validate the fields of the service client`, func() {
+		_, err := platformnotificationsv1.NewNotificationFeed(&platformnotificationsv1.NotificationFeedOptions{
+			Service:   &taggedPlatformNotifications{PlatformNotificationsV1Intf: service},
+			StatePath: statePath,
+			Sinks:     []platformnotificationsv1.NotificationSink{platformnotificationsv1.NewWriterSink(io.Discard)},
+		})
+		Expect(err).To(BeNil())
+	})
+})
+
+// taggedPlatformNotifications : A service client with a field that does not pass validation, which must not be
+// reached when the options holding the client are validated.
+type taggedPlatformNotifications struct {
+	platformnotificationsv1.PlatformNotificationsV1Intf
+	Name *string `validate:"required"`
+}
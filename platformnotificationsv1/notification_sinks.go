@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformnotificationsv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// NotificationSink : A destination of the notifications of a NotificationFeed.
+type NotificationSink interface {
+	// Deliver receives the new notifications of a poll, oldest first. When it returns an error, the notifications
+	// are delivered again by the next poll.
+	Deliver(ctx context.Context, notifications []Notification) error
+}
+
+// NotificationSinkFunc : A function used as a NotificationSink.
+type NotificationSinkFunc func(ctx context.Context, notifications []Notification) error
+
+// Deliver calls the function.
+func (f NotificationSinkFunc) Deliver(ctx context.Context, notifications []Notification) error {
+	return f(ctx, notifications)
+}
+
+// NewChannelSink returns a sink that sends each notification to a channel. Deliver blocks until the notifications
+// are received or the context is canceled.
+func NewChannelSink(channel chan<- Notification) NotificationSink {
+	return NotificationSinkFunc(func(ctx context.Context, notifications []Notification) error {
+		for _, notification := range notifications {
+			select {
+			case channel <- notification:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
+
+// NewWriterSink returns a sink that writes each notification as a line of JSON, for example to os.Stdout.
+func NewWriterSink(writer io.Writer) NotificationSink {
+	var mutex sync.Mutex
+	return NotificationSinkFunc(func(ctx context.Context, notifications []Notification) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		encoder := json.NewEncoder(writer)
+		for i := range notifications {
+			if err := encoder.Encode(&notifications[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// NewWebhookSink returns a sink that posts the notifications of each poll, as a JSON array, to a URL such as a
+// local endpoint. Responses with a status code other than 2xx are errors. The client defaults to
+// http.DefaultClient.
+func NewWebhookSink(url string, client *http.Client) NotificationSink {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return NotificationSinkFunc(func(ctx context.Context, notifications []Notification) error {
+		body, err := json.Marshal(notifications)
+		if err != nil {
+			return core.SDKErrorf(err, "", "marshal-notifications-error", common.GetComponentInfo())
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return core.SDKErrorf(err, "", "webhook-request-error", common.GetComponentInfo())
+		}
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return core.SDKErrorf(err, "", "webhook-request-error", common.GetComponentInfo())
+		}
+		defer res.Body.Close()
+		_, _ = io.Copy(io.Discard, res.Body)
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return core.SDKErrorf(nil, fmt.Sprintf("the webhook %s returned status %d", url, res.StatusCode), "webhook-status-error",
+				common.GetComponentInfo())
+		}
+		return nil
+	})
+}
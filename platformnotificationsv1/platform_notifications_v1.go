@@ -191,7 +191,7 @@ func (platformNotifications *PlatformNotificationsV1) ListNotificationsWithConte
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "ListNotifications"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/notifications`, nil)
 	if err != nil {
@@ -229,7 +229,7 @@ func (platformNotifications *PlatformNotificationsV1) ListNotificationsWithConte
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_notifications", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -261,7 +261,7 @@ func (platformNotifications *PlatformNotificationsV1) GetAcknowledgementWithCont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "GetAcknowledgement"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/notifications/acknowledgement`, nil)
 	if err != nil {
@@ -293,7 +293,7 @@ func (platformNotifications *PlatformNotificationsV1) GetAcknowledgementWithCont
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_acknowledgement", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -330,7 +330,7 @@ func (platformNotifications *PlatformNotificationsV1) ReplaceNotificationAcknowl
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "ReplaceNotificationAcknowledgement"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/notifications/acknowledgement`, nil)
 	if err != nil {
@@ -373,7 +373,7 @@ func (platformNotifications *PlatformNotificationsV1) ReplaceNotificationAcknowl
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_notification_acknowledgement", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -414,7 +414,7 @@ func (platformNotifications *PlatformNotificationsV1) ListDistributionListDestin
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "ListDistributionListDestinations"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/distribution_lists/{account_id}/destinations`, pathParamsMap)
 	if err != nil {
@@ -442,7 +442,7 @@ func (platformNotifications *PlatformNotificationsV1) ListDistributionListDestin
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_distribution_list_destinations", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -486,7 +486,7 @@ func (platformNotifications *PlatformNotificationsV1) CreateDistributionListDest
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "CreateDistributionListDestination"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/distribution_lists/{account_id}/destinations`, pathParamsMap)
 	if err != nil {
@@ -521,7 +521,7 @@ func (platformNotifications *PlatformNotificationsV1) CreateDistributionListDest
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_distribution_list_destination", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -563,7 +563,7 @@ func (platformNotifications *PlatformNotificationsV1) GetDistributionListDestina
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "GetDistributionListDestination"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/distribution_lists/{account_id}/destinations/{destination_id}`, pathParamsMap)
 	if err != nil {
@@ -591,7 +591,7 @@ func (platformNotifications *PlatformNotificationsV1) GetDistributionListDestina
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_distribution_list_destination", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -633,7 +633,7 @@ func (platformNotifications *PlatformNotificationsV1) DeleteDistributionListDest
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "DeleteDistributionListDestination"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/distribution_lists/{account_id}/destinations/{destination_id}`, pathParamsMap)
 	if err != nil {
@@ -659,7 +659,7 @@ func (platformNotifications *PlatformNotificationsV1) DeleteDistributionListDest
 	response, err = platformNotifications.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_distribution_list_destination", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -694,7 +694,7 @@ func (platformNotifications *PlatformNotificationsV1) TestDistributionListDestin
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "TestDistributionListDestination"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/distribution_lists/{account_id}/destinations/{destination_id}/test`, pathParamsMap)
 	if err != nil {
@@ -729,7 +729,7 @@ func (platformNotifications *PlatformNotificationsV1) TestDistributionListDestin
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "test_distribution_list_destination", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -770,7 +770,7 @@ func (platformNotifications *PlatformNotificationsV1) GetPreferencesWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "GetPreferences"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/notifications/{iam_id}/preferences`, pathParamsMap)
 	if err != nil {
@@ -802,7 +802,7 @@ func (platformNotifications *PlatformNotificationsV1) GetPreferencesWithContext(
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_preferences", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -843,7 +843,7 @@ func (platformNotifications *PlatformNotificationsV1) CreatePreferencesWithConte
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "CreatePreferences"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/notifications/{iam_id}/preferences`, pathParamsMap)
 	if err != nil {
@@ -955,7 +955,7 @@ func (platformNotifications *PlatformNotificationsV1) CreatePreferencesWithConte
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_preferences", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -996,7 +996,7 @@ func (platformNotifications *PlatformNotificationsV1) ReplaceNotificationPrefere
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "ReplaceNotificationPreferences"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/notifications/{iam_id}/preferences`, pathParamsMap)
 	if err != nil {
@@ -1108,7 +1108,7 @@ func (platformNotifications *PlatformNotificationsV1) ReplaceNotificationPrefere
 	response, err = platformNotifications.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_notification_preferences", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1149,7 +1149,7 @@ func (platformNotifications *PlatformNotificationsV1) DeleteNotificationPreferen
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "platform_notifications", "V1", "DeleteNotificationPreferences"))
 	builder.EnableGzipCompression = platformNotifications.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(platformNotifications.Service.Options.URL, `/v1/notifications/{iam_id}/preferences`, pathParamsMap)
 	if err != nil {
@@ -1179,7 +1179,7 @@ func (platformNotifications *PlatformNotificationsV1) DeleteNotificationPreferen
 	response, err = platformNotifications.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_notification_preferences", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -0,0 +1,80 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package platformnotificationsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PlatformNotificationsV1Intf : The operations of the PlatformNotificationsV1 service client.
+// The interface is satisfied by *PlatformNotificationsV1 and by mocks.PlatformNotificationsV1, allowing consumers to substitute a mock for the client in tests.
+type PlatformNotificationsV1Intf interface {
+	// ListNotifications : Get user notifications
+	ListNotifications(listNotificationsOptions *ListNotificationsOptions) (result *NotificationCollection, response *core.DetailedResponse, err error)
+	// ListNotificationsWithContext is an alternate form of the ListNotifications method which supports a Context parameter
+	ListNotificationsWithContext(ctx context.Context, listNotificationsOptions *ListNotificationsOptions) (result *NotificationCollection, response *core.DetailedResponse, err error)
+	// GetAcknowledgement : Get acknowledgement
+	GetAcknowledgement(getAcknowledgementOptions *GetAcknowledgementOptions) (result *Acknowledgement, response *core.DetailedResponse, err error)
+	// GetAcknowledgementWithContext is an alternate form of the GetAcknowledgement method which supports a Context parameter
+	GetAcknowledgementWithContext(ctx context.Context, getAcknowledgementOptions *GetAcknowledgementOptions) (result *Acknowledgement, response *core.DetailedResponse, err error)
+	// ReplaceNotificationAcknowledgement : Update acknowledgement
+	ReplaceNotificationAcknowledgement(replaceNotificationAcknowledgementOptions *ReplaceNotificationAcknowledgementOptions) (result *Acknowledgement, response *core.DetailedResponse, err error)
+	// ReplaceNotificationAcknowledgementWithContext is an alternate form of the ReplaceNotificationAcknowledgement method which supports a Context parameter
+	ReplaceNotificationAcknowledgementWithContext(ctx context.Context, replaceNotificationAcknowledgementOptions *ReplaceNotificationAcknowledgementOptions) (result *Acknowledgement, response *core.DetailedResponse, err error)
+	// ListDistributionListDestinations : Get all destination entries
+	ListDistributionListDestinations(listDistributionListDestinationsOptions *ListDistributionListDestinationsOptions) (result *AddDestinationCollection, response *core.DetailedResponse, err error)
+	// ListDistributionListDestinationsWithContext is an alternate form of the ListDistributionListDestinations method which supports a Context parameter
+	ListDistributionListDestinationsWithContext(ctx context.Context, listDistributionListDestinationsOptions *ListDistributionListDestinationsOptions) (result *AddDestinationCollection, response *core.DetailedResponse, err error)
+	// CreateDistributionListDestination : Add a destination entry
+	CreateDistributionListDestination(createDistributionListDestinationOptions *CreateDistributionListDestinationOptions) (result AddDestinationIntf, response *core.DetailedResponse, err error)
+	// CreateDistributionListDestinationWithContext is an alternate form of the CreateDistributionListDestination method which supports a Context parameter
+	CreateDistributionListDestinationWithContext(ctx context.Context, createDistributionListDestinationOptions *CreateDistributionListDestinationOptions) (result AddDestinationIntf, response *core.DetailedResponse, err error)
+	// GetDistributionListDestination : Get a destination entry
+	GetDistributionListDestination(getDistributionListDestinationOptions *GetDistributionListDestinationOptions) (result AddDestinationIntf, response *core.DetailedResponse, err error)
+	// GetDistributionListDestinationWithContext is an alternate form of the GetDistributionListDestination method which supports a Context parameter
+	GetDistributionListDestinationWithContext(ctx context.Context, getDistributionListDestinationOptions *GetDistributionListDestinationOptions) (result AddDestinationIntf, response *core.DetailedResponse, err error)
+	// DeleteDistributionListDestination : Delete a destination entry
+	DeleteDistributionListDestination(deleteDistributionListDestinationOptions *DeleteDistributionListDestinationOptions) (response *core.DetailedResponse, err error)
+	// DeleteDistributionListDestinationWithContext is an alternate form of the DeleteDistributionListDestination method which supports a Context parameter
+	DeleteDistributionListDestinationWithContext(ctx context.Context, deleteDistributionListDestinationOptions *DeleteDistributionListDestinationOptions) (response *core.DetailedResponse, err error)
+	// TestDistributionListDestination : Test a destination entry
+	TestDistributionListDestination(testDistributionListDestinationOptions *TestDistributionListDestinationOptions) (result *TestDestinationResponseBody, response *core.DetailedResponse, err error)
+	// TestDistributionListDestinationWithContext is an alternate form of the TestDistributionListDestination method which supports a Context parameter
+	TestDistributionListDestinationWithContext(ctx context.Context, testDistributionListDestinationOptions *TestDistributionListDestinationOptions) (result *TestDestinationResponseBody, response *core.DetailedResponse, err error)
+	// GetPreferences : Get all communication preferences
+	GetPreferences(getPreferencesOptions *GetPreferencesOptions) (result *PreferencesObject, response *core.DetailedResponse, err error)
+	// GetPreferencesWithContext is an alternate form of the GetPreferences method which supports a Context parameter
+	GetPreferencesWithContext(ctx context.Context, getPreferencesOptions *GetPreferencesOptions) (result *PreferencesObject, response *core.DetailedResponse, err error)
+	// CreatePreferences : Create communication preferences
+	CreatePreferences(createPreferencesOptions *CreatePreferencesOptions) (result *PreferencesObject, response *core.DetailedResponse, err error)
+	// CreatePreferencesWithContext is an alternate form of the CreatePreferences method which supports a Context parameter
+	CreatePreferencesWithContext(ctx context.Context, createPreferencesOptions *CreatePreferencesOptions) (result *PreferencesObject, response *core.DetailedResponse, err error)
+	// ReplaceNotificationPreferences : Update communication preferences
+	ReplaceNotificationPreferences(replaceNotificationPreferencesOptions *ReplaceNotificationPreferencesOptions) (result *PreferencesObject, response *core.DetailedResponse, err error)
+	// ReplaceNotificationPreferencesWithContext is an alternate form of the ReplaceNotificationPreferences method which supports a Context parameter
+	ReplaceNotificationPreferencesWithContext(ctx context.Context, replaceNotificationPreferencesOptions *ReplaceNotificationPreferencesOptions) (result *PreferencesObject, response *core.DetailedResponse, err error)
+	// DeleteNotificationPreferences : Reset all preferences to their default values
+	DeleteNotificationPreferences(deleteNotificationPreferencesOptions *DeleteNotificationPreferencesOptions) (response *core.DetailedResponse, err error)
+	// DeleteNotificationPreferencesWithContext is an alternate form of the DeleteNotificationPreferences method which supports a Context parameter
+	DeleteNotificationPreferencesWithContext(ctx context.Context, deleteNotificationPreferencesOptions *DeleteNotificationPreferencesOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ PlatformNotificationsV1Intf = (*PlatformNotificationsV1)(nil)
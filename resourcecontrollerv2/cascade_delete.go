@@ -0,0 +1,673 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+const (
+	defaultCascadeDeletePollInterval = 5 * time.Second
+	defaultCascadeDeleteTimeout      = 10 * time.Minute
+)
+
+// Kinds of the resources deleted by DeleteResourceInstanceCascade.
+const (
+	CascadeDeleteKindResourceBindingConst  = "resource_binding"
+	CascadeDeleteKindResourceAliasConst    = "resource_alias"
+	CascadeDeleteKindResourceKeyConst      = "resource_key"
+	CascadeDeleteKindResourceInstanceConst = "resource_instance"
+)
+
+// Statuses of the steps of a CascadeDeleteReport.
+const (
+	CascadeDeleteStatusPlannedConst  = "planned"
+	CascadeDeleteStatusDeletedConst  = "deleted"
+	CascadeDeleteStatusFailedConst   = "failed"
+	CascadeDeleteStatusRestoredConst = "restored"
+)
+
+// DeleteResourceInstanceCascadeOptions : The DeleteResourceInstanceCascade options.
+type DeleteResourceInstanceCascadeOptions struct {
+	Service ResourceControllerV2Intf `validate:"required,structonly"`
+
+	// The ID or GUID of the resource instance.
+	ID string `validate:"required"`
+
+	// Unlock the instance if it is locked. Locked instances are not deleted otherwise.
+	Unlock bool
+
+	// Only discover the dependents of the instance and return the plan, without changing anything.
+	DryRun bool
+
+	// The file to which the report is written after every step. No report is written when empty.
+	ReportPath string
+
+	// The interval between the checks that a resource is deleted. Defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// The time to wait for each resource to be deleted. Defaults to 10 minutes.
+	Timeout time.Duration
+}
+
+// CascadeDeleteReport : The plan and the outcome of DeleteResourceInstanceCascade, from which
+// RestoreResourceInstanceCascade restores the instance and re-creates its dependents.
+type CascadeDeleteReport struct {
+	InstanceID      string `json:"instance_id"`
+	InstanceGUID    string `json:"instance_guid,omitempty"`
+	InstanceCRN     string `json:"instance_crn,omitempty"`
+	InstanceName    string `json:"instance_name,omitempty"`
+	ResourceGroupID string `json:"resource_group_id,omitempty"`
+
+	// Whether the instance was unlocked before it was deleted. Restored instances are locked again.
+	Unlocked bool `json:"unlocked,omitempty"`
+
+	DryRun     bool       `json:"dry_run,omitempty"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// The resources to delete, in order: the bindings and keys of the aliases, the aliases, the keys of the instance,
+	// then the instance.
+	Steps []CascadeDeleteStep `json:"steps"`
+}
+
+// CascadeDeleteStep : The deletion of one resource, with what is needed to re-create it.
+type CascadeDeleteStep struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	CRN    string `json:"crn,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+
+	// The CRN of the source of a key or binding, or the ID of the instance of an alias.
+	Source string `json:"source,omitempty"`
+
+	// The target CRN of an alias or binding.
+	Target string `json:"target,omitempty"`
+
+	// The CRN of the IAM role of a key or binding.
+	Role string `json:"role,omitempty"`
+
+	// The ID of the reclamation of a deleted instance.
+	ReclamationID string `json:"reclamation_id,omitempty"`
+
+	// The ID of the resource restored or re-created by RestoreResourceInstanceCascade.
+	RestoredID string `json:"restored_id,omitempty"`
+}
+
+// ReclamationID returns the ID of the reclamation of the deleted instance, or an empty string.
+func (report *CascadeDeleteReport) ReclamationID() string {
+	for _, step := range report.Steps {
+		if step.Kind == CascadeDeleteKindResourceInstanceConst {
+			return step.ReclamationID
+		}
+	}
+	return ""
+}
+
+// DeleteResourceInstanceCascade deletes a resource instance with its dependents. It discovers the keys and aliases of
+// the instance and the bindings and keys of its aliases, then deletes the bindings, the keys of the aliases, the
+// aliases, the keys of the instance and finally the instance, waiting for each resource to be deleted before the next. A locked instance is unlocked first when
+// options.Unlock is set, and rejected before anything is deleted otherwise.
+//
+// The report lists the steps with the ID of the reclamation of the instance. It is returned, and written to
+// options.ReportPath, even when a step fails.
+func DeleteResourceInstanceCascade(ctx context.Context, options *DeleteResourceInstanceCascadeOptions) (report *CascadeDeleteReport, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	poller := newResourcePoller(options.PollInterval, options.Timeout)
+	service := options.Service
+
+	instance, _, err := service.GetResourceInstanceWithContext(ctx, &GetResourceInstanceOptions{ID: core.StringPtr(options.ID)})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-resource-instance-error")
+		return
+	}
+	state := core.StringNilMapper(instance.State)
+	if state == ResourceInstanceStateRemovedConst || state == ResourceInstanceStatePendingReclamationConst {
+		err = core.SDKErrorf(nil, fmt.Sprintf("resource instance '%s' is already deleted (state '%s')", options.ID, state),
+			"resource-instance-deleted", common.GetComponentInfo())
+		return
+	}
+
+	report = &CascadeDeleteReport{
+		InstanceID:      core.StringNilMapper(instance.ID),
+		InstanceGUID:    core.StringNilMapper(instance.GUID),
+		InstanceCRN:     core.StringNilMapper(instance.CRN),
+		InstanceName:    core.StringNilMapper(instance.Name),
+		ResourceGroupID: core.StringNilMapper(instance.ResourceGroupID),
+		DryRun:          options.DryRun,
+		StartedAt:       time.Now().UTC(),
+	}
+	err = planCascadeDelete(ctx, service, report)
+	if err != nil {
+		report = nil
+		return
+	}
+	defer func() {
+		if !report.DryRun {
+			finishedAt := time.Now().UTC()
+			report.FinishedAt = &finishedAt
+		}
+		if options.ReportPath != "" {
+			writeErr := WriteCascadeDeleteReport(options.ReportPath, report)
+			if err == nil {
+				err = writeErr
+			}
+		}
+	}()
+
+	if instance.Locked != nil && *instance.Locked && !options.Unlock {
+		err = core.SDKErrorf(nil, fmt.Sprintf("resource instance '%s' is locked; set options.Unlock to unlock it", options.ID),
+			"resource-instance-locked", common.GetComponentInfo())
+		return
+	}
+	if options.DryRun {
+		return
+	}
+	if instance.Locked != nil && *instance.Locked {
+		_, _, err = service.UnlockResourceInstanceWithContext(ctx, &UnlockResourceInstanceOptions{ID: core.StringPtr(report.InstanceID)})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "unlock-resource-instance-error")
+			return
+		}
+		report.Unlocked = true
+	}
+
+	for i := range report.Steps {
+		step := &report.Steps[i]
+		err = deleteCascadeStep(ctx, service, poller, report, step)
+		if err != nil {
+			step.Status = CascadeDeleteStatusFailedConst
+			step.Error = err.Error()
+			return
+		}
+		step.Status = CascadeDeleteStatusDeletedConst
+		if options.ReportPath != "" && i < len(report.Steps)-1 {
+			err = WriteCascadeDeleteReport(options.ReportPath, report)
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// planCascadeDelete lists the dependents of the instance of the report and adds the steps that delete them.
+func planCascadeDelete(ctx context.Context, service ResourceControllerV2Intf, report *CascadeDeleteReport) error {
+	var aliases []ResourceAlias
+	options := &ListResourceAliasesForInstanceOptions{ID: core.StringPtr(report.InstanceID)}
+	for {
+		result, _, err := service.ListResourceAliasesForInstanceWithContext(ctx, options)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-resource-aliases-error")
+		}
+		aliases = append(aliases, result.Resources...)
+		options.Start, err = result.GetNextStart()
+		if err != nil {
+			return err
+		}
+		if options.Start == nil {
+			break
+		}
+	}
+
+	for _, alias := range aliases {
+		options := &ListResourceBindingsForAliasOptions{ID: alias.ID}
+		for {
+			result, _, err := service.ListResourceBindingsForAliasWithContext(ctx, options)
+			if err != nil {
+				return core.RepurposeSDKProblem(err, "list-resource-bindings-error")
+			}
+			for _, binding := range result.Resources {
+				report.Steps = append(report.Steps, CascadeDeleteStep{
+					Kind:   CascadeDeleteKindResourceBindingConst,
+					ID:     core.StringNilMapper(binding.ID),
+					CRN:    core.StringNilMapper(binding.CRN),
+					Name:   core.StringNilMapper(binding.Name),
+					Status: CascadeDeleteStatusPlannedConst,
+					Source: core.StringNilMapper(binding.SourceCRN),
+					Target: core.StringNilMapper(binding.TargetCRN),
+					Role:   credentialsRole(binding.Credentials),
+				})
+			}
+			options.Start, err = result.GetNextStart()
+			if err != nil {
+				return err
+			}
+			if options.Start == nil {
+				break
+			}
+		}
+	}
+
+	planned := map[string]bool{}
+	planKeys := func(keys []ResourceKey, source string) {
+		for _, key := range keys {
+			id := core.StringNilMapper(key.ID)
+			if planned[id] || (source != "" && core.StringNilMapper(key.SourceCRN) != source) {
+				continue
+			}
+			planned[id] = true
+			report.Steps = append(report.Steps, CascadeDeleteStep{
+				Kind:   CascadeDeleteKindResourceKeyConst,
+				ID:     id,
+				CRN:    core.StringNilMapper(key.CRN),
+				Name:   core.StringNilMapper(key.Name),
+				Status: CascadeDeleteStatusPlannedConst,
+				Source: core.StringNilMapper(key.SourceCRN),
+				Role:   credentialsRole(key.Credentials),
+			})
+		}
+	}
+
+	// The keys of an alias are deleted before the alias. Only the listed keys whose source is the alias are planned.
+	for _, alias := range aliases {
+		options := &ListResourceKeysOptions{ResourceID: alias.ID}
+		for {
+			result, _, err := service.ListResourceKeysWithContext(ctx, options)
+			if err != nil {
+				return core.RepurposeSDKProblem(err, "list-resource-keys-error")
+			}
+			planKeys(result.Resources, core.StringNilMapper(alias.CRN))
+			options.Start, err = result.GetNextStart()
+			if err != nil {
+				return err
+			}
+			if options.Start == nil {
+				break
+			}
+		}
+	}
+
+	for _, alias := range aliases {
+		report.Steps = append(report.Steps, CascadeDeleteStep{
+			Kind:   CascadeDeleteKindResourceAliasConst,
+			ID:     core.StringNilMapper(alias.ID),
+			CRN:    core.StringNilMapper(alias.CRN),
+			Name:   core.StringNilMapper(alias.Name),
+			Status: CascadeDeleteStatusPlannedConst,
+			Source: core.StringNilMapper(alias.ResourceInstanceID),
+			Target: core.StringNilMapper(alias.TargetCRN),
+		})
+	}
+
+	keysOptions := &ListResourceKeysForInstanceOptions{ID: core.StringPtr(report.InstanceID)}
+	for {
+		result, _, err := service.ListResourceKeysForInstanceWithContext(ctx, keysOptions)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-resource-keys-error")
+		}
+		planKeys(result.Resources, "")
+		keysOptions.Start, err = result.GetNextStart()
+		if err != nil {
+			return err
+		}
+		if keysOptions.Start == nil {
+			break
+		}
+	}
+
+	report.Steps = append(report.Steps, CascadeDeleteStep{
+		Kind:   CascadeDeleteKindResourceInstanceConst,
+		ID:     report.InstanceID,
+		CRN:    report.InstanceCRN,
+		Name:   report.InstanceName,
+		Status: CascadeDeleteStatusPlannedConst,
+	})
+	return nil
+}
+
+// deleteCascadeStep deletes the resource of a step and waits until it is deleted.
+func deleteCascadeStep(ctx context.Context, service ResourceControllerV2Intf, poller *resourcePoller, report *CascadeDeleteReport,
+	step *CascadeDeleteStep) (err error) {
+	id := core.StringPtr(step.ID)
+	switch step.Kind {
+	case CascadeDeleteKindResourceBindingConst:
+		_, err = service.DeleteResourceBindingWithContext(ctx, &DeleteResourceBindingOptions{ID: id})
+		if err == nil {
+			err = poller.waitDeleted(ctx, step.Kind, step.ID, func(ctx context.Context) (*string, error) {
+				binding, _, err := service.GetResourceBindingWithContext(ctx, &GetResourceBindingOptions{ID: id})
+				if binding == nil {
+					return nil, err
+				}
+				return binding.State, err
+			})
+		}
+	case CascadeDeleteKindResourceAliasConst:
+		_, err = service.DeleteResourceAliasWithContext(ctx, &DeleteResourceAliasOptions{ID: id})
+		if err == nil {
+			err = poller.waitDeleted(ctx, step.Kind, step.ID, func(ctx context.Context) (*string, error) {
+				alias, _, err := service.GetResourceAliasWithContext(ctx, &GetResourceAliasOptions{ID: id})
+				if alias == nil {
+					return nil, err
+				}
+				return alias.State, err
+			})
+		}
+	case CascadeDeleteKindResourceKeyConst:
+		_, err = service.DeleteResourceKeyWithContext(ctx, &DeleteResourceKeyOptions{ID: id})
+		if err == nil {
+			err = poller.waitDeleted(ctx, step.Kind, step.ID, func(ctx context.Context) (*string, error) {
+				key, _, err := service.GetResourceKeyWithContext(ctx, &GetResourceKeyOptions{ID: id})
+				if key == nil {
+					return nil, err
+				}
+				return key.State, err
+			})
+		}
+	case CascadeDeleteKindResourceInstanceConst:
+		_, err = service.DeleteResourceInstanceWithContext(ctx, &DeleteResourceInstanceOptions{ID: id})
+		if err == nil {
+			err = poller.waitDeleted(ctx, step.Kind, step.ID, func(ctx context.Context) (*string, error) {
+				instance, _, err := service.GetResourceInstanceWithContext(ctx, &GetResourceInstanceOptions{ID: id})
+				if instance == nil {
+					return nil, err
+				}
+				return instance.State, err
+			})
+		}
+		if err == nil {
+			step.ReclamationID, err = findReclamationID(ctx, service, report)
+		}
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "cascade-delete-error")
+	}
+	return
+}
+
+// findReclamationID returns the ID of the reclamation of the deleted instance of a report, or an empty string if the
+// instance was deleted without a reclamation.
+func findReclamationID(ctx context.Context, service ResourceControllerV2Intf, report *CascadeDeleteReport) (string, error) {
+	instanceID := report.InstanceGUID
+	if instanceID == "" {
+		instanceID = report.InstanceID
+	}
+	result, _, err := service.ListReclamationsWithContext(ctx, &ListReclamationsOptions{ResourceInstanceID: core.StringPtr(instanceID)})
+	if err != nil {
+		return "", core.RepurposeSDKProblem(err, "list-reclamations-error")
+	}
+	for _, reclamation := range result.Resources {
+		if core.StringNilMapper(reclamation.ResourceInstanceID) == instanceID || core.StringNilMapper(reclamation.EntityCRN) == report.InstanceCRN {
+			return core.StringNilMapper(reclamation.ID), nil
+		}
+	}
+	return "", nil
+}
+
+// resourcePoller : Waits for resources to reach a state.
+type resourcePoller struct {
+	interval time.Duration
+	timeout  time.Duration
+}
+
+func newResourcePoller(interval time.Duration, timeout time.Duration) *resourcePoller {
+	if interval <= 0 {
+		interval = defaultCascadeDeletePollInterval
+	}
+	if timeout <= 0 {
+		timeout = defaultCascadeDeleteTimeout
+	}
+	return &resourcePoller{interval: interval, timeout: timeout}
+}
+
+// waitDeleted calls "get" until the resource is not found or in the removed or pending_reclamation state.
+func (poller *resourcePoller) waitDeleted(ctx context.Context, kind string, id string, get func(context.Context) (*string, error)) error {
+	return poller.wait(ctx, kind, id, "deleted", func(ctx context.Context) (bool, error) {
+		state, err := get(ctx)
+		if _, notFound := common.AsNotFound(err); notFound {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return core.StringNilMapper(state) == ResourceInstanceStateRemovedConst ||
+			core.StringNilMapper(state) == ResourceInstanceStatePendingReclamationConst, nil
+	})
+}
+
+// waitState calls "get" until the resource is in the specified state.
+func (poller *resourcePoller) waitState(ctx context.Context, kind string, id string, state string,
+	get func(context.Context) (*string, error)) error {
+	return poller.wait(ctx, kind, id, state, func(ctx context.Context) (bool, error) {
+		current, err := get(ctx)
+		if err != nil {
+			return false, err
+		}
+		return core.StringNilMapper(current) == state, nil
+	})
+}
+
+func (poller *resourcePoller) wait(ctx context.Context, kind string, id string, goal string, done func(context.Context) (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, poller.timeout)
+	defer cancel()
+	for {
+		ok, err := done(ctx)
+		if err != nil || ok {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return core.SDKErrorf(ctx.Err(), fmt.Sprintf("timed out waiting for %s '%s' to be %s", kind, id, goal),
+				"wait-timeout", common.GetComponentInfo())
+		case <-time.After(poller.interval):
+		}
+	}
+}
+
+// RestoreResourceInstanceCascadeOptions : The RestoreResourceInstanceCascade options.
+type RestoreResourceInstanceCascadeOptions struct {
+	Service ResourceControllerV2Intf `validate:"required,structonly"`
+
+	// The report of DeleteResourceInstanceCascade. Its steps are updated with the IDs of the restored resources.
+	Report *CascadeDeleteReport `validate:"required"`
+
+	// The file to which the updated report is written after every step. No report is written when empty.
+	ReportPath string
+
+	// The interval between the checks that the instance is restored. Defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// The time to wait for the instance to be restored. Defaults to 10 minutes.
+	Timeout time.Duration
+}
+
+// RestoreResourceInstanceCascade undoes DeleteResourceInstanceCascade: it restores the instance from its
+// reclamation, locks it again if it was unlocked, then re-creates its keys, its aliases, and the keys and bindings of
+// the aliases. Re-created keys
+// and bindings have new credentials. Steps already restored, and those that were not deleted, are skipped, so a
+// failed restore can be resumed with the updated report.
+func RestoreResourceInstanceCascade(ctx context.Context, options *RestoreResourceInstanceCascadeOptions) (err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	poller := newResourcePoller(options.PollInterval, options.Timeout)
+	service := options.Service
+	report := options.Report
+
+	// The IDs of the re-created aliases, by the CRN of the deleted alias, which the bindings refer to.
+	aliases := map[string]string{}
+	restore := func(step *CascadeDeleteStep) (err error) {
+		switch step.Kind {
+		case CascadeDeleteKindResourceInstanceConst:
+			if step.ReclamationID == "" {
+				return core.SDKErrorf(nil, fmt.Sprintf("resource instance '%s' has no reclamation to restore", step.ID),
+					"reclamation-not-found", common.GetComponentInfo())
+			}
+			_, _, err = service.RunReclamationActionWithContext(ctx, &RunReclamationActionOptions{
+				ID:         core.StringPtr(step.ReclamationID),
+				ActionName: core.StringPtr("restore"),
+			})
+			if err != nil {
+				return
+			}
+			id := core.StringPtr(step.ID)
+			err = poller.waitState(ctx, step.Kind, step.ID, ResourceInstanceStateActiveConst, func(ctx context.Context) (*string, error) {
+				instance, _, err := service.GetResourceInstanceWithContext(ctx, &GetResourceInstanceOptions{ID: id})
+				if instance == nil {
+					return nil, err
+				}
+				return instance.State, err
+			})
+			if err == nil && report.Unlocked {
+				_, _, err = service.LockResourceInstanceWithContext(ctx, &LockResourceInstanceOptions{ID: id})
+			}
+			if err == nil {
+				step.RestoredID = step.ID
+			}
+		case CascadeDeleteKindResourceAliasConst:
+			var alias *ResourceAlias
+			alias, _, err = service.CreateResourceAliasWithContext(ctx, &CreateResourceAliasOptions{
+				Name:   core.StringPtr(step.Name),
+				Source: core.StringPtr(step.Source),
+				Target: core.StringPtr(step.Target),
+			})
+			if err == nil {
+				step.RestoredID = core.StringNilMapper(alias.ID)
+				aliases[step.CRN] = step.RestoredID
+			}
+		case CascadeDeleteKindResourceKeyConst:
+			var key *ResourceKey
+			key, _, err = service.CreateResourceKeyWithContext(ctx, &CreateResourceKeyOptions{
+				Name:   core.StringPtr(step.Name),
+				Source: core.StringPtr(restoredSource(aliases, step.Source)),
+				Role:   stringPtrOrNil(step.Role),
+			})
+			if err == nil {
+				step.RestoredID = core.StringNilMapper(key.ID)
+			}
+		case CascadeDeleteKindResourceBindingConst:
+			var binding *ResourceBinding
+			binding, _, err = service.CreateResourceBindingWithContext(ctx, &CreateResourceBindingOptions{
+				Name:   stringPtrOrNil(step.Name),
+				Source: core.StringPtr(restoredSource(aliases, step.Source)),
+				Target: core.StringPtr(step.Target),
+				Role:   stringPtrOrNil(step.Role),
+			})
+			if err == nil {
+				step.RestoredID = core.StringNilMapper(binding.ID)
+			}
+		}
+		return
+	}
+
+	// Restore in the reverse order of the deletion: the instance, its keys, the aliases, then the keys and bindings of
+	// the aliases, which refer to the re-created aliases.
+	for i := len(report.Steps) - 1; i >= 0; i-- {
+		step := &report.Steps[i]
+		if step.Status == CascadeDeleteStatusRestoredConst {
+			if step.Kind == CascadeDeleteKindResourceAliasConst {
+				aliases[step.CRN] = step.RestoredID
+			}
+			continue
+		}
+		if step.Status != CascadeDeleteStatusDeletedConst {
+			continue
+		}
+		err = restore(step)
+		if err != nil {
+			step.Error = err.Error()
+		} else {
+			step.Status = CascadeDeleteStatusRestoredConst
+			step.Error = ""
+		}
+		if options.ReportPath != "" {
+			writeErr := WriteCascadeDeleteReport(options.ReportPath, report)
+			if err == nil {
+				err = writeErr
+			}
+		}
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "cascade-restore-error")
+		}
+	}
+	return nil
+}
+
+// restoredSource returns the ID of the re-created alias for the source of a key or binding, or the source itself.
+func restoredSource(aliases map[string]string, source string) string {
+	if restored, found := aliases[source]; found {
+		return restored
+	}
+	return source
+}
+
+// ReadCascadeDeleteReport reads a report written by DeleteResourceInstanceCascade.
+func ReadCascadeDeleteReport(path string) (report *CascadeDeleteReport, err error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-error", common.GetComponentInfo())
+		return
+	}
+	report = new(CascadeDeleteReport)
+	err = json.Unmarshal(data, report)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+		report = nil
+	}
+	return
+}
+
+// WriteCascadeDeleteReport writes a report as indented JSON, replacing the file atomically.
+func WriteCascadeDeleteReport(path string, report *CascadeDeleteReport) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+	}
+	if err = common.WriteFileAtomic(path, append(data, '\n')); err != nil {
+		return core.RepurposeSDKProblem(err, "write-error")
+	}
+	return nil
+}
+
+func credentialsRole(credentials *Credentials) string {
+	if credentials == nil {
+		return ""
+	}
+	return core.StringNilMapper(credentials.IamRoleCRN)
+}
+
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return core.StringPtr(s)
+}
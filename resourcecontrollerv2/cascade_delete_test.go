@@ -0,0 +1,320 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Cascading delete`, func() {
+	const roleCRN = "crn:v1:bluemix:public:iam::::serviceRole:Writer"
+
+	var testServer *httptest.Server
+	var service *resourcecontrollerv2.ResourceControllerV2
+	var dir string
+	var mutex sync.Mutex
+	var requests []string
+	var resources map[string]map[string]interface{}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "cascade-delete")
+		Expect(err).To(BeNil())
+
+		requests = nil
+		resources = map[string]map[string]interface{}{
+			"resource_instances/inst1": {"id": "inst1", "guid": "guid1", "crn": "crn:v1:bluemix:public:kms:us-south:a/acc:guid1::",
+				"name": "keys", "resource_group_id": "rg1", "state": "active", "locked": true},
+			"resource_aliases/alias1": {"id": "alias1", "crn": "crn:alias1", "name": "alias", "resource_instance_id": "inst1",
+				"target_crn": "crn:v1:bluemix:public:cf:us-south:o/org::cf-space:space", "state": "active"},
+			"resource_bindings/binding1": {"id": "binding1", "crn": "crn:binding1", "name": "binding", "source_crn": "crn:alias1",
+				"target_crn": "crn:v1:bluemix:public:cf:us-south:s/space::cf-application:app", "state": "active",
+				"credentials": map[string]interface{}{"iam_role_crn": roleCRN}},
+			"resource_keys/key1": {"id": "key1", "crn": "crn:key1", "name": "key", "source_crn": "crn:v1:bluemix:public:kms:us-south:a/acc:guid1::",
+				"state": "active", "credentials": map[string]interface{}{"iam_role_crn": roleCRN, "apikey": "secret"}},
+		}
+		created := 0
+		list := func(kind string, match func(map[string]interface{}) bool) string {
+			var items []map[string]interface{}
+			for key, resource := range resources {
+				if strings.HasPrefix(key, kind+"/") && resource["state"] == "active" && match(resource) {
+					items = append(items, resource)
+				}
+			}
+			data, _ := json.Marshal(map[string]interface{}{"rows_count": len(items), "next_url": nil, "resources": items})
+			return string(data)
+		}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			path := strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, "/v2/"), "/v1/")
+			if req.Method != http.MethodGet {
+				requests = append(requests, req.Method+" "+path)
+			}
+			switch {
+			case path == "resource_instances/inst1/resource_aliases":
+				fmt.Fprint(res, list("resource_aliases", func(r map[string]interface{}) bool { return r["resource_instance_id"] == "inst1" }))
+			case path == "resource_aliases/alias1/resource_bindings":
+				fmt.Fprint(res, list("resource_bindings", func(r map[string]interface{}) bool { return r["source_crn"] == "crn:alias1" }))
+			case path == "resource_instances/inst1/resource_keys":
+				fmt.Fprint(res, list("resource_keys", func(r map[string]interface{}) bool {
+					return r["source_crn"] == resources["resource_instances/inst1"]["crn"]
+				}))
+			case path == "resource_keys" && req.Method == http.MethodGet:
+				alias := resources["resource_aliases/"+req.URL.Query().Get("resource_id")]
+				fmt.Fprint(res, list("resource_keys", func(r map[string]interface{}) bool { return alias != nil && r["source_crn"] == alias["crn"] }))
+			case path == "resource_instances/inst1/lock":
+				resources["resource_instances/inst1"]["locked"] = req.Method == http.MethodPost
+				fmt.Fprint(res, `{"id": "inst1"}`)
+			case path == "reclamations":
+				Expect(req.URL.Query().Get("resource_instance_id")).To(Equal("guid1"))
+				fmt.Fprint(res, `{"resources": [{"id": "reclamation1", "resource_instance_id": "guid1", "state": "SCHEDULED"}]}`)
+			case path == "reclamations/reclamation1/actions/restore":
+				resources["resource_instances/inst1"]["state"] = "active"
+				fmt.Fprint(res, `{"id": "reclamation1", "state": "RESTORING"}`)
+			case req.Method == http.MethodPost:
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				created++
+				id := fmt.Sprintf("new%d", created)
+				body["id"], body["crn"], body["state"] = id, "crn:"+id, "active"
+				resources[path+"/"+id] = body
+				data, _ := json.Marshal(body)
+				res.WriteHeader(201)
+				fmt.Fprint(res, string(data))
+			case resources[path] == nil:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"message": "not found"}`)
+			case req.Method == http.MethodDelete:
+				resource := resources[path]
+				if strings.HasPrefix(path, "resource_instances/") {
+					if resource["locked"] == true {
+						res.WriteHeader(422)
+						fmt.Fprint(res, `{"message": "instance is locked"}`)
+						return
+					}
+					resource["state"] = "pending_reclamation"
+				} else if strings.HasPrefix(path, "resource_aliases/") {
+					delete(resources, path)
+				} else {
+					resource["state"] = "removed"
+				}
+				res.WriteHeader(204)
+			default:
+				data, _ := json.Marshal(resources[path])
+				fmt.Fprint(res, string(data))
+			}
+		}))
+		service, err = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(dir)
+	})
+
+	options := func() *resourcecontrollerv2.DeleteResourceInstanceCascadeOptions {
+		return &resourcecontrollerv2.DeleteResourceInstanceCascadeOptions{
+			Service:      service,
+			ID:           "inst1",
+			ReportPath:   filepath.Join(dir, "report.json"),
+			PollInterval: time.Millisecond,
+			Timeout:      time.Second,
+		}
+	}
+	steps := func(report *resourcecontrollerv2.CascadeDeleteReport) (steps []string) {
+		for _, step := range report.Steps {
+			steps = append(steps, step.Kind+" "+step.ID+" "+step.Status)
+		}
+		return
+	}
+
+	It(`Plans the deletion and refuses locked instances`, func() {
+		dryRun := options()
+		dryRun.DryRun = true
+		dryRun.Unlock = true
+		report, err := resourcecontrollerv2.DeleteResourceInstanceCascade(context.Background(), dryRun)
+		Expect(err).To(BeNil())
+		Expect(steps(report)).To(Equal([]string{
+			"resource_binding binding1 planned",
+			"resource_alias alias1 planned",
+			"resource_key key1 planned",
+			"resource_instance inst1 planned",
+		}))
+		Expect(report.Steps[0].Role).To(Equal(roleCRN))
+		Expect(report.FinishedAt).To(BeNil())
+
+		report, err = resourcecontrollerv2.DeleteResourceInstanceCascade(context.Background(), options())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("locked"))
+		Expect(report.Steps).To(HaveLen(4))
+		Expect(requests).To(BeEmpty())
+
+		written, err := resourcecontrollerv2.ReadCascadeDeleteReport(filepath.Join(dir, "report.json"))
+		Expect(err).To(BeNil())
+		Expect(written.Steps).To(Equal(report.Steps))
+	})
+	It(`Deletes the instance with its dependents and restores them`, func() {
+		deleteOptions := options()
+		deleteOptions.Unlock = true
+		report, err := resourcecontrollerv2.DeleteResourceInstanceCascade(context.Background(), deleteOptions)
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{
+			"DELETE resource_instances/inst1/lock",
+			"DELETE resource_bindings/binding1",
+			"DELETE resource_aliases/alias1",
+			"DELETE resource_keys/key1",
+			"DELETE resource_instances/inst1",
+		}))
+		Expect(steps(report)).To(Equal([]string{
+			"resource_binding binding1 deleted",
+			"resource_alias alias1 deleted",
+			"resource_key key1 deleted",
+			"resource_instance inst1 deleted",
+		}))
+		Expect(report.Unlocked).To(BeTrue())
+		Expect(report.ReclamationID()).To(Equal("reclamation1"))
+		Expect(report.FinishedAt).ToNot(BeNil())
+
+		// The report does not leak credentials.
+		data, err := os.ReadFile(filepath.Join(dir, "report.json"))
+		Expect(err).To(BeNil())
+		Expect(string(data)).ToNot(ContainSubstring("secret"))
+
+		_, err = resourcecontrollerv2.DeleteResourceInstanceCascade(context.Background(), deleteOptions)
+		Expect(err).ToNot(BeNil())
+
+		requests = nil
+		report, err = resourcecontrollerv2.ReadCascadeDeleteReport(filepath.Join(dir, "report.json"))
+		Expect(err).To(BeNil())
+		err = resourcecontrollerv2.RestoreResourceInstanceCascade(context.Background(), &resourcecontrollerv2.RestoreResourceInstanceCascadeOptions{
+			Service:      service,
+			Report:       report,
+			ReportPath:   filepath.Join(dir, "report.json"),
+			PollInterval: time.Millisecond,
+			Timeout:      time.Second,
+		})
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{
+			"POST reclamations/reclamation1/actions/restore",
+			"POST resource_instances/inst1/lock",
+			"POST resource_keys",
+			"POST resource_aliases",
+			"POST resource_bindings",
+		}))
+		Expect(resources["resource_instances/inst1"]["locked"]).To(BeTrue())
+		Expect(resources["resource_keys/new1"]["role"]).To(Equal(roleCRN))
+		Expect(resources["resource_aliases/new2"]["source"]).To(Equal("inst1"))
+		Expect(resources["resource_bindings/new3"]["source"]).To(Equal("new2"))
+		Expect(steps(report)).To(Equal([]string{
+			"resource_binding binding1 restored",
+			"resource_alias alias1 restored",
+			"resource_key key1 restored",
+			"resource_instance inst1 restored",
+		}))
+		Expect(report.Steps[0].RestoredID).To(Equal("new3"))
+	})
+	It(`Deletes the keys of the aliases before the aliases and re-creates them after`, func() {
+		resources["resource_keys/key2"] = map[string]interface{}{"id": "key2", "crn": "crn:key2", "name": "alias-key",
+			"source_crn": "crn:alias1", "state": "active", "credentials": map[string]interface{}{"iam_role_crn": roleCRN}}
+		deleteOptions := options()
+		deleteOptions.Unlock = true
+		report, err := resourcecontrollerv2.DeleteResourceInstanceCascade(context.Background(), deleteOptions)
+		Expect(err).To(BeNil())
+		Expect(steps(report)).To(Equal([]string{
+			"resource_binding binding1 deleted",
+			"resource_key key2 deleted",
+			"resource_alias alias1 deleted",
+			"resource_key key1 deleted",
+			"resource_instance inst1 deleted",
+		}))
+
+		requests = nil
+		err = resourcecontrollerv2.RestoreResourceInstanceCascade(context.Background(), &resourcecontrollerv2.RestoreResourceInstanceCascadeOptions{
+			Service:      service,
+			Report:       report,
+			PollInterval: time.Millisecond,
+			Timeout:      time.Second,
+		})
+		Expect(err).To(BeNil())
+		Expect(requests).To(Equal([]string{
+			"POST reclamations/reclamation1/actions/restore",
+			"POST resource_instances/inst1/lock",
+			"POST resource_keys",
+			"POST resource_aliases",
+			"POST resource_keys",
+			"POST resource_bindings",
+		}))
+		Expect(resources["resource_keys/new1"]["source"]).To(Equal("crn:v1:bluemix:public:kms:us-south:a/acc:guid1::"))
+		Expect(resources["resource_keys/new3"]["name"]).To(Equal("alias-key"))
+		Expect(resources["resource_keys/new3"]["source"]).To(Equal("new2"))
+		Expect(resources["resource_bindings/new4"]["source"]).To(Equal("new2"))
+	})
+	It(`Records the step that failed`, func() {
+		deleteOptions := options()
+		deleteOptions.Unlock = true
+		resources["resource_keys/key1"]["id"] = "missing"
+		report, err := resourcecontrollerv2.DeleteResourceInstanceCascade(context.Background(), deleteOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(steps(report)).To(Equal([]string{
+			"resource_binding binding1 deleted",
+			"resource_alias alias1 deleted",
+			"resource_key missing failed",
+			"resource_instance inst1 planned",
+		}))
+		Expect(report.Steps[2].Error).ToNot(BeEmpty())
+
+		written, err := resourcecontrollerv2.ReadCascadeDeleteReport(filepath.Join(dir, "report.json"))
+		Expect(err).To(BeNil())
+		Expect(written.Steps[2].Status).To(Equal(resourcecontrollerv2.CascadeDeleteStatusFailedConst))
+	})
+	It(`Does not validate the fields of the service client`, func() {
+		dryRun := options()
+		dryRun.Service = &taggedResourceController{ResourceControllerV2Intf: service}
+		dryRun.DryRun = true
+		dryRun.Unlock = true
+		_, err := resourcecontrollerv2.DeleteResourceInstanceCascade(context.Background(), dryRun)
+		Expect(err).To(BeNil())
+	})
+})
+
+// taggedResourceController : A service client with a field that does not pass validation, which must not be
+// reached when the options holding the client are validated.
+type taggedResourceController struct {
+	resourcecontrollerv2.ResourceControllerV2Intf
+	Name *string `validate:"required"`
+}
@@ -0,0 +1,858 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the ResourceControllerV2 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/stretchr/testify/mock"
+)
+
+// ResourceControllerV2 : A testify mock of the ResourceControllerV2 service client.
+type ResourceControllerV2 struct {
+	mock.Mock
+}
+
+var _ resourcecontrollerv2.ResourceControllerV2Intf = (*ResourceControllerV2)(nil)
+
+// ListResourceInstances provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceInstances(listResourceInstancesOptions *resourcecontrollerv2.ListResourceInstancesOptions) (*resourcecontrollerv2.ResourceInstancesList, *core.DetailedResponse, error) {
+	ret := _m.Called(listResourceInstancesOptions)
+	var r0 *resourcecontrollerv2.ResourceInstancesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstancesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceInstancesWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceInstancesWithContext(ctx context.Context, listResourceInstancesOptions *resourcecontrollerv2.ListResourceInstancesOptions) (*resourcecontrollerv2.ResourceInstancesList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceInstancesOptions)
+	var r0 *resourcecontrollerv2.ResourceInstancesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstancesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceInstance provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CreateResourceInstance(createResourceInstanceOptions *resourcecontrollerv2.CreateResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(createResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceInstanceWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CreateResourceInstanceWithContext(ctx context.Context, createResourceInstanceOptions *resourcecontrollerv2.CreateResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceInstance provides a mock function with the given fields.
+func (_m *ResourceControllerV2) GetResourceInstance(getResourceInstanceOptions *resourcecontrollerv2.GetResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceInstanceWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) GetResourceInstanceWithContext(ctx context.Context, getResourceInstanceOptions *resourcecontrollerv2.GetResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteResourceInstance provides a mock function with the given fields.
+func (_m *ResourceControllerV2) DeleteResourceInstance(deleteResourceInstanceOptions *resourcecontrollerv2.DeleteResourceInstanceOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteResourceInstanceOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteResourceInstanceWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) DeleteResourceInstanceWithContext(ctx context.Context, deleteResourceInstanceOptions *resourcecontrollerv2.DeleteResourceInstanceOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteResourceInstanceOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateResourceInstance provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UpdateResourceInstance(updateResourceInstanceOptions *resourcecontrollerv2.UpdateResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(updateResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateResourceInstanceWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UpdateResourceInstanceWithContext(ctx context.Context, updateResourceInstanceOptions *resourcecontrollerv2.UpdateResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceAliasesForInstance provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceAliasesForInstance(listResourceAliasesForInstanceOptions *resourcecontrollerv2.ListResourceAliasesForInstanceOptions) (*resourcecontrollerv2.ResourceAliasesList, *core.DetailedResponse, error) {
+	ret := _m.Called(listResourceAliasesForInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceAliasesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAliasesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceAliasesForInstanceWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceAliasesForInstanceWithContext(ctx context.Context, listResourceAliasesForInstanceOptions *resourcecontrollerv2.ListResourceAliasesForInstanceOptions) (*resourcecontrollerv2.ResourceAliasesList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceAliasesForInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceAliasesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAliasesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceKeysForInstance provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceKeysForInstance(listResourceKeysForInstanceOptions *resourcecontrollerv2.ListResourceKeysForInstanceOptions) (*resourcecontrollerv2.ResourceKeysList, *core.DetailedResponse, error) {
+	ret := _m.Called(listResourceKeysForInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceKeysList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKeysList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceKeysForInstanceWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceKeysForInstanceWithContext(ctx context.Context, listResourceKeysForInstanceOptions *resourcecontrollerv2.ListResourceKeysForInstanceOptions) (*resourcecontrollerv2.ResourceKeysList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceKeysForInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceKeysList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKeysList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// LockResourceInstance provides a mock function with the given fields.
+func (_m *ResourceControllerV2) LockResourceInstance(lockResourceInstanceOptions *resourcecontrollerv2.LockResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(lockResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// LockResourceInstanceWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) LockResourceInstanceWithContext(ctx context.Context, lockResourceInstanceOptions *resourcecontrollerv2.LockResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, lockResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UnlockResourceInstance provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UnlockResourceInstance(unlockResourceInstanceOptions *resourcecontrollerv2.UnlockResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(unlockResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UnlockResourceInstanceWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UnlockResourceInstanceWithContext(ctx context.Context, unlockResourceInstanceOptions *resourcecontrollerv2.UnlockResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, unlockResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CancelLastopResourceInstance provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CancelLastopResourceInstance(cancelLastopResourceInstanceOptions *resourcecontrollerv2.CancelLastopResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(cancelLastopResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CancelLastopResourceInstanceWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CancelLastopResourceInstanceWithContext(ctx context.Context, cancelLastopResourceInstanceOptions *resourcecontrollerv2.CancelLastopResourceInstanceOptions) (*resourcecontrollerv2.ResourceInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, cancelLastopResourceInstanceOptions)
+	var r0 *resourcecontrollerv2.ResourceInstance
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceInstance)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceKeys provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceKeys(listResourceKeysOptions *resourcecontrollerv2.ListResourceKeysOptions) (*resourcecontrollerv2.ResourceKeysList, *core.DetailedResponse, error) {
+	ret := _m.Called(listResourceKeysOptions)
+	var r0 *resourcecontrollerv2.ResourceKeysList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKeysList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceKeysWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceKeysWithContext(ctx context.Context, listResourceKeysOptions *resourcecontrollerv2.ListResourceKeysOptions) (*resourcecontrollerv2.ResourceKeysList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceKeysOptions)
+	var r0 *resourcecontrollerv2.ResourceKeysList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKeysList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceKey provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CreateResourceKey(createResourceKeyOptions *resourcecontrollerv2.CreateResourceKeyOptions) (*resourcecontrollerv2.ResourceKey, *core.DetailedResponse, error) {
+	ret := _m.Called(createResourceKeyOptions)
+	var r0 *resourcecontrollerv2.ResourceKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceKeyWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CreateResourceKeyWithContext(ctx context.Context, createResourceKeyOptions *resourcecontrollerv2.CreateResourceKeyOptions) (*resourcecontrollerv2.ResourceKey, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createResourceKeyOptions)
+	var r0 *resourcecontrollerv2.ResourceKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceKey provides a mock function with the given fields.
+func (_m *ResourceControllerV2) GetResourceKey(getResourceKeyOptions *resourcecontrollerv2.GetResourceKeyOptions) (*resourcecontrollerv2.ResourceKey, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceKeyOptions)
+	var r0 *resourcecontrollerv2.ResourceKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceKeyWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) GetResourceKeyWithContext(ctx context.Context, getResourceKeyOptions *resourcecontrollerv2.GetResourceKeyOptions) (*resourcecontrollerv2.ResourceKey, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceKeyOptions)
+	var r0 *resourcecontrollerv2.ResourceKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKey)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteResourceKey provides a mock function with the given fields.
+func (_m *ResourceControllerV2) DeleteResourceKey(deleteResourceKeyOptions *resourcecontrollerv2.DeleteResourceKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteResourceKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteResourceKeyWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) DeleteResourceKeyWithContext(ctx context.Context, deleteResourceKeyOptions *resourcecontrollerv2.DeleteResourceKeyOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteResourceKeyOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateResourceKey provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UpdateResourceKey(updateResourceKeyOptions *resourcecontrollerv2.UpdateResourceKeyOptions) (*resourcecontrollerv2.ResourceKey, *core.DetailedResponse, error) {
+	ret := _m.Called(updateResourceKeyOptions)
+	var r0 *resourcecontrollerv2.ResourceKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateResourceKeyWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UpdateResourceKeyWithContext(ctx context.Context, updateResourceKeyOptions *resourcecontrollerv2.UpdateResourceKeyOptions) (*resourcecontrollerv2.ResourceKey, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateResourceKeyOptions)
+	var r0 *resourcecontrollerv2.ResourceKey
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceKey)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceBindings provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceBindings(listResourceBindingsOptions *resourcecontrollerv2.ListResourceBindingsOptions) (*resourcecontrollerv2.ResourceBindingsList, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(listResourceBindingsOptions)
+	var r0 *resourcecontrollerv2.ResourceBindingsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBindingsList)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceBindingsWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceBindingsWithContext(ctx context.Context, listResourceBindingsOptions *resourcecontrollerv2.ListResourceBindingsOptions) (*resourcecontrollerv2.ResourceBindingsList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceBindingsOptions)
+	var r0 *resourcecontrollerv2.ResourceBindingsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBindingsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceBinding provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CreateResourceBinding(createResourceBindingOptions *resourcecontrollerv2.CreateResourceBindingOptions) (*resourcecontrollerv2.ResourceBinding, *core.DetailedResponse, error) {
+	ret := _m.Called(createResourceBindingOptions)
+	var r0 *resourcecontrollerv2.ResourceBinding
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBinding)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceBindingWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CreateResourceBindingWithContext(ctx context.Context, createResourceBindingOptions *resourcecontrollerv2.CreateResourceBindingOptions) (*resourcecontrollerv2.ResourceBinding, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createResourceBindingOptions)
+	var r0 *resourcecontrollerv2.ResourceBinding
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBinding)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceBinding provides a mock function with the given fields.
+func (_m *ResourceControllerV2) GetResourceBinding(getResourceBindingOptions *resourcecontrollerv2.GetResourceBindingOptions) (*resourcecontrollerv2.ResourceBinding, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceBindingOptions)
+	var r0 *resourcecontrollerv2.ResourceBinding
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBinding)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceBindingWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) GetResourceBindingWithContext(ctx context.Context, getResourceBindingOptions *resourcecontrollerv2.GetResourceBindingOptions) (*resourcecontrollerv2.ResourceBinding, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceBindingOptions)
+	var r0 *resourcecontrollerv2.ResourceBinding
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBinding)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteResourceBinding provides a mock function with the given fields.
+func (_m *ResourceControllerV2) DeleteResourceBinding(deleteResourceBindingOptions *resourcecontrollerv2.DeleteResourceBindingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteResourceBindingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteResourceBindingWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) DeleteResourceBindingWithContext(ctx context.Context, deleteResourceBindingOptions *resourcecontrollerv2.DeleteResourceBindingOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteResourceBindingOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateResourceBinding provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UpdateResourceBinding(updateResourceBindingOptions *resourcecontrollerv2.UpdateResourceBindingOptions) (*resourcecontrollerv2.ResourceBinding, *core.DetailedResponse, error) {
+	ret := _m.Called(updateResourceBindingOptions)
+	var r0 *resourcecontrollerv2.ResourceBinding
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBinding)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateResourceBindingWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UpdateResourceBindingWithContext(ctx context.Context, updateResourceBindingOptions *resourcecontrollerv2.UpdateResourceBindingOptions) (*resourcecontrollerv2.ResourceBinding, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateResourceBindingOptions)
+	var r0 *resourcecontrollerv2.ResourceBinding
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBinding)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceAliases provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceAliases(listResourceAliasesOptions *resourcecontrollerv2.ListResourceAliasesOptions) (*resourcecontrollerv2.ResourceAliasesList, *core.DetailedResponse, error) {
+	ret := _m.Called(listResourceAliasesOptions)
+	var r0 *resourcecontrollerv2.ResourceAliasesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAliasesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceAliasesWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceAliasesWithContext(ctx context.Context, listResourceAliasesOptions *resourcecontrollerv2.ListResourceAliasesOptions) (*resourcecontrollerv2.ResourceAliasesList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceAliasesOptions)
+	var r0 *resourcecontrollerv2.ResourceAliasesList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAliasesList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceAlias provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CreateResourceAlias(createResourceAliasOptions *resourcecontrollerv2.CreateResourceAliasOptions) (*resourcecontrollerv2.ResourceAlias, *core.DetailedResponse, error) {
+	ret := _m.Called(createResourceAliasOptions)
+	var r0 *resourcecontrollerv2.ResourceAlias
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAlias)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceAliasWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) CreateResourceAliasWithContext(ctx context.Context, createResourceAliasOptions *resourcecontrollerv2.CreateResourceAliasOptions) (*resourcecontrollerv2.ResourceAlias, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createResourceAliasOptions)
+	var r0 *resourcecontrollerv2.ResourceAlias
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAlias)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceAlias provides a mock function with the given fields.
+func (_m *ResourceControllerV2) GetResourceAlias(getResourceAliasOptions *resourcecontrollerv2.GetResourceAliasOptions) (*resourcecontrollerv2.ResourceAlias, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceAliasOptions)
+	var r0 *resourcecontrollerv2.ResourceAlias
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAlias)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceAliasWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) GetResourceAliasWithContext(ctx context.Context, getResourceAliasOptions *resourcecontrollerv2.GetResourceAliasOptions) (*resourcecontrollerv2.ResourceAlias, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceAliasOptions)
+	var r0 *resourcecontrollerv2.ResourceAlias
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAlias)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteResourceAlias provides a mock function with the given fields.
+func (_m *ResourceControllerV2) DeleteResourceAlias(deleteResourceAliasOptions *resourcecontrollerv2.DeleteResourceAliasOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteResourceAliasOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteResourceAliasWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) DeleteResourceAliasWithContext(ctx context.Context, deleteResourceAliasOptions *resourcecontrollerv2.DeleteResourceAliasOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteResourceAliasOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateResourceAlias provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UpdateResourceAlias(updateResourceAliasOptions *resourcecontrollerv2.UpdateResourceAliasOptions) (*resourcecontrollerv2.ResourceAlias, *core.DetailedResponse, error) {
+	ret := _m.Called(updateResourceAliasOptions)
+	var r0 *resourcecontrollerv2.ResourceAlias
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAlias)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateResourceAliasWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) UpdateResourceAliasWithContext(ctx context.Context, updateResourceAliasOptions *resourcecontrollerv2.UpdateResourceAliasOptions) (*resourcecontrollerv2.ResourceAlias, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateResourceAliasOptions)
+	var r0 *resourcecontrollerv2.ResourceAlias
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceAlias)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceBindingsForAlias provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceBindingsForAlias(listResourceBindingsForAliasOptions *resourcecontrollerv2.ListResourceBindingsForAliasOptions) (*resourcecontrollerv2.ResourceBindingsList, *core.DetailedResponse, error) {
+	ret := _m.Called(listResourceBindingsForAliasOptions)
+	var r0 *resourcecontrollerv2.ResourceBindingsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBindingsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceBindingsForAliasWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListResourceBindingsForAliasWithContext(ctx context.Context, listResourceBindingsForAliasOptions *resourcecontrollerv2.ListResourceBindingsForAliasOptions) (*resourcecontrollerv2.ResourceBindingsList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceBindingsForAliasOptions)
+	var r0 *resourcecontrollerv2.ResourceBindingsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ResourceBindingsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListReclamations provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListReclamations(listReclamationsOptions *resourcecontrollerv2.ListReclamationsOptions) (*resourcecontrollerv2.ReclamationsList, *core.DetailedResponse, error) {
+	ret := _m.Called(listReclamationsOptions)
+	var r0 *resourcecontrollerv2.ReclamationsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ReclamationsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListReclamationsWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) ListReclamationsWithContext(ctx context.Context, listReclamationsOptions *resourcecontrollerv2.ListReclamationsOptions) (*resourcecontrollerv2.ReclamationsList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listReclamationsOptions)
+	var r0 *resourcecontrollerv2.ReclamationsList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.ReclamationsList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RunReclamationAction provides a mock function with the given fields.
+func (_m *ResourceControllerV2) RunReclamationAction(runReclamationActionOptions *resourcecontrollerv2.RunReclamationActionOptions) (*resourcecontrollerv2.Reclamation, *core.DetailedResponse, error) {
+	ret := _m.Called(runReclamationActionOptions)
+	var r0 *resourcecontrollerv2.Reclamation
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.Reclamation)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// RunReclamationActionWithContext provides a mock function with the given fields.
+func (_m *ResourceControllerV2) RunReclamationActionWithContext(ctx context.Context, runReclamationActionOptions *resourcecontrollerv2.RunReclamationActionOptions) (*resourcecontrollerv2.Reclamation, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, runReclamationActionOptions)
+	var r0 *resourcecontrollerv2.Reclamation
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcecontrollerv2.Reclamation)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
@@ -0,0 +1,376 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/go-openapi/strfmt"
+)
+
+// Reclamation actions, as run by RunReclamationAction.
+const (
+	ReclamationActionRestoreConst = "restore"
+	ReclamationActionReclaimConst = "reclaim"
+)
+
+// defaultReclamationConcurrency is used when ReclamationManagerOptions.Concurrency is not set.
+const defaultReclamationConcurrency = 8
+
+// ReclamationManagerOptions : The NewReclamationManager options.
+type ReclamationManagerOptions struct {
+	Service ResourceControllerV2Intf `validate:"required,structonly"`
+
+	// Looks up the user tags of the instances, for ReclamationFilter.Tags. Tags are not loaded when nil.
+	GlobalTagging globaltaggingv1.GlobalTaggingV1Intf `validate:"-"`
+
+	// The account of the reclamations. Defaults to the account of the credentials.
+	AccountID string
+
+	// The user and comment recorded with the actions.
+	RequestBy string
+	Comment   string
+
+	// The number of instances looked up, or actions run, at the same time. Defaults to 8.
+	Concurrency int
+
+	// The interval between the checks that an action completed. Defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// The time to wait for each action to complete. Defaults to 10 minutes.
+	Timeout time.Duration
+
+	// Called with the errors of RunRetention, which then keeps running. When nil, RunRetention returns the first
+	// error.
+	OnError func(err error)
+
+	// Returns the current time, for retention policies. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// PendingReclamation : A reclamation with the instance it reclaims.
+type PendingReclamation struct {
+	Reclamation
+
+	InstanceName string           `json:"instance_name,omitempty"`
+	InstanceCRN  string           `json:"instance_crn,omitempty"`
+	DeletedBy    string           `json:"deleted_by,omitempty"`
+	DeletedAt    *strfmt.DateTime `json:"deleted_at,omitempty"`
+
+	// The user tags of the instance, when ReclamationManagerOptions.GlobalTagging is set.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// deletedAt returns the time at which the instance was deleted, or when the reclamation was created, or the zero
+// time if neither is known.
+func (reclamation *PendingReclamation) deletedAt() time.Time {
+	if reclamation.DeletedAt != nil {
+		return time.Time(*reclamation.DeletedAt)
+	}
+	if reclamation.CreatedAt != nil {
+		return time.Time(*reclamation.CreatedAt)
+	}
+	return time.Time{}
+}
+
+// ReclamationFilter : Selects reclamations. Empty fields match every reclamation.
+type ReclamationFilter struct {
+	ResourceGroupIDs []string
+
+	// Matches instances with at least one of the tags. Requires ReclamationManagerOptions.GlobalTagging.
+	Tags []string
+
+	// The users who deleted the instances.
+	DeletedBy []string
+
+	// Matches instances deleted before this time. Reclamations whose time of deletion is unknown do not match.
+	DeletedBefore time.Time
+}
+
+// Matches reports whether the filter selects a reclamation.
+func (filter *ReclamationFilter) Matches(reclamation *PendingReclamation) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.ResourceGroupIDs) > 0 && !common.ContainsString(filter.ResourceGroupIDs, core.StringNilMapper(reclamation.ResourceGroupID)) {
+		return false
+	}
+	if len(filter.DeletedBy) > 0 && !common.ContainsString(filter.DeletedBy, reclamation.DeletedBy) {
+		return false
+	}
+	if !filter.DeletedBefore.IsZero() {
+		if deletedAt := reclamation.deletedAt(); deletedAt.IsZero() || !deletedAt.Before(filter.DeletedBefore) {
+			return false
+		}
+	}
+	if len(filter.Tags) > 0 {
+		for _, tag := range filter.Tags {
+			if common.ContainsString(reclamation.Tags, tag) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// RetentionPolicy : How long deleted instances are kept before they are reclaimed permanently.
+type RetentionPolicy struct {
+	// The time after the deletion of an instance at which it is reclaimed.
+	Retention time.Duration `validate:"required,gt=0"`
+
+	// Restricts the policy to some reclamations.
+	Filter *ReclamationFilter
+}
+
+// ReclamationActionResult : The outcome of an action on a reclamation.
+type ReclamationActionResult struct {
+	Reclamation PendingReclamation
+	Action      string
+
+	// The error of the action, or nil if it completed.
+	Err error
+}
+
+// ReclamationManager : Lists, restores and reclaims the resource instances pending reclamation.
+type ReclamationManager struct {
+	options *ReclamationManagerOptions
+	poller  *resourcePoller
+}
+
+// NewReclamationManager returns a new ReclamationManager.
+func NewReclamationManager(options *ReclamationManagerOptions) (manager *ReclamationManager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	optionsCopy := *options
+	if optionsCopy.Concurrency <= 0 {
+		optionsCopy.Concurrency = defaultReclamationConcurrency
+	}
+	if optionsCopy.Now == nil {
+		optionsCopy.Now = time.Now
+	}
+	manager = &ReclamationManager{
+		options: &optionsCopy,
+		poller:  newResourcePoller(options.PollInterval, options.Timeout),
+	}
+	return
+}
+
+// List returns the reclamations selected by a filter, or all of them when the filter is nil, with the names, CRNs
+// and tags of their instances.
+func (manager *ReclamationManager) List(ctx context.Context, filter *ReclamationFilter) (reclamations []PendingReclamation, err error) {
+	options := &ListReclamationsOptions{AccountID: stringPtrOrNil(manager.options.AccountID)}
+	result, _, err := manager.options.Service.ListReclamationsWithContext(ctx, options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-reclamations-error")
+		return
+	}
+
+	all := make([]PendingReclamation, len(result.Resources))
+	errs := common.ForEach(ctx, len(all), manager.options.Concurrency, func(ctx context.Context, i int) error {
+		all[i].Reclamation = result.Resources[i]
+		return manager.join(ctx, &all[i])
+	})
+	for i := range all {
+		if errs[i] != nil {
+			err = errs[i]
+			return nil, err
+		}
+		if filter.Matches(&all[i]) {
+			reclamations = append(reclamations, all[i])
+		}
+	}
+	return
+}
+
+// join completes a reclamation with its instance and the tags of the instance.
+func (manager *ReclamationManager) join(ctx context.Context, reclamation *PendingReclamation) error {
+	id := core.StringNilMapper(reclamation.ResourceInstanceID)
+	if id == "" {
+		return nil
+	}
+	instance, _, err := manager.options.Service.GetResourceInstanceWithContext(ctx, &GetResourceInstanceOptions{ID: core.StringPtr(id)})
+	if _, notFound := common.AsNotFound(err); notFound {
+		return nil
+	}
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "get-resource-instance-error")
+	}
+	reclamation.InstanceName = core.StringNilMapper(instance.Name)
+	reclamation.InstanceCRN = core.StringNilMapper(instance.CRN)
+	reclamation.DeletedBy = core.StringNilMapper(instance.DeletedBy)
+	reclamation.DeletedAt = instance.DeletedAt
+	if reclamation.ResourceGroupID == nil {
+		reclamation.ResourceGroupID = instance.ResourceGroupID
+	}
+
+	if manager.options.GlobalTagging == nil || reclamation.InstanceCRN == "" {
+		return nil
+	}
+	tags, _, err := manager.options.GlobalTagging.ListTagsWithContext(ctx, &globaltaggingv1.ListTagsOptions{
+		AccountID:  stringPtrOrNil(manager.options.AccountID),
+		TagType:    core.StringPtr(globaltaggingv1.ListTagsOptionsTagTypeUserConst),
+		AttachedTo: core.StringPtr(reclamation.InstanceCRN),
+		Limit:      core.Int64Ptr(1000),
+	})
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "list-tags-error")
+	}
+	for _, tag := range tags.Items {
+		reclamation.Tags = append(reclamation.Tags, core.StringNilMapper(tag.Name))
+	}
+	return nil
+}
+
+// Restore restores the instances of the reclamations selected by a filter and waits until they are active. The
+// error is non-nil if any action failed; the results tell which.
+func (manager *ReclamationManager) Restore(ctx context.Context, filter *ReclamationFilter) ([]ReclamationActionResult, error) {
+	return manager.run(ctx, ReclamationActionRestoreConst, filter)
+}
+
+// Reclaim permanently deletes the instances of the reclamations selected by a filter and waits until they are
+// removed. The error is non-nil if any action failed; the results tell which.
+func (manager *ReclamationManager) Reclaim(ctx context.Context, filter *ReclamationFilter) ([]ReclamationActionResult, error) {
+	return manager.run(ctx, ReclamationActionReclaimConst, filter)
+}
+
+// ReclaimExpired reclaims the instances deleted longer ago than the retention of a policy. Instances whose time of
+// deletion is unknown are kept.
+func (manager *ReclamationManager) ReclaimExpired(ctx context.Context, policy *RetentionPolicy) ([]ReclamationActionResult, error) {
+	err := core.ValidateNotNil(policy, "policy cannot be nil")
+	if err == nil {
+		err = core.ValidateStruct(policy, "policy")
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	filter := ReclamationFilter{}
+	if policy.Filter != nil {
+		filter = *policy.Filter
+	}
+	deadline := manager.options.Now().Add(-policy.Retention)
+	if filter.DeletedBefore.IsZero() || deadline.Before(filter.DeletedBefore) {
+		filter.DeletedBefore = deadline
+	}
+	return manager.run(ctx, ReclamationActionReclaimConst, &filter)
+}
+
+// RunRetention applies a retention policy every interval until the context is done, and then returns the error of
+// the context. See ReclamationManagerOptions.OnError for the handling of errors.
+func (manager *ReclamationManager) RunRetention(ctx context.Context, policy *RetentionPolicy, interval time.Duration) error {
+	if interval <= 0 {
+		return core.SDKErrorf(nil, "the interval must be positive", "invalid-interval", common.GetComponentInfo())
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := manager.ReclaimExpired(ctx, policy); err != nil && ctx.Err() == nil {
+			if manager.options.OnError == nil {
+				return err
+			}
+			manager.options.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// run runs an action on the reclamations selected by a filter and waits for each action to complete.
+func (manager *ReclamationManager) run(ctx context.Context, action string, filter *ReclamationFilter) (results []ReclamationActionResult, err error) {
+	reclamations, err := manager.List(ctx, filter)
+	if err != nil {
+		return
+	}
+	results = make([]ReclamationActionResult, len(reclamations))
+	for i := range reclamations {
+		results[i] = ReclamationActionResult{Reclamation: reclamations[i], Action: action}
+	}
+	errs := common.ForEach(ctx, len(reclamations), manager.options.Concurrency, func(ctx context.Context, i int) error {
+		return manager.runAction(ctx, action, &reclamations[i])
+	})
+	var failed []error
+	for i := range results {
+		results[i].Err = errs[i]
+		if errs[i] != nil {
+			failed = append(failed, errs[i])
+		}
+	}
+	if len(failed) > 0 {
+		err = core.SDKErrorf(errors.Join(failed...), fmt.Sprintf("%d of %d reclamation action(s) '%s' failed", len(failed), len(results), action),
+			"reclamation-action-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// runAction runs an action on a reclamation, then waits until the instance is active after a restore, or removed
+// after a reclaim.
+func (manager *ReclamationManager) runAction(ctx context.Context, action string, reclamation *PendingReclamation) error {
+	_, _, err := manager.options.Service.RunReclamationActionWithContext(ctx, &RunReclamationActionOptions{
+		ID:         reclamation.ID,
+		ActionName: core.StringPtr(action),
+		RequestBy:  stringPtrOrNil(manager.options.RequestBy),
+		Comment:    stringPtrOrNil(manager.options.Comment),
+	})
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "run-reclamation-action-error")
+	}
+
+	id := core.StringNilMapper(reclamation.ResourceInstanceID)
+	if id == "" {
+		return nil
+	}
+	get := func(ctx context.Context) (*string, error) {
+		instance, _, err := manager.options.Service.GetResourceInstanceWithContext(ctx, &GetResourceInstanceOptions{ID: core.StringPtr(id)})
+		if instance == nil {
+			return nil, err
+		}
+		return instance.State, err
+	}
+	if action == ReclamationActionRestoreConst {
+		err = manager.poller.waitState(ctx, CascadeDeleteKindResourceInstanceConst, id, ResourceInstanceStateActiveConst, get)
+	} else {
+		err = manager.poller.wait(ctx, CascadeDeleteKindResourceInstanceConst, id, ResourceInstanceStateRemovedConst,
+			func(ctx context.Context) (bool, error) {
+				state, err := get(ctx)
+				if _, notFound := common.AsNotFound(err); notFound {
+					return true, nil
+				}
+				return core.StringNilMapper(state) == ResourceInstanceStateRemovedConst, err
+			})
+	}
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "wait-reclamation-action-error")
+	}
+	return nil
+}
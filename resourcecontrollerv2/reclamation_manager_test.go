@@ -0,0 +1,223 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ReclamationManager`, func() {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	var testServer *httptest.Server
+	var manager *resourcecontrollerv2.ReclamationManager
+	var mutex sync.Mutex
+	var requests []string
+	var states map[string]string
+
+	BeforeEach(func() {
+		requests = nil
+		states = map[string]string{"inst1": "pending_reclamation", "inst2": "pending_reclamation"}
+		instances := map[string]string{
+			"inst1": `{"id": "inst1", "name": "one", "crn": "crn:inst1", "deleted_by": "alice", "deleted_at": "2026-10-09T12:00:00.000Z", "state": "%s"}`,
+			"inst2": `{"id": "inst2", "name": "two", "crn": "crn:inst2", "deleted_by": "bob", "deleted_at": "2026-10-18T12:00:00.000Z", "state": "%s"}`,
+		}
+		reclamations := map[string]string{"r1": "inst1", "r2": "inst2", "r3": "inst3", "r4": "inst4"}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			path := req.URL.Path
+			switch {
+			case path == "/v1/reclamations":
+				fmt.Fprint(res, `{"resources": [
+					{"id": "r1", "resource_instance_id": "inst1", "resource_group_id": "rg1", "state": "SCHEDULED"},
+					{"id": "r2", "resource_instance_id": "inst2", "resource_group_id": "rg2", "state": "SCHEDULED"},
+					{"id": "r3", "resource_instance_id": "inst3", "resource_group_id": "rg1", "state": "SCHEDULED",
+					 "created_at": "2026-10-19T00:00:00.000Z"},
+					{"id": "r4", "resource_instance_id": "inst4", "resource_group_id": "rg2", "state": "SCHEDULED"}]}`)
+			case strings.HasPrefix(path, "/v1/reclamations/"):
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body["request_by"]).To(Equal("admin"))
+				parts := strings.Split(path, "/")
+				id, action := parts[3], parts[5]
+				requests = append(requests, action+" "+id)
+				instance := reclamations[id]
+				if instances[instance] == "" {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"message": "reclamation failed"}`)
+					return
+				}
+				if action == "restore" {
+					states[instance] = "active"
+				} else {
+					states[instance] = "removed"
+				}
+				fmt.Fprintf(res, `{"id": "%s"}`, id)
+			case strings.HasPrefix(path, "/v2/resource_instances/"):
+				id := strings.TrimPrefix(path, "/v2/resource_instances/")
+				if instances[id] == "" {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"message": "not found"}`)
+					return
+				}
+				fmt.Fprintf(res, instances[id], states[id])
+			case path == "/v3/tags":
+				if req.URL.Query().Get("attached_to") == "crn:inst1" {
+					fmt.Fprint(res, `{"items": [{"name": "env:dev"}]}`)
+				} else {
+					fmt.Fprint(res, `{"items": []}`)
+				}
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+
+		service, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL: testServer.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		Expect(err).To(BeNil())
+		tagging, err := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL: testServer.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		Expect(err).To(BeNil())
+		manager, err = resourcecontrollerv2.NewReclamationManager(&resourcecontrollerv2.ReclamationManagerOptions{
+			Service:       service,
+			GlobalTagging: tagging,
+			RequestBy:     "admin",
+			PollInterval:  time.Millisecond,
+			Timeout:       time.Second,
+			Now:           func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	ids := func(reclamations []resourcecontrollerv2.PendingReclamation) (ids []string) {
+		for _, reclamation := range reclamations {
+			ids = append(ids, *reclamation.ID)
+		}
+		return
+	}
+
+	It(`Lists reclamations with their instances`, func() {
+		reclamations, err := manager.List(context.Background(), nil)
+		Expect(err).To(BeNil())
+		Expect(ids(reclamations)).To(Equal([]string{"r1", "r2", "r3", "r4"}))
+		Expect(reclamations[0].InstanceName).To(Equal("one"))
+		Expect(reclamations[0].DeletedBy).To(Equal("alice"))
+		Expect(reclamations[0].Tags).To(Equal([]string{"env:dev"}))
+		Expect(reclamations[2].InstanceName).To(BeEmpty())
+
+		filters := map[string]*resourcecontrollerv2.ReclamationFilter{
+			"r1":    {Tags: []string{"env:dev", "env:test"}},
+			"r2":    {DeletedBy: []string{"bob"}},
+			"r1 r3": {ResourceGroupIDs: []string{"rg1"}},
+		}
+		for expected, filter := range filters {
+			reclamations, err = manager.List(context.Background(), filter)
+			Expect(err).To(BeNil())
+			Expect(strings.Join(ids(reclamations), " ")).To(Equal(expected))
+		}
+	})
+	It(`Restores and reclaims in bulk`, func() {
+		results, err := manager.Restore(context.Background(), &resourcecontrollerv2.ReclamationFilter{DeletedBy: []string{"alice"}})
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Err).To(BeNil())
+		Expect(states["inst1"]).To(Equal("active"))
+
+		results, err = manager.Reclaim(context.Background(), &resourcecontrollerv2.ReclamationFilter{ResourceGroupIDs: []string{"rg1"}})
+		Expect(err).ToNot(BeNil())
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Err).To(BeNil())
+		Expect(results[1].Err).ToNot(BeNil())
+		Expect(*results[1].Reclamation.ID).To(Equal("r3"))
+		Expect(states["inst1"]).To(Equal("removed"))
+		Expect(states["inst2"]).To(Equal("pending_reclamation"))
+	})
+	It(`Reclaims the instances past their retention`, func() {
+		results, err := manager.ReclaimExpired(context.Background(), &resourcecontrollerv2.RetentionPolicy{Retention: 7 * 24 * time.Hour})
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(1))
+		Expect(requests).To(Equal([]string{"reclaim r1"}))
+
+		_, err = manager.ReclaimExpired(context.Background(), &resourcecontrollerv2.RetentionPolicy{})
+		Expect(err).ToNot(BeNil())
+
+		// Requests canceled with the context may still be handled by the server.
+		handled := func() []string {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return append([]string(nil), requests...)
+		}
+
+		// Without OnError, the first failure stops the retention.
+		requests = nil
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		err = manager.RunRetention(ctx, &resourcecontrollerv2.RetentionPolicy{Retention: time.Hour}, 10*time.Millisecond)
+		Expect(err).ToNot(BeNil())
+		Expect(ctx.Err()).To(BeNil())
+		Expect(handled()).To(ContainElements("reclaim r2", "reclaim r3"))
+
+		// With OnError, the retention runs until the context is done.
+		var errs []error
+		service, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL: testServer.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		Expect(err).To(BeNil())
+		manager, err = resourcecontrollerv2.NewReclamationManager(&resourcecontrollerv2.ReclamationManagerOptions{
+			Service:      service,
+			RequestBy:    "admin",
+			PollInterval: time.Millisecond,
+			Timeout:      time.Second,
+			OnError:      func(err error) { errs = append(errs, err) },
+			Now:          func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+		ctx, cancel = context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		err = manager.RunRetention(ctx, &resourcecontrollerv2.RetentionPolicy{Retention: time.Hour}, 10*time.Millisecond)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+		Expect(len(errs)).To(BeNumerically(">", 1))
+
+		// The reclamation deleted at an unknown time is never reclaimed.
+		Expect(handled()).ToNot(ContainElement("reclaim r4"))
+	})
+	It(`Validates its options`, func() {
+		_, err := resourcecontrollerv2.NewReclamationManager(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = resourcecontrollerv2.NewReclamationManager(&resourcecontrollerv2.ReclamationManagerOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -190,7 +190,7 @@ func (resourceController *ResourceControllerV2) ListResourceInstancesWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "ListResourceInstances"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances`, nil)
 	if err != nil {
@@ -255,7 +255,7 @@ func (resourceController *ResourceControllerV2) ListResourceInstancesWithContext
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_instances", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -293,7 +293,7 @@ func (resourceController *ResourceControllerV2) CreateResourceInstanceWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "CreateResourceInstance"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances`, nil)
 	if err != nil {
@@ -353,7 +353,7 @@ func (resourceController *ResourceControllerV2) CreateResourceInstanceWithContex
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -395,7 +395,7 @@ func (resourceController *ResourceControllerV2) GetResourceInstanceWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "GetResourceInstance"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances/{id}`, pathParamsMap)
 	if err != nil {
@@ -423,7 +423,7 @@ func (resourceController *ResourceControllerV2) GetResourceInstanceWithContext(c
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -465,7 +465,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceInstanceWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "DeleteResourceInstance"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances/{id}`, pathParamsMap)
 	if err != nil {
@@ -495,7 +495,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceInstanceWithContex
 	response, err = resourceController.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -529,7 +529,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceInstanceWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "UpdateResourceInstance"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances/{id}`, pathParamsMap)
 	if err != nil {
@@ -577,7 +577,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceInstanceWithContex
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -621,7 +621,7 @@ func (resourceController *ResourceControllerV2) ListResourceAliasesForInstanceWi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "ListResourceAliasesForInstance"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances/{id}/resource_aliases`, pathParamsMap)
 	if err != nil {
@@ -656,7 +656,7 @@ func (resourceController *ResourceControllerV2) ListResourceAliasesForInstanceWi
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_aliases_for_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -698,7 +698,7 @@ func (resourceController *ResourceControllerV2) ListResourceKeysForInstanceWithC
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "ListResourceKeysForInstance"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances/{id}/resource_keys`, pathParamsMap)
 	if err != nil {
@@ -733,7 +733,7 @@ func (resourceController *ResourceControllerV2) ListResourceKeysForInstanceWithC
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_keys_for_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -775,7 +775,7 @@ func (resourceController *ResourceControllerV2) LockResourceInstanceWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "LockResourceInstance"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances/{id}/lock`, pathParamsMap)
 	if err != nil {
@@ -803,7 +803,7 @@ func (resourceController *ResourceControllerV2) LockResourceInstanceWithContext(
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "lock_resource_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -845,7 +845,7 @@ func (resourceController *ResourceControllerV2) UnlockResourceInstanceWithContex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "UnlockResourceInstance"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances/{id}/lock`, pathParamsMap)
 	if err != nil {
@@ -873,7 +873,7 @@ func (resourceController *ResourceControllerV2) UnlockResourceInstanceWithContex
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "unlock_resource_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -915,7 +915,7 @@ func (resourceController *ResourceControllerV2) CancelLastopResourceInstanceWith
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "CancelLastopResourceInstance"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_instances/{id}/last_operation`, pathParamsMap)
 	if err != nil {
@@ -943,7 +943,7 @@ func (resourceController *ResourceControllerV2) CancelLastopResourceInstanceWith
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "cancel_lastop_resource_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -975,7 +975,7 @@ func (resourceController *ResourceControllerV2) ListResourceKeysWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "ListResourceKeys"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_keys`, nil)
 	if err != nil {
@@ -1028,7 +1028,7 @@ func (resourceController *ResourceControllerV2) ListResourceKeysWithContext(ctx
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_keys", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1065,7 +1065,7 @@ func (resourceController *ResourceControllerV2) CreateResourceKeyWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "CreateResourceKey"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_keys`, nil)
 	if err != nil {
@@ -1113,7 +1113,7 @@ func (resourceController *ResourceControllerV2) CreateResourceKeyWithContext(ctx
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1154,7 +1154,7 @@ func (resourceController *ResourceControllerV2) GetResourceKeyWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "GetResourceKey"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_keys/{id}`, pathParamsMap)
 	if err != nil {
@@ -1182,7 +1182,7 @@ func (resourceController *ResourceControllerV2) GetResourceKeyWithContext(ctx co
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1223,7 +1223,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceKeyWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "DeleteResourceKey"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_keys/{id}`, pathParamsMap)
 	if err != nil {
@@ -1249,7 +1249,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceKeyWithContext(ctx
 	response, err = resourceController.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1282,7 +1282,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceKeyWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "UpdateResourceKey"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_keys/{id}`, pathParamsMap)
 	if err != nil {
@@ -1321,7 +1321,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceKeyWithContext(ctx
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_key", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1356,7 +1356,7 @@ func (resourceController *ResourceControllerV2) ListResourceBindingsWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "ListResourceBindings"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_bindings`, nil)
 	if err != nil {
@@ -1412,7 +1412,7 @@ func (resourceController *ResourceControllerV2) ListResourceBindingsWithContext(
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_bindings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1452,7 +1452,7 @@ func (resourceController *ResourceControllerV2) CreateResourceBindingWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "CreateResourceBinding"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_bindings`, nil)
 	if err != nil {
@@ -1503,7 +1503,7 @@ func (resourceController *ResourceControllerV2) CreateResourceBindingWithContext
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_binding", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1548,7 +1548,7 @@ func (resourceController *ResourceControllerV2) GetResourceBindingWithContext(ct
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "GetResourceBinding"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_bindings/{id}`, pathParamsMap)
 	if err != nil {
@@ -1576,7 +1576,7 @@ func (resourceController *ResourceControllerV2) GetResourceBindingWithContext(ct
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_binding", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1620,7 +1620,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceBindingWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "DeleteResourceBinding"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_bindings/{id}`, pathParamsMap)
 	if err != nil {
@@ -1646,7 +1646,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceBindingWithContext
 	response, err = resourceController.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_binding", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1682,7 +1682,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceBindingWithContext
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "UpdateResourceBinding"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_bindings/{id}`, pathParamsMap)
 	if err != nil {
@@ -1721,7 +1721,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceBindingWithContext
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_binding", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1756,7 +1756,7 @@ func (resourceController *ResourceControllerV2) ListResourceAliasesWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "ListResourceAliases"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_aliases`, nil)
 	if err != nil {
@@ -1815,7 +1815,7 @@ func (resourceController *ResourceControllerV2) ListResourceAliasesWithContext(c
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_aliases", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1855,7 +1855,7 @@ func (resourceController *ResourceControllerV2) CreateResourceAliasWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "CreateResourceAlias"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_aliases`, nil)
 	if err != nil {
@@ -1900,7 +1900,7 @@ func (resourceController *ResourceControllerV2) CreateResourceAliasWithContext(c
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_alias", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1945,7 +1945,7 @@ func (resourceController *ResourceControllerV2) GetResourceAliasWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "GetResourceAlias"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_aliases/{id}`, pathParamsMap)
 	if err != nil {
@@ -1973,7 +1973,7 @@ func (resourceController *ResourceControllerV2) GetResourceAliasWithContext(ctx
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_alias", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2018,7 +2018,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceAliasWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "DeleteResourceAlias"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_aliases/{id}`, pathParamsMap)
 	if err != nil {
@@ -2048,7 +2048,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceAliasWithContext(c
 	response, err = resourceController.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_alias", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2084,7 +2084,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceAliasWithContext(c
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "UpdateResourceAlias"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_aliases/{id}`, pathParamsMap)
 	if err != nil {
@@ -2123,7 +2123,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceAliasWithContext(c
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_alias", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2167,7 +2167,7 @@ func (resourceController *ResourceControllerV2) ListResourceBindingsForAliasWith
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "ListResourceBindingsForAlias"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v2/resource_aliases/{id}/resource_bindings`, pathParamsMap)
 	if err != nil {
@@ -2202,7 +2202,7 @@ func (resourceController *ResourceControllerV2) ListResourceBindingsForAliasWith
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_bindings_for_alias", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2234,7 +2234,7 @@ func (resourceController *ResourceControllerV2) ListReclamationsWithContext(ctx
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "ListReclamations"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v1/reclamations`, nil)
 	if err != nil {
@@ -2272,7 +2272,7 @@ func (resourceController *ResourceControllerV2) ListReclamationsWithContext(ctx
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_reclamations", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2315,7 +2315,7 @@ func (resourceController *ResourceControllerV2) RunReclamationActionWithContext(
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_controller", "V2", "RunReclamationAction"))
 	builder.EnableGzipCompression = resourceController.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceController.Service.Options.URL, `/v1/reclamations/{id}/actions/{action_name}`, pathParamsMap)
 	if err != nil {
@@ -2357,7 +2357,7 @@ func (resourceController *ResourceControllerV2) RunReclamationActionWithContext(
 	response, err = resourceController.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "run_reclamation_action", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,144 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package resourcecontrollerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ResourceControllerV2Intf : The operations of the ResourceControllerV2 service client.
+// The interface is satisfied by *ResourceControllerV2 and by mocks.ResourceControllerV2, allowing consumers to substitute a mock for the client in tests.
+type ResourceControllerV2Intf interface {
+	// ListResourceInstances : Get a list of all resource instances
+	ListResourceInstances(listResourceInstancesOptions *ListResourceInstancesOptions) (result *ResourceInstancesList, response *core.DetailedResponse, err error)
+	// ListResourceInstancesWithContext is an alternate form of the ListResourceInstances method which supports a Context parameter
+	ListResourceInstancesWithContext(ctx context.Context, listResourceInstancesOptions *ListResourceInstancesOptions) (result *ResourceInstancesList, response *core.DetailedResponse, err error)
+	// CreateResourceInstance : Create (provision) a new resource instance
+	CreateResourceInstance(createResourceInstanceOptions *CreateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// CreateResourceInstanceWithContext is an alternate form of the CreateResourceInstance method which supports a Context parameter
+	CreateResourceInstanceWithContext(ctx context.Context, createResourceInstanceOptions *CreateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// GetResourceInstance : Get a resource instance
+	GetResourceInstance(getResourceInstanceOptions *GetResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// GetResourceInstanceWithContext is an alternate form of the GetResourceInstance method which supports a Context parameter
+	GetResourceInstanceWithContext(ctx context.Context, getResourceInstanceOptions *GetResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// DeleteResourceInstance : Delete a resource instance
+	DeleteResourceInstance(deleteResourceInstanceOptions *DeleteResourceInstanceOptions) (response *core.DetailedResponse, err error)
+	// DeleteResourceInstanceWithContext is an alternate form of the DeleteResourceInstance method which supports a Context parameter
+	DeleteResourceInstanceWithContext(ctx context.Context, deleteResourceInstanceOptions *DeleteResourceInstanceOptions) (response *core.DetailedResponse, err error)
+	// UpdateResourceInstance : Update a resource instance
+	UpdateResourceInstance(updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// UpdateResourceInstanceWithContext is an alternate form of the UpdateResourceInstance method which supports a Context parameter
+	UpdateResourceInstanceWithContext(ctx context.Context, updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// ListResourceAliasesForInstance : Get a list of all resource aliases for the instance
+	ListResourceAliasesForInstance(listResourceAliasesForInstanceOptions *ListResourceAliasesForInstanceOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+	// ListResourceAliasesForInstanceWithContext is an alternate form of the ListResourceAliasesForInstance method which supports a Context parameter
+	ListResourceAliasesForInstanceWithContext(ctx context.Context, listResourceAliasesForInstanceOptions *ListResourceAliasesForInstanceOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+	// ListResourceKeysForInstance : Get a list of all the resource keys for the instance
+	ListResourceKeysForInstance(listResourceKeysForInstanceOptions *ListResourceKeysForInstanceOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+	// ListResourceKeysForInstanceWithContext is an alternate form of the ListResourceKeysForInstance method which supports a Context parameter
+	ListResourceKeysForInstanceWithContext(ctx context.Context, listResourceKeysForInstanceOptions *ListResourceKeysForInstanceOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+	// LockResourceInstance : Lock a resource instance
+	LockResourceInstance(lockResourceInstanceOptions *LockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// LockResourceInstanceWithContext is an alternate form of the LockResourceInstance method which supports a Context parameter
+	LockResourceInstanceWithContext(ctx context.Context, lockResourceInstanceOptions *LockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// UnlockResourceInstance : Unlock a resource instance
+	UnlockResourceInstance(unlockResourceInstanceOptions *UnlockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// UnlockResourceInstanceWithContext is an alternate form of the UnlockResourceInstance method which supports a Context parameter
+	UnlockResourceInstanceWithContext(ctx context.Context, unlockResourceInstanceOptions *UnlockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// CancelLastopResourceInstance : Cancel the in progress last operation of the resource instance
+	CancelLastopResourceInstance(cancelLastopResourceInstanceOptions *CancelLastopResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// CancelLastopResourceInstanceWithContext is an alternate form of the CancelLastopResourceInstance method which supports a Context parameter
+	CancelLastopResourceInstanceWithContext(ctx context.Context, cancelLastopResourceInstanceOptions *CancelLastopResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+	// ListResourceKeys : Get a list of all of the resource keys
+	ListResourceKeys(listResourceKeysOptions *ListResourceKeysOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+	// ListResourceKeysWithContext is an alternate form of the ListResourceKeys method which supports a Context parameter
+	ListResourceKeysWithContext(ctx context.Context, listResourceKeysOptions *ListResourceKeysOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+	// CreateResourceKey : Create a new resource key
+	CreateResourceKey(createResourceKeyOptions *CreateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+	// CreateResourceKeyWithContext is an alternate form of the CreateResourceKey method which supports a Context parameter
+	CreateResourceKeyWithContext(ctx context.Context, createResourceKeyOptions *CreateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+	// GetResourceKey : Get resource key
+	GetResourceKey(getResourceKeyOptions *GetResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+	// GetResourceKeyWithContext is an alternate form of the GetResourceKey method which supports a Context parameter
+	GetResourceKeyWithContext(ctx context.Context, getResourceKeyOptions *GetResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+	// DeleteResourceKey : Delete a resource key
+	DeleteResourceKey(deleteResourceKeyOptions *DeleteResourceKeyOptions) (response *core.DetailedResponse, err error)
+	// DeleteResourceKeyWithContext is an alternate form of the DeleteResourceKey method which supports a Context parameter
+	DeleteResourceKeyWithContext(ctx context.Context, deleteResourceKeyOptions *DeleteResourceKeyOptions) (response *core.DetailedResponse, err error)
+	// UpdateResourceKey : Update a resource key
+	UpdateResourceKey(updateResourceKeyOptions *UpdateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+	// UpdateResourceKeyWithContext is an alternate form of the UpdateResourceKey method which supports a Context parameter
+	UpdateResourceKeyWithContext(ctx context.Context, updateResourceKeyOptions *UpdateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+	// ListResourceBindings : Get a list of all resource bindings
+	ListResourceBindings(listResourceBindingsOptions *ListResourceBindingsOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+	// ListResourceBindingsWithContext is an alternate form of the ListResourceBindings method which supports a Context parameter
+	ListResourceBindingsWithContext(ctx context.Context, listResourceBindingsOptions *ListResourceBindingsOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+	// CreateResourceBinding : Create a new resource binding
+	CreateResourceBinding(createResourceBindingOptions *CreateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+	// CreateResourceBindingWithContext is an alternate form of the CreateResourceBinding method which supports a Context parameter
+	CreateResourceBindingWithContext(ctx context.Context, createResourceBindingOptions *CreateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+	// GetResourceBinding : Get a resource binding
+	GetResourceBinding(getResourceBindingOptions *GetResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+	// GetResourceBindingWithContext is an alternate form of the GetResourceBinding method which supports a Context parameter
+	GetResourceBindingWithContext(ctx context.Context, getResourceBindingOptions *GetResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+	// DeleteResourceBinding : Delete a resource binding
+	DeleteResourceBinding(deleteResourceBindingOptions *DeleteResourceBindingOptions) (response *core.DetailedResponse, err error)
+	// DeleteResourceBindingWithContext is an alternate form of the DeleteResourceBinding method which supports a Context parameter
+	DeleteResourceBindingWithContext(ctx context.Context, deleteResourceBindingOptions *DeleteResourceBindingOptions) (response *core.DetailedResponse, err error)
+	// UpdateResourceBinding : Update a resource binding
+	UpdateResourceBinding(updateResourceBindingOptions *UpdateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+	// UpdateResourceBindingWithContext is an alternate form of the UpdateResourceBinding method which supports a Context parameter
+	UpdateResourceBindingWithContext(ctx context.Context, updateResourceBindingOptions *UpdateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+	// ListResourceAliases : Get a list of all resource aliases
+	ListResourceAliases(listResourceAliasesOptions *ListResourceAliasesOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+	// ListResourceAliasesWithContext is an alternate form of the ListResourceAliases method which supports a Context parameter
+	ListResourceAliasesWithContext(ctx context.Context, listResourceAliasesOptions *ListResourceAliasesOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+	// CreateResourceAlias : Create a new resource alias
+	CreateResourceAlias(createResourceAliasOptions *CreateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+	// CreateResourceAliasWithContext is an alternate form of the CreateResourceAlias method which supports a Context parameter
+	CreateResourceAliasWithContext(ctx context.Context, createResourceAliasOptions *CreateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+	// GetResourceAlias : Get a resource alias
+	GetResourceAlias(getResourceAliasOptions *GetResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+	// GetResourceAliasWithContext is an alternate form of the GetResourceAlias method which supports a Context parameter
+	GetResourceAliasWithContext(ctx context.Context, getResourceAliasOptions *GetResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+	// DeleteResourceAlias : Delete a resource alias
+	DeleteResourceAlias(deleteResourceAliasOptions *DeleteResourceAliasOptions) (response *core.DetailedResponse, err error)
+	// DeleteResourceAliasWithContext is an alternate form of the DeleteResourceAlias method which supports a Context parameter
+	DeleteResourceAliasWithContext(ctx context.Context, deleteResourceAliasOptions *DeleteResourceAliasOptions) (response *core.DetailedResponse, err error)
+	// UpdateResourceAlias : Update a resource alias
+	UpdateResourceAlias(updateResourceAliasOptions *UpdateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+	// UpdateResourceAliasWithContext is an alternate form of the UpdateResourceAlias method which supports a Context parameter
+	UpdateResourceAliasWithContext(ctx context.Context, updateResourceAliasOptions *UpdateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+	// ListResourceBindingsForAlias : Get a list of all resource bindings for the alias
+	ListResourceBindingsForAlias(listResourceBindingsForAliasOptions *ListResourceBindingsForAliasOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+	// ListResourceBindingsForAliasWithContext is an alternate form of the ListResourceBindingsForAlias method which supports a Context parameter
+	ListResourceBindingsForAliasWithContext(ctx context.Context, listResourceBindingsForAliasOptions *ListResourceBindingsForAliasOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+	// ListReclamations : Get a list of all reclamations
+	ListReclamations(listReclamationsOptions *ListReclamationsOptions) (result *ReclamationsList, response *core.DetailedResponse, err error)
+	// ListReclamationsWithContext is an alternate form of the ListReclamations method which supports a Context parameter
+	ListReclamationsWithContext(ctx context.Context, listReclamationsOptions *ListReclamationsOptions) (result *ReclamationsList, response *core.DetailedResponse, err error)
+	// RunReclamationAction : Perform a reclamation action
+	RunReclamationAction(runReclamationActionOptions *RunReclamationActionOptions) (result *Reclamation, response *core.DetailedResponse, err error)
+	// RunReclamationActionWithContext is an alternate form of the RunReclamationAction method which supports a Context parameter
+	RunReclamationActionWithContext(ctx context.Context, runReclamationActionOptions *RunReclamationActionOptions) (result *Reclamation, response *core.DetailedResponse, err error)
+}
+
+var _ ResourceControllerV2Intf = (*ResourceControllerV2)(nil)
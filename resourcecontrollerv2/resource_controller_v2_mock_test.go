@@ -0,0 +1,43 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package resourcecontrollerv2_test
+
+import (
+	"errors"
+
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2/mocks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ resourcecontrollerv2.ResourceControllerV2Intf = (*mocks.ResourceControllerV2)(nil)
+
+var _ = Describe(`mocks.ResourceControllerV2`, func() {
+	It(`Can be stubbed and invoked through ResourceControllerV2Intf`, func() {
+		stub := new(mocks.ResourceControllerV2)
+		stub.On("ListResourceInstances", mock.Anything).Return(nil, nil, errors.New("boom"))
+
+		var client resourcecontrollerv2.ResourceControllerV2Intf = stub
+		_, _, err := client.ListResourceInstances(nil)
+		Expect(err).To(MatchError("boom"))
+		stub.AssertNumberOfCalls(GinkgoT(), "ListResourceInstances", 1)
+	})
+})
@@ -0,0 +1,243 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the ResourceManagerV2 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/stretchr/testify/mock"
+)
+
+// ResourceManagerV2 : A testify mock of the ResourceManagerV2 service client.
+type ResourceManagerV2 struct {
+	mock.Mock
+}
+
+var _ resourcemanagerv2.ResourceManagerV2Intf = (*ResourceManagerV2)(nil)
+
+// ListResourceGroups provides a mock function with the given fields.
+func (_m *ResourceManagerV2) ListResourceGroups(listResourceGroupsOptions *resourcemanagerv2.ListResourceGroupsOptions) (*resourcemanagerv2.ResourceGroupList, *core.DetailedResponse, error) {
+	ret := _m.Called(listResourceGroupsOptions)
+	var r0 *resourcemanagerv2.ResourceGroupList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.ResourceGroupList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListResourceGroupsWithContext provides a mock function with the given fields.
+func (_m *ResourceManagerV2) ListResourceGroupsWithContext(ctx context.Context, listResourceGroupsOptions *resourcemanagerv2.ListResourceGroupsOptions) (*resourcemanagerv2.ResourceGroupList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listResourceGroupsOptions)
+	var r0 *resourcemanagerv2.ResourceGroupList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.ResourceGroupList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceGroup provides a mock function with the given fields.
+func (_m *ResourceManagerV2) CreateResourceGroup(createResourceGroupOptions *resourcemanagerv2.CreateResourceGroupOptions) (*resourcemanagerv2.ResCreateResourceGroup, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(createResourceGroupOptions)
+	var r0 *resourcemanagerv2.ResCreateResourceGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.ResCreateResourceGroup)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateResourceGroupWithContext provides a mock function with the given fields.
+func (_m *ResourceManagerV2) CreateResourceGroupWithContext(ctx context.Context, createResourceGroupOptions *resourcemanagerv2.CreateResourceGroupOptions) (*resourcemanagerv2.ResCreateResourceGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createResourceGroupOptions)
+	var r0 *resourcemanagerv2.ResCreateResourceGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.ResCreateResourceGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceGroup provides a mock function with the given fields.
+func (_m *ResourceManagerV2) GetResourceGroup(getResourceGroupOptions *resourcemanagerv2.GetResourceGroupOptions) (*resourcemanagerv2.ResourceGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceGroupOptions)
+	var r0 *resourcemanagerv2.ResourceGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.ResourceGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceGroupWithContext provides a mock function with the given fields.
+func (_m *ResourceManagerV2) GetResourceGroupWithContext(ctx context.Context, getResourceGroupOptions *resourcemanagerv2.GetResourceGroupOptions) (*resourcemanagerv2.ResourceGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceGroupOptions)
+	var r0 *resourcemanagerv2.ResourceGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.ResourceGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateResourceGroup provides a mock function with the given fields.
+func (_m *ResourceManagerV2) UpdateResourceGroup(updateResourceGroupOptions *resourcemanagerv2.UpdateResourceGroupOptions) (*resourcemanagerv2.ResourceGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(updateResourceGroupOptions)
+	var r0 *resourcemanagerv2.ResourceGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.ResourceGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateResourceGroupWithContext provides a mock function with the given fields.
+func (_m *ResourceManagerV2) UpdateResourceGroupWithContext(ctx context.Context, updateResourceGroupOptions *resourcemanagerv2.UpdateResourceGroupOptions) (*resourcemanagerv2.ResourceGroup, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateResourceGroupOptions)
+	var r0 *resourcemanagerv2.ResourceGroup
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.ResourceGroup)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteResourceGroup provides a mock function with the given fields.
+func (_m *ResourceManagerV2) DeleteResourceGroup(deleteResourceGroupOptions *resourcemanagerv2.DeleteResourceGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteResourceGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteResourceGroupWithContext provides a mock function with the given fields.
+func (_m *ResourceManagerV2) DeleteResourceGroupWithContext(ctx context.Context, deleteResourceGroupOptions *resourcemanagerv2.DeleteResourceGroupOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteResourceGroupOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ListQuotaDefinitions provides a mock function with the given fields.
+func (_m *ResourceManagerV2) ListQuotaDefinitions(listQuotaDefinitionsOptions *resourcemanagerv2.ListQuotaDefinitionsOptions) (*resourcemanagerv2.QuotaDefinitionList, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(listQuotaDefinitionsOptions)
+	var r0 *resourcemanagerv2.QuotaDefinitionList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.QuotaDefinitionList)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListQuotaDefinitionsWithContext provides a mock function with the given fields.
+func (_m *ResourceManagerV2) ListQuotaDefinitionsWithContext(ctx context.Context, listQuotaDefinitionsOptions *resourcemanagerv2.ListQuotaDefinitionsOptions) (*resourcemanagerv2.QuotaDefinitionList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listQuotaDefinitionsOptions)
+	var r0 *resourcemanagerv2.QuotaDefinitionList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.QuotaDefinitionList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetQuotaDefinition provides a mock function with the given fields.
+func (_m *ResourceManagerV2) GetQuotaDefinition(getQuotaDefinitionOptions *resourcemanagerv2.GetQuotaDefinitionOptions) (*resourcemanagerv2.QuotaDefinition, *core.DetailedResponse, error) {
+	ret := _m.Called(getQuotaDefinitionOptions)
+	var r0 *resourcemanagerv2.QuotaDefinition
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.QuotaDefinition)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetQuotaDefinitionWithContext provides a mock function with the given fields.
+func (_m *ResourceManagerV2) GetQuotaDefinitionWithContext(ctx context.Context, getQuotaDefinitionOptions *resourcemanagerv2.GetQuotaDefinitionOptions) (*resourcemanagerv2.QuotaDefinition, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getQuotaDefinitionOptions)
+	var r0 *resourcemanagerv2.QuotaDefinition
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*resourcemanagerv2.QuotaDefinition)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
@@ -178,7 +178,7 @@ func (resourceManager *ResourceManagerV2) ListResourceGroupsWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_manager", "V2", "ListResourceGroups"))
 	builder.EnableGzipCompression = resourceManager.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceManager.Service.Options.URL, `/v2/resource_groups`, nil)
 	if err != nil {
@@ -219,6 +219,8 @@ func (resourceManager *ResourceManagerV2) ListResourceGroupsWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceManager.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "list_resource_groups", core.NewProblemComponent(DefaultServiceName, "2.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -252,7 +254,7 @@ func (resourceManager *ResourceManagerV2) CreateResourceGroupWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_manager", "V2", "CreateResourceGroup"))
 	builder.EnableGzipCompression = resourceManager.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceManager.Service.Options.URL, `/v2/resource_groups`, nil)
 	if err != nil {
@@ -290,6 +292,8 @@ func (resourceManager *ResourceManagerV2) CreateResourceGroupWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceManager.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "create_resource_group", core.NewProblemComponent(DefaultServiceName, "2.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -329,7 +333,7 @@ func (resourceManager *ResourceManagerV2) GetResourceGroupWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_manager", "V2", "GetResourceGroup"))
 	builder.EnableGzipCompression = resourceManager.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceManager.Service.Options.URL, `/v2/resource_groups/{id}`, pathParamsMap)
 	if err != nil {
@@ -354,6 +358,8 @@ func (resourceManager *ResourceManagerV2) GetResourceGroupWithContext(ctx contex
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceManager.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_resource_group", core.NewProblemComponent(DefaultServiceName, "2.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -391,7 +397,7 @@ func (resourceManager *ResourceManagerV2) UpdateResourceGroupWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_manager", "V2", "UpdateResourceGroup"))
 	builder.EnableGzipCompression = resourceManager.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceManager.Service.Options.URL, `/v2/resource_groups/{id}`, pathParamsMap)
 	if err != nil {
@@ -429,6 +435,8 @@ func (resourceManager *ResourceManagerV2) UpdateResourceGroupWithContext(ctx con
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceManager.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "update_resource_group", core.NewProblemComponent(DefaultServiceName, "2.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -470,7 +478,7 @@ func (resourceManager *ResourceManagerV2) DeleteResourceGroupWithContext(ctx con
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_manager", "V2", "DeleteResourceGroup"))
 	builder.EnableGzipCompression = resourceManager.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceManager.Service.Options.URL, `/v2/resource_groups/{id}`, pathParamsMap)
 	if err != nil {
@@ -493,6 +501,10 @@ func (resourceManager *ResourceManagerV2) DeleteResourceGroupWithContext(ctx con
 
 	response, err = resourceManager.Service.Request(request, nil)
 
+	if err != nil {
+		core.EnrichHTTPProblem(err, "delete_resource_group", core.NewProblemComponent(DefaultServiceName, "2.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
+	}
 	return
 }
 
@@ -514,7 +526,7 @@ func (resourceManager *ResourceManagerV2) ListQuotaDefinitionsWithContext(ctx co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_manager", "V2", "ListQuotaDefinitions"))
 	builder.EnableGzipCompression = resourceManager.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceManager.Service.Options.URL, `/v2/quota_definitions`, nil)
 	if err != nil {
@@ -539,6 +551,8 @@ func (resourceManager *ResourceManagerV2) ListQuotaDefinitionsWithContext(ctx co
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceManager.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "list_quota_definitions", core.NewProblemComponent(DefaultServiceName, "2.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -580,7 +594,7 @@ func (resourceManager *ResourceManagerV2) GetQuotaDefinitionWithContext(ctx cont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "resource_manager", "V2", "GetQuotaDefinition"))
 	builder.EnableGzipCompression = resourceManager.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(resourceManager.Service.Options.URL, `/v2/quota_definitions/{id}`, pathParamsMap)
 	if err != nil {
@@ -605,6 +619,8 @@ func (resourceManager *ResourceManagerV2) GetQuotaDefinitionWithContext(ctx cont
 	var rawResponse map[string]json.RawMessage
 	response, err = resourceManager.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_quota_definition", core.NewProblemComponent(DefaultServiceName, "2.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
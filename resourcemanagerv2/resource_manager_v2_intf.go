@@ -0,0 +1,60 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package resourcemanagerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ResourceManagerV2Intf : The operations of the ResourceManagerV2 service client.
+// The interface is satisfied by *ResourceManagerV2 and by mocks.ResourceManagerV2, allowing consumers to substitute a mock for the client in tests.
+type ResourceManagerV2Intf interface {
+	// ListResourceGroups : Get a list of all resource groups
+	ListResourceGroups(listResourceGroupsOptions *ListResourceGroupsOptions) (result *ResourceGroupList, response *core.DetailedResponse, err error)
+	// ListResourceGroupsWithContext is an alternate form of the ListResourceGroups method which supports a Context parameter
+	ListResourceGroupsWithContext(ctx context.Context, listResourceGroupsOptions *ListResourceGroupsOptions) (result *ResourceGroupList, response *core.DetailedResponse, err error)
+	// CreateResourceGroup : Create a resource group
+	CreateResourceGroup(createResourceGroupOptions *CreateResourceGroupOptions) (result *ResCreateResourceGroup, response *core.DetailedResponse, err error)
+	// CreateResourceGroupWithContext is an alternate form of the CreateResourceGroup method which supports a Context parameter
+	CreateResourceGroupWithContext(ctx context.Context, createResourceGroupOptions *CreateResourceGroupOptions) (result *ResCreateResourceGroup, response *core.DetailedResponse, err error)
+	// GetResourceGroup : Get a resource group
+	GetResourceGroup(getResourceGroupOptions *GetResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+	// GetResourceGroupWithContext is an alternate form of the GetResourceGroup method which supports a Context parameter
+	GetResourceGroupWithContext(ctx context.Context, getResourceGroupOptions *GetResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+	// UpdateResourceGroup : Update a resource group
+	UpdateResourceGroup(updateResourceGroupOptions *UpdateResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+	// UpdateResourceGroupWithContext is an alternate form of the UpdateResourceGroup method which supports a Context parameter
+	UpdateResourceGroupWithContext(ctx context.Context, updateResourceGroupOptions *UpdateResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+	// DeleteResourceGroup : Delete a resource group
+	DeleteResourceGroup(deleteResourceGroupOptions *DeleteResourceGroupOptions) (response *core.DetailedResponse, err error)
+	// DeleteResourceGroupWithContext is an alternate form of the DeleteResourceGroup method which supports a Context parameter
+	DeleteResourceGroupWithContext(ctx context.Context, deleteResourceGroupOptions *DeleteResourceGroupOptions) (response *core.DetailedResponse, err error)
+	// ListQuotaDefinitions : List quota definitions
+	ListQuotaDefinitions(listQuotaDefinitionsOptions *ListQuotaDefinitionsOptions) (result *QuotaDefinitionList, response *core.DetailedResponse, err error)
+	// ListQuotaDefinitionsWithContext is an alternate form of the ListQuotaDefinitions method which supports a Context parameter
+	ListQuotaDefinitionsWithContext(ctx context.Context, listQuotaDefinitionsOptions *ListQuotaDefinitionsOptions) (result *QuotaDefinitionList, response *core.DetailedResponse, err error)
+	// GetQuotaDefinition : Get a quota definition
+	GetQuotaDefinition(getQuotaDefinitionOptions *GetQuotaDefinitionOptions) (result *QuotaDefinition, response *core.DetailedResponse, err error)
+	// GetQuotaDefinitionWithContext is an alternate form of the GetQuotaDefinition method which supports a Context parameter
+	GetQuotaDefinitionWithContext(ctx context.Context, getQuotaDefinitionOptions *GetQuotaDefinitionOptions) (result *QuotaDefinition, response *core.DetailedResponse, err error)
+}
+
+var _ ResourceManagerV2Intf = (*ResourceManagerV2)(nil)
@@ -0,0 +1,238 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package routerconfig : Declarative configuration of the targets, routes and settings of the
+// Activity Tracker (atrackerv2), logs router (logsrouterv3) and metrics router (metricsrouterv3) services.
+package routerconfig
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"sigs.k8s.io/yaml"
+)
+
+// Router kinds, as used in Config.Router.
+const (
+	RouterActivityTrackerConst = "atracker"
+	RouterLogsConst            = "logs"
+	RouterMetricsConst         = "metrics"
+)
+
+// Rule actions.
+const (
+	RuleActionSendConst = "send"
+	RuleActionDropConst = "drop"
+)
+
+// Inclusion filter operands and operators understood by every router.
+const (
+	FilterOperandLocationConst = "location"
+	FilterOperatorIsConst      = "is"
+	FilterOperatorInConst      = "in"
+)
+
+// Document : A set of router configurations, typically loaded from a YAML or JSON file.
+type Document struct {
+	Configs []Config `json:"configs"`
+}
+
+// Config : The desired state of one router in one region.
+type Config struct {
+	// The router: atracker, logs or metrics.
+	Router string `json:"router" validate:"required,oneof=atracker logs metrics"`
+
+	// The region of the router's API endpoint, such as "us-south".
+	Region string `json:"region" validate:"required"`
+
+	// The targets of the router, identified by name.
+	Targets []TargetConfig `json:"targets,omitempty" validate:"omitempty,dive"`
+
+	// The routes of the router, identified by name.
+	Routes []RouteConfig `json:"routes,omitempty" validate:"omitempty,dive"`
+
+	// The account settings of the router. Settings are left untouched when omitted.
+	Settings *SettingsConfig `json:"settings,omitempty"`
+}
+
+// TargetConfig : The desired state of a target.
+type TargetConfig struct {
+	Name string `json:"name" validate:"required"`
+
+	// The type of target. The logs and metrics routers infer it from the destination; Activity Tracker requires one of
+	// cloud_logs, cloud_object_storage, event_streams or app_config.
+	Type string `json:"type,omitempty"`
+
+	// The CRN of the destination service instance.
+	DestinationCRN string `json:"destination_crn" validate:"required"`
+
+	// The region in which to create the target, if different from the region of the router.
+	Region string `json:"region,omitempty"`
+
+	// Activity Tracker cloud_object_storage targets only.
+	COS *COSConfig `json:"cos,omitempty"`
+
+	// Activity Tracker event_streams targets only.
+	EventStreams *EventStreamsConfig `json:"event_streams,omitempty"`
+}
+
+// COSConfig : The Cloud Object Storage parameters of an Activity Tracker target.
+type COSConfig struct {
+	Endpoint                string `json:"endpoint" validate:"required"`
+	Bucket                  string `json:"bucket" validate:"required"`
+	APIKey                  string `json:"api_key,omitempty"`
+	ServiceToServiceEnabled bool   `json:"service_to_service_enabled,omitempty"`
+}
+
+// EventStreamsConfig : The Event Streams parameters of an Activity Tracker target.
+type EventStreamsConfig struct {
+	Brokers                 []string `json:"brokers" validate:"required"`
+	Topic                   string   `json:"topic" validate:"required"`
+	APIKey                  string   `json:"api_key,omitempty"`
+	ServiceToServiceEnabled bool     `json:"service_to_service_enabled,omitempty"`
+}
+
+// RouteConfig : The desired state of a route.
+type RouteConfig struct {
+	Name  string       `json:"name" validate:"required"`
+	Rules []RuleConfig `json:"rules" validate:"required,min=1,dive"`
+}
+
+// RuleConfig : A routing rule. Rules are evaluated in order and the first matching rule applies.
+type RuleConfig struct {
+	// The action of the rule: send (the default) or drop. Activity Tracker only supports send.
+	Action string `json:"action,omitempty"`
+
+	// The names of the targets to send to. In live configurations read from a router, these are target IDs.
+	Targets []string `json:"targets,omitempty"`
+
+	// The locations matched by the rule, a shorthand for a location inclusion filter. "*" matches every location.
+	Locations []string `json:"locations,omitempty"`
+
+	// The inclusion filters of the rule (logs and metrics routers).
+	Filters []FilterConfig `json:"filters,omitempty"`
+}
+
+// FilterConfig : An inclusion filter of a rule.
+type FilterConfig struct {
+	Operand  string   `json:"operand" validate:"required"`
+	Operator string   `json:"operator" validate:"required"`
+	Values   []string `json:"values" validate:"required"`
+}
+
+// SettingsConfig : The desired account settings of a router. Only the fields that are set are reconciled.
+type SettingsConfig struct {
+	// The names of the default targets. In live configurations read from a router, these are target IDs.
+	DefaultTargets         []string `json:"default_targets,omitempty"`
+	PermittedTargetRegions []string `json:"permitted_target_regions,omitempty"`
+	PrimaryMetadataRegion  string   `json:"primary_metadata_region,omitempty"`
+	BackupMetadataRegion   string   `json:"backup_metadata_region,omitempty"`
+	PrivateAPIEndpointOnly *bool    `json:"private_api_endpoint_only,omitempty"`
+}
+
+// ParseDocument parses a document in YAML or JSON format and validates it.
+func ParseDocument(data []byte) (document *Document, err error) {
+	document = new(Document)
+	err = yaml.UnmarshalStrict(data, document)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+		document = nil
+		return
+	}
+	err = document.Validate()
+	if err != nil {
+		document = nil
+	}
+	return
+}
+
+// LoadDocument reads a document in YAML or JSON format from the specified file.
+func LoadDocument(path string) (document *Document, err error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-error", common.GetComponentInfo())
+		return
+	}
+	return ParseDocument(data)
+}
+
+// Validate checks the document for missing fields, duplicate names and references to undeclared targets.
+func (document *Document) Validate() (err error) {
+	seen := map[string]bool{}
+	for i := range document.Configs {
+		config := &document.Configs[i]
+		key := config.Router + "/" + config.Region
+		if seen[key] {
+			return core.SDKErrorf(nil, fmt.Sprintf("router '%s' in region '%s' is configured more than once", config.Router, config.Region),
+				"duplicate-config", common.GetComponentInfo())
+		}
+		seen[key] = true
+		if err = config.Validate(); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Validate checks the configuration for missing fields, duplicate names and references to undeclared targets.
+func (config *Config) Validate() (err error) {
+	err = core.ValidateStruct(config, "config")
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	invalid := func(format string, args ...interface{}) error {
+		message := fmt.Sprintf("%s/%s: ", config.Router, config.Region) + fmt.Sprintf(format, args...)
+		return core.SDKErrorf(nil, message, "invalid-config", common.GetComponentInfo())
+	}
+
+	targets := map[string]bool{}
+	for _, target := range config.Targets {
+		if targets[target.Name] {
+			return invalid("target '%s' is declared more than once", target.Name)
+		}
+		targets[target.Name] = true
+		if config.Router == RouterActivityTrackerConst && target.Type == "" {
+			return invalid("target '%s' must specify a type", target.Name)
+		}
+	}
+	routes := map[string]bool{}
+	for _, route := range config.Routes {
+		if routes[route.Name] {
+			return invalid("route '%s' is declared more than once", route.Name)
+		}
+		routes[route.Name] = true
+		for i, rule := range route.Rules {
+			if config.Router == RouterActivityTrackerConst && (rule.Action == RuleActionDropConst || len(rule.Filters) > 0) {
+				return invalid("route '%s' rule %d: Activity Tracker rules only support locations", route.Name, i)
+			}
+			for _, name := range rule.Targets {
+				if !targets[name] {
+					return invalid("route '%s' rule %d refers to undeclared target '%s'", route.Name, i, name)
+				}
+			}
+		}
+	}
+	if config.Settings != nil {
+		for _, name := range config.Settings.DefaultTargets {
+			if !targets[name] {
+				return invalid("settings refer to undeclared default target '%s'", name)
+			}
+		}
+	}
+	return
+}
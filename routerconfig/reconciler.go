@@ -0,0 +1,475 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package routerconfig
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Constants associated with the Change.Action property.
+const (
+	ChangeActionCreateConst = "create"
+	ChangeActionUpdateConst = "update"
+	ChangeActionDeleteConst = "delete"
+)
+
+// Constants associated with the Change.Resource property.
+const (
+	ChangeResourceTargetConst   = "target"
+	ChangeResourceRouteConst    = "route"
+	ChangeResourceSettingsConst = "settings"
+)
+
+// Change : A difference between the desired and the live configuration of a router.
+type Change struct {
+	// The change to make: create, update or delete.
+	Action string
+
+	// The kind of resource: target, route or settings.
+	Resource string
+
+	// The name of the target or route.
+	Name string
+
+	// The ID of the live target or route, for updates and deletions.
+	ID string
+
+	// For updates, the fields that differ from the desired configuration.
+	Fields []string
+}
+
+// String returns a one-line description of the change.
+func (change *Change) String() string {
+	symbol := map[string]string{ChangeActionCreateConst: "+", ChangeActionUpdateConst: "~", ChangeActionDeleteConst: "-"}[change.Action]
+	description := fmt.Sprintf("%s %s", symbol, change.Resource)
+	if change.Name != "" {
+		description += " " + change.Name
+	}
+	if len(change.Fields) > 0 {
+		description += " (" + strings.Join(change.Fields, ", ") + ")"
+	}
+	return description
+}
+
+// Plan : The changes that bring one router in one region to its desired configuration.
+type Plan struct {
+	// The desired configuration.
+	Config *Config
+
+	// The router that the plan applies to.
+	Router Router
+
+	// The changes, in the order in which Apply makes them.
+	Changes []Change
+
+	// The IDs of the live targets, by name.
+	targetIDs map[string]string
+}
+
+// HasDrift returns true if the live configuration differs from the desired configuration.
+func (plan *Plan) HasDrift() bool {
+	return len(plan.Changes) > 0
+}
+
+// String returns the changes of the plan, one per line.
+func (plan *Plan) String() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%s/%s:", plan.Config.Router, plan.Config.Region)
+	if !plan.HasDrift() {
+		builder.WriteString(" no changes\n")
+		return builder.String()
+	}
+	builder.WriteString("\n")
+	for i := range plan.Changes {
+		fmt.Fprintf(&builder, "  %s\n", plan.Changes[i].String())
+	}
+	return builder.String()
+}
+
+// Reconciler : Plans and applies the changes that bring routers to the configuration of a Document.
+type Reconciler struct {
+	// Returns the router of the specified kind for the specified region. See NewRouterFactory.
+	RouterFor func(kind string, region string) (Router, error)
+
+	// If set, targets and routes that are not declared in the configuration are deleted, except for targets
+	// that remain default targets of the router once the configuration is applied.
+	Prune bool
+}
+
+// NewReconciler : Instantiate Reconciler
+func NewReconciler(routerFor func(kind string, region string) (Router, error)) *Reconciler {
+	return &Reconciler{
+		RouterFor: routerFor,
+	}
+}
+
+// Plan compares each configuration of the document with the live configuration of its router and returns
+// the changes needed to reconcile them. Only the fields that the configuration specifies are compared.
+func (reconciler *Reconciler) Plan(ctx context.Context, document *Document) (plans []*Plan, err error) {
+	if err = document.Validate(); err != nil {
+		return
+	}
+	plans = []*Plan{}
+	for i := range document.Configs {
+		var plan *Plan
+		plan, err = reconciler.plan(ctx, &document.Configs[i])
+		if err != nil {
+			plans = nil
+			return
+		}
+		plans = append(plans, plan)
+	}
+	return
+}
+
+func (reconciler *Reconciler) plan(ctx context.Context, config *Config) (plan *Plan, err error) {
+	router, err := reconciler.RouterFor(config.Router, config.Region)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "router-error", common.GetComponentInfo())
+		return
+	}
+	plan = &Plan{Config: config, Router: router, Changes: []Change{}, targetIDs: map[string]string{}}
+
+	liveTargets, err := router.ListTargets(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-targets-error")
+		return
+	}
+	liveRoutes, err := router.ListRoutes(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-routes-error")
+		return
+	}
+
+	liveTargetsByName := map[string]*LiveTarget{}
+	for i := range liveTargets {
+		liveTargetsByName[liveTargets[i].Config.Name] = &liveTargets[i]
+		plan.targetIDs[liveTargets[i].Config.Name] = liveTargets[i].ID
+	}
+	liveRoutesByName := map[string]*LiveRoute{}
+	for i := range liveRoutes {
+		liveRoutesByName[liveRoutes[i].Config.Name] = &liveRoutes[i]
+	}
+
+	declaredTargets := map[string]bool{}
+	for i := range config.Targets {
+		desired := &config.Targets[i]
+		declaredTargets[desired.Name] = true
+		live, exists := liveTargetsByName[desired.Name]
+		if !exists {
+			plan.Changes = append(plan.Changes, Change{Action: ChangeActionCreateConst, Resource: ChangeResourceTargetConst, Name: desired.Name})
+		} else if fields := targetDrift(desired, &live.Config); len(fields) > 0 {
+			plan.Changes = append(plan.Changes, Change{Action: ChangeActionUpdateConst, Resource: ChangeResourceTargetConst, Name: desired.Name, ID: live.ID, Fields: fields})
+		}
+	}
+
+	declaredRoutes := map[string]bool{}
+	for i := range config.Routes {
+		desired := &config.Routes[i]
+		declaredRoutes[desired.Name] = true
+		live, exists := liveRoutesByName[desired.Name]
+		if !exists {
+			plan.Changes = append(plan.Changes, Change{Action: ChangeActionCreateConst, Resource: ChangeResourceRouteConst, Name: desired.Name})
+		} else if !rulesEqual(router.Kind(), plan.resolveRoute(desired).Rules, live.Config.Rules) {
+			plan.Changes = append(plan.Changes, Change{Action: ChangeActionUpdateConst, Resource: ChangeResourceRouteConst, Name: desired.Name, ID: live.ID, Fields: []string{"rules"}})
+		}
+	}
+
+	var liveSettings *SettingsConfig
+	if config.Settings != nil || reconciler.Prune {
+		liveSettings, err = router.GetSettings(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-settings-error")
+			return
+		}
+	}
+	if config.Settings != nil {
+		if fields := settingsDrift(plan.resolveSettings(config.Settings), liveSettings); len(fields) > 0 {
+			plan.Changes = append(plan.Changes, Change{Action: ChangeActionUpdateConst, Resource: ChangeResourceSettingsConst, Fields: fields})
+		}
+	}
+
+	if reconciler.Prune {
+		for _, live := range liveRoutes {
+			if !declaredRoutes[live.Config.Name] {
+				plan.Changes = append(plan.Changes, Change{Action: ChangeActionDeleteConst, Resource: ChangeResourceRouteConst, Name: live.Config.Name, ID: live.ID})
+			}
+		}
+		// The router refuses to delete its default targets; settings are applied before targets are deleted.
+		defaultTargets := liveSettings.DefaultTargets
+		if config.Settings != nil && config.Settings.DefaultTargets != nil {
+			defaultTargets = plan.resolveSettings(config.Settings).DefaultTargets
+		}
+		for _, live := range liveTargets {
+			if !declaredTargets[live.Config.Name] && !common.ContainsString(defaultTargets, live.ID) {
+				plan.Changes = append(plan.Changes, Change{Action: ChangeActionDeleteConst, Resource: ChangeResourceTargetConst, Name: live.Config.Name, ID: live.ID})
+			}
+		}
+	}
+	return
+}
+
+// Apply makes the changes of the plans. Within a plan, targets are created and updated first, then routes,
+// then settings, so that routes and default targets can refer to new targets by name; routes are deleted
+// before the targets they may refer to. Apply stops at the first failed change.
+func (reconciler *Reconciler) Apply(ctx context.Context, plans []*Plan) (err error) {
+	for _, plan := range plans {
+		if err = plan.apply(ctx); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (plan *Plan) apply(ctx context.Context) (err error) {
+	fail := func(err error, change *Change) error {
+		return core.SDKErrorf(err, fmt.Sprintf("%s/%s: %s: %s", plan.Config.Router, plan.Config.Region, change.String(), err.Error()),
+			"apply-error", common.GetComponentInfo())
+	}
+
+	// Plans only contain changes of declared targets and routes, so the lookups below always succeed.
+	for i := range plan.Changes {
+		change := &plan.Changes[i]
+		if change.Resource != ChangeResourceTargetConst || change.Action == ChangeActionDeleteConst {
+			continue
+		}
+		target := plan.Config.target(change.Name)
+		if change.Action == ChangeActionCreateConst {
+			var id string
+			if id, err = plan.Router.CreateTarget(ctx, target); err != nil {
+				return fail(err, change)
+			}
+			change.ID = id
+			plan.targetIDs[change.Name] = id
+		} else if err = plan.Router.UpdateTarget(ctx, change.ID, target); err != nil {
+			return fail(err, change)
+		}
+	}
+
+	for i := range plan.Changes {
+		change := &plan.Changes[i]
+		if change.Resource != ChangeResourceRouteConst || change.Action == ChangeActionDeleteConst {
+			continue
+		}
+		route := plan.resolveRoute(plan.Config.route(change.Name))
+		if change.Action == ChangeActionCreateConst {
+			var id string
+			if id, err = plan.Router.CreateRoute(ctx, route); err != nil {
+				return fail(err, change)
+			}
+			change.ID = id
+		} else if err = plan.Router.UpdateRoute(ctx, change.ID, route); err != nil {
+			return fail(err, change)
+		}
+	}
+
+	for i := range plan.Changes {
+		change := &plan.Changes[i]
+		if change.Resource == ChangeResourceSettingsConst {
+			if err = plan.Router.UpdateSettings(ctx, plan.resolveSettings(plan.Config.Settings)); err != nil {
+				return fail(err, change)
+			}
+		}
+	}
+
+	for _, resource := range []string{ChangeResourceRouteConst, ChangeResourceTargetConst} {
+		for i := range plan.Changes {
+			change := &plan.Changes[i]
+			if change.Resource != resource || change.Action != ChangeActionDeleteConst {
+				continue
+			}
+			if resource == ChangeResourceRouteConst {
+				err = plan.Router.DeleteRoute(ctx, change.ID)
+			} else {
+				err = plan.Router.DeleteTarget(ctx, change.ID)
+			}
+			if err != nil {
+				return fail(err, change)
+			}
+		}
+	}
+	return
+}
+
+func (config *Config) target(name string) *TargetConfig {
+	for i := range config.Targets {
+		if config.Targets[i].Name == name {
+			return &config.Targets[i]
+		}
+	}
+	return nil
+}
+
+func (config *Config) route(name string) *RouteConfig {
+	for i := range config.Routes {
+		if config.Routes[i].Name == name {
+			return &config.Routes[i]
+		}
+	}
+	return nil
+}
+
+// resolveRoute returns the route in the form exchanged with the router: target names are replaced by IDs and,
+// for the logs and metrics routers, locations become a location inclusion filter and the action defaults to send.
+// Targets that do not exist yet keep their name, which makes the route differ from any live route.
+func (plan *Plan) resolveRoute(route *RouteConfig) *RouteConfig {
+	resolved := &RouteConfig{Name: route.Name, Rules: make([]RuleConfig, 0, len(route.Rules))}
+	for _, rule := range route.Rules {
+		converted := RuleConfig{Targets: plan.resolveTargets(rule.Targets)}
+		if plan.Router.Kind() == RouterActivityTrackerConst {
+			converted.Locations = rule.Locations
+		} else {
+			converted.Action = rule.Action
+			if converted.Action == "" {
+				converted.Action = RuleActionSendConst
+			}
+			converted.Filters = append([]FilterConfig{}, rule.Filters...)
+			if len(rule.Locations) == 1 {
+				converted.Filters = append(converted.Filters, FilterConfig{Operand: FilterOperandLocationConst, Operator: FilterOperatorIsConst, Values: rule.Locations})
+			} else if len(rule.Locations) > 1 {
+				converted.Filters = append(converted.Filters, FilterConfig{Operand: FilterOperandLocationConst, Operator: FilterOperatorInConst, Values: rule.Locations})
+			}
+		}
+		resolved.Rules = append(resolved.Rules, converted)
+	}
+	return resolved
+}
+
+// resolveSettings returns the settings with the names of the default targets replaced by IDs.
+func (plan *Plan) resolveSettings(settings *SettingsConfig) *SettingsConfig {
+	resolved := *settings
+	if settings.DefaultTargets != nil {
+		resolved.DefaultTargets = plan.resolveTargets(settings.DefaultTargets)
+	}
+	return &resolved
+}
+
+func (plan *Plan) resolveTargets(names []string) []string {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if id, exists := plan.targetIDs[name]; exists {
+			ids = append(ids, id)
+		} else {
+			ids = append(ids, name)
+		}
+	}
+	return ids
+}
+
+// targetDrift returns the fields of the desired target that differ from the live target. The region of a target
+// cannot be changed and API keys cannot be read back, so neither is compared.
+func targetDrift(desired *TargetConfig, live *TargetConfig) (fields []string) {
+	if desired.DestinationCRN != live.DestinationCRN {
+		fields = append(fields, "destination_crn")
+	}
+	if desired.Type != "" && desired.Type != live.Type {
+		fields = append(fields, "type")
+	}
+	if desired.COS != nil {
+		if live.COS == nil || desired.COS.Endpoint != live.COS.Endpoint || desired.COS.Bucket != live.COS.Bucket ||
+			desired.COS.ServiceToServiceEnabled != live.COS.ServiceToServiceEnabled {
+			fields = append(fields, "cos")
+		}
+	}
+	if desired.EventStreams != nil {
+		if live.EventStreams == nil || !sameSet(desired.EventStreams.Brokers, live.EventStreams.Brokers) ||
+			desired.EventStreams.Topic != live.EventStreams.Topic ||
+			desired.EventStreams.ServiceToServiceEnabled != live.EventStreams.ServiceToServiceEnabled {
+			fields = append(fields, "event_streams")
+		}
+	}
+	return
+}
+
+// rulesEqual compares rules in order; the targets, locations and filters of a rule are compared as sets.
+func rulesEqual(kind string, desired []RuleConfig, live []RuleConfig) bool {
+	if len(desired) != len(live) {
+		return false
+	}
+	for i := range desired {
+		if !sameSet(desired[i].Targets, live[i].Targets) {
+			return false
+		}
+		if kind == RouterActivityTrackerConst {
+			if !sameSet(desired[i].Locations, live[i].Locations) {
+				return false
+			}
+			continue
+		}
+		liveAction := live[i].Action
+		if liveAction == "" {
+			liveAction = RuleActionSendConst
+		}
+		if desired[i].Action != liveAction || !sameSet(filterKeys(desired[i].Filters), filterKeys(live[i].Filters)) {
+			return false
+		}
+	}
+	return true
+}
+
+func filterKeys(filters []FilterConfig) []string {
+	keys := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		values := append([]string{}, filter.Values...)
+		sort.Strings(values)
+		keys = append(keys, filter.Operand+" "+filter.Operator+" "+strings.Join(values, ","))
+	}
+	return keys
+}
+
+// settingsDrift returns the fields set in the desired settings that differ from the live settings.
+func settingsDrift(desired *SettingsConfig, live *SettingsConfig) (fields []string) {
+	if desired.DefaultTargets != nil && !sameSet(desired.DefaultTargets, live.DefaultTargets) {
+		fields = append(fields, "default_targets")
+	}
+	if desired.PermittedTargetRegions != nil && !sameSet(desired.PermittedTargetRegions, live.PermittedTargetRegions) {
+		fields = append(fields, "permitted_target_regions")
+	}
+	if desired.PrimaryMetadataRegion != "" && desired.PrimaryMetadataRegion != live.PrimaryMetadataRegion {
+		fields = append(fields, "primary_metadata_region")
+	}
+	if desired.BackupMetadataRegion != "" && desired.BackupMetadataRegion != live.BackupMetadataRegion {
+		fields = append(fields, "backup_metadata_region")
+	}
+	if desired.PrivateAPIEndpointOnly != nil &&
+		(live.PrivateAPIEndpointOnly == nil || *desired.PrivateAPIEndpointOnly != *live.PrivateAPIEndpointOnly) {
+		fields = append(fields, "private_api_endpoint_only")
+	}
+	return
+}
+
+func sameSet(a []string, b []string) bool {
+	counts := map[string]int{}
+	for _, value := range a {
+		counts[value]++
+	}
+	for _, value := range b {
+		counts[value]--
+	}
+	for _, count := range counts {
+		if count != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,207 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package routerconfig_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/logsrouterv3"
+	"github.com/IBM/platform-services-go-sdk/routerconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RouterConfig`, func() {
+	Describe(`ParseDocument`, func() {
+		It(`Parses YAML and rejects invalid configurations`, func() {
+			document, err := routerconfig.ParseDocument([]byte(`
+configs:
+  - router: logs
+    region: us-south
+    targets:
+      - name: main
+        destination_crn: "crn:v1:bluemix:public:logs:us-south:a/1:inst1::"
+    routes:
+      - name: all
+        rules:
+          - targets: [main]
+            locations: ["*"]
+    settings:
+      default_targets: [main]
+`))
+			Expect(err).To(BeNil())
+			Expect(document.Configs).To(HaveLen(1))
+			Expect(document.Configs[0].Routes[0].Rules[0].Targets).To(Equal([]string{"main"}))
+
+			invalid := map[string]string{
+				"unknown field":     `{"configs": [{"router": "logs", "region": "us-south", "colour": "red"}]}`,
+				"unknown router":    `{"configs": [{"router": "events", "region": "us-south"}]}`,
+				"duplicate config":  `{"configs": [{"router": "logs", "region": "us-south"}, {"router": "logs", "region": "us-south"}]}`,
+				"undeclared target": `{"configs": [{"router": "logs", "region": "us-south", "routes": [{"name": "r", "rules": [{"targets": ["x"]}]}]}]}`,
+				"atracker filters": `{"configs": [{"router": "atracker", "region": "us-south", "routes": [{"name": "r", "rules": [{"filters": [
+					{"operand": "location", "operator": "is", "values": ["us-south"]}]}]}]}]}`,
+				"atracker type": `{"configs": [{"router": "atracker", "region": "us-south", "targets": [{"name": "t", "destination_crn": "crn"}]}]}`,
+			}
+			for name, data := range invalid {
+				_, err = routerconfig.ParseDocument([]byte(data))
+				Expect(err).ToNot(BeNil(), name)
+			}
+		})
+	})
+
+	Describe(`Reconciler`, func() {
+		var requests []string
+		var testServer *httptest.Server
+
+		BeforeEach(func() {
+			requests = []string{}
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				body, _ := io.ReadAll(req.Body)
+				res.Header().Set("Content-type", "application/json")
+				key := req.Method + " " + req.URL.Path
+				if req.Method != http.MethodGet {
+					requests = append(requests, key)
+				}
+				switch key {
+				case "GET /targets":
+					fmt.Fprint(res, `{"targets": [
+						{"id": "t-main", "name": "main", "destination_crn": "crn:v1:bluemix:public:logs:us-south:a/1:old::"},
+						{"id": "t-stale", "name": "stale", "destination_crn": "crn:v1:bluemix:public:logs:us-south:a/1:stale::"}]}`)
+				case "GET /routes":
+					fmt.Fprint(res, `{"routes": [
+						{"id": "r-all", "name": "all", "rules": [{"action": "send", "targets": [{"id": "t-main"}],
+							"inclusion_filters": [{"operand": "location", "operator": "is", "values": ["us-south"]}]}]},
+						{"id": "r-stale", "name": "stale", "rules": [{"targets": [{"id": "t-stale"}], "inclusion_filters": []}]}]}`)
+				case "GET /settings":
+					fmt.Fprint(res, `{"default_targets": [{"id": "t-stale"}], "primary_metadata_region": "us-south"}`)
+				case "POST /targets":
+					res.WriteHeader(201)
+					fmt.Fprint(res, `{"id": "t-archive", "name": "archive"}`)
+				case "PATCH /targets/t-main":
+					fmt.Fprint(res, `{"id": "t-main"}`)
+				case "POST /routes":
+					var route map[string]interface{}
+					Expect(json.Unmarshal(body, &route)).To(Succeed())
+					Expect(fmt.Sprint(route["rules"])).To(ContainSubstring("t-archive"))
+					res.WriteHeader(201)
+					fmt.Fprint(res, `{"id": "r-archive"}`)
+				case "PATCH /settings":
+					Expect(string(body)).To(ContainSubstring(`"default_targets":[{"id":"t-archive"}]`))
+					fmt.Fprint(res, `{}`)
+				case "DELETE /routes/r-stale", "DELETE /targets/t-stale":
+					res.WriteHeader(204)
+				default:
+					Fail("unexpected request " + key)
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		routerFor := func(kind string, region string) (routerconfig.Router, error) {
+			Expect(kind).To(Equal(routerconfig.RouterLogsConst))
+			service, err := logsrouterv3.NewLogsRouterV3(&logsrouterv3.LogsRouterV3Options{URL: testServer.URL, Authenticator: &core.NoAuthAuthenticator{}})
+			if err != nil {
+				return nil, err
+			}
+			return routerconfig.NewLogsRouter(service), nil
+		}
+		document := &routerconfig.Document{Configs: []routerconfig.Config{{
+			Router: routerconfig.RouterLogsConst,
+			Region: "us-south",
+			Targets: []routerconfig.TargetConfig{
+				{Name: "main", DestinationCRN: "crn:v1:bluemix:public:logs:us-south:a/1:new::"},
+				{Name: "archive", DestinationCRN: "crn:v1:bluemix:public:logs:us-south:a/1:archive::"},
+			},
+			Routes: []routerconfig.RouteConfig{
+				{Name: "all", Rules: []routerconfig.RuleConfig{{Targets: []string{"main"}, Locations: []string{"us-south"}}}},
+				{Name: "archive", Rules: []routerconfig.RuleConfig{{Targets: []string{"archive"}}}},
+			},
+			Settings: &routerconfig.SettingsConfig{DefaultTargets: []string{"archive"}, PrimaryMetadataRegion: "us-south"},
+		}}}
+
+		It(`Detects drift only in the specified fields`, func() {
+			reconciler := routerconfig.NewReconciler(routerFor)
+			plans, err := reconciler.Plan(context.Background(), document)
+			Expect(err).To(BeNil())
+			Expect(plans).To(HaveLen(1))
+			Expect(plans[0].HasDrift()).To(BeTrue())
+
+			changes := []string{}
+			for _, change := range plans[0].Changes {
+				changes = append(changes, change.String())
+			}
+			Expect(changes).To(Equal([]string{
+				"~ target main (destination_crn)",
+				"+ target archive",
+				"+ route archive",
+				"~ settings (default_targets)",
+			}))
+			Expect(plans[0].String()).To(HavePrefix("logs/us-south:\n"))
+			Expect(requests).To(BeEmpty())
+		})
+
+		It(`Applies changes in dependency order and prunes undeclared resources`, func() {
+			reconciler := routerconfig.NewReconciler(routerFor)
+			reconciler.Prune = true
+			plans, err := reconciler.Plan(context.Background(), document)
+			Expect(err).To(BeNil())
+			Expect(plans[0].Changes).To(HaveLen(6))
+
+			Expect(reconciler.Apply(context.Background(), plans)).To(Succeed())
+			Expect(requests).To(Equal([]string{
+				"PATCH /targets/t-main",
+				"POST /targets",
+				"POST /routes",
+				"PATCH /settings",
+				"DELETE /routes/r-stale",
+				"DELETE /targets/t-stale",
+			}))
+			Expect(plans[0].Changes[1].ID).To(Equal("t-archive"))
+		})
+
+		It(`Does not prune the default targets of the router`, func() {
+			reconciler := routerconfig.NewReconciler(routerFor)
+			reconciler.Prune = true
+			plans, err := reconciler.Plan(context.Background(), &routerconfig.Document{Configs: []routerconfig.Config{{
+				Router: routerconfig.RouterLogsConst,
+				Region: "us-south",
+				Targets: []routerconfig.TargetConfig{
+					{Name: "main", DestinationCRN: "crn:v1:bluemix:public:logs:us-south:a/1:old::"},
+				},
+				Routes: []routerconfig.RouteConfig{
+					{Name: "all", Rules: []routerconfig.RuleConfig{{Targets: []string{"main"}, Locations: []string{"us-south"}}}},
+				},
+			}}})
+			Expect(err).To(BeNil())
+
+			changes := []string{}
+			for _, change := range plans[0].Changes {
+				changes = append(changes, change.String())
+			}
+			Expect(changes).To(Equal([]string{"- route stale"}))
+		})
+	})
+})
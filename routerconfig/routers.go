@@ -0,0 +1,650 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package routerconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/logsrouterv3"
+	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
+)
+
+// LiveTarget : A target that exists in a router.
+type LiveTarget struct {
+	ID     string
+	Config TargetConfig
+}
+
+// LiveRoute : A route that exists in a router. The targets of its rules are target IDs.
+type LiveRoute struct {
+	ID     string
+	Config RouteConfig
+}
+
+// Router : The operations of a router service needed to reconcile its configuration, in terms of the shared model.
+// In the RuleConfig and SettingsConfig values exchanged with a Router, targets are identified by ID rather than name.
+type Router interface {
+	// Kind returns the router kind: atracker, logs or metrics.
+	Kind() string
+
+	ListTargets(ctx context.Context) ([]LiveTarget, error)
+	CreateTarget(ctx context.Context, target *TargetConfig) (id string, err error)
+	UpdateTarget(ctx context.Context, id string, target *TargetConfig) error
+	DeleteTarget(ctx context.Context, id string) error
+
+	ListRoutes(ctx context.Context) ([]LiveRoute, error)
+	CreateRoute(ctx context.Context, route *RouteConfig) (id string, err error)
+	UpdateRoute(ctx context.Context, id string, route *RouteConfig) error
+	DeleteRoute(ctx context.Context, id string) error
+
+	GetSettings(ctx context.Context) (*SettingsConfig, error)
+	UpdateSettings(ctx context.Context, settings *SettingsConfig) error
+}
+
+//
+// Activity Tracker
+//
+
+type activityTrackerRouter struct {
+	client *atrackerv2.AtrackerV2
+}
+
+// NewActivityTrackerRouter returns the Router for an Activity Tracker client.
+func NewActivityTrackerRouter(client *atrackerv2.AtrackerV2) Router {
+	return &activityTrackerRouter{client: client}
+}
+
+func (router *activityTrackerRouter) Kind() string {
+	return RouterActivityTrackerConst
+}
+
+func (router *activityTrackerRouter) ListTargets(ctx context.Context) (targets []LiveTarget, err error) {
+	result, _, err := router.client.ListTargetsWithContext(ctx, router.client.NewListTargetsOptions())
+	if err != nil {
+		return
+	}
+	targets = []LiveTarget{}
+	for _, target := range result.Targets {
+		config := TargetConfig{
+			Name:   core.StringNilMapper(target.Name),
+			Type:   core.StringNilMapper(target.TargetType),
+			Region: core.StringNilMapper(target.Region),
+		}
+		switch {
+		case target.CloudlogsEndpoint != nil:
+			config.DestinationCRN = core.StringNilMapper(target.CloudlogsEndpoint.TargetCRN)
+		case target.AppconfigEndpoint != nil:
+			config.DestinationCRN = core.StringNilMapper(target.AppconfigEndpoint.TargetCRN)
+		case target.CosEndpoint != nil:
+			config.DestinationCRN = core.StringNilMapper(target.CosEndpoint.TargetCRN)
+			config.COS = &COSConfig{
+				Endpoint:                core.StringNilMapper(target.CosEndpoint.Endpoint),
+				Bucket:                  core.StringNilMapper(target.CosEndpoint.Bucket),
+				ServiceToServiceEnabled: target.CosEndpoint.ServiceToServiceEnabled != nil && *target.CosEndpoint.ServiceToServiceEnabled,
+			}
+		case target.EventstreamsEndpoint != nil:
+			config.DestinationCRN = core.StringNilMapper(target.EventstreamsEndpoint.TargetCRN)
+			config.EventStreams = &EventStreamsConfig{
+				Brokers:                 target.EventstreamsEndpoint.Brokers,
+				Topic:                   core.StringNilMapper(target.EventstreamsEndpoint.Topic),
+				ServiceToServiceEnabled: target.EventstreamsEndpoint.ServiceToServiceEnabled != nil && *target.EventstreamsEndpoint.ServiceToServiceEnabled,
+			}
+		}
+		targets = append(targets, LiveTarget{ID: core.StringNilMapper(target.ID), Config: config})
+	}
+	return
+}
+
+func (router *activityTrackerRouter) CreateTarget(ctx context.Context, target *TargetConfig) (id string, err error) {
+	options := router.client.NewCreateTargetOptions(target.Name, target.Type)
+	options.CosEndpoint, options.EventstreamsEndpoint, options.CloudlogsEndpoint, options.AppconfigEndpoint = atrackerEndpoints(target)
+	if target.Region != "" {
+		options.SetRegion(target.Region)
+	}
+	result, _, err := router.client.CreateTargetWithContext(ctx, options)
+	if err != nil {
+		return
+	}
+	id = core.StringNilMapper(result.ID)
+	return
+}
+
+func (router *activityTrackerRouter) UpdateTarget(ctx context.Context, id string, target *TargetConfig) (err error) {
+	options := router.client.NewReplaceTargetOptions(id)
+	options.SetName(target.Name)
+	options.CosEndpoint, options.EventstreamsEndpoint, options.CloudlogsEndpoint, options.AppconfigEndpoint = atrackerEndpoints(target)
+	_, _, err = router.client.ReplaceTargetWithContext(ctx, options)
+	return
+}
+
+func atrackerEndpoints(target *TargetConfig) (cos *atrackerv2.CosEndpointPrototype, eventStreams *atrackerv2.EventstreamsEndpointPrototype,
+	cloudLogs *atrackerv2.CloudLogsEndpointPrototype, appConfig *atrackerv2.AppconfigEndpointPrototype) {
+	crn := core.StringPtr(target.DestinationCRN)
+	switch target.Type {
+	case atrackerv2.TargetTargetTypeCloudObjectStorageConst:
+		cos = &atrackerv2.CosEndpointPrototype{TargetCRN: crn}
+		if target.COS != nil {
+			cos.Endpoint = core.StringPtr(target.COS.Endpoint)
+			cos.Bucket = core.StringPtr(target.COS.Bucket)
+			cos.ServiceToServiceEnabled = core.BoolPtr(target.COS.ServiceToServiceEnabled)
+			if target.COS.APIKey != "" {
+				cos.APIKey = core.StringPtr(target.COS.APIKey)
+			}
+		}
+	case atrackerv2.TargetTargetTypeEventStreamsConst:
+		eventStreams = &atrackerv2.EventstreamsEndpointPrototype{TargetCRN: crn}
+		if target.EventStreams != nil {
+			eventStreams.Brokers = target.EventStreams.Brokers
+			eventStreams.Topic = core.StringPtr(target.EventStreams.Topic)
+			eventStreams.ServiceToServiceEnabled = core.BoolPtr(target.EventStreams.ServiceToServiceEnabled)
+			if target.EventStreams.APIKey != "" {
+				eventStreams.APIKey = core.StringPtr(target.EventStreams.APIKey)
+			}
+		}
+	case atrackerv2.TargetTargetTypeAppConfigConst:
+		appConfig = &atrackerv2.AppconfigEndpointPrototype{TargetCRN: crn}
+	default:
+		cloudLogs = &atrackerv2.CloudLogsEndpointPrototype{TargetCRN: crn}
+	}
+	return
+}
+
+func (router *activityTrackerRouter) DeleteTarget(ctx context.Context, id string) (err error) {
+	_, _, err = router.client.DeleteTargetWithContext(ctx, router.client.NewDeleteTargetOptions(id))
+	return
+}
+
+func (router *activityTrackerRouter) ListRoutes(ctx context.Context) (routes []LiveRoute, err error) {
+	result, _, err := router.client.ListRoutesWithContext(ctx, router.client.NewListRoutesOptions())
+	if err != nil {
+		return
+	}
+	routes = []LiveRoute{}
+	for _, route := range result.Routes {
+		config := RouteConfig{Name: core.StringNilMapper(route.Name), Rules: []RuleConfig{}}
+		for _, rule := range route.Rules {
+			config.Rules = append(config.Rules, RuleConfig{Targets: rule.TargetIds, Locations: rule.Locations})
+		}
+		routes = append(routes, LiveRoute{ID: core.StringNilMapper(route.ID), Config: config})
+	}
+	return
+}
+
+func (router *activityTrackerRouter) CreateRoute(ctx context.Context, route *RouteConfig) (id string, err error) {
+	result, _, err := router.client.CreateRouteWithContext(ctx, router.client.NewCreateRouteOptions(route.Name, atrackerRules(route)))
+	if err != nil {
+		return
+	}
+	id = core.StringNilMapper(result.ID)
+	return
+}
+
+func (router *activityTrackerRouter) UpdateRoute(ctx context.Context, id string, route *RouteConfig) (err error) {
+	_, _, err = router.client.ReplaceRouteWithContext(ctx, router.client.NewReplaceRouteOptions(id, route.Name, atrackerRules(route)))
+	return
+}
+
+func atrackerRules(route *RouteConfig) []atrackerv2.RulePrototype {
+	rules := make([]atrackerv2.RulePrototype, 0, len(route.Rules))
+	for _, rule := range route.Rules {
+		rules = append(rules, atrackerv2.RulePrototype{TargetIds: rule.Targets, Locations: rule.Locations})
+	}
+	return rules
+}
+
+func (router *activityTrackerRouter) DeleteRoute(ctx context.Context, id string) (err error) {
+	_, err = router.client.DeleteRouteWithContext(ctx, router.client.NewDeleteRouteOptions(id))
+	return
+}
+
+func (router *activityTrackerRouter) GetSettings(ctx context.Context) (settings *SettingsConfig, err error) {
+	result, _, err := router.client.GetSettingsWithContext(ctx, router.client.NewGetSettingsOptions())
+	if err != nil {
+		return
+	}
+	settings = &SettingsConfig{
+		DefaultTargets:         result.DefaultTargets,
+		PermittedTargetRegions: result.PermittedTargetRegions,
+		PrimaryMetadataRegion:  core.StringNilMapper(result.MetadataRegionPrimary),
+		BackupMetadataRegion:   core.StringNilMapper(result.MetadataRegionBackup),
+		PrivateAPIEndpointOnly: result.PrivateAPIEndpointOnly,
+	}
+	return
+}
+
+// UpdateSettings replaces the settings, so that fields not set in "settings" keep their current value.
+func (router *activityTrackerRouter) UpdateSettings(ctx context.Context, settings *SettingsConfig) (err error) {
+	current, err := router.GetSettings(ctx)
+	if err != nil {
+		return
+	}
+	merged := mergeSettings(current, settings)
+	options := router.client.NewPutSettingsOptions(merged.PrimaryMetadataRegion, merged.PrivateAPIEndpointOnly != nil && *merged.PrivateAPIEndpointOnly)
+	options.DefaultTargets = merged.DefaultTargets
+	options.PermittedTargetRegions = merged.PermittedTargetRegions
+	if merged.BackupMetadataRegion != "" {
+		options.SetMetadataRegionBackup(merged.BackupMetadataRegion)
+	}
+	_, _, err = router.client.PutSettingsWithContext(ctx, options)
+	return
+}
+
+//
+// Logs router
+//
+
+type logsRouter struct {
+	client *logsrouterv3.LogsRouterV3
+}
+
+// NewLogsRouter returns the Router for a logs router client.
+func NewLogsRouter(client *logsrouterv3.LogsRouterV3) Router {
+	return &logsRouter{client: client}
+}
+
+func (router *logsRouter) Kind() string {
+	return RouterLogsConst
+}
+
+func (router *logsRouter) ListTargets(ctx context.Context) (targets []LiveTarget, err error) {
+	result, _, err := router.client.ListTargetsWithContext(ctx, router.client.NewListTargetsOptions())
+	if err != nil {
+		return
+	}
+	targets = []LiveTarget{}
+	for _, target := range result.Targets {
+		targets = append(targets, LiveTarget{
+			ID: core.StringNilMapper(target.ID),
+			Config: TargetConfig{
+				Name:           core.StringNilMapper(target.Name),
+				Type:           core.StringNilMapper(target.TargetType),
+				DestinationCRN: core.StringNilMapper(target.DestinationCRN),
+				Region:         core.StringNilMapper(target.Region),
+			},
+		})
+	}
+	return
+}
+
+func (router *logsRouter) CreateTarget(ctx context.Context, target *TargetConfig) (id string, err error) {
+	options := router.client.NewCreateTargetOptions(target.Name, target.DestinationCRN)
+	if target.Region != "" {
+		options.SetRegion(target.Region)
+	}
+	result, _, err := router.client.CreateTargetWithContext(ctx, options)
+	if err != nil {
+		return
+	}
+	id = core.StringNilMapper(result.ID)
+	return
+}
+
+func (router *logsRouter) UpdateTarget(ctx context.Context, id string, target *TargetConfig) (err error) {
+	options := router.client.NewUpdateTargetOptions(id).SetName(target.Name).SetDestinationCRN(target.DestinationCRN)
+	_, _, err = router.client.UpdateTargetWithContext(ctx, options)
+	return
+}
+
+func (router *logsRouter) DeleteTarget(ctx context.Context, id string) (err error) {
+	_, err = router.client.DeleteTargetWithContext(ctx, router.client.NewDeleteTargetOptions(id))
+	return
+}
+
+func (router *logsRouter) ListRoutes(ctx context.Context) (routes []LiveRoute, err error) {
+	result, _, err := router.client.ListRoutesWithContext(ctx, router.client.NewListRoutesOptions())
+	if err != nil {
+		return
+	}
+	routes = []LiveRoute{}
+	for _, route := range result.Routes {
+		config := RouteConfig{Name: core.StringNilMapper(route.Name), Rules: []RuleConfig{}}
+		for _, rule := range route.Rules {
+			converted := RuleConfig{Action: core.StringNilMapper(rule.Action), Targets: []string{}, Filters: []FilterConfig{}}
+			for _, target := range rule.Targets {
+				converted.Targets = append(converted.Targets, core.StringNilMapper(target.ID))
+			}
+			for _, filter := range rule.InclusionFilters {
+				converted.Filters = append(converted.Filters, FilterConfig{
+					Operand:  core.StringNilMapper(filter.Operand),
+					Operator: core.StringNilMapper(filter.Operator),
+					Values:   filter.Values,
+				})
+			}
+			config.Rules = append(config.Rules, converted)
+		}
+		routes = append(routes, LiveRoute{ID: core.StringNilMapper(route.ID), Config: config})
+	}
+	return
+}
+
+func (router *logsRouter) CreateRoute(ctx context.Context, route *RouteConfig) (id string, err error) {
+	result, _, err := router.client.CreateRouteWithContext(ctx, router.client.NewCreateRouteOptions(route.Name, logsRules(route)))
+	if err != nil {
+		return
+	}
+	id = core.StringNilMapper(result.ID)
+	return
+}
+
+func (router *logsRouter) UpdateRoute(ctx context.Context, id string, route *RouteConfig) (err error) {
+	options := router.client.NewUpdateRouteOptions(id).SetName(route.Name).SetRules(logsRules(route))
+	_, _, err = router.client.UpdateRouteWithContext(ctx, options)
+	return
+}
+
+func logsRules(route *RouteConfig) []logsrouterv3.RulePrototype {
+	rules := make([]logsrouterv3.RulePrototype, 0, len(route.Rules))
+	for _, rule := range route.Rules {
+		prototype := logsrouterv3.RulePrototype{Targets: []logsrouterv3.TargetIdentity{}}
+		if rule.Action != "" {
+			prototype.Action = core.StringPtr(rule.Action)
+		}
+		for _, id := range rule.Targets {
+			prototype.Targets = append(prototype.Targets, logsrouterv3.TargetIdentity{ID: core.StringPtr(id)})
+		}
+		for _, filter := range rule.Filters {
+			prototype.InclusionFilters = append(prototype.InclusionFilters, logsrouterv3.InclusionFilterPrototype{
+				Operand:  core.StringPtr(filter.Operand),
+				Operator: core.StringPtr(filter.Operator),
+				Values:   filter.Values,
+			})
+		}
+		rules = append(rules, prototype)
+	}
+	return rules
+}
+
+func (router *logsRouter) DeleteRoute(ctx context.Context, id string) (err error) {
+	_, err = router.client.DeleteRouteWithContext(ctx, router.client.NewDeleteRouteOptions(id))
+	return
+}
+
+func (router *logsRouter) GetSettings(ctx context.Context) (settings *SettingsConfig, err error) {
+	result, _, err := router.client.GetSettingsWithContext(ctx, router.client.NewGetSettingsOptions())
+	if err != nil {
+		return
+	}
+	settings = &SettingsConfig{
+		DefaultTargets:         []string{},
+		PermittedTargetRegions: result.PermittedTargetRegions,
+		PrimaryMetadataRegion:  core.StringNilMapper(result.PrimaryMetadataRegion),
+		BackupMetadataRegion:   core.StringNilMapper(result.BackupMetadataRegion),
+		PrivateAPIEndpointOnly: result.PrivateAPIEndpointOnly,
+	}
+	for _, target := range result.DefaultTargets {
+		settings.DefaultTargets = append(settings.DefaultTargets, core.StringNilMapper(target.ID))
+	}
+	return
+}
+
+func (router *logsRouter) UpdateSettings(ctx context.Context, settings *SettingsConfig) (err error) {
+	options := router.client.NewUpdateSettingsOptions()
+	if settings.DefaultTargets != nil {
+		options.DefaultTargets = []logsrouterv3.TargetIdentity{}
+		for _, id := range settings.DefaultTargets {
+			options.DefaultTargets = append(options.DefaultTargets, logsrouterv3.TargetIdentity{ID: core.StringPtr(id)})
+		}
+	}
+	options.PermittedTargetRegions = settings.PermittedTargetRegions
+	if settings.PrimaryMetadataRegion != "" {
+		options.SetPrimaryMetadataRegion(settings.PrimaryMetadataRegion)
+	}
+	if settings.BackupMetadataRegion != "" {
+		options.SetBackupMetadataRegion(settings.BackupMetadataRegion)
+	}
+	options.PrivateAPIEndpointOnly = settings.PrivateAPIEndpointOnly
+	_, _, err = router.client.UpdateSettingsWithContext(ctx, options)
+	return
+}
+
+//
+// Metrics router
+//
+
+type metricsRouter struct {
+	client *metricsrouterv3.MetricsRouterV3
+}
+
+// NewMetricsRouter returns the Router for a metrics router client.
+func NewMetricsRouter(client *metricsrouterv3.MetricsRouterV3) Router {
+	return &metricsRouter{client: client}
+}
+
+func (router *metricsRouter) Kind() string {
+	return RouterMetricsConst
+}
+
+func (router *metricsRouter) ListTargets(ctx context.Context) (targets []LiveTarget, err error) {
+	result, _, err := router.client.ListTargetsWithContext(ctx, router.client.NewListTargetsOptions())
+	if err != nil {
+		return
+	}
+	targets = []LiveTarget{}
+	for _, target := range result.Targets {
+		targets = append(targets, LiveTarget{
+			ID: core.StringNilMapper(target.ID),
+			Config: TargetConfig{
+				Name:           core.StringNilMapper(target.Name),
+				Type:           core.StringNilMapper(target.TargetType),
+				DestinationCRN: core.StringNilMapper(target.DestinationCRN),
+				Region:         core.StringNilMapper(target.Region),
+			},
+		})
+	}
+	return
+}
+
+func (router *metricsRouter) CreateTarget(ctx context.Context, target *TargetConfig) (id string, err error) {
+	options := router.client.NewCreateTargetOptions(target.Name, target.DestinationCRN)
+	if target.Region != "" {
+		options.SetRegion(target.Region)
+	}
+	result, _, err := router.client.CreateTargetWithContext(ctx, options)
+	if err != nil {
+		return
+	}
+	id = core.StringNilMapper(result.ID)
+	return
+}
+
+func (router *metricsRouter) UpdateTarget(ctx context.Context, id string, target *TargetConfig) (err error) {
+	options := router.client.NewUpdateTargetOptions(id).SetName(target.Name).SetDestinationCRN(target.DestinationCRN)
+	_, _, err = router.client.UpdateTargetWithContext(ctx, options)
+	return
+}
+
+func (router *metricsRouter) DeleteTarget(ctx context.Context, id string) (err error) {
+	_, err = router.client.DeleteTargetWithContext(ctx, router.client.NewDeleteTargetOptions(id))
+	return
+}
+
+func (router *metricsRouter) ListRoutes(ctx context.Context) (routes []LiveRoute, err error) {
+	result, _, err := router.client.ListRoutesWithContext(ctx, router.client.NewListRoutesOptions())
+	if err != nil {
+		return
+	}
+	routes = []LiveRoute{}
+	for _, route := range result.Routes {
+		config := RouteConfig{Name: core.StringNilMapper(route.Name), Rules: []RuleConfig{}}
+		for _, rule := range route.Rules {
+			converted := RuleConfig{Action: core.StringNilMapper(rule.Action), Targets: []string{}, Filters: []FilterConfig{}}
+			for _, target := range rule.Targets {
+				converted.Targets = append(converted.Targets, core.StringNilMapper(target.ID))
+			}
+			for _, filter := range rule.InclusionFilters {
+				converted.Filters = append(converted.Filters, FilterConfig{
+					Operand:  core.StringNilMapper(filter.Operand),
+					Operator: core.StringNilMapper(filter.Operator),
+					Values:   filter.Values,
+				})
+			}
+			config.Rules = append(config.Rules, converted)
+		}
+		routes = append(routes, LiveRoute{ID: core.StringNilMapper(route.ID), Config: config})
+	}
+	return
+}
+
+func (router *metricsRouter) CreateRoute(ctx context.Context, route *RouteConfig) (id string, err error) {
+	result, _, err := router.client.CreateRouteWithContext(ctx, router.client.NewCreateRouteOptions(route.Name, metricsRules(route)))
+	if err != nil {
+		return
+	}
+	id = core.StringNilMapper(result.ID)
+	return
+}
+
+func (router *metricsRouter) UpdateRoute(ctx context.Context, id string, route *RouteConfig) (err error) {
+	options := router.client.NewUpdateRouteOptions(id).SetName(route.Name).SetRules(metricsRules(route))
+	_, _, err = router.client.UpdateRouteWithContext(ctx, options)
+	return
+}
+
+func metricsRules(route *RouteConfig) []metricsrouterv3.RulePrototype {
+	rules := make([]metricsrouterv3.RulePrototype, 0, len(route.Rules))
+	for _, rule := range route.Rules {
+		prototype := metricsrouterv3.RulePrototype{
+			Targets:          []metricsrouterv3.TargetIdentity{},
+			InclusionFilters: []metricsrouterv3.InclusionFilterPrototype{},
+		}
+		if rule.Action != "" {
+			prototype.Action = core.StringPtr(rule.Action)
+		}
+		for _, id := range rule.Targets {
+			prototype.Targets = append(prototype.Targets, metricsrouterv3.TargetIdentity{ID: core.StringPtr(id)})
+		}
+		for _, filter := range rule.Filters {
+			prototype.InclusionFilters = append(prototype.InclusionFilters, metricsrouterv3.InclusionFilterPrototype{
+				Operand:  core.StringPtr(filter.Operand),
+				Operator: core.StringPtr(filter.Operator),
+				Values:   filter.Values,
+			})
+		}
+		rules = append(rules, prototype)
+	}
+	return rules
+}
+
+func (router *metricsRouter) DeleteRoute(ctx context.Context, id string) (err error) {
+	_, err = router.client.DeleteRouteWithContext(ctx, router.client.NewDeleteRouteOptions(id))
+	return
+}
+
+func (router *metricsRouter) GetSettings(ctx context.Context) (settings *SettingsConfig, err error) {
+	result, _, err := router.client.GetSettingsWithContext(ctx, router.client.NewGetSettingsOptions())
+	if err != nil {
+		return
+	}
+	settings = &SettingsConfig{
+		DefaultTargets:         []string{},
+		PermittedTargetRegions: result.PermittedTargetRegions,
+		PrimaryMetadataRegion:  core.StringNilMapper(result.PrimaryMetadataRegion),
+		BackupMetadataRegion:   core.StringNilMapper(result.BackupMetadataRegion),
+		PrivateAPIEndpointOnly: result.PrivateAPIEndpointOnly,
+	}
+	for _, target := range result.DefaultTargets {
+		settings.DefaultTargets = append(settings.DefaultTargets, core.StringNilMapper(target.ID))
+	}
+	return
+}
+
+func (router *metricsRouter) UpdateSettings(ctx context.Context, settings *SettingsConfig) (err error) {
+	options := router.client.NewUpdateSettingsOptions()
+	if settings.DefaultTargets != nil {
+		options.DefaultTargets = []metricsrouterv3.TargetIdentity{}
+		for _, id := range settings.DefaultTargets {
+			options.DefaultTargets = append(options.DefaultTargets, metricsrouterv3.TargetIdentity{ID: core.StringPtr(id)})
+		}
+	}
+	options.PermittedTargetRegions = settings.PermittedTargetRegions
+	if settings.PrimaryMetadataRegion != "" {
+		options.SetPrimaryMetadataRegion(settings.PrimaryMetadataRegion)
+	}
+	if settings.BackupMetadataRegion != "" {
+		options.SetBackupMetadataRegion(settings.BackupMetadataRegion)
+	}
+	options.PrivateAPIEndpointOnly = settings.PrivateAPIEndpointOnly
+	_, _, err = router.client.UpdateSettingsWithContext(ctx, options)
+	return
+}
+
+// mergeSettings returns "current" overridden by the fields set in "desired".
+func mergeSettings(current *SettingsConfig, desired *SettingsConfig) *SettingsConfig {
+	merged := *current
+	if desired.DefaultTargets != nil {
+		merged.DefaultTargets = desired.DefaultTargets
+	}
+	if desired.PermittedTargetRegions != nil {
+		merged.PermittedTargetRegions = desired.PermittedTargetRegions
+	}
+	if desired.PrimaryMetadataRegion != "" {
+		merged.PrimaryMetadataRegion = desired.PrimaryMetadataRegion
+	}
+	if desired.BackupMetadataRegion != "" {
+		merged.BackupMetadataRegion = desired.BackupMetadataRegion
+	}
+	if desired.PrivateAPIEndpointOnly != nil {
+		merged.PrivateAPIEndpointOnly = desired.PrivateAPIEndpointOnly
+	}
+	return &merged
+}
+
+// NewRouterFactory returns a function that creates the Router of the specified kind for the public endpoint of a region,
+// suitable for Reconciler.RouterFor. Every client uses "authenticator".
+func NewRouterFactory(authenticator core.Authenticator) func(kind string, region string) (Router, error) {
+	return func(kind string, region string) (router Router, err error) {
+		switch kind {
+		case RouterActivityTrackerConst:
+			var url string
+			var client *atrackerv2.AtrackerV2
+			if url, err = atrackerv2.GetServiceURLForRegion(region); err == nil {
+				client, err = atrackerv2.NewAtrackerV2(&atrackerv2.AtrackerV2Options{URL: url, Authenticator: authenticator})
+			}
+			if err == nil {
+				router = NewActivityTrackerRouter(client)
+			}
+		case RouterLogsConst:
+			var url string
+			var client *logsrouterv3.LogsRouterV3
+			if url, err = logsrouterv3.GetServiceURLForRegion(region); err == nil {
+				client, err = logsrouterv3.NewLogsRouterV3(&logsrouterv3.LogsRouterV3Options{URL: url, Authenticator: authenticator})
+			}
+			if err == nil {
+				router = NewLogsRouter(client)
+			}
+		case RouterMetricsConst:
+			var url string
+			var client *metricsrouterv3.MetricsRouterV3
+			if url, err = metricsrouterv3.GetServiceURLForRegion(region); err == nil {
+				client, err = metricsrouterv3.NewMetricsRouterV3(&metricsrouterv3.MetricsRouterV3Options{URL: url, Authenticator: authenticator})
+			}
+			if err == nil {
+				router = NewMetricsRouter(client)
+			}
+		default:
+			err = fmt.Errorf("unknown router kind '%s'", kind)
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, "", "router-factory-error", common.GetComponentInfo())
+		}
+		return
+	}
+}
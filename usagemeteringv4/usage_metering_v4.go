@@ -185,7 +185,7 @@ func (usageMetering *UsageMeteringV4) ReportResourceUsageWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "usage_metering", "V4", "ReportResourceUsage"))
 	builder.EnableGzipCompression = usageMetering.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(usageMetering.Service.Options.URL, `/v4/metering/resources/{resource_id}/usage`, pathParamsMap)
 	if err != nil {
@@ -216,6 +216,8 @@ func (usageMetering *UsageMeteringV4) ReportResourceUsageWithContext(ctx context
 	var rawResponse map[string]json.RawMessage
 	response, err = usageMetering.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "report_resource_usage", core.NewProblemComponent(DefaultServiceName, "4.0.8"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalResponseAccepted)
@@ -0,0 +1,36 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package usagemeteringv4
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// UsageMeteringV4Intf : The operations of the UsageMeteringV4 service client.
+// The interface is satisfied by *UsageMeteringV4 and by mocks.UsageMeteringV4, allowing consumers to substitute a mock for the client in tests.
+type UsageMeteringV4Intf interface {
+	// ReportResourceUsage : Report Resource Controller resource usage
+	ReportResourceUsage(reportResourceUsageOptions *ReportResourceUsageOptions) (result *ResponseAccepted, response *core.DetailedResponse, err error)
+	// ReportResourceUsageWithContext is an alternate form of the ReportResourceUsage method which supports a Context parameter
+	ReportResourceUsageWithContext(ctx context.Context, reportResourceUsageOptions *ReportResourceUsageOptions) (result *ResponseAccepted, response *core.DetailedResponse, err error)
+}
+
+var _ UsageMeteringV4Intf = (*UsageMeteringV4)(nil)
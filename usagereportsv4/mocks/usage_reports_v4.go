@@ -0,0 +1,426 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the UsageReportsV4 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	"github.com/stretchr/testify/mock"
+)
+
+// UsageReportsV4 : A testify mock of the UsageReportsV4 service client.
+type UsageReportsV4 struct {
+	mock.Mock
+}
+
+var _ usagereportsv4.UsageReportsV4Intf = (*UsageReportsV4)(nil)
+
+// GetAccountSummary provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetAccountSummary(getAccountSummaryOptions *usagereportsv4.GetAccountSummaryOptions) (*usagereportsv4.AccountSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountSummaryOptions)
+	var r0 *usagereportsv4.AccountSummary
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.AccountSummary)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountSummaryWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetAccountSummaryWithContext(ctx context.Context, getAccountSummaryOptions *usagereportsv4.GetAccountSummaryOptions) (*usagereportsv4.AccountSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountSummaryOptions)
+	var r0 *usagereportsv4.AccountSummary
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.AccountSummary)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountUsage provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetAccountUsage(getAccountUsageOptions *usagereportsv4.GetAccountUsageOptions) (*usagereportsv4.AccountUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(getAccountUsageOptions)
+	var r0 *usagereportsv4.AccountUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.AccountUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetAccountUsageWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetAccountUsageWithContext(ctx context.Context, getAccountUsageOptions *usagereportsv4.GetAccountUsageOptions) (*usagereportsv4.AccountUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getAccountUsageOptions)
+	var r0 *usagereportsv4.AccountUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.AccountUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceGroupUsage provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetResourceGroupUsage(getResourceGroupUsageOptions *usagereportsv4.GetResourceGroupUsageOptions) (*usagereportsv4.ResourceGroupUsage, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getResourceGroupUsageOptions)
+	var r0 *usagereportsv4.ResourceGroupUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.ResourceGroupUsage)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceGroupUsageWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetResourceGroupUsageWithContext(ctx context.Context, getResourceGroupUsageOptions *usagereportsv4.GetResourceGroupUsageOptions) (*usagereportsv4.ResourceGroupUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceGroupUsageOptions)
+	var r0 *usagereportsv4.ResourceGroupUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.ResourceGroupUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceUsageAccount provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetResourceUsageAccount(getResourceUsageAccountOptions *usagereportsv4.GetResourceUsageAccountOptions) (*usagereportsv4.InstancesUsage, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getResourceUsageAccountOptions)
+	var r0 *usagereportsv4.InstancesUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.InstancesUsage)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceUsageAccountWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetResourceUsageAccountWithContext(ctx context.Context, getResourceUsageAccountOptions *usagereportsv4.GetResourceUsageAccountOptions) (*usagereportsv4.InstancesUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceUsageAccountOptions)
+	var r0 *usagereportsv4.InstancesUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.InstancesUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceUsageResourceGroup provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetResourceUsageResourceGroup(getResourceUsageResourceGroupOptions *usagereportsv4.GetResourceUsageResourceGroupOptions) (*usagereportsv4.InstancesUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceUsageResourceGroupOptions)
+	var r0 *usagereportsv4.InstancesUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.InstancesUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceUsageResourceGroupWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetResourceUsageResourceGroupWithContext(ctx context.Context, getResourceUsageResourceGroupOptions *usagereportsv4.GetResourceUsageResourceGroupOptions) (*usagereportsv4.InstancesUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceUsageResourceGroupOptions)
+	var r0 *usagereportsv4.InstancesUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.InstancesUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceUsageOrg provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetResourceUsageOrg(getResourceUsageOrgOptions *usagereportsv4.GetResourceUsageOrgOptions) (*usagereportsv4.InstancesUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(getResourceUsageOrgOptions)
+	var r0 *usagereportsv4.InstancesUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.InstancesUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetResourceUsageOrgWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetResourceUsageOrgWithContext(ctx context.Context, getResourceUsageOrgOptions *usagereportsv4.GetResourceUsageOrgOptions) (*usagereportsv4.InstancesUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getResourceUsageOrgOptions)
+	var r0 *usagereportsv4.InstancesUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.InstancesUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOrgUsage provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetOrgUsage(getOrgUsageOptions *usagereportsv4.GetOrgUsageOptions) (*usagereportsv4.OrgUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(getOrgUsageOptions)
+	var r0 *usagereportsv4.OrgUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.OrgUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetOrgUsageWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetOrgUsageWithContext(ctx context.Context, getOrgUsageOptions *usagereportsv4.GetOrgUsageOptions) (*usagereportsv4.OrgUsage, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getOrgUsageOptions)
+	var r0 *usagereportsv4.OrgUsage
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.OrgUsage)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateReportsSnapshotConfig provides a mock function with the given fields.
+func (_m *UsageReportsV4) CreateReportsSnapshotConfig(createReportsSnapshotConfigOptions *usagereportsv4.CreateReportsSnapshotConfigOptions) (*usagereportsv4.SnapshotConfig, *core.DetailedResponse, error) {
+	ret := _m.Called(createReportsSnapshotConfigOptions)
+	var r0 *usagereportsv4.SnapshotConfig
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotConfig)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// CreateReportsSnapshotConfigWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) CreateReportsSnapshotConfigWithContext(ctx context.Context, createReportsSnapshotConfigOptions *usagereportsv4.CreateReportsSnapshotConfigOptions) (*usagereportsv4.SnapshotConfig, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createReportsSnapshotConfigOptions)
+	var r0 *usagereportsv4.SnapshotConfig
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotConfig)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetReportsSnapshotConfig provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetReportsSnapshotConfig(getReportsSnapshotConfigOptions *usagereportsv4.GetReportsSnapshotConfigOptions) (*usagereportsv4.SnapshotConfig, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(getReportsSnapshotConfigOptions)
+	var r0 *usagereportsv4.SnapshotConfig
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotConfig)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetReportsSnapshotConfigWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetReportsSnapshotConfigWithContext(ctx context.Context, getReportsSnapshotConfigOptions *usagereportsv4.GetReportsSnapshotConfigOptions) (*usagereportsv4.SnapshotConfig, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportsSnapshotConfigOptions)
+	var r0 *usagereportsv4.SnapshotConfig
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotConfig)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateReportsSnapshotConfig provides a mock function with the given fields.
+func (_m *UsageReportsV4) UpdateReportsSnapshotConfig(updateReportsSnapshotConfigOptions *usagereportsv4.UpdateReportsSnapshotConfigOptions) (*usagereportsv4.SnapshotConfig, *core.DetailedResponse, error) {
+	ret := _m.Called(updateReportsSnapshotConfigOptions)
+	var r0 *usagereportsv4.SnapshotConfig
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotConfig)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateReportsSnapshotConfigWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) UpdateReportsSnapshotConfigWithContext(ctx context.Context, updateReportsSnapshotConfigOptions *usagereportsv4.UpdateReportsSnapshotConfigOptions) (*usagereportsv4.SnapshotConfig, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateReportsSnapshotConfigOptions)
+	var r0 *usagereportsv4.SnapshotConfig
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotConfig)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// DeleteReportsSnapshotConfig provides a mock function with the given fields.
+func (_m *UsageReportsV4) DeleteReportsSnapshotConfig(deleteReportsSnapshotConfigOptions *usagereportsv4.DeleteReportsSnapshotConfigOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteReportsSnapshotConfigOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// DeleteReportsSnapshotConfigWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) DeleteReportsSnapshotConfigWithContext(ctx context.Context, deleteReportsSnapshotConfigOptions *usagereportsv4.DeleteReportsSnapshotConfigOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteReportsSnapshotConfigOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// ValidateReportsSnapshotConfig provides a mock function with the given fields.
+func (_m *UsageReportsV4) ValidateReportsSnapshotConfig(validateReportsSnapshotConfigOptions *usagereportsv4.ValidateReportsSnapshotConfigOptions) (*usagereportsv4.SnapshotConfigValidateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(validateReportsSnapshotConfigOptions)
+	var r0 *usagereportsv4.SnapshotConfigValidateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotConfigValidateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ValidateReportsSnapshotConfigWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) ValidateReportsSnapshotConfigWithContext(ctx context.Context, validateReportsSnapshotConfigOptions *usagereportsv4.ValidateReportsSnapshotConfigOptions) (*usagereportsv4.SnapshotConfigValidateResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, validateReportsSnapshotConfigOptions)
+	var r0 *usagereportsv4.SnapshotConfigValidateResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotConfigValidateResponse)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetReportsSnapshot provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetReportsSnapshot(getReportsSnapshotOptions *usagereportsv4.GetReportsSnapshotOptions) (*usagereportsv4.SnapshotList, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportsSnapshotOptions)
+	var r0 *usagereportsv4.SnapshotList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetReportsSnapshotWithContext provides a mock function with the given fields.
+func (_m *UsageReportsV4) GetReportsSnapshotWithContext(ctx context.Context, getReportsSnapshotOptions *usagereportsv4.GetReportsSnapshotOptions) (*usagereportsv4.SnapshotList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportsSnapshotOptions)
+	var r0 *usagereportsv4.SnapshotList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usagereportsv4.SnapshotList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
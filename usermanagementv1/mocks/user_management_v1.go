@@ -0,0 +1,271 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+// Package mocks : Testify mocks of the UserManagementV1 service client.
+package mocks
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+	"github.com/stretchr/testify/mock"
+)
+
+// UserManagementV1 : A testify mock of the UserManagementV1 service client.
+type UserManagementV1 struct {
+	mock.Mock
+}
+
+var _ usermanagementv1.UserManagementV1Intf = (*UserManagementV1)(nil)
+
+// ListUsers provides a mock function with the given fields.
+func (_m *UserManagementV1) ListUsers(listUsersOptions *usermanagementv1.ListUsersOptions) (*usermanagementv1.UserList, *core.DetailedResponse, error) {
+	ret := _m.Called(listUsersOptions)
+	var r0 *usermanagementv1.UserList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usermanagementv1.UserList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// ListUsersWithContext provides a mock function with the given fields.
+func (_m *UserManagementV1) ListUsersWithContext(ctx context.Context, listUsersOptions *usermanagementv1.ListUsersOptions) (*usermanagementv1.UserList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listUsersOptions)
+	var r0 *usermanagementv1.UserList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usermanagementv1.UserList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// InviteUsers provides a mock function with the given fields.
+func (_m *UserManagementV1) InviteUsers(inviteUsersOptions *usermanagementv1.InviteUsersOptions) (*usermanagementv1.InvitedUserList, *core.DetailedResponse, error) {
+	ret := _m.Called(inviteUsersOptions)
+	var r0 *usermanagementv1.InvitedUserList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usermanagementv1.InvitedUserList)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// InviteUsersWithContext provides a mock function with the given fields.
+func (_m *UserManagementV1) InviteUsersWithContext(ctx context.Context, inviteUsersOptions *usermanagementv1.InviteUsersOptions) (*usermanagementv1.InvitedUserList, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, inviteUsersOptions)
+	var r0 *usermanagementv1.InvitedUserList
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usermanagementv1.InvitedUserList)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetUserProfile provides a mock function with the given fields.
+func (_m *UserManagementV1) GetUserProfile(getUserProfileOptions *usermanagementv1.GetUserProfileOptions) (*usermanagementv1.UserProfile, *core.DetailedResponse, error) {
+	ret := _m.Called(getUserProfileOptions)
+	var r0 *usermanagementv1.UserProfile
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usermanagementv1.UserProfile)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetUserProfileWithContext provides a mock function with the given fields.
+func (_m *UserManagementV1) GetUserProfileWithContext(ctx context.Context, getUserProfileOptions *usermanagementv1.GetUserProfileOptions) (*usermanagementv1.UserProfile, *core.
+	DetailedResponse, error) {
+	ret := _m.Called(ctx, getUserProfileOptions)
+	var r0 *usermanagementv1.UserProfile
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usermanagementv1.UserProfile)
+	}
+	var r1 *core.
+		DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.
+			DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateUserProfile provides a mock function with the given fields.
+func (_m *UserManagementV1) UpdateUserProfile(updateUserProfileOptions *usermanagementv1.UpdateUserProfileOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(updateUserProfileOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateUserProfileWithContext provides a mock function with the given fields.
+func (_m *UserManagementV1) UpdateUserProfileWithContext(ctx context.Context, updateUserProfileOptions *usermanagementv1.UpdateUserProfileOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateUserProfileOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// RemoveUser provides a mock function with the given fields.
+func (_m *UserManagementV1) RemoveUser(removeUserOptions *usermanagementv1.RemoveUserOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(removeUserOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// RemoveUserWithContext provides a mock function with the given fields.
+func (_m *UserManagementV1) RemoveUserWithContext(ctx context.Context, removeUserOptions *usermanagementv1.RemoveUserOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, removeUserOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// Accept provides a mock function with the given fields.
+func (_m *UserManagementV1) Accept(acceptOptions *usermanagementv1.AcceptOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(acceptOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// AcceptWithContext provides a mock function with the given fields.
+func (_m *UserManagementV1) AcceptWithContext(ctx context.Context, acceptOptions *usermanagementv1.AcceptOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, acceptOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// V3RemoveUser provides a mock function with the given fields.
+func (_m *UserManagementV1) V3RemoveUser(v3RemoveUserOptions *usermanagementv1.V3RemoveUserOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(v3RemoveUserOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// V3RemoveUserWithContext provides a mock function with the given fields.
+func (_m *UserManagementV1) V3RemoveUserWithContext(ctx context.Context, v3RemoveUserOptions *usermanagementv1.V3RemoveUserOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, v3RemoveUserOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// GetUserSettings provides a mock function with the given fields.
+func (_m *UserManagementV1) GetUserSettings(getUserSettingsOptions *usermanagementv1.GetUserSettingsOptions) (*usermanagementv1.UserSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(getUserSettingsOptions)
+	var r0 *usermanagementv1.UserSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usermanagementv1.UserSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// GetUserSettingsWithContext provides a mock function with the given fields.
+func (_m *UserManagementV1) GetUserSettingsWithContext(ctx context.Context, getUserSettingsOptions *usermanagementv1.GetUserSettingsOptions) (*usermanagementv1.UserSettings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getUserSettingsOptions)
+	var r0 *usermanagementv1.UserSettings
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*usermanagementv1.UserSettings)
+	}
+	var r1 *core.DetailedResponse
+	if v := ret.Get(1); v != nil {
+		r1 = v.(*core.DetailedResponse)
+	}
+	r2 := ret.Error(2)
+	return r0, r1, r2
+}
+
+// UpdateUserSettings provides a mock function with the given fields.
+func (_m *UserManagementV1) UpdateUserSettings(updateUserSettingsOptions *usermanagementv1.UpdateUserSettingsOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(updateUserSettingsOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
+
+// UpdateUserSettingsWithContext provides a mock function with the given fields.
+func (_m *UserManagementV1) UpdateUserSettingsWithContext(ctx context.Context, updateUserSettingsOptions *usermanagementv1.UpdateUserSettingsOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateUserSettingsOptions)
+	var r0 *core.DetailedResponse
+	if v := ret.Get(0); v != nil {
+		r0 = v.(*core.DetailedResponse)
+	}
+	r1 := ret.Error(1)
+	return r0, r1
+}
@@ -187,7 +187,7 @@ func (userManagement *UserManagementV1) ListUsersWithContext(ctx context.Context
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "user_management", "V1", "ListUsers"))
 	builder.EnableGzipCompression = userManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(userManagement.Service.Options.URL, `/v2/accounts/{account_id}/users`, pathParamsMap)
 	if err != nil {
@@ -228,6 +228,8 @@ func (userManagement *UserManagementV1) ListUsersWithContext(ctx context.Context
 	var rawResponse map[string]json.RawMessage
 	response, err = userManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "list_users", core.NewProblemComponent(DefaultServiceName, "1.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -271,7 +273,7 @@ func (userManagement *UserManagementV1) InviteUsersWithContext(ctx context.Conte
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "user_management", "V1", "InviteUsers"))
 	builder.EnableGzipCompression = userManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(userManagement.Service.Options.URL, `/v2/accounts/{account_id}/users`, pathParamsMap)
 	if err != nil {
@@ -312,6 +314,8 @@ func (userManagement *UserManagementV1) InviteUsersWithContext(ctx context.Conte
 	var rawResponse map[string]json.RawMessage
 	response, err = userManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "invite_users", core.NewProblemComponent(DefaultServiceName, "1.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -350,7 +354,7 @@ func (userManagement *UserManagementV1) GetUserProfileWithContext(ctx context.Co
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "user_management", "V1", "GetUserProfile"))
 	builder.EnableGzipCompression = userManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(userManagement.Service.Options.URL, `/v2/accounts/{account_id}/users/{iam_id}`, pathParamsMap)
 	if err != nil {
@@ -379,6 +383,8 @@ func (userManagement *UserManagementV1) GetUserProfileWithContext(ctx context.Co
 	var rawResponse map[string]json.RawMessage
 	response, err = userManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_user_profile", core.NewProblemComponent(DefaultServiceName, "1.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -420,7 +426,7 @@ func (userManagement *UserManagementV1) UpdateUserProfileWithContext(ctx context
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "user_management", "V1", "UpdateUserProfile"))
 	builder.EnableGzipCompression = userManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(userManagement.Service.Options.URL, `/v2/accounts/{account_id}/users/{iam_id}`, pathParamsMap)
 	if err != nil {
@@ -475,6 +481,10 @@ func (userManagement *UserManagementV1) UpdateUserProfileWithContext(ctx context
 
 	response, err = userManagement.Service.Request(request, nil)
 
+	if err != nil {
+		core.EnrichHTTPProblem(err, "update_user_profile", core.NewProblemComponent(DefaultServiceName, "1.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
+	}
 	return
 }
 
@@ -504,7 +514,7 @@ func (userManagement *UserManagementV1) RemoveUserWithContext(ctx context.Contex
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "user_management", "V1", "RemoveUser"))
 	builder.EnableGzipCompression = userManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(userManagement.Service.Options.URL, `/v2/accounts/{account_id}/users/{iam_id}`, pathParamsMap)
 	if err != nil {
@@ -531,6 +541,10 @@ func (userManagement *UserManagementV1) RemoveUserWithContext(ctx context.Contex
 
 	response, err = userManagement.Service.Request(request, nil)
 
+	if err != nil {
+		core.EnrichHTTPProblem(err, "remove_user", core.NewProblemComponent(DefaultServiceName, "1.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
+	}
 	return
 }
 
@@ -550,7 +564,7 @@ func (userManagement *UserManagementV1) AcceptWithContext(ctx context.Context, a
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "user_management", "V1", "Accept"))
 	builder.EnableGzipCompression = userManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(userManagement.Service.Options.URL, `/v2/users/accept`, nil)
 	if err != nil {
@@ -583,6 +597,10 @@ func (userManagement *UserManagementV1) AcceptWithContext(ctx context.Context, a
 
 	response, err = userManagement.Service.Request(request, nil)
 
+	if err != nil {
+		core.EnrichHTTPProblem(err, "accept", core.NewProblemComponent(DefaultServiceName, "1.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
+	}
 	return
 }
 
@@ -613,7 +631,7 @@ func (userManagement *UserManagementV1) V3RemoveUserWithContext(ctx context.Cont
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "user_management", "V1", "V3RemoveUser"))
 	builder.EnableGzipCompression = userManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(userManagement.Service.Options.URL, `/v3/accounts/{account_id}/users/{iam_id}`, pathParamsMap)
 	if err != nil {
@@ -636,6 +654,10 @@ func (userManagement *UserManagementV1) V3RemoveUserWithContext(ctx context.Cont
 
 	response, err = userManagement.Service.Request(request, nil)
 
+	if err != nil {
+		core.EnrichHTTPProblem(err, "v3_remove_user", core.NewProblemComponent(DefaultServiceName, "1.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
+	}
 	return
 }
 
@@ -669,7 +691,7 @@ func (userManagement *UserManagementV1) GetUserSettingsWithContext(ctx context.C
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "user_management", "V1", "GetUserSettings"))
 	builder.EnableGzipCompression = userManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(userManagement.Service.Options.URL, `/v2/accounts/{account_id}/users/{iam_id}/settings`, pathParamsMap)
 	if err != nil {
@@ -694,6 +716,8 @@ func (userManagement *UserManagementV1) GetUserSettingsWithContext(ctx context.C
 	var rawResponse map[string]json.RawMessage
 	response, err = userManagement.Service.Request(request, &rawResponse)
 	if err != nil {
+		core.EnrichHTTPProblem(err, "get_user_settings", core.NewProblemComponent(DefaultServiceName, "1.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -733,7 +757,7 @@ func (userManagement *UserManagementV1) UpdateUserSettingsWithContext(ctx contex
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(common.WithOperation(ctx, "user_management", "V1", "UpdateUserSettings"))
 	builder.EnableGzipCompression = userManagement.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(userManagement.Service.Options.URL, `/v2/accounts/{account_id}/users/{iam_id}/settings`, pathParamsMap)
 	if err != nil {
@@ -775,6 +799,10 @@ func (userManagement *UserManagementV1) UpdateUserSettingsWithContext(ctx contex
 
 	response, err = userManagement.Service.Request(request, nil)
 
+	if err != nil {
+		core.EnrichHTTPProblem(err, "update_user_settings", core.NewProblemComponent(DefaultServiceName, "1.0"))
+		err = core.SDKErrorf(common.ClassifyError(err), "", "http-request-err", common.GetComponentInfo())
+	}
 	return
 }
 
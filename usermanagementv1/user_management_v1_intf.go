@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by ifacegen. DO NOT EDIT.
+
+package usermanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// UserManagementV1Intf : The operations of the UserManagementV1 service client.
+// The interface is satisfied by *UserManagementV1 and by mocks.UserManagementV1, allowing consumers to substitute a mock for the client in tests.
+type UserManagementV1Intf interface {
+	// ListUsers : List users
+	ListUsers(listUsersOptions *ListUsersOptions) (result *UserList, response *core.DetailedResponse, err error)
+	// ListUsersWithContext is an alternate form of the ListUsers method which supports a Context parameter
+	ListUsersWithContext(ctx context.Context, listUsersOptions *ListUsersOptions) (result *UserList, response *core.DetailedResponse, err error)
+	// InviteUsers : Invite users to an account
+	InviteUsers(inviteUsersOptions *InviteUsersOptions) (result *InvitedUserList, response *core.DetailedResponse, err error)
+	// InviteUsersWithContext is an alternate form of the InviteUsers method which supports a Context parameter
+	InviteUsersWithContext(ctx context.Context, inviteUsersOptions *InviteUsersOptions) (result *InvitedUserList, response *core.DetailedResponse, err error)
+	// GetUserProfile : Get user profile
+	GetUserProfile(getUserProfileOptions *GetUserProfileOptions) (result *UserProfile, response *core.DetailedResponse, err error)
+	// GetUserProfileWithContext is an alternate form of the GetUserProfile method which supports a Context parameter
+	GetUserProfileWithContext(ctx context.Context, getUserProfileOptions *GetUserProfileOptions) (result *UserProfile, response *core.DetailedResponse, err error)
+	// UpdateUserProfile : Partially update user profile
+	UpdateUserProfile(updateUserProfileOptions *UpdateUserProfileOptions) (response *core.DetailedResponse, err error)
+	// UpdateUserProfileWithContext is an alternate form of the UpdateUserProfile method which supports a Context parameter
+	UpdateUserProfileWithContext(ctx context.Context, updateUserProfileOptions *UpdateUserProfileOptions) (response *core.DetailedResponse, err error)
+	// RemoveUser : Remove user from account
+	RemoveUser(removeUserOptions *RemoveUserOptions) (response *core.DetailedResponse, err error)
+	// RemoveUserWithContext is an alternate form of the RemoveUser method which supports a Context parameter
+	RemoveUserWithContext(ctx context.Context, removeUserOptions *RemoveUserOptions) (response *core.DetailedResponse, err error)
+	// Accept : Accept an invitation
+	Accept(acceptOptions *AcceptOptions) (response *core.DetailedResponse, err error)
+	// AcceptWithContext is an alternate form of the Accept method which supports a Context parameter
+	AcceptWithContext(ctx context.Context, acceptOptions *AcceptOptions) (response *core.DetailedResponse, err error)
+	// V3RemoveUser : Remove user from account (Asynchronous)
+	V3RemoveUser(v3RemoveUserOptions *V3RemoveUserOptions) (response *core.DetailedResponse, err error)
+	// V3RemoveUserWithContext is an alternate form of the V3RemoveUser method which supports a Context parameter
+	V3RemoveUserWithContext(ctx context.Context, v3RemoveUserOptions *V3RemoveUserOptions) (response *core.DetailedResponse, err error)
+	// GetUserSettings : Get user settings
+	GetUserSettings(getUserSettingsOptions *GetUserSettingsOptions) (result *UserSettings, response *core.DetailedResponse, err error)
+	// GetUserSettingsWithContext is an alternate form of the GetUserSettings method which supports a Context parameter
+	GetUserSettingsWithContext(ctx context.Context, getUserSettingsOptions *GetUserSettingsOptions) (result *UserSettings, response *core.DetailedResponse, err error)
+	// UpdateUserSettings : Partially update user settings
+	UpdateUserSettings(updateUserSettingsOptions *UpdateUserSettingsOptions) (response *core.DetailedResponse, err error)
+	// UpdateUserSettingsWithContext is an alternate form of the UpdateUserSettings method which supports a Context parameter
+	UpdateUserSettingsWithContext(ctx context.Context, updateUserSettingsOptions *UpdateUserSettingsOptions) (response *core.DetailedResponse, err error)
+}
+
+var _ UserManagementV1Intf = (*UserManagementV1)(nil)